@@ -8,6 +8,10 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
 	"syscall"
 	"time"
 
@@ -24,6 +28,7 @@ import (
 	"VDM2-BankBE/internal/repository"
 	"VDM2-BankBE/internal/router"
 	"VDM2-BankBE/internal/service"
+	"VDM2-BankBE/internal/worker"
 	"VDM2-BankBE/pkg/cache"
 	"VDM2-BankBE/pkg/oauth"
 	
@@ -75,16 +80,42 @@ func main() {
 	// Initialize repositories
 	userRepo := repository.NewGormUserRepository(db)
 	accountRepo := repository.NewGormAccountRepository(db)
+	cosignerRepo := repository.NewGormCosignerRepository(db)
 	movementRepo := repository.NewGormMovementRepository(db)
+	holdRepo := repository.NewGormHoldRepository(db)
 	oauthTokenRepo := repository.NewGormOAuthTokenRepository(db)
 	transferRepo := repository.NewGormTransferRepository(db)
+	transferApprovalRepo := repository.NewGormTransferApprovalRepository(db)
+	transferOutboxRepo := repository.NewGormTransferOutboxRepository(db)
+	transferBatchRepo := repository.NewGormTransferBatchRepository(db)
+	transferLimitRepo := repository.NewGormTransferLimitRepository(db)
+	fxRepo := repository.NewGormFXRepository(db)
+	beneficiaryRepo := repository.NewGormBeneficiaryRepository(db)
+	paymentRequestRepo := repository.NewGormPaymentRequestRepository(db)
+	standingOrderRepo := repository.NewGormStandingOrderRepository(db)
+	ledgerRepo := repository.NewGormLedgerRepository(db)
+	reconciliationRepo := repository.NewGormReconciliationRepository(db)
+	idempotencyKeyRepo := repository.NewGormIdempotencyKeyRepository(db)
 
 	repos := repository.NewRepository(
 		userRepo,
 		accountRepo,
+		cosignerRepo,
 		movementRepo,
+		holdRepo,
 		oauthTokenRepo,
 		transferRepo,
+		transferApprovalRepo,
+		transferOutboxRepo,
+		transferBatchRepo,
+		transferLimitRepo,
+		fxRepo,
+		beneficiaryRepo,
+		paymentRequestRepo,
+		standingOrderRepo,
+		ledgerRepo,
+		reconciliationRepo,
+		idempotencyKeyRepo,
 	)
 
 	// Initialize OAuth client
@@ -100,50 +131,193 @@ func main() {
 		cfg,
 	)
 
-	accountService := service.NewAccountService(
-		repos.Account,
-		redisClient,
+	ledgerService := service.NewLedgerService(
+		repos.Ledger,
+	)
+
+	feeService := service.NewFeeService(
+		repos.FX,
+		&cfg.Fees,
 	)
 
 	movementService := service.NewMovementService(
 		repos.Movement,
 		repos.Account,
+		repos.Transfer,
+		repos.User,
+		ledgerService,
+		feeService,
 		redisClient,
+		db,
 	)
 
+	holdService := service.NewHoldService(
+		repos.Hold,
+		repos.Account,
+		repos.Movement,
+		ledgerService,
+		redisClient,
+		db,
+		&cfg.Holds,
+	)
+
+	transferLimitService := service.NewTransferLimitService(
+		repos.TransferLimit,
+		repos.Transfer,
+		repos.Beneficiary,
+		repos.FX,
+		&cfg.Limits,
+		&cfg.Beneficiaries,
+	)
+
+	fxService := service.NewFXService(
+		repos.FX,
+		&cfg.FX,
+	)
+
+	// Load the exchange rates of the rates file, if any; rates set since through the API are kept unless listed
+	if cfg.FX.RatesFile != "" {
+		entries, err := config.LoadFXRates(cfg.FX.RatesFile)
+		if err != nil {
+			logger.Fatal("Failed to load FX rates", zap.Error(err))
+		}
+		updates := make([]service.FXRateUpdate, 0, len(entries))
+		for _, entry := range entries {
+			updates = append(updates, service.FXRateUpdate{
+				BaseCurrency:  entry.Base,
+				QuoteCurrency: entry.Quote,
+				Rate:          entry.Rate,
+			})
+		}
+		if _, err := fxService.SetRates(context.Background(), updates); err != nil {
+			logger.Fatal("Failed to save FX rates", zap.Error(err))
+		}
+		logger.Info("Loaded FX rates", zap.Int("count", len(updates)), zap.String("file", cfg.FX.RatesFile))
+	}
+
 	transferService := service.NewTransferService(
 		repos.Transfer,
+		repos.TransferApproval,
+		repos.TransferOutbox,
 		repos.Account,
+		repos.User,
+		repos.Cosigner,
 		repos.Movement,
+		ledgerService,
+		transferLimitService,
+		feeService,
+		repos.FX,
+		redisClient,
+		db,
+		&cfg.Approvals,
+		&cfg.Scheduler.Outbox,
+	)
+
+	transferBatchService := service.NewTransferBatchService(
+		repos.TransferBatch,
+		repos.Account,
+		repos.Hold,
+		transferService,
+		transferLimitService,
+		feeService,
+		db,
+		&cfg.TransferBatches,
+	)
+
+	beneficiaryService := service.NewBeneficiaryService(
+		repos.Beneficiary,
+		repos.Account,
+	)
+
+	paymentRequestService := service.NewPaymentRequestService(
+		repos.PaymentRequest,
+		repos.User,
+		transferService,
+		db,
+		&cfg.PaymentRequests,
+	)
+
+	accountService := service.NewAccountService(
+		repos.Account,
+		repos.Cosigner,
+		repos.User,
+		transferService,
 		redisClient,
+	)
+
+	standingOrderService := service.NewStandingOrderService(
+		repos.StandingOrder,
+		repos.Account,
+		transferService,
 		db,
+		&cfg.Scheduler.StandingOrders,
+	)
+
+	reconciliationService := service.NewReconciliationService(
+		repos.Reconciliation,
+		&cfg.Scheduler.Reconciliation,
+	)
+
+	idempotencyService := service.NewIdempotencyService(
+		repos.IdempotencyKey,
+		redisClient,
+		cfg.Security.Idempotency.TTL,
 	)
 
 	services := service.NewService(
 		authService,
 		accountService,
+		ledgerService,
 		movementService,
+		holdService,
 		transferService,
+		feeService,
+		fxService,
+		transferBatchService,
+		transferLimitService,
+		beneficiaryService,
+		paymentRequestService,
+		standingOrderService,
+		reconciliationService,
+		idempotencyService,
 	)
 
 	// Initialize handlers
 	authHandler := handler.NewAuthHandler(services.Auth)
 	accountHandler := handler.NewAccountHandler(services.Account)
 	movementHandler := handler.NewMovementHandler(services.Movement, services.Account)
-	transferHandler := handler.NewTransferHandler(services.Transfer, services.Account)
+	holdHandler := handler.NewHoldHandler(services.Hold, services.Account)
+	transferHandler := handler.NewTransferHandler(services.Transfer, services.Account, services.Beneficiary)
+	transferBatchHandler := handler.NewTransferBatchHandler(services.TransferBatch, services.Account)
+	transferLimitHandler := handler.NewTransferLimitHandler(services.TransferLimit)
+	fxHandler := handler.NewFXHandler(services.FX)
+	feeHandler := handler.NewFeeHandler(services.Fee, services.Account)
+	beneficiaryHandler := handler.NewBeneficiaryHandler(services.Beneficiary)
+	paymentRequestHandler := handler.NewPaymentRequestHandler(services.PaymentRequest, services.Account)
+	standingOrderHandler := handler.NewStandingOrderHandler(services.StandingOrder, services.Account)
 
 	// Initialize middleware
 	authMiddleware := middleware.NewAuthMiddleware(services.Auth, logger)
 	rateLimitMiddleware := middleware.NewRateLimitMiddleware(redisClient, &cfg.Security.RateLimit, logger)
+	idempotencyMiddleware := middleware.NewIdempotencyMiddleware(services.Idempotency, logger)
 
 	// Setup router
 	r := router.NewRouter(
 		authHandler,
 		accountHandler,
 		movementHandler,
+		holdHandler,
 		transferHandler,
+		transferBatchHandler,
+		transferLimitHandler,
+		beneficiaryHandler,
+		paymentRequestHandler,
+		standingOrderHandler,
+		fxHandler,
+		feeHandler,
 		authMiddleware,
 		rateLimitMiddleware,
+		idempotencyMiddleware,
 		logger,
 	)
 
@@ -167,6 +341,36 @@ func main() {
 	// Register metrics
 	registerMetrics()
 
+	// Start background jobs
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+
+	scheduledTransferExecutor := worker.NewScheduledTransferExecutor(services.Transfer, &cfg.Scheduler, logger)
+	go scheduledTransferExecutor.Start(jobsCtx)
+
+	standingOrderScheduler := worker.NewStandingOrderScheduler(services.StandingOrder, &cfg.Scheduler, logger)
+	go standingOrderScheduler.Start(jobsCtx)
+
+	transferBatchProcessor := worker.NewTransferBatchProcessor(services.TransferBatch, &cfg.Scheduler, logger)
+	go transferBatchProcessor.Start(jobsCtx)
+
+	holdExpirer := worker.NewHoldExpirer(services.Hold, &cfg.Scheduler, logger)
+	go holdExpirer.Start(jobsCtx)
+
+	paymentRequestExpirer := worker.NewPaymentRequestExpirer(services.PaymentRequest, &cfg.Scheduler, logger)
+	go paymentRequestExpirer.Start(jobsCtx)
+
+	transferOutboxProcessor := worker.NewTransferOutboxProcessor(services.Transfer, &cfg.Scheduler.Outbox, logger)
+	go transferOutboxProcessor.Start(jobsCtx)
+
+	transferApprovalExpirer := worker.NewTransferApprovalExpirer(services.Transfer, &cfg.Scheduler, logger)
+	go transferApprovalExpirer.Start(jobsCtx)
+
+	if cfg.Scheduler.Reconciliation.Enabled {
+		reconciler := worker.NewReconciler(services.Reconciliation, &cfg.Scheduler.Reconciliation, logger)
+		go reconciler.Start(jobsCtx)
+	}
+
 	// Wait for interrupt signal to gracefully shut down the server
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
@@ -174,6 +378,9 @@ func main() {
 
 	logger.Info("Shutting down server...")
 
+	// Stop background jobs before draining requests
+	stopJobs()
+
 	// Create a deadline to wait for current operations to complete
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
@@ -213,7 +420,32 @@ func connectToDatabase(cfg config.DBConfig, logger *zap.Logger) (*gorm.DB, error
 	return db, nil
 }
 
-// migrateDatabase automatically creates or updates the database schema based on GORM models
+// requiredTables lists the tables that must exist once all migrations have been applied
+var requiredTables = []string{
+	"users",
+	"accounts",
+	"movements",
+	"oauth_tokens",
+	"transfers",
+	"idempotency_keys",
+	"standing_orders",
+	"system_accounts",
+	"journal_entries",
+	"postings",
+	"holds",
+	"transfer_limits",
+	"beneficiaries",
+	"payment_requests",
+	"transfer_batches",
+	"transfer_batch_items",
+	"fx_rates",
+	"fx_quotes",
+	"account_cosigners",
+	"transfer_approvals",
+	"transfer_outbox",
+}
+
+// migrateDatabase applies pending SQL migrations and verifies the resulting schema
 func migrateDatabase(db *gorm.DB, logger *zap.Logger) error {
 	// Enable PostgreSQL-specific extensions
 	logger.Info("Setting up PostgreSQL extensions")
@@ -223,74 +455,47 @@ func migrateDatabase(db *gorm.DB, logger *zap.Logger) error {
 	}
 	logger.Info("PostgreSQL extensions setup complete")
 
-	// First check if tables exist
-	var tableCount int
-	if err := db.Raw("SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = 'public' AND table_type = 'BASE TABLE'").Scan(&tableCount).Error; err != nil {
-		logger.Error("Failed to check existing tables", zap.Error(err))
-		return fmt.Errorf("failed to check existing tables: %w", err)
+	// Track applied migrations so that new files can be rolled out to existing databases
+	if err := db.Exec(`
+		CREATE TABLE IF NOT EXISTS schema_migrations (
+			version BIGINT PRIMARY KEY,
+			applied_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+		)
+	`).Error; err != nil {
+		logger.Error("Failed to create schema_migrations table", zap.Error(err))
+		return fmt.Errorf("failed to create schema_migrations table: %w", err)
 	}
 
-	logger.Info("Current database state", zap.Int("existing_tables", tableCount))
-
-	// If tables already exist, verify they're the ones we need and skip migration
-	if tableCount > 0 {
-		tables := []string{"users", "accounts", "movements", "oauth_tokens", "transfers"}
-		allTablesExist := true
-
-		for _, table := range tables {
-			var exists bool
-			checkTableSQL := `
-				SELECT EXISTS (
-					SELECT FROM information_schema.tables 
-					WHERE table_schema = 'public' 
-					AND table_name = $1
-				)
-			`
-			if err := db.Raw(checkTableSQL, table).Scan(&exists).Error; err != nil {
-				logger.Error("Failed to check if table exists",
-					zap.String("table", table),
-					zap.Error(err))
-				allTablesExist = false
-				break
-			} else if !exists {
-				logger.Warn("Required table does not exist",
-					zap.String("table", table))
-				allTablesExist = false
-				break
-			} else {
-				logger.Info("Table verified", zap.String("table", table))
-			}
+	// Databases created before migrations were tracked already contain the initial schema
+	var appliedCount int64
+	if err := db.Raw("SELECT COUNT(*) FROM schema_migrations").Scan(&appliedCount).Error; err != nil {
+		logger.Error("Failed to count applied migrations", zap.Error(err))
+		return fmt.Errorf("failed to count applied migrations: %w", err)
+	}
+	if appliedCount == 0 {
+		usersExists, err := tableExists(db, "users")
+		if err != nil {
+			logger.Error("Failed to check if table exists", zap.String("table", "users"), zap.Error(err))
+			return fmt.Errorf("failed to check existing tables: %w", err)
 		}
-
-		if allTablesExist {
-			logger.Info("All required tables already exist, skipping migration")
-			return nil
-		} else {
-			logger.Warn("Some required tables are missing, will attempt SQL migration")
+		if usersExists {
+			logger.Info("Existing schema detected, recording initial migration as applied")
+			if err := db.Exec("INSERT INTO schema_migrations (version) VALUES (1)").Error; err != nil {
+				return fmt.Errorf("failed to record initial migration: %w", err)
+			}
 		}
 	}
 
-	// If no tables exist or required tables are missing, try using SQL migrations
-	logger.Info("Database requires setup, attempting to run SQL migrations")
+	logger.Info("Running pending SQL migrations")
 	if err := runSQLMigrations(db, logger); err != nil {
 		logger.Error("SQL migrations failed", zap.Error(err))
 		return fmt.Errorf("failed to run SQL migrations: %w", err)
 	}
 
-	logger.Info("SQL migrations completed successfully")
-
 	// Verify all tables were created
-	tables := []string{"users", "accounts", "movements", "oauth_tokens", "transfers"}
-	for _, table := range tables {
-		var exists bool
-		checkTableSQL := `
-			SELECT EXISTS (
-				SELECT FROM information_schema.tables 
-				WHERE table_schema = 'public' 
-				AND table_name = $1
-			)
-		`
-		if err := db.Raw(checkTableSQL, table).Scan(&exists).Error; err != nil {
+	for _, table := range requiredTables {
+		exists, err := tableExists(db, table)
+		if err != nil {
 			logger.Error("Failed to check if table exists",
 				zap.String("table", table),
 				zap.Error(err))
@@ -308,28 +513,73 @@ func migrateDatabase(db *gorm.DB, logger *zap.Logger) error {
 	return nil
 }
 
-// runSQLMigrations runs the SQL migration files directly
+// tableExists reports whether a table exists in the public schema
+func tableExists(db *gorm.DB, table string) (bool, error) {
+	var exists bool
+	checkTableSQL := `
+		SELECT EXISTS (
+			SELECT FROM information_schema.tables 
+			WHERE table_schema = 'public' 
+			AND table_name = $1
+		)
+	`
+	if err := db.Raw(checkTableSQL, table).Scan(&exists).Error; err != nil {
+		return false, err
+	}
+	return exists, nil
+}
+
+// runSQLMigrations runs the pending `migrations/*.up.sql` files in version order
 func runSQLMigrations(db *gorm.DB, logger *zap.Logger) error {
 	logger.Info("Running SQL migrations from files")
 
-	// Run the initial schema migration script
-	migrationPath := "migrations/000001_initial_schema.up.sql"
-	logger.Info("Reading migration file", zap.String("path", migrationPath))
-
-	// Read the migration file
-	content, err := os.ReadFile(migrationPath)
+	files, err := filepath.Glob("migrations/*.up.sql")
 	if err != nil {
-		logger.Error("Failed to read migration file",
-			zap.String("path", migrationPath),
-			zap.Error(err))
 		return err
 	}
+	sort.Strings(files)
+
+	for _, migrationPath := range files {
+		// File names follow the `<version>_<name>.up.sql` convention
+		versionStr, _, _ := strings.Cut(filepath.Base(migrationPath), "_")
+		version, err := strconv.ParseInt(versionStr, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid migration file name %s: %w", migrationPath, err)
+		}
 
-	// Execute the SQL script as a single batch
-	logger.Info("Executing SQL migration script")
-	if err := db.Exec(string(content)).Error; err != nil {
-		logger.Error("Failed to execute SQL migration script", zap.Error(err))
-		return err
+		var applied bool
+		if err := db.Raw("SELECT EXISTS (SELECT 1 FROM schema_migrations WHERE version = ?)", version).Scan(&applied).Error; err != nil {
+			return fmt.Errorf("failed to check migration %d: %w", version, err)
+		}
+		if applied {
+			continue
+		}
+
+		logger.Info("Reading migration file", zap.String("path", migrationPath))
+
+		// Read the migration file
+		content, err := os.ReadFile(migrationPath)
+		if err != nil {
+			logger.Error("Failed to read migration file",
+				zap.String("path", migrationPath),
+				zap.Error(err))
+			return err
+		}
+
+		// Execute the SQL script and record it as a single unit
+		logger.Info("Executing SQL migration script", zap.Int64("version", version))
+		err = db.Transaction(func(tx *gorm.DB) error {
+			if err := tx.Exec(string(content)).Error; err != nil {
+				return err
+			}
+			return tx.Exec("INSERT INTO schema_migrations (version) VALUES (?)", version).Error
+		})
+		if err != nil {
+			logger.Error("Failed to execute SQL migration script",
+				zap.String("path", migrationPath),
+				zap.Error(err))
+			return err
+		}
 	}
 
 	logger.Info("SQL migrations completed successfully")
@@ -0,0 +1,141 @@
+// Command reconcile checks that account balances, movements and transfers agree and writes a discrepancy report.
+//
+// It exits with status 2 when discrepancies are found, so that it can be scheduled (e.g. nightly) and alert on
+// failure. The same checks run inside the API server when scheduler.reconciliation.enabled is set.
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"io"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+
+	"VDM2-BankBE/internal/config"
+	"VDM2-BankBE/internal/model"
+	"VDM2-BankBE/internal/repository"
+	"VDM2-BankBE/internal/service"
+)
+
+func main() {
+	format := flag.String("format", "json", "report format: json or csv")
+	output := flag.String("output", "", "file to write the report to (default stdout)")
+	failOrphaned := flag.Bool("fail-orphaned", false, "mark stale pending transfers that never moved any funds as failed")
+	staleAfter := flag.Duration("stale-after", 0, "report transfers pending for longer than this (default scheduler.reconciliation.stale_pending_after)")
+	flag.Parse()
+
+	if *format != "json" && *format != "csv" {
+		log.Fatalf("Unknown report format %q", *format)
+	}
+
+	// Load configuration
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+
+	reconciliationCfg := cfg.Scheduler.Reconciliation
+	if *failOrphaned {
+		reconciliationCfg.FailOrphanedPending = true
+	}
+	if *staleAfter > 0 {
+		reconciliationCfg.StalePendingAfter = *staleAfter
+	}
+
+	// Connect to database; the schema is migrated by the API server
+	db, err := gorm.Open(postgres.Open(cfg.DB.GetDBURL()), &gorm.Config{
+		Logger: gormlogger.Default.LogMode(gormlogger.Silent),
+	})
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+
+	reconciliationService := service.NewReconciliationService(
+		repository.NewGormReconciliationRepository(db),
+		&reconciliationCfg,
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Minute)
+	defer cancel()
+
+	report, err := reconciliationService.Reconcile(ctx)
+	if err != nil {
+		log.Fatalf("Reconciliation failed: %v", err)
+	}
+
+	if err := writeReport(*output, *format, report); err != nil {
+		log.Fatalf("Failed to write report: %v", err)
+	}
+
+	if report.HasDiscrepancies() {
+		log.Printf("Reconciliation found %d balance, %d transfer and %d stale pending discrepancies",
+			len(report.BalanceDiscrepancies), len(report.TransferDiscrepancies), len(report.StalePendingTransfers))
+		os.Exit(2)
+	}
+}
+
+// writeReport writes the report in the given format to path, or to stdout when path is empty
+func writeReport(path, format string, report *model.ReconciliationReport) error {
+	var w io.Writer = os.Stdout
+	if path != "" {
+		f, err := os.Create(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+
+	if format == "csv" {
+		return writeCSV(w, report)
+	}
+	return writeJSON(w, report)
+}
+
+// writeJSON writes the report as an indented JSON document
+func writeJSON(w io.Writer, report *model.ReconciliationReport) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(report)
+}
+
+// writeCSV writes the report as one row per discrepancy; columns that do not apply to a check are left empty
+func writeCSV(w io.Writer, report *model.ReconciliationReport) error {
+	cw := csv.NewWriter(w)
+
+	rows := [][]string{{
+		"check", "account_id", "transfer_id", "balance", "movements_total", "difference",
+		"debit_movements", "credit_movements", "pending_since", "orphaned", "marked_failed",
+	}}
+	for _, d := range report.BalanceDiscrepancies {
+		rows = append(rows, []string{
+			"balance", d.AccountID.String(), "", d.Balance.String(), d.MovementsTotal.String(), d.Difference.String(),
+			"", "", "", "", "",
+		})
+	}
+	for _, d := range report.TransferDiscrepancies {
+		rows = append(rows, []string{
+			"transfer_movements", "", strconv.FormatUint(d.TransferID, 10), "", "", "",
+			strconv.FormatInt(d.DebitMovements, 10), strconv.FormatInt(d.CreditMovements, 10), "", "", "",
+		})
+	}
+	for _, t := range report.StalePendingTransfers {
+		rows = append(rows, []string{
+			"stale_pending", "", strconv.FormatUint(t.TransferID, 10), "", "", "",
+			"", "", t.PendingSince.Format(time.RFC3339), strconv.FormatBool(t.Orphaned), strconv.FormatBool(t.MarkedFailed),
+		})
+	}
+
+	if err := cw.WriteAll(rows); err != nil {
+		return err
+	}
+	return cw.Error()
+}
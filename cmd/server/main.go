@@ -59,7 +59,43 @@ func (s *Server) AuthGoogleCallback(c *gin.Context, params generated.AuthGoogleC
 	c.JSON(http.StatusNotImplemented, gin.H{"error": "not implemented"})
 }
 
-func (s *Server) AccountsGetBalance(c *gin.Context) {
+func (s *Server) AccountsList(c *gin.Context) {
+	c.JSON(http.StatusNotImplemented, gin.H{"error": "not implemented"})
+}
+
+func (s *Server) AccountsCreate(c *gin.Context, params generated.AccountsCreateParams) {
+	c.JSON(http.StatusNotImplemented, gin.H{"error": "not implemented"})
+}
+
+func (s *Server) AccountsSetDefault(c *gin.Context, id generated.AccountIdParam) {
+	c.JSON(http.StatusNotImplemented, gin.H{"error": "not implemented"})
+}
+
+func (s *Server) AccountsClose(c *gin.Context, id generated.AccountIdParam, params generated.AccountsCloseParams) {
+	c.JSON(http.StatusNotImplemented, gin.H{"error": "not implemented"})
+}
+
+func (s *Server) AccountsUpdateStatus(c *gin.Context, id generated.AccountIdParam) {
+	c.JSON(http.StatusNotImplemented, gin.H{"error": "not implemented"})
+}
+
+func (s *Server) AccountsSetOverdraft(c *gin.Context, id generated.AccountIdParam) {
+	c.JSON(http.StatusNotImplemented, gin.H{"error": "not implemented"})
+}
+
+func (s *Server) AccountsListCosigners(c *gin.Context, id generated.AccountIdParam) {
+	c.JSON(http.StatusNotImplemented, gin.H{"error": "not implemented"})
+}
+
+func (s *Server) AccountsAddCosigner(c *gin.Context, id generated.AccountIdParam) {
+	c.JSON(http.StatusNotImplemented, gin.H{"error": "not implemented"})
+}
+
+func (s *Server) AccountsRemoveCosigner(c *gin.Context, id generated.AccountIdParam, userId generated.CosignerUserIdParam) {
+	c.JSON(http.StatusNotImplemented, gin.H{"error": "not implemented"})
+}
+
+func (s *Server) AccountsGetBalance(c *gin.Context, params generated.AccountsGetBalanceParams) {
 	c.JSON(http.StatusNotImplemented, gin.H{"error": "not implemented"})
 }
 
@@ -67,7 +103,31 @@ func (s *Server) AccountsListMovements(c *gin.Context, params generated.Accounts
 	c.JSON(http.StatusNotImplemented, gin.H{"error": "not implemented"})
 }
 
-func (s *Server) AccountsCreateMovement(c *gin.Context) {
+func (s *Server) AccountsGetMovement(c *gin.Context, id generated.MovementIdParam) {
+	c.JSON(http.StatusNotImplemented, gin.H{"error": "not implemented"})
+}
+
+func (s *Server) AccountsCreateMovement(c *gin.Context, params generated.AccountsCreateMovementParams) {
+	c.JSON(http.StatusNotImplemented, gin.H{"error": "not implemented"})
+}
+
+func (s *Server) HoldsList(c *gin.Context, params generated.HoldsListParams) {
+	c.JSON(http.StatusNotImplemented, gin.H{"error": "not implemented"})
+}
+
+func (s *Server) HoldsCreate(c *gin.Context, params generated.HoldsCreateParams) {
+	c.JSON(http.StatusNotImplemented, gin.H{"error": "not implemented"})
+}
+
+func (s *Server) HoldsGet(c *gin.Context, id generated.HoldIdParam, params generated.HoldsGetParams) {
+	c.JSON(http.StatusNotImplemented, gin.H{"error": "not implemented"})
+}
+
+func (s *Server) HoldsCapture(c *gin.Context, id generated.HoldIdParam, params generated.HoldsCaptureParams) {
+	c.JSON(http.StatusNotImplemented, gin.H{"error": "not implemented"})
+}
+
+func (s *Server) HoldsRelease(c *gin.Context, id generated.HoldIdParam, params generated.HoldsReleaseParams) {
 	c.JSON(http.StatusNotImplemented, gin.H{"error": "not implemented"})
 }
 
@@ -75,7 +135,143 @@ func (s *Server) TransfersList(c *gin.Context, params generated.TransfersListPar
 	c.JSON(http.StatusNotImplemented, gin.H{"error": "not implemented"})
 }
 
-func (s *Server) TransfersCreate(c *gin.Context) {
+func (s *Server) TransfersCreate(c *gin.Context, params generated.TransfersCreateParams) {
+	c.JSON(http.StatusNotImplemented, gin.H{"error": "not implemented"})
+}
+
+func (s *Server) TransfersListScheduled(c *gin.Context, params generated.TransfersListScheduledParams) {
+	c.JSON(http.StatusNotImplemented, gin.H{"error": "not implemented"})
+}
+
+func (s *Server) TransfersPreviewRecipient(c *gin.Context, params generated.TransfersPreviewRecipientParams) {
+	c.JSON(http.StatusNotImplemented, gin.H{"error": "not implemented"})
+}
+
+func (s *Server) TransfersCreateBatch(c *gin.Context, params generated.TransfersCreateBatchParams) {
+	c.JSON(http.StatusNotImplemented, gin.H{"error": "not implemented"})
+}
+
+func (s *Server) TransfersGetBatch(c *gin.Context, id generated.TransferBatchIdParam, params generated.TransfersGetBatchParams) {
+	c.JSON(http.StatusNotImplemented, gin.H{"error": "not implemented"})
+}
+
+func (s *Server) TransferLimitsGet(c *gin.Context) {
+	c.JSON(http.StatusNotImplemented, gin.H{"error": "not implemented"})
+}
+
+func (s *Server) TransferLimitsUpdate(c *gin.Context) {
+	c.JSON(http.StatusNotImplemented, gin.H{"error": "not implemented"})
+}
+
+func (s *Server) FxListRates(c *gin.Context) {
+	c.JSON(http.StatusNotImplemented, gin.H{"error": "not implemented"})
+}
+
+func (s *Server) FxSetRates(c *gin.Context) {
+	c.JSON(http.StatusNotImplemented, gin.H{"error": "not implemented"})
+}
+
+func (s *Server) FxCreateQuote(c *gin.Context, params generated.FxCreateQuoteParams) {
+	c.JSON(http.StatusNotImplemented, gin.H{"error": "not implemented"})
+}
+
+func (s *Server) FeesPreview(c *gin.Context, params generated.FeesPreviewParams) {
+	c.JSON(http.StatusNotImplemented, gin.H{"error": "not implemented"})
+}
+
+func (s *Server) TransfersGet(c *gin.Context, id generated.TransferIdParam) {
+	c.JSON(http.StatusNotImplemented, gin.H{"error": "not implemented"})
+}
+
+func (s *Server) TransfersCancel(c *gin.Context, id generated.TransferIdParam, params generated.TransfersCancelParams) {
+	c.JSON(http.StatusNotImplemented, gin.H{"error": "not implemented"})
+}
+
+func (s *Server) TransfersReverse(c *gin.Context, id generated.TransferIdParam, params generated.TransfersReverseParams) {
+	c.JSON(http.StatusNotImplemented, gin.H{"error": "not implemented"})
+}
+
+func (s *Server) TransfersListAwaitingApproval(c *gin.Context, params generated.TransfersListAwaitingApprovalParams) {
+	c.JSON(http.StatusNotImplemented, gin.H{"error": "not implemented"})
+}
+
+func (s *Server) TransfersListApprovals(c *gin.Context, id generated.TransferIdParam) {
+	c.JSON(http.StatusNotImplemented, gin.H{"error": "not implemented"})
+}
+
+func (s *Server) TransfersApprove(c *gin.Context, id generated.TransferIdParam, params generated.TransfersApproveParams) {
+	c.JSON(http.StatusNotImplemented, gin.H{"error": "not implemented"})
+}
+
+func (s *Server) TransfersReject(c *gin.Context, id generated.TransferIdParam) {
+	c.JSON(http.StatusNotImplemented, gin.H{"error": "not implemented"})
+}
+
+func (s *Server) BeneficiariesList(c *gin.Context, params generated.BeneficiariesListParams) {
+	c.JSON(http.StatusNotImplemented, gin.H{"error": "not implemented"})
+}
+
+func (s *Server) BeneficiariesCreate(c *gin.Context, params generated.BeneficiariesCreateParams) {
+	c.JSON(http.StatusNotImplemented, gin.H{"error": "not implemented"})
+}
+
+func (s *Server) BeneficiariesGet(c *gin.Context, id generated.BeneficiaryIdParam) {
+	c.JSON(http.StatusNotImplemented, gin.H{"error": "not implemented"})
+}
+
+func (s *Server) BeneficiariesUpdate(c *gin.Context, id generated.BeneficiaryIdParam) {
+	c.JSON(http.StatusNotImplemented, gin.H{"error": "not implemented"})
+}
+
+func (s *Server) BeneficiariesDelete(c *gin.Context, id generated.BeneficiaryIdParam) {
+	c.JSON(http.StatusNotImplemented, gin.H{"error": "not implemented"})
+}
+
+func (s *Server) PaymentRequestsCreate(c *gin.Context, params generated.PaymentRequestsCreateParams) {
+	c.JSON(http.StatusNotImplemented, gin.H{"error": "not implemented"})
+}
+
+func (s *Server) PaymentRequestsListIncoming(c *gin.Context, params generated.PaymentRequestsListIncomingParams) {
+	c.JSON(http.StatusNotImplemented, gin.H{"error": "not implemented"})
+}
+
+func (s *Server) PaymentRequestsListOutgoing(c *gin.Context, params generated.PaymentRequestsListOutgoingParams) {
+	c.JSON(http.StatusNotImplemented, gin.H{"error": "not implemented"})
+}
+
+func (s *Server) PaymentRequestsGet(c *gin.Context, id generated.PaymentRequestIdParam) {
+	c.JSON(http.StatusNotImplemented, gin.H{"error": "not implemented"})
+}
+
+func (s *Server) PaymentRequestsPay(c *gin.Context, id generated.PaymentRequestIdParam, params generated.PaymentRequestsPayParams) {
+	c.JSON(http.StatusNotImplemented, gin.H{"error": "not implemented"})
+}
+
+func (s *Server) PaymentRequestsDecline(c *gin.Context, id generated.PaymentRequestIdParam) {
+	c.JSON(http.StatusNotImplemented, gin.H{"error": "not implemented"})
+}
+
+func (s *Server) PaymentRequestsCancel(c *gin.Context, id generated.PaymentRequestIdParam) {
+	c.JSON(http.StatusNotImplemented, gin.H{"error": "not implemented"})
+}
+
+func (s *Server) StandingOrdersList(c *gin.Context, params generated.StandingOrdersListParams) {
+	c.JSON(http.StatusNotImplemented, gin.H{"error": "not implemented"})
+}
+
+func (s *Server) StandingOrdersCreate(c *gin.Context, params generated.StandingOrdersCreateParams) {
+	c.JSON(http.StatusNotImplemented, gin.H{"error": "not implemented"})
+}
+
+func (s *Server) StandingOrdersGet(c *gin.Context, id generated.StandingOrderIdParam, params generated.StandingOrdersGetParams) {
+	c.JSON(http.StatusNotImplemented, gin.H{"error": "not implemented"})
+}
+
+func (s *Server) StandingOrdersUpdate(c *gin.Context, id generated.StandingOrderIdParam, params generated.StandingOrdersUpdateParams) {
+	c.JSON(http.StatusNotImplemented, gin.H{"error": "not implemented"})
+}
+
+func (s *Server) StandingOrdersCancel(c *gin.Context, id generated.StandingOrderIdParam, params generated.StandingOrdersCancelParams) {
 	c.JSON(http.StatusNotImplemented, gin.H{"error": "not implemented"})
 }
 
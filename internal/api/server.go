@@ -15,10 +15,18 @@ import (
 // Server delegates generated OpenAPI handlers to the existing handwritten handlers.
 // This is the bridge that makes "contract = reality" enforceable at runtime.
 type Server struct {
-	Auth     *handler.AuthHandler
-	Account  *handler.AccountHandler
-	Movement *handler.MovementHandler
-	Transfer *handler.TransferHandler
+	Auth           *handler.AuthHandler
+	Account        *handler.AccountHandler
+	Movement       *handler.MovementHandler
+	Hold           *handler.HoldHandler
+	Transfer       *handler.TransferHandler
+	TransferBatch  *handler.TransferBatchHandler
+	TransferLimit  *handler.TransferLimitHandler
+	Beneficiary    *handler.BeneficiaryHandler
+	PaymentRequest *handler.PaymentRequestHandler
+	StandingOrder  *handler.StandingOrderHandler
+	FX             *handler.FXHandler
+	Fee            *handler.FeeHandler
 }
 
 var _ generated.ServerInterface = (*Server)(nil)
@@ -27,13 +35,29 @@ func NewServer(
 	auth *handler.AuthHandler,
 	account *handler.AccountHandler,
 	movement *handler.MovementHandler,
+	hold *handler.HoldHandler,
 	transfer *handler.TransferHandler,
+	transferBatch *handler.TransferBatchHandler,
+	transferLimit *handler.TransferLimitHandler,
+	beneficiary *handler.BeneficiaryHandler,
+	paymentRequest *handler.PaymentRequestHandler,
+	standingOrder *handler.StandingOrderHandler,
+	fx *handler.FXHandler,
+	fee *handler.FeeHandler,
 ) *Server {
 	return &Server{
-		Auth:     auth,
-		Account:  account,
-		Movement: movement,
-		Transfer: transfer,
+		Auth:           auth,
+		Account:        account,
+		Movement:       movement,
+		Hold:           hold,
+		Transfer:       transfer,
+		TransferBatch:  transferBatch,
+		TransferLimit:  transferLimit,
+		Beneficiary:    beneficiary,
+		PaymentRequest: paymentRequest,
+		StandingOrder:  standingOrder,
+		FX:             fx,
+		Fee:            fee,
 	}
 }
 
@@ -45,21 +69,256 @@ func (s *Server) AuthGoogleCallback(c *gin.Context, _ generated.AuthGoogleCallba
 	s.Auth.GoogleCallback(c)
 }
 
-func (s *Server) AccountsGetBalance(c *gin.Context) { s.Account.Balance(c) }
+func (s *Server) AccountsList(c *gin.Context) { s.Account.List(c) }
+
+func (s *Server) AccountsCreate(c *gin.Context, _ generated.AccountsCreateParams) {
+	// Idempotency-Key is handled by the idempotency middleware.
+	s.Account.Create(c)
+}
+
+func (s *Server) AccountsSetDefault(c *gin.Context, _ generated.AccountIdParam) {
+	// Handler reads the path param directly.
+	s.Account.SetDefault(c)
+}
+
+func (s *Server) AccountsClose(c *gin.Context, _ generated.AccountIdParam, _ generated.AccountsCloseParams) {
+	// Handler reads the path param directly; Idempotency-Key is handled by the idempotency middleware.
+	s.Account.Close(c)
+}
+
+func (s *Server) AccountsUpdateStatus(c *gin.Context, _ generated.AccountIdParam) {
+	// Handler reads the path param directly.
+	s.Account.UpdateStatus(c)
+}
+
+func (s *Server) AccountsSetOverdraft(c *gin.Context, _ generated.AccountIdParam) {
+	// Handler reads the path param directly.
+	s.Account.SetOverdraft(c)
+}
+
+func (s *Server) AccountsListCosigners(c *gin.Context, _ generated.AccountIdParam) {
+	// Handler reads the path param directly.
+	s.Account.ListCosigners(c)
+}
+
+func (s *Server) AccountsAddCosigner(c *gin.Context, _ generated.AccountIdParam) {
+	// Handler reads the path param directly.
+	s.Account.AddCosigner(c)
+}
+
+func (s *Server) AccountsRemoveCosigner(c *gin.Context, _ generated.AccountIdParam, _ generated.CosignerUserIdParam) {
+	// Handler reads the path params directly.
+	s.Account.RemoveCosigner(c)
+}
+
+func (s *Server) AccountsGetBalance(c *gin.Context, _ generated.AccountsGetBalanceParams) {
+	// Existing handler reads query params directly.
+	s.Account.Balance(c)
+}
 
 func (s *Server) AccountsListMovements(c *gin.Context, _ generated.AccountsListMovementsParams) {
 	// Existing handler reads query params directly.
 	s.Movement.List(c)
 }
 
-func (s *Server) AccountsCreateMovement(c *gin.Context) { s.Movement.Create(c) }
+func (s *Server) AccountsGetMovement(c *gin.Context, _ generated.MovementIdParam) {
+	// Handler reads the path param directly.
+	s.Movement.Get(c)
+}
+
+func (s *Server) AccountsCreateMovement(c *gin.Context, _ generated.AccountsCreateMovementParams) {
+	// Idempotency-Key is handled by the idempotency middleware.
+	s.Movement.Create(c)
+}
+
+func (s *Server) HoldsList(c *gin.Context, _ generated.HoldsListParams) {
+	// Existing handler reads query params directly.
+	s.Hold.List(c)
+}
+
+func (s *Server) HoldsCreate(c *gin.Context, _ generated.HoldsCreateParams) {
+	// Idempotency-Key is handled by the idempotency middleware.
+	s.Hold.Create(c)
+}
+
+func (s *Server) HoldsGet(c *gin.Context, _ generated.HoldIdParam, _ generated.HoldsGetParams) {
+	// Handler reads the path and query params directly.
+	s.Hold.Get(c)
+}
+
+func (s *Server) HoldsCapture(c *gin.Context, _ generated.HoldIdParam, _ generated.HoldsCaptureParams) {
+	// Handler reads the path and query params directly; Idempotency-Key is handled by the idempotency middleware.
+	s.Hold.Capture(c)
+}
+
+func (s *Server) HoldsRelease(c *gin.Context, _ generated.HoldIdParam, _ generated.HoldsReleaseParams) {
+	// Handler reads the path and query params directly.
+	s.Hold.Release(c)
+}
 
 func (s *Server) TransfersList(c *gin.Context, _ generated.TransfersListParams) {
 	// Existing handler reads query params directly.
 	s.Transfer.List(c)
 }
 
-func (s *Server) TransfersCreate(c *gin.Context) { s.Transfer.Transfer(c) }
+func (s *Server) TransfersCreate(c *gin.Context, _ generated.TransfersCreateParams) {
+	// Idempotency-Key is handled by the idempotency middleware.
+	s.Transfer.Transfer(c)
+}
+
+func (s *Server) TransfersListScheduled(c *gin.Context, _ generated.TransfersListScheduledParams) {
+	// Existing handler reads query params directly.
+	s.Transfer.ListScheduled(c)
+}
+
+func (s *Server) TransfersPreviewRecipient(c *gin.Context, _ generated.TransfersPreviewRecipientParams) {
+	// Existing handler reads query params directly.
+	s.Transfer.PreviewRecipient(c)
+}
+
+func (s *Server) TransfersCreateBatch(c *gin.Context, _ generated.TransfersCreateBatchParams) {
+	// Handler reads the query params and the JSON or CSV body directly; Idempotency-Key is handled by the
+	// idempotency middleware.
+	s.TransferBatch.Create(c)
+}
+
+func (s *Server) TransfersGetBatch(c *gin.Context, _ generated.TransferBatchIdParam, _ generated.TransfersGetBatchParams) {
+	// Handler reads the path and query params directly.
+	s.TransferBatch.Get(c)
+}
+
+func (s *Server) TransferLimitsGet(c *gin.Context)    { s.TransferLimit.Get(c) }
+func (s *Server) TransferLimitsUpdate(c *gin.Context) { s.TransferLimit.Update(c) }
+
+func (s *Server) TransfersGet(c *gin.Context, _ generated.TransferIdParam) {
+	// Handler reads the path param directly.
+	s.Transfer.Get(c)
+}
+
+func (s *Server) TransfersCancel(c *gin.Context, _ generated.TransferIdParam, _ generated.TransfersCancelParams) {
+	// Handler reads the path and query params directly.
+	s.Transfer.Cancel(c)
+}
+
+func (s *Server) TransfersReverse(c *gin.Context, _ generated.TransferIdParam, _ generated.TransfersReverseParams) {
+	// Handler reads the path param directly; Idempotency-Key is handled by the idempotency middleware.
+	s.Transfer.Reverse(c)
+}
+
+func (s *Server) TransfersListAwaitingApproval(c *gin.Context, _ generated.TransfersListAwaitingApprovalParams) {
+	// Existing handler reads query params directly.
+	s.Transfer.ListAwaitingApproval(c)
+}
+
+func (s *Server) TransfersListApprovals(c *gin.Context, _ generated.TransferIdParam) {
+	// Handler reads the path param directly.
+	s.Transfer.ListApprovals(c)
+}
+
+func (s *Server) TransfersApprove(c *gin.Context, _ generated.TransferIdParam, _ generated.TransfersApproveParams) {
+	// Handler reads the path param directly.
+	s.Transfer.Approve(c)
+}
+
+func (s *Server) TransfersReject(c *gin.Context, _ generated.TransferIdParam) {
+	// Handler reads the path param directly.
+	s.Transfer.Reject(c)
+}
+
+func (s *Server) BeneficiariesList(c *gin.Context, _ generated.BeneficiariesListParams) {
+	// Existing handler reads query params directly.
+	s.Beneficiary.List(c)
+}
+
+func (s *Server) BeneficiariesCreate(c *gin.Context, _ generated.BeneficiariesCreateParams) {
+	// Idempotency-Key is handled by the idempotency middleware.
+	s.Beneficiary.Create(c)
+}
+
+func (s *Server) BeneficiariesGet(c *gin.Context, _ generated.BeneficiaryIdParam) {
+	// Handler reads the path param directly.
+	s.Beneficiary.Get(c)
+}
+
+func (s *Server) BeneficiariesUpdate(c *gin.Context, _ generated.BeneficiaryIdParam) {
+	// Handler reads the path param directly.
+	s.Beneficiary.Update(c)
+}
+
+func (s *Server) BeneficiariesDelete(c *gin.Context, _ generated.BeneficiaryIdParam) {
+	// Handler reads the path param directly.
+	s.Beneficiary.Delete(c)
+}
+
+func (s *Server) FxListRates(c *gin.Context)   { s.FX.ListRates(c) }
+func (s *Server) FxSetRates(c *gin.Context)    { s.FX.SetRates(c) }
+func (s *Server) FxCreateQuote(c *gin.Context, _ generated.FxCreateQuoteParams) {
+	s.FX.CreateQuote(c)
+}
+
+func (s *Server) FeesPreview(c *gin.Context, _ generated.FeesPreviewParams) {
+	// Existing handler reads query params directly.
+	s.Fee.Preview(c)
+}
+
+func (s *Server) PaymentRequestsCreate(c *gin.Context, _ generated.PaymentRequestsCreateParams) {
+	// Idempotency-Key is handled by the idempotency middleware.
+	s.PaymentRequest.Create(c)
+}
+
+func (s *Server) PaymentRequestsListIncoming(c *gin.Context, _ generated.PaymentRequestsListIncomingParams) {
+	// Existing handler reads query params directly.
+	s.PaymentRequest.ListIncoming(c)
+}
+
+func (s *Server) PaymentRequestsListOutgoing(c *gin.Context, _ generated.PaymentRequestsListOutgoingParams) {
+	// Existing handler reads query params directly.
+	s.PaymentRequest.ListOutgoing(c)
+}
+
+func (s *Server) PaymentRequestsGet(c *gin.Context, _ generated.PaymentRequestIdParam) {
+	// Handler reads the path param directly.
+	s.PaymentRequest.Get(c)
+}
+
+func (s *Server) PaymentRequestsPay(c *gin.Context, _ generated.PaymentRequestIdParam, _ generated.PaymentRequestsPayParams) {
+	// Handler reads the path param directly.
+	s.PaymentRequest.Pay(c)
+}
+
+func (s *Server) PaymentRequestsDecline(c *gin.Context, _ generated.PaymentRequestIdParam) {
+	// Handler reads the path param directly.
+	s.PaymentRequest.Decline(c)
+}
+
+func (s *Server) PaymentRequestsCancel(c *gin.Context, _ generated.PaymentRequestIdParam) {
+	// Handler reads the path param directly.
+	s.PaymentRequest.Cancel(c)
+}
+
+func (s *Server) StandingOrdersList(c *gin.Context, _ generated.StandingOrdersListParams) {
+	// Existing handler reads query params directly.
+	s.StandingOrder.List(c)
+}
+
+func (s *Server) StandingOrdersCreate(c *gin.Context, _ generated.StandingOrdersCreateParams) {
+	s.StandingOrder.Create(c)
+}
+
+func (s *Server) StandingOrdersGet(c *gin.Context, _ generated.StandingOrderIdParam, _ generated.StandingOrdersGetParams) {
+	// Handler reads the path and query params directly.
+	s.StandingOrder.Get(c)
+}
+
+func (s *Server) StandingOrdersUpdate(c *gin.Context, _ generated.StandingOrderIdParam, _ generated.StandingOrdersUpdateParams) {
+	// Handler reads the path and query params directly.
+	s.StandingOrder.Update(c)
+}
+
+func (s *Server) StandingOrdersCancel(c *gin.Context, _ generated.StandingOrderIdParam, _ generated.StandingOrdersCancelParams) {
+	// Handler reads the path and query params directly.
+	s.StandingOrder.Cancel(c)
+}
 
 func (s *Server) HealthCheck(c *gin.Context) { c.Status(http.StatusOK) }
 
@@ -5,20 +5,31 @@ import (
 	"time"
 
 	"github.com/joho/godotenv"
+	"github.com/mitchellh/mapstructure"
 	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
 	"github.com/spf13/viper"
 )
 
 // Config represents the application configuration
 type Config struct {
-	Server   ServerConfig
-	DB       DBConfig
-	Redis    RedisConfig
-	JWT      JWTConfig
-	PASETO   PASETOConfig
-	OAuth    OAuthConfig
-	Logging  LoggingConfig
-	Security SecurityConfig
+	Server          ServerConfig
+	DB              DBConfig
+	Redis           RedisConfig
+	JWT             JWTConfig
+	PASETO          PASETOConfig
+	OAuth           OAuthConfig
+	Logging         LoggingConfig
+	Security        SecurityConfig
+	Holds           HoldConfig
+	Limits          LimitConfig
+	Beneficiaries   BeneficiaryConfig
+	PaymentRequests PaymentRequestConfig `mapstructure:"payment_requests"`
+	TransferBatches TransferBatchConfig  `mapstructure:"transfer_batches"`
+	FX              FXConfig
+	Fees            FeeConfig
+	Approvals       ApprovalConfig
+	Scheduler       SchedulerConfig
 }
 
 // ServerConfig holds the server configuration
@@ -83,7 +94,8 @@ type LoggingConfig struct {
 
 // SecurityConfig holds security-related configuration
 type SecurityConfig struct {
-	RateLimit RateLimitConfig
+	RateLimit   RateLimitConfig
+	Idempotency IdempotencyConfig
 }
 
 // RateLimitConfig holds rate limiting configuration
@@ -93,6 +105,186 @@ type RateLimitConfig struct {
 	Duration time.Duration
 }
 
+// IdempotencyConfig holds Idempotency-Key handling configuration
+type IdempotencyConfig struct {
+	// TTL is how long a stored response can be replayed for the same key
+	TTL time.Duration
+}
+
+// HoldConfig holds the lifetime of authorization holds
+type HoldConfig struct {
+	// DefaultTTL is how long a hold lasts when it is placed without an expiry
+	DefaultTTL time.Duration `mapstructure:"default_ttl"`
+	// MaxTTL bounds the expiry a hold can be placed with
+	MaxTTL time.Duration `mapstructure:"max_ttl"`
+}
+
+// LimitConfig holds the default transfer limits of every user. Users can lower their own limits below these.
+type LimitConfig struct {
+	// Currency the limits are expressed in; transfers in other currencies count at the current exchange rates
+	Currency string
+	// PerTransaction bounds the amount of a single transfer
+	PerTransaction decimal.Decimal `mapstructure:"per_transaction"`
+	// Daily bounds the outgoing transfers of a calendar day
+	Daily decimal.Decimal
+	// Monthly bounds the outgoing transfers of a calendar month
+	Monthly decimal.Decimal
+}
+
+// BeneficiaryConfig holds the cooling-off rule applied to transfers to newly added beneficiaries
+type BeneficiaryConfig struct {
+	// CoolingOff is how long after being added a beneficiary can only receive transfers up to CoolingOffLimit in total.
+	// Zero disables the rule.
+	CoolingOff time.Duration `mapstructure:"cooling_off"`
+	// CoolingOffLimit bounds the amount sent to a beneficiary in its cooling-off period, in the currency of the limits
+	CoolingOffLimit decimal.Decimal `mapstructure:"cooling_off_limit"`
+}
+
+// PaymentRequestConfig holds the lifetime of payment requests
+type PaymentRequestConfig struct {
+	// DefaultTTL is how long a request stays open when it is created without an expiry
+	DefaultTTL time.Duration `mapstructure:"default_ttl"`
+	// MaxTTL bounds the expiry a request can be created with
+	MaxTTL time.Duration `mapstructure:"max_ttl"`
+}
+
+// TransferBatchConfig holds the limits of bulk transfers
+type TransferBatchConfig struct {
+	// MaxItems bounds the number of transfers of a batch
+	MaxItems int `mapstructure:"max_items"`
+	// HoldTTL is how long the total of a batch stays reserved while it waits to be processed
+	HoldTTL time.Duration `mapstructure:"hold_ttl"`
+	// Lease is how long a batch being processed is hidden from the other processors; a batch whose processing
+	// stopped mid-way is resumed once it expires
+	Lease time.Duration
+}
+
+// FXConfig holds the configuration of currency conversion
+type FXConfig struct {
+	// RatesFile is a local YAML file of exchange rates loaded into the rates table at startup, if set
+	RatesFile string `mapstructure:"rates_file"`
+	// QuoteTTL is how long a quoted rate can be used for a transfer
+	QuoteTTL time.Duration `mapstructure:"quote_ttl"`
+}
+
+// FeeConfig holds the fee rule of each operation type; operations without a rule are free
+type FeeConfig struct {
+	// Currency the amounts of the rules are expressed in; operations in other currencies are charged them converted
+	// at the current exchange rates
+	Currency              string
+	InstantTransfer       *FeeRule `mapstructure:"instant_transfer"`
+	ScheduledTransfer     *FeeRule `mapstructure:"scheduled_transfer"`
+	CrossCurrencyTransfer *FeeRule `mapstructure:"cross_currency_transfer"`
+	Withdrawal            *FeeRule
+}
+
+// Rule returns the fee rule of an operation type, nil when it is free. ok is false for unknown operation types.
+func (c *FeeConfig) Rule(operation string) (rule *FeeRule, ok bool) {
+	switch operation {
+	case "instant_transfer":
+		return c.InstantTransfer, true
+	case "scheduled_transfer":
+		return c.ScheduledTransfer, true
+	case "cross_currency_transfer":
+		return c.CrossCurrencyTransfer, true
+	case "withdrawal":
+		return c.Withdrawal, true
+	}
+	return nil, false
+}
+
+// FeeRule computes the fee of an operation from its amount. Amounts are in the currency of the fees.
+type FeeRule struct {
+	// Type is flat, percentage or tiered
+	Type string
+	// Amount is the fee of flat rules
+	Amount decimal.Decimal
+	// Percentage of the operation amount charged by percentage rules, e.g. 0.5 for 0.5%
+	Percentage decimal.Decimal
+	// Tiers of tiered rules by increasing UpTo; the first tier covering the operation amount applies
+	Tiers []FeeTier
+	// Min and Max bound the fee when greater than zero
+	Min decimal.Decimal
+	Max decimal.Decimal
+}
+
+// FeeTier is a band of a tiered fee rule, charging a flat amount plus a percentage of operation amounts up to
+// UpTo; the last tier can leave UpTo at zero to cover any larger amount
+type FeeTier struct {
+	UpTo       decimal.Decimal `mapstructure:"up_to"`
+	Amount     decimal.Decimal
+	Percentage decimal.Decimal
+}
+
+// ApprovalConfig holds the four-eyes rule of business accounts
+type ApprovalConfig struct {
+	// Threshold is the amount, in Currency, above which transfers from business accounts to other users wait for a
+	// co-signer to approve them; zero disables approvals. Accounts in other currencies compare it converted at the
+	// current exchange rates
+	Threshold decimal.Decimal
+	Currency  string
+	// TTL is how long a transfer waits for approval before it fails
+	TTL time.Duration `mapstructure:"ttl"`
+}
+
+// FXRateEntry is an exchange rate of the rates file: one unit of Base buys Rate units of Quote
+type FXRateEntry struct {
+	Base  string
+	Quote string
+	Rate  decimal.Decimal
+}
+
+// SchedulerConfig holds the configuration of the background jobs run inside the server process
+type SchedulerConfig struct {
+	// Interval is the time between two runs of the jobs
+	Interval time.Duration
+	// BatchSize bounds the number of items a job processes per run
+	BatchSize int `mapstructure:"batch_size"`
+	// StandingOrders holds the retry policy of standing orders
+	StandingOrders StandingOrderConfig `mapstructure:"standing_orders"`
+	// Reconciliation holds the configuration of the ledger reconciliation job
+	Reconciliation ReconciliationConfig
+	// Outbox holds the configuration of the workers executing the transfers submitted through the API
+	Outbox OutboxConfig
+}
+
+// OutboxConfig holds the worker pool and the retry policy of the transfer outbox
+type OutboxConfig struct {
+	// Workers is the number of transfers processed concurrently
+	Workers int
+	// Interval is the time between two polls of the outbox
+	Interval time.Duration
+	// BatchSize bounds the number of messages claimed per poll
+	BatchSize int `mapstructure:"batch_size"`
+	// Lease is how long a claimed message is hidden from the other workers
+	Lease time.Duration
+	// MaxAttempts is the number of attempts after which a transfer failing on a transient error is marked as failed
+	MaxAttempts int `mapstructure:"max_attempts"`
+	// RetryDelay is the delay before the first retry, doubled on each further attempt up to MaxRetryDelay
+	RetryDelay    time.Duration `mapstructure:"retry_delay"`
+	MaxRetryDelay time.Duration `mapstructure:"max_retry_delay"`
+}
+
+// StandingOrderConfig holds the retry policy applied when a standing order occurrence fails
+type StandingOrderConfig struct {
+	// RetryDelay is the time before a failed occurrence is attempted again
+	RetryDelay time.Duration `mapstructure:"retry_delay"`
+	// MaxConsecutiveFailures is the number of failed attempts after which the order is suspended
+	MaxConsecutiveFailures int `mapstructure:"max_consecutive_failures"`
+}
+
+// ReconciliationConfig holds the configuration of the job checking balances, movements and transfers agree
+type ReconciliationConfig struct {
+	// Enabled runs the job inside the server process
+	Enabled bool
+	// Interval is the time between two reconciliation runs
+	Interval time.Duration
+	// StalePendingAfter is how long a transfer can stay pending before it is reported
+	StalePendingAfter time.Duration `mapstructure:"stale_pending_after"`
+	// FailOrphanedPending marks stale pending transfers that never moved any funds as failed
+	FailOrphanedPending bool `mapstructure:"fail_orphaned_pending"`
+}
+
 // Load loads the configuration from a file
 func Load() (*Config, error) {
 	// Load .env file if it exists
@@ -107,6 +299,41 @@ func Load() (*Config, error) {
 	viper.SetDefault("server.port", 8080)
 	viper.SetDefault("server.timeout", "30s")
 	viper.SetDefault("server.debug", true)
+	viper.SetDefault("security.idempotency.ttl", "24h")
+	viper.SetDefault("holds.default_ttl", "168h")
+	viper.SetDefault("holds.max_ttl", "720h")
+	viper.SetDefault("limits.currency", "EUR")
+	viper.SetDefault("fees.currency", "EUR")
+	viper.SetDefault("limits.per_transaction", "5000.00")
+	viper.SetDefault("limits.daily", "10000.00")
+	viper.SetDefault("limits.monthly", "50000.00")
+	viper.SetDefault("beneficiaries.cooling_off", "24h")
+	viper.SetDefault("beneficiaries.cooling_off_limit", "500.00")
+	viper.SetDefault("payment_requests.default_ttl", "168h")
+	viper.SetDefault("payment_requests.max_ttl", "720h")
+	viper.SetDefault("transfer_batches.max_items", 5000)
+	viper.SetDefault("transfer_batches.hold_ttl", "24h")
+	viper.SetDefault("transfer_batches.lease", "10m")
+	viper.SetDefault("fx.rates_file", "")
+	viper.SetDefault("fx.quote_ttl", "30s")
+	viper.SetDefault("approvals.threshold", "10000.00")
+	viper.SetDefault("approvals.currency", "EUR")
+	viper.SetDefault("approvals.ttl", "72h")
+	viper.SetDefault("scheduler.interval", "30s")
+	viper.SetDefault("scheduler.batch_size", 100)
+	viper.SetDefault("scheduler.standing_orders.retry_delay", "6h")
+	viper.SetDefault("scheduler.standing_orders.max_consecutive_failures", 3)
+	viper.SetDefault("scheduler.reconciliation.enabled", true)
+	viper.SetDefault("scheduler.reconciliation.interval", "24h")
+	viper.SetDefault("scheduler.reconciliation.stale_pending_after", "1h")
+	viper.SetDefault("scheduler.reconciliation.fail_orphaned_pending", false)
+	viper.SetDefault("scheduler.outbox.workers", 4)
+	viper.SetDefault("scheduler.outbox.interval", "1s")
+	viper.SetDefault("scheduler.outbox.batch_size", 50)
+	viper.SetDefault("scheduler.outbox.lease", "1m")
+	viper.SetDefault("scheduler.outbox.max_attempts", 8)
+	viper.SetDefault("scheduler.outbox.retry_delay", "2s")
+	viper.SetDefault("scheduler.outbox.max_retry_delay", "5m")
 
 	// Enable environment variable support
 	viper.AutomaticEnv()
@@ -140,7 +367,13 @@ func Load() (*Config, error) {
 
 	// Parse the config
 	var config Config
-	if err := viper.Unmarshal(&config); err != nil {
+	// Amounts are decoded through decimal.Decimal's UnmarshalText
+	decodeHook := viper.DecodeHook(mapstructure.ComposeDecodeHookFunc(
+		mapstructure.StringToTimeDurationHookFunc(),
+		mapstructure.StringToSliceHookFunc(","),
+		mapstructure.TextUnmarshallerHookFunc(),
+	))
+	if err := viper.Unmarshal(&config, decodeHook); err != nil {
 		return nil, errors.Wrap(err, "failed to parse config")
 	}
 
@@ -152,6 +385,25 @@ func Load() (*Config, error) {
 	return &config, nil
 }
 
+// LoadFXRates reads the exchange rates of a rates file
+func LoadFXRates(path string) ([]FXRateEntry, error) {
+	v := viper.New()
+	v.SetConfigFile(path)
+	if err := v.ReadInConfig(); err != nil {
+		return nil, errors.Wrap(err, "failed to read fx rates file")
+	}
+
+	var file struct {
+		Rates []FXRateEntry
+	}
+	decodeHook := viper.DecodeHook(mapstructure.TextUnmarshallerHookFunc())
+	if err := v.Unmarshal(&file, decodeHook); err != nil {
+		return nil, errors.Wrap(err, "failed to parse fx rates file")
+	}
+
+	return file.Rates, nil
+}
+
 // GetDBURL returns the PostgreSQL connection string
 func (c *DBConfig) GetDBURL() string {
 	return fmt.Sprintf(
@@ -178,6 +430,52 @@ func validateConfig(config *Config) error {
 		return errors.New("database name is required")
 	}
 
+	// Validate transfer limits
+	if !config.Limits.PerTransaction.IsPositive() || !config.Limits.Daily.IsPositive() || !config.Limits.Monthly.IsPositive() {
+		return errors.New("transfer limits must be greater than zero")
+	}
+	if config.Limits.Currency == "" {
+		return errors.New("transfer limit currency is required")
+	}
+
+	if config.Beneficiaries.CoolingOff > 0 && !config.Beneficiaries.CoolingOffLimit.IsPositive() {
+		return errors.New("beneficiary cooling-off limit must be greater than zero")
+	}
+
+	if config.TransferBatches.Lease <= 0 {
+		return errors.New("transfer batch lease must be greater than zero")
+	}
+
+	if config.FX.QuoteTTL <= 0 {
+		return errors.New("fx quote TTL must be greater than zero")
+	}
+
+	// Validate fee rules
+	if config.Fees.Currency == "" {
+		return errors.New("fee currency is required")
+	}
+	for _, operation := range []string{"instant_transfer", "scheduled_transfer", "cross_currency_transfer", "withdrawal"} {
+		rule, _ := config.Fees.Rule(operation)
+		if err := validateFeeRule(rule); err != nil {
+			return errors.Wrapf(err, "invalid %s fee", operation)
+		}
+	}
+
+	if config.Approvals.Threshold.IsNegative() {
+		return errors.New("approval threshold cannot be negative")
+	}
+	if config.Approvals.Threshold.IsPositive() && config.Approvals.TTL <= 0 {
+		return errors.New("approval TTL must be greater than zero")
+	}
+	if config.Approvals.Threshold.IsPositive() && config.Approvals.Currency == "" {
+		return errors.New("approval currency is required")
+	}
+
+	outbox := config.Scheduler.Outbox
+	if outbox.Workers <= 0 || outbox.Interval <= 0 || outbox.BatchSize <= 0 || outbox.Lease <= 0 || outbox.MaxAttempts <= 0 {
+		return errors.New("outbox workers, interval, batch size, lease and max attempts must be greater than zero")
+	}
+
 	// Validate JWT config
 	if config.JWT.Secret == "" {
 		return errors.New("JWT secret is required")
@@ -185,3 +483,40 @@ func validateConfig(config *Config) error {
 
 	return nil
 }
+
+// validateFeeRule checks that a fee rule is complete and consistent
+func validateFeeRule(rule *FeeRule) error {
+	if rule == nil {
+		return nil
+	}
+	if rule.Amount.IsNegative() || rule.Percentage.IsNegative() || rule.Min.IsNegative() || rule.Max.IsNegative() {
+		return errors.New("fee amounts cannot be negative")
+	}
+	if rule.Min.IsPositive() && rule.Max.IsPositive() && rule.Min.GreaterThan(rule.Max) {
+		return errors.New("min cannot be greater than max")
+	}
+
+	switch rule.Type {
+	case "flat", "percentage":
+		return nil
+	case "tiered":
+		if len(rule.Tiers) == 0 {
+			return errors.New("tiered fees need at least one tier")
+		}
+		for i, tier := range rule.Tiers {
+			if tier.Amount.IsNegative() || tier.Percentage.IsNegative() {
+				return errors.New("fee amounts cannot be negative")
+			}
+			last := i == len(rule.Tiers)-1
+			if !tier.UpTo.IsPositive() && !last {
+				return errors.New("only the last tier can leave up_to unset")
+			}
+			if i > 0 && tier.UpTo.IsPositive() && !tier.UpTo.GreaterThan(rule.Tiers[i-1].UpTo) {
+				return errors.New("tiers must be sorted by increasing up_to")
+			}
+		}
+		return nil
+	default:
+		return errors.Errorf("unknown fee type %q", rule.Type)
+	}
+}
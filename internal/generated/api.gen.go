@@ -4,6 +4,7 @@
 package generated
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"time"
@@ -18,29 +19,407 @@ const (
 	BearerPASETOScopes = "BearerPASETO.Scopes"
 )
 
+// Defines values for APIErrorReason.
+const (
+	TransferLimitExceeded APIErrorReason = "transfer_limit_exceeded"
+)
+
+// Defines values for APIErrorWindow.
+const (
+	APIErrorWindowDaily          APIErrorWindow = "daily"
+	APIErrorWindowMonthly        APIErrorWindow = "monthly"
+	APIErrorWindowPerTransaction APIErrorWindow = "per_transaction"
+)
+
+// Defines values for AccountStatus.
+const (
+	AccountStatusActive       AccountStatus = "active"
+	AccountStatusClosed       AccountStatus = "closed"
+	AccountStatusDebitBlocked AccountStatus = "debit_blocked"
+	AccountStatusFrozen       AccountStatus = "frozen"
+)
+
+// Defines values for AccountType.
+const (
+	AccountTypeBusiness AccountType = "business"
+	AccountTypeChecking AccountType = "checking"
+	AccountTypePocket   AccountType = "pocket"
+	AccountTypeSavings  AccountType = "savings"
+)
+
+// Defines values for CreateAccountRequestType.
+const (
+	CreateAccountRequestTypeBusiness CreateAccountRequestType = "business"
+	CreateAccountRequestTypeChecking CreateAccountRequestType = "checking"
+	CreateAccountRequestTypePocket   CreateAccountRequestType = "pocket"
+	CreateAccountRequestTypeSavings  CreateAccountRequestType = "savings"
+)
+
 // Defines values for CreateMovementRequestType.
 const (
 	CreateMovementRequestTypeCredit CreateMovementRequestType = "credit"
 	CreateMovementRequestTypeDebit  CreateMovementRequestType = "debit"
 )
 
+// Defines values for CreateTransferBatchRequestMode.
+const (
+	CreateTransferBatchRequestModeAllOrNothing CreateTransferBatchRequestMode = "all_or_nothing"
+	CreateTransferBatchRequestModeBestEffort   CreateTransferBatchRequestMode = "best_effort"
+)
+
+// Defines values for FeeBreakdownCappedAt.
+const (
+	Max FeeBreakdownCappedAt = "max"
+	Min FeeBreakdownCappedAt = "min"
+)
+
+// Defines values for FeeBreakdownOperation.
+const (
+	FeeBreakdownOperationCrossCurrencyTransfer FeeBreakdownOperation = "cross_currency_transfer"
+	FeeBreakdownOperationInstantTransfer       FeeBreakdownOperation = "instant_transfer"
+	FeeBreakdownOperationScheduledTransfer     FeeBreakdownOperation = "scheduled_transfer"
+	FeeBreakdownOperationWithdrawal            FeeBreakdownOperation = "withdrawal"
+)
+
+// Defines values for FeeBreakdownRule.
+const (
+	Flat       FeeBreakdownRule = "flat"
+	None       FeeBreakdownRule = "none"
+	Percentage FeeBreakdownRule = "percentage"
+	Tiered     FeeBreakdownRule = "tiered"
+)
+
+// Defines values for HoldStatus.
+const (
+	HoldStatusActive   HoldStatus = "active"
+	HoldStatusCaptured HoldStatus = "captured"
+	HoldStatusExpired  HoldStatus = "expired"
+	HoldStatusReleased HoldStatus = "released"
+)
+
 // Defines values for MovementType.
 const (
 	MovementTypeCredit MovementType = "credit"
 	MovementTypeDebit  MovementType = "debit"
 )
 
+// Defines values for MovementDetailsType.
+const (
+	MovementDetailsTypeCredit MovementDetailsType = "credit"
+	MovementDetailsTypeDebit  MovementDetailsType = "debit"
+)
+
+// Defines values for PaymentRequestStatus.
+const (
+	PaymentRequestStatusCancelled PaymentRequestStatus = "cancelled"
+	PaymentRequestStatusDeclined  PaymentRequestStatus = "declined"
+	PaymentRequestStatusExpired   PaymentRequestStatus = "expired"
+	PaymentRequestStatusOpen      PaymentRequestStatus = "open"
+	PaymentRequestStatusPaid      PaymentRequestStatus = "paid"
+)
+
+// Defines values for StandingOrderFrequency.
+const (
+	StandingOrderFrequencyMonthly StandingOrderFrequency = "monthly"
+	StandingOrderFrequencyWeekly  StandingOrderFrequency = "weekly"
+)
+
+// Defines values for StandingOrderStatus.
+const (
+	StandingOrderStatusActive    StandingOrderStatus = "active"
+	StandingOrderStatusCancelled StandingOrderStatus = "cancelled"
+	StandingOrderStatusCompleted StandingOrderStatus = "completed"
+	StandingOrderStatusSuspended StandingOrderStatus = "suspended"
+)
+
+// Defines values for StandingOrderRequestFrequency.
+const (
+	Monthly StandingOrderRequestFrequency = "monthly"
+	Weekly  StandingOrderRequestFrequency = "weekly"
+)
+
+// Defines values for StandingOrderUpdateRequestStatus.
+const (
+	StandingOrderUpdateRequestStatusActive    StandingOrderUpdateRequestStatus = "active"
+	StandingOrderUpdateRequestStatusSuspended StandingOrderUpdateRequestStatus = "suspended"
+)
+
+// Defines values for TransferDirection.
+const (
+	TransferDirectionIn  TransferDirection = "in"
+	TransferDirectionOut TransferDirection = "out"
+)
+
 // Defines values for TransferStatus.
 const (
-	Completed TransferStatus = "completed"
-	Failed    TransferStatus = "failed"
-	Pending   TransferStatus = "pending"
+	TransferStatusAwaitingApproval TransferStatus = "awaiting_approval"
+	TransferStatusCancelled        TransferStatus = "cancelled"
+	TransferStatusCompleted        TransferStatus = "completed"
+	TransferStatusFailed           TransferStatus = "failed"
+	TransferStatusPending          TransferStatus = "pending"
+	TransferStatusScheduled        TransferStatus = "scheduled"
+)
+
+// Defines values for TransferApprovalDecision.
+const (
+	TransferApprovalDecisionApproved  TransferApprovalDecision = "approved"
+	TransferApprovalDecisionExpired   TransferApprovalDecision = "expired"
+	TransferApprovalDecisionRejected  TransferApprovalDecision = "rejected"
+	TransferApprovalDecisionRequested TransferApprovalDecision = "requested"
+)
+
+// Defines values for TransferBatchMode.
+const (
+	TransferBatchModeAllOrNothing TransferBatchMode = "all_or_nothing"
+	TransferBatchModeBestEffort   TransferBatchMode = "best_effort"
+)
+
+// Defines values for TransferBatchStatus.
+const (
+	TransferBatchStatusCompleted          TransferBatchStatus = "completed"
+	TransferBatchStatusFailed             TransferBatchStatus = "failed"
+	TransferBatchStatusPartiallyCompleted TransferBatchStatus = "partially_completed"
+	TransferBatchStatusPending            TransferBatchStatus = "pending"
+	TransferBatchStatusProcessing         TransferBatchStatus = "processing"
+)
+
+// Defines values for TransferBatchItemStatus.
+const (
+	TransferBatchItemStatusCompleted TransferBatchItemStatus = "completed"
+	TransferBatchItemStatusFailed    TransferBatchItemStatus = "failed"
+	TransferBatchItemStatusPending   TransferBatchItemStatus = "pending"
+	TransferBatchItemStatusSkipped   TransferBatchItemStatus = "skipped"
+)
+
+// Defines values for TransferDetailsDirection.
+const (
+	TransferDetailsDirectionIn  TransferDetailsDirection = "in"
+	TransferDetailsDirectionOut TransferDetailsDirection = "out"
+)
+
+// Defines values for TransferDetailsStatus.
+const (
+	TransferDetailsStatusAwaitingApproval TransferDetailsStatus = "awaiting_approval"
+	TransferDetailsStatusCancelled        TransferDetailsStatus = "cancelled"
+	TransferDetailsStatusCompleted        TransferDetailsStatus = "completed"
+	TransferDetailsStatusFailed           TransferDetailsStatus = "failed"
+	TransferDetailsStatusPending          TransferDetailsStatus = "pending"
+	TransferDetailsStatusScheduled        TransferDetailsStatus = "scheduled"
+)
+
+// Defines values for UpdateAccountStatusRequestStatus.
+const (
+	UpdateAccountStatusRequestStatusActive       UpdateAccountStatusRequestStatus = "active"
+	UpdateAccountStatusRequestStatusDebitBlocked UpdateAccountStatusRequestStatus = "debit_blocked"
+	UpdateAccountStatusRequestStatusFrozen       UpdateAccountStatusRequestStatus = "frozen"
+)
+
+// Defines values for UserRole.
+const (
+	UserRoleAdmin UserRole = "admin"
+	UserRoleUser  UserRole = "user"
+)
+
+// Defines values for FeeOperationQueryParam.
+const (
+	FeeOperationQueryParamCrossCurrencyTransfer FeeOperationQueryParam = "cross_currency_transfer"
+	FeeOperationQueryParamInstantTransfer       FeeOperationQueryParam = "instant_transfer"
+	FeeOperationQueryParamScheduledTransfer     FeeOperationQueryParam = "scheduled_transfer"
+	FeeOperationQueryParamWithdrawal            FeeOperationQueryParam = "withdrawal"
+)
+
+// Defines values for MovementSortQueryParam.
+const (
+	MovementSortQueryParamAmount     MovementSortQueryParam = "amount"
+	MovementSortQueryParamOccurredAt MovementSortQueryParam = "occurred_at"
+)
+
+// Defines values for MovementTypeQueryParam.
+const (
+	MovementTypeQueryParamCredit MovementTypeQueryParam = "credit"
+	MovementTypeQueryParamDebit  MovementTypeQueryParam = "debit"
+)
+
+// Defines values for OrderQueryParam.
+const (
+	OrderQueryParamAsc  OrderQueryParam = "asc"
+	OrderQueryParamDesc OrderQueryParam = "desc"
+)
+
+// Defines values for PaymentRequestStatusQueryParam.
+const (
+	PaymentRequestStatusQueryParamCancelled PaymentRequestStatusQueryParam = "cancelled"
+	PaymentRequestStatusQueryParamDeclined  PaymentRequestStatusQueryParam = "declined"
+	PaymentRequestStatusQueryParamExpired   PaymentRequestStatusQueryParam = "expired"
+	PaymentRequestStatusQueryParamOpen      PaymentRequestStatusQueryParam = "open"
+	PaymentRequestStatusQueryParamPaid      PaymentRequestStatusQueryParam = "paid"
+)
+
+// Defines values for TransferBatchModeQueryParam.
+const (
+	TransferBatchModeQueryParamAllOrNothing TransferBatchModeQueryParam = "all_or_nothing"
+	TransferBatchModeQueryParamBestEffort   TransferBatchModeQueryParam = "best_effort"
+)
+
+// Defines values for TransferDirectionQueryParam.
+const (
+	TransferDirectionQueryParamIn  TransferDirectionQueryParam = "in"
+	TransferDirectionQueryParamOut TransferDirectionQueryParam = "out"
+)
+
+// Defines values for TransferStatusQueryParam.
+const (
+	TransferStatusQueryParamAwaitingApproval TransferStatusQueryParam = "awaiting_approval"
+	TransferStatusQueryParamCancelled        TransferStatusQueryParam = "cancelled"
+	TransferStatusQueryParamCompleted        TransferStatusQueryParam = "completed"
+	TransferStatusQueryParamFailed           TransferStatusQueryParam = "failed"
+	TransferStatusQueryParamPending          TransferStatusQueryParam = "pending"
+	TransferStatusQueryParamScheduled        TransferStatusQueryParam = "scheduled"
+)
+
+// Defines values for AccountsListMovementsParamsType.
+const (
+	Credit AccountsListMovementsParamsType = "credit"
+	Debit  AccountsListMovementsParamsType = "debit"
+)
+
+// Defines values for AccountsListMovementsParamsSort.
+const (
+	AccountsListMovementsParamsSortAmount     AccountsListMovementsParamsSort = "amount"
+	AccountsListMovementsParamsSortOccurredAt AccountsListMovementsParamsSort = "occurred_at"
+)
+
+// Defines values for AccountsListMovementsParamsOrder.
+const (
+	AccountsListMovementsParamsOrderAsc  AccountsListMovementsParamsOrder = "asc"
+	AccountsListMovementsParamsOrderDesc AccountsListMovementsParamsOrder = "desc"
+)
+
+// Defines values for FeesPreviewParamsOperation.
+const (
+	CrossCurrencyTransfer FeesPreviewParamsOperation = "cross_currency_transfer"
+	InstantTransfer       FeesPreviewParamsOperation = "instant_transfer"
+	ScheduledTransfer     FeesPreviewParamsOperation = "scheduled_transfer"
+	Withdrawal            FeesPreviewParamsOperation = "withdrawal"
+)
+
+// Defines values for PaymentRequestsListIncomingParamsStatus.
+const (
+	PaymentRequestsListIncomingParamsStatusCancelled PaymentRequestsListIncomingParamsStatus = "cancelled"
+	PaymentRequestsListIncomingParamsStatusDeclined  PaymentRequestsListIncomingParamsStatus = "declined"
+	PaymentRequestsListIncomingParamsStatusExpired   PaymentRequestsListIncomingParamsStatus = "expired"
+	PaymentRequestsListIncomingParamsStatusOpen      PaymentRequestsListIncomingParamsStatus = "open"
+	PaymentRequestsListIncomingParamsStatusPaid      PaymentRequestsListIncomingParamsStatus = "paid"
+)
+
+// Defines values for PaymentRequestsListOutgoingParamsStatus.
+const (
+	PaymentRequestsListOutgoingParamsStatusCancelled PaymentRequestsListOutgoingParamsStatus = "cancelled"
+	PaymentRequestsListOutgoingParamsStatusDeclined  PaymentRequestsListOutgoingParamsStatus = "declined"
+	PaymentRequestsListOutgoingParamsStatusExpired   PaymentRequestsListOutgoingParamsStatus = "expired"
+	PaymentRequestsListOutgoingParamsStatusOpen      PaymentRequestsListOutgoingParamsStatus = "open"
+	PaymentRequestsListOutgoingParamsStatusPaid      PaymentRequestsListOutgoingParamsStatus = "paid"
+)
+
+// Defines values for TransfersListParamsDirection.
+const (
+	TransfersListParamsDirectionIn  TransfersListParamsDirection = "in"
+	TransfersListParamsDirectionOut TransfersListParamsDirection = "out"
+)
+
+// Defines values for TransfersListParamsStatus.
+const (
+	TransfersListParamsStatusAwaitingApproval TransfersListParamsStatus = "awaiting_approval"
+	TransfersListParamsStatusCancelled        TransfersListParamsStatus = "cancelled"
+	TransfersListParamsStatusCompleted        TransfersListParamsStatus = "completed"
+	TransfersListParamsStatusFailed           TransfersListParamsStatus = "failed"
+	TransfersListParamsStatusPending          TransfersListParamsStatus = "pending"
+	TransfersListParamsStatusScheduled        TransfersListParamsStatus = "scheduled"
+)
+
+// Defines values for TransfersCreateBatchParamsMode.
+const (
+	TransfersCreateBatchParamsModeAllOrNothing TransfersCreateBatchParamsMode = "all_or_nothing"
+	TransfersCreateBatchParamsModeBestEffort   TransfersCreateBatchParamsMode = "best_effort"
 )
 
 // APIError defines model for APIError.
 type APIError struct {
-	Code    int32  `json:"code"`
-	Message string `json:"message"`
+	Code int32 `json:"code"`
+
+	// Details Individual problems behind the error, e.g. the invalid lines of a transfer batch
+	Details *[]ErrorDetail `json:"details,omitempty"`
+	Message string         `json:"message"`
+
+	// Reason Stable machine-readable code of the errors clients are expected to act on. `transfer_limit_exceeded` refuses
+	// a transfer over a limit of the sender, named by `window`.
+	Reason *APIErrorReason `json:"reason,omitempty"`
+
+	// Window Transfer limit exceeded, set with the `transfer_limit_exceeded` reason.
+	Window *APIErrorWindow `json:"window,omitempty"`
+}
+
+// APIErrorReason Stable machine-readable code of the errors clients are expected to act on. `transfer_limit_exceeded` refuses
+// a transfer over a limit of the sender, named by `window`.
+type APIErrorReason string
+
+// APIErrorWindow Transfer limit exceeded, set with the `transfer_limit_exceeded` reason.
+type APIErrorWindow string
+
+// Account defines model for Account.
+type Account struct {
+	// Balance Decimal encoded as string (shopspring/decimal)
+	Balance   DecimalString `json:"balance"`
+	ClosedAt  *time.Time    `json:"closed_at"`
+	CreatedAt DateTime      `json:"created_at"`
+	Currency  string        `json:"currency"`
+
+	// HeldAmount Decimal encoded as string (shopspring/decimal)
+	HeldAmount DecimalString `json:"held_amount"`
+
+	// Iban International Bank Account Number; spaces are ignored on input
+	Iban IBAN `json:"iban"`
+	Id   UUID `json:"id"`
+
+	// IsDefault The account used when a request does not pick one.
+	IsDefault bool   `json:"is_default"`
+	Name      string `json:"name"`
+
+	// OverdraftLimit Decimal encoded as string (shopspring/decimal)
+	OverdraftLimit DecimalString `json:"overdraft_limit"`
+
+	// OverdraftRate Decimal encoded as string (shopspring/decimal)
+	OverdraftRate DecimalString `json:"overdraft_rate"`
+
+	// Status Frozen accounts can neither send nor receive funds, debit_blocked accounts can only receive them, closed accounts are read-only.
+	Status    AccountStatus `json:"status"`
+	Type      AccountType   `json:"type"`
+	UpdatedAt DateTime      `json:"updated_at"`
+	UserId    UUID          `json:"user_id"`
+}
+
+// AccountStatus Frozen accounts can neither send nor receive funds, debit_blocked accounts can only receive them, closed accounts are read-only.
+type AccountStatus string
+
+// AccountType defines model for Account.Type.
+type AccountType string
+
+// AccountCosigner defines model for AccountCosigner.
+type AccountCosigner struct {
+	AccountId UUID     `json:"account_id"`
+	CreatedAt DateTime `json:"created_at"`
+	UserId    UUID     `json:"user_id"`
+	Username  string   `json:"username"`
+}
+
+// AccountsResponse defines model for AccountsResponse.
+type AccountsResponse struct {
+	Accounts []Account `json:"accounts"`
+}
+
+// AddCosignerRequest defines model for AddCosignerRequest.
+type AddCosignerRequest struct {
+	Username string `json:"username"`
 }
 
 // AuthResponse defines model for AuthResponse.
@@ -56,13 +435,90 @@ type AuthResponse struct {
 type BalanceResponse struct {
 	AccountId UUID `json:"account_id"`
 
-	// Balance Balance formatted as string (decimal.Decimal.String()).
+	// AvailableBalance Funds that can be spent, i.e. balance - held_amount + overdraft_limit.
+	AvailableBalance string `json:"available_balance"`
+
+	// Balance Ledger balance formatted as string (decimal.Decimal.String()).
 	Balance  string `json:"balance"`
 	Currency string `json:"currency"`
+
+	// HeldAmount Total of the active holds of the account.
+	HeldAmount string `json:"held_amount"`
+
+	// OverdraftLimit How far below zero the balance can go.
+	OverdraftLimit string `json:"overdraft_limit"`
+}
+
+// Beneficiary defines model for Beneficiary.
+type Beneficiary struct {
+	AccountId UUID     `json:"account_id"`
+	CreatedAt DateTime `json:"created_at"`
+
+	// Iban IBAN of the account; empty when the account has none.
+	Iban string `json:"iban"`
+	Id   int64  `json:"id"`
+
+	// LastUsedAt When the beneficiary was last used for a transfer.
+	LastUsedAt *time.Time `json:"last_used_at"`
+	Nickname   string     `json:"nickname"`
+	UserId     UUID       `json:"user_id"`
+}
+
+// CaptureHoldRequest defines model for CaptureHoldRequest.
+type CaptureHoldRequest struct {
+	// Amount Amount to capture, at most the held amount (default: the whole hold).
+	Amount *string `json:"amount,omitempty"`
+
+	// Description Description of the debit movement (default: the description of the hold).
+	Description *string `json:"description,omitempty"`
+}
+
+// CloseAccountRequest defines model for CloseAccountRequest.
+type CloseAccountRequest struct {
+	SweepTo *UUID `json:"sweep_to,omitempty"`
+}
+
+// CosignersResponse defines model for CosignersResponse.
+type CosignersResponse struct {
+	Cosigners []AccountCosigner `json:"cosigners"`
+}
+
+// CreateAccountRequest defines model for CreateAccountRequest.
+type CreateAccountRequest struct {
+	// Currency ISO 4217 currency code. Accounts are opened in EUR when it is not given.
+	Currency *Currency                 `json:"currency,omitempty"`
+	Name     *string                   `json:"name,omitempty"`
+	Type     *CreateAccountRequestType `json:"type,omitempty"`
+}
+
+// CreateAccountRequestType defines model for CreateAccountRequest.Type.
+type CreateAccountRequestType string
+
+// CreateBeneficiaryRequest The account is given by exactly one of account_id and iban.
+type CreateBeneficiaryRequest struct {
+	AccountId *UUID `json:"account_id,omitempty"`
+
+	// Iban International Bank Account Number; spaces are ignored on input
+	Iban     *IBAN  `json:"iban,omitempty"`
+	Nickname string `json:"nickname"`
+}
+
+// CreateHoldRequest defines model for CreateHoldRequest.
+type CreateHoldRequest struct {
+	AccountId *UUID `json:"account_id,omitempty"`
+
+	// Amount Decimal encoded as string (shopspring/decimal)
+	Amount      DecimalString `json:"amount"`
+	Description *string       `json:"description,omitempty"`
+
+	// ExpiresAt When the hold is released if not captured (default: configured hold lifetime).
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
 }
 
 // CreateMovementRequest defines model for CreateMovementRequest.
 type CreateMovementRequest struct {
+	AccountId *UUID `json:"account_id,omitempty"`
+
 	// Amount Decimal encoded as string (shopspring/decimal)
 	Amount      DecimalString             `json:"amount"`
 	Description *string                   `json:"description,omitempty"`
@@ -72,18 +528,167 @@ type CreateMovementRequest struct {
 // CreateMovementRequestType defines model for CreateMovementRequest.Type.
 type CreateMovementRequestType string
 
+// CreatePaymentRequestRequest The payer is given by exactly one of from_username and from_email.
+type CreatePaymentRequestRequest struct {
+	AccountId *UUID `json:"account_id,omitempty"`
+
+	// Amount Decimal encoded as string (shopspring/decimal)
+	Amount DecimalString `json:"amount"`
+
+	// ExpiresAt When the request expires if not paid (default: configured request lifetime).
+	ExpiresAt    *time.Time           `json:"expires_at,omitempty"`
+	FromEmail    *openapi_types.Email `json:"from_email,omitempty"`
+	FromUsername *string              `json:"from_username,omitempty"`
+	Message      *string              `json:"message,omitempty"`
+}
+
+// CreateTransferBatchRequest defines model for CreateTransferBatchRequest.
+type CreateTransferBatchRequest struct {
+	AccountId *UUID                           `json:"account_id,omitempty"`
+	Mode      *CreateTransferBatchRequestMode `json:"mode,omitempty"`
+	Transfers []TransferBatchLine             `json:"transfers"`
+}
+
+// CreateTransferBatchRequestMode defines model for CreateTransferBatchRequest.Mode.
+type CreateTransferBatchRequestMode string
+
+// Currency ISO 4217 currency code. Accounts are opened in EUR when it is not given.
+type Currency = string
+
+// CursorPaginationMeta Concrete shape of the pagination of `util.CursorResponse`, returned instead of `PaginationMeta` when a list
+// is requested by `cursor`.
+type CursorPaginationMeta struct {
+	// NextCursor Cursor of the next page; omitted on the last one
+	NextCursor *string `json:"next_cursor,omitempty"`
+	PerPage    int32   `json:"per_page"`
+
+	// PrevCursor Cursor of the previous page; omitted on the first one
+	PrevCursor *string `json:"prev_cursor,omitempty"`
+}
+
 // DateTime defines model for DateTime.
 type DateTime = time.Time
 
 // DecimalString Decimal encoded as string (shopspring/decimal)
 type DecimalString = string
 
+// ErrorDetail defines model for ErrorDetail.
+type ErrorDetail struct {
+	// Line 1-based position of the offending transfer within a batch
+	Line    *int   `json:"line,omitempty"`
+	Message string `json:"message"`
+}
+
 // ErrorResponse Current error envelope from `internal/util/errors.go`.
 // Note: for non-*util.APIError errors, the server responds with code=500 and message="internal server error".
 type ErrorResponse struct {
 	Error APIError `json:"error"`
 }
 
+// FXQuote defines model for FXQuote.
+type FXQuote struct {
+	// Amount Decimal encoded as string (shopspring/decimal)
+	Amount DecimalString `json:"amount"`
+
+	// ConvertedAmount Decimal encoded as string (shopspring/decimal)
+	ConvertedAmount DecimalString `json:"converted_amount"`
+	CreatedAt       DateTime      `json:"created_at"`
+	ExpiresAt       DateTime      `json:"expires_at"`
+	FromCurrency    string        `json:"from_currency"`
+	Id              UUID          `json:"id"`
+
+	// Rate Decimal encoded as string (shopspring/decimal)
+	Rate       DecimalString `json:"rate"`
+	ToCurrency string        `json:"to_currency"`
+	UsedAt     *time.Time    `json:"used_at"`
+}
+
+// FXQuoteRequest defines model for FXQuoteRequest.
+type FXQuoteRequest struct {
+	// Amount Decimal encoded as string (shopspring/decimal)
+	Amount DecimalString `json:"amount"`
+
+	// FromCurrency ISO 4217 currency code. Accounts are opened in EUR when it is not given.
+	FromCurrency Currency `json:"from_currency"`
+
+	// ToCurrency ISO 4217 currency code. Accounts are opened in EUR when it is not given.
+	ToCurrency Currency `json:"to_currency"`
+}
+
+// FXRate One unit of base_currency buys rate units of quote_currency.
+type FXRate struct {
+	BaseCurrency  string `json:"base_currency"`
+	QuoteCurrency string `json:"quote_currency"`
+
+	// Rate Decimal encoded as string (shopspring/decimal)
+	Rate      DecimalString `json:"rate"`
+	UpdatedAt DateTime      `json:"updated_at"`
+}
+
+// FXRatesResponse defines model for FXRatesResponse.
+type FXRatesResponse struct {
+	Rates []FXRate `json:"rates"`
+}
+
+// FeeBreakdown defines model for FeeBreakdown.
+type FeeBreakdown struct {
+	// Amount Decimal encoded as string (shopspring/decimal)
+	Amount DecimalString `json:"amount"`
+
+	// CappedAt Set when the fee was raised to the minimum or lowered to the maximum of the rule.
+	CappedAt *FeeBreakdownCappedAt `json:"capped_at,omitempty"`
+	Currency string                `json:"currency"`
+
+	// Flat Decimal encoded as string (shopspring/decimal)
+	Flat      DecimalString         `json:"flat"`
+	Operation FeeBreakdownOperation `json:"operation"`
+
+	// Percentage Decimal encoded as string (shopspring/decimal)
+	Percentage DecimalString `json:"percentage"`
+
+	// PercentageAmount Decimal encoded as string (shopspring/decimal)
+	PercentageAmount DecimalString    `json:"percentage_amount"`
+	Rule             FeeBreakdownRule `json:"rule"`
+
+	// Tier Tier of a tiered rule the amount falls in, starting from 1.
+	Tier *int `json:"tier,omitempty"`
+
+	// Total Decimal encoded as string (shopspring/decimal)
+	Total DecimalString `json:"total"`
+}
+
+// FeeBreakdownCappedAt Set when the fee was raised to the minimum or lowered to the maximum of the rule.
+type FeeBreakdownCappedAt string
+
+// FeeBreakdownOperation defines model for FeeBreakdown.Operation.
+type FeeBreakdownOperation string
+
+// FeeBreakdownRule defines model for FeeBreakdown.Rule.
+type FeeBreakdownRule string
+
+// Hold defines model for Hold.
+type Hold struct {
+	AccountId UUID `json:"account_id"`
+
+	// Amount Decimal encoded as string (shopspring/decimal)
+	Amount DecimalString `json:"amount"`
+
+	// CapturedAmount Decimal encoded as string (shopspring/decimal)
+	CapturedAmount DecimalString `json:"captured_amount"`
+	CreatedAt      DateTime      `json:"created_at"`
+	Description    string        `json:"description"`
+	ExpiresAt      DateTime      `json:"expires_at"`
+	Id             int64         `json:"id"`
+	Status         HoldStatus    `json:"status"`
+	UpdatedAt      DateTime      `json:"updated_at"`
+}
+
+// HoldStatus defines model for Hold.Status.
+type HoldStatus string
+
+// IBAN International Bank Account Number; spaces are ignored on input
+type IBAN = string
+
 // LoginRequest defines model for LoginRequest.
 type LoginRequest struct {
 	Email    openapi_types.Email `json:"email"`
@@ -97,38 +702,208 @@ type Movement struct {
 	AccountId UUID `json:"account_id"`
 
 	// Amount Decimal encoded as string (shopspring/decimal)
-	Amount      DecimalString `json:"amount"`
-	Description string        `json:"description"`
-	Id          int64         `json:"id"`
-	OccurredAt  DateTime      `json:"occurred_at"`
-	Type        MovementType  `json:"type"`
+	Amount DecimalString `json:"amount"`
+
+	// BalanceAfter Decimal encoded as string (shopspring/decimal)
+	BalanceAfter DecimalString `json:"balance_after"`
+
+	// ConvertedAmount Decimal encoded as string (shopspring/decimal)
+	ConvertedAmount *DecimalString `json:"converted_amount,omitempty"`
+
+	// ConvertedCurrency Currency of the receiving account of a converted transfer.
+	ConvertedCurrency *string `json:"converted_currency,omitempty"`
+	Description       string  `json:"description"`
+
+	// FxRate Decimal encoded as string (shopspring/decimal)
+	FxRate *DecimalString `json:"fx_rate,omitempty"`
+
+	// HoldId Hold captured by this movement.
+	HoldId *int64 `json:"hold_id"`
+	Id     int64  `json:"id"`
+
+	// JournalEntryId Ledger journal entry that moved the funds for this movement.
+	JournalEntryId *int64   `json:"journal_entry_id"`
+	OccurredAt     DateTime `json:"occurred_at"`
+
+	// OriginalAmount Decimal encoded as string (shopspring/decimal)
+	OriginalAmount *DecimalString `json:"original_amount,omitempty"`
+
+	// OriginalCurrency Currency of the sending account of a converted transfer.
+	OriginalCurrency *string      `json:"original_currency,omitempty"`
+	Type             MovementType `json:"type"`
 }
 
 // MovementType defines model for Movement.Type.
 type MovementType string
 
-// PaginatedMovementsResponse Concrete shape of `util.PaginatedResponse` as returned by `MovementService.GetByAccountID()`.
-type PaginatedMovementsResponse struct {
-	Data       []Movement     `json:"data"`
+// MovementDetails defines model for MovementDetails.
+type MovementDetails struct {
+	AccountId UUID `json:"account_id"`
+
+	// Amount Decimal encoded as string (shopspring/decimal)
+	Amount DecimalString `json:"amount"`
+
+	// BalanceAfter Decimal encoded as string (shopspring/decimal)
+	BalanceAfter DecimalString `json:"balance_after"`
+
+	// ConvertedAmount Decimal encoded as string (shopspring/decimal)
+	ConvertedAmount *DecimalString `json:"converted_amount,omitempty"`
+
+	// ConvertedCurrency Currency of the receiving account of a converted transfer.
+	ConvertedCurrency *string `json:"converted_currency,omitempty"`
+
+	// CounterpartyName Masked name of the owner of the account on the other side of the transfer.
+	CounterpartyName *string `json:"counterparty_name,omitempty"`
+	Description      string  `json:"description"`
+
+	// FxRate Decimal encoded as string (shopspring/decimal)
+	FxRate *DecimalString `json:"fx_rate,omitempty"`
+
+	// HoldId Hold captured by this movement.
+	HoldId *int64 `json:"hold_id"`
+	Id     int64  `json:"id"`
+
+	// JournalEntryId Ledger journal entry that moved the funds for this movement.
+	JournalEntryId *int64   `json:"journal_entry_id"`
+	OccurredAt     DateTime `json:"occurred_at"`
+
+	// OriginalAmount Decimal encoded as string (shopspring/decimal)
+	OriginalAmount *DecimalString `json:"original_amount,omitempty"`
+
+	// OriginalCurrency Currency of the sending account of a converted transfer.
+	OriginalCurrency *string `json:"original_currency,omitempty"`
+
+	// Transfer Transfer that posted the movement, null for deposits, withdrawals and fees.
+	Transfer *Transfer           `json:"transfer"`
+	Type     MovementDetailsType `json:"type"`
+}
+
+// MovementDetailsType defines model for MovementDetails.Type.
+type MovementDetailsType string
+
+// PaginatedBeneficiariesResponse Concrete shape of `util.PaginatedResponse` as returned by `BeneficiaryService.GetByUserID()`.
+type PaginatedBeneficiariesResponse struct {
+	Data       []Beneficiary  `json:"data"`
 	Pagination PaginationMeta `json:"pagination"`
 }
 
-// PaginatedTransfersResponse Concrete shape of `util.PaginatedResponse` as returned by `TransferService.GetByAccountID()`.
-type PaginatedTransfersResponse struct {
-	Data       []Transfer     `json:"data"`
+// PaginatedHoldsResponse Concrete shape of `util.PaginatedResponse` as returned by `HoldService.GetByAccountID()`.
+type PaginatedHoldsResponse struct {
+	Data       []Hold         `json:"data"`
 	Pagination PaginationMeta `json:"pagination"`
 }
 
-// PaginationMeta defines model for PaginationMeta.
-type PaginationMeta struct {
+// PaginatedMovementsResponse Concrete shape of `util.PaginatedResponse` as returned by `MovementService.GetByAccountID()`, or of
+// `util.CursorResponse` as returned by `MovementService.GetByAccountIDWithCursor()`.
+type PaginatedMovementsResponse struct {
+	Data       []Movement                            `json:"data"`
+	Pagination PaginatedMovementsResponse_Pagination `json:"pagination"`
+}
+
+// PaginatedMovementsResponse_Pagination defines model for PaginatedMovementsResponse.Pagination.
+type PaginatedMovementsResponse_Pagination struct {
+	union json.RawMessage
+}
+
+// PaginatedPaymentRequestsResponse Concrete shape of `util.PaginatedResponse` as returned by `PaymentRequestService.GetIncoming()` and
+// `PaymentRequestService.GetOutgoing()`.
+type PaginatedPaymentRequestsResponse struct {
+	Data       []PaymentRequest `json:"data"`
+	Pagination PaginationMeta   `json:"pagination"`
+}
+
+// PaginatedStandingOrdersResponse Concrete shape of `util.PaginatedResponse` as returned by `StandingOrderService.GetByAccountID()`.
+type PaginatedStandingOrdersResponse struct {
+	Data       []StandingOrder `json:"data"`
+	Pagination PaginationMeta  `json:"pagination"`
+}
+
+// PaginatedTransfersResponse Concrete shape of `util.PaginatedResponse` as returned by `TransferService.GetByAccountID()`, or of
+// `util.CursorResponse` as returned by `TransferService.GetByAccountIDWithCursor()`.
+type PaginatedTransfersResponse struct {
+	Data       []Transfer                            `json:"data"`
+	Pagination PaginatedTransfersResponse_Pagination `json:"pagination"`
+}
+
+// PaginatedTransfersResponse_Pagination defines model for PaginatedTransfersResponse.Pagination.
+type PaginatedTransfersResponse_Pagination struct {
+	union json.RawMessage
+}
+
+// PaginationMeta defines model for PaginationMeta.
+type PaginationMeta struct {
 	CurrentPage int32 `json:"current_page"`
 	PerPage     int32 `json:"per_page"`
 	TotalItems  int32 `json:"total_items"`
 	TotalPages  int32 `json:"total_pages"`
 }
 
+// PayPaymentRequestRequest defines model for PayPaymentRequestRequest.
+type PayPaymentRequestRequest struct {
+	AccountId *UUID `json:"account_id,omitempty"`
+}
+
+// PaymentRequest defines model for PaymentRequest.
+type PaymentRequest struct {
+	// Amount Decimal encoded as string (shopspring/decimal)
+	Amount      DecimalString        `json:"amount"`
+	CreatedAt   DateTime             `json:"created_at"`
+	ExpiresAt   DateTime             `json:"expires_at"`
+	Id          int64                `json:"id"`
+	Message     string               `json:"message"`
+	PayerId     UUID                 `json:"payer_id"`
+	RequesterId UUID                 `json:"requester_id"`
+	Status      PaymentRequestStatus `json:"status"`
+	ToAccount   UUID                 `json:"to_account"`
+
+	// TransferId Transfer that paid the request.
+	TransferId *int64   `json:"transfer_id"`
+	UpdatedAt  DateTime `json:"updated_at"`
+}
+
+// PaymentRequestStatus defines model for PaymentRequest.Status.
+type PaymentRequestStatus string
+
+// Recipient defines model for Recipient.
+type Recipient struct {
+	// Name Masked full name of the recipient user.
+	Name string `json:"name"`
+}
+
+// ReverseTransferRequest defines model for ReverseTransferRequest.
+type ReverseTransferRequest struct {
+	// Amount Decimal encoded as string (shopspring/decimal)
+	Amount      *DecimalString `json:"amount,omitempty"`
+	Description *string        `json:"description,omitempty"`
+}
+
+// SetFXRatesRequest defines model for SetFXRatesRequest.
+type SetFXRatesRequest struct {
+	Rates []struct {
+		// BaseCurrency ISO 4217 currency code. Accounts are opened in EUR when it is not given.
+		BaseCurrency Currency `json:"base_currency"`
+
+		// QuoteCurrency ISO 4217 currency code. Accounts are opened in EUR when it is not given.
+		QuoteCurrency Currency `json:"quote_currency"`
+
+		// Rate Decimal encoded as string (shopspring/decimal)
+		Rate DecimalString `json:"rate"`
+	} `json:"rates"`
+}
+
+// SetOverdraftRequest defines model for SetOverdraftRequest.
+type SetOverdraftRequest struct {
+	// Limit Decimal encoded as string (shopspring/decimal)
+	Limit DecimalString `json:"limit"`
+
+	// Rate Decimal encoded as string (shopspring/decimal)
+	Rate *DecimalString `json:"rate,omitempty"`
+}
+
 // SignUpRequest defines model for SignUpRequest.
 type SignUpRequest struct {
+	// Currency ISO 4217 currency code. Accounts are opened in EUR when it is not given.
+	Currency   *Currency           `json:"currency,omitempty"`
 	Email      openapi_types.Email `json:"email"`
 	FirstName  string              `json:"first_name"`
 	FiscalCode string              `json:"fiscal_code"`
@@ -137,32 +912,340 @@ type SignUpRequest struct {
 	Username   string              `json:"username"`
 }
 
+// StandingOrder defines model for StandingOrder.
+type StandingOrder struct {
+	// Amount Decimal encoded as string (shopspring/decimal)
+	Amount              DecimalString          `json:"amount"`
+	ConsecutiveFailures int                    `json:"consecutive_failures"`
+	CreatedAt           DateTime               `json:"created_at"`
+	Description         string                 `json:"description"`
+	EndDate             *time.Time             `json:"end_date"`
+	Frequency           StandingOrderFrequency `json:"frequency"`
+	FromAccount         UUID                   `json:"from_account"`
+	Id                  int64                  `json:"id"`
+	Interval            int                    `json:"interval"`
+	LastError           string                 `json:"last_error"`
+	LastRunAt           *time.Time             `json:"last_run_at"`
+	LastTransferId      *int64                 `json:"last_transfer_id"`
+	NextRunAt           *time.Time             `json:"next_run_at"`
+	Occurrences         int                    `json:"occurrences"`
+	StartDate           DateTime               `json:"start_date"`
+	Status              StandingOrderStatus    `json:"status"`
+	ToAccount           UUID                   `json:"to_account"`
+	UpdatedAt           DateTime               `json:"updated_at"`
+}
+
+// StandingOrderFrequency defines model for StandingOrder.Frequency.
+type StandingOrderFrequency string
+
+// StandingOrderStatus defines model for StandingOrder.Status.
+type StandingOrderStatus string
+
+// StandingOrderRequest defines model for StandingOrderRequest.
+type StandingOrderRequest struct {
+	// Amount Decimal encoded as string (shopspring/decimal)
+	Amount      DecimalString `json:"amount"`
+	Description *string       `json:"description,omitempty"`
+
+	// EndDate Last date an occurrence may fall on. The order runs until cancelled when omitted.
+	EndDate     *time.Time                    `json:"end_date,omitempty"`
+	Frequency   StandingOrderRequestFrequency `json:"frequency"`
+	FromAccount *UUID                         `json:"from_account,omitempty"`
+
+	// Interval Number of weeks or months between two occurrences.
+	Interval  *int     `json:"interval,omitempty"`
+	StartDate DateTime `json:"start_date"`
+	ToAccount UUID     `json:"to_account"`
+}
+
+// StandingOrderRequestFrequency defines model for StandingOrderRequest.Frequency.
+type StandingOrderRequestFrequency string
+
+// StandingOrderUpdateRequest defines model for StandingOrderUpdateRequest.
+type StandingOrderUpdateRequest struct {
+	// Amount Decimal encoded as string (shopspring/decimal)
+	Amount      *DecimalString `json:"amount,omitempty"`
+	Description *string        `json:"description,omitempty"`
+	EndDate     *time.Time     `json:"end_date,omitempty"`
+
+	// Status Pause (`suspended`) or resume (`active`) the order. Resuming skips the missed occurrences.
+	Status *StandingOrderUpdateRequestStatus `json:"status,omitempty"`
+}
+
+// StandingOrderUpdateRequestStatus Pause (`suspended`) or resume (`active`) the order. Resuming skips the missed occurrences.
+type StandingOrderUpdateRequestStatus string
+
 // Transfer defines model for Transfer.
 type Transfer struct {
 	// Amount Decimal encoded as string (shopspring/decimal)
-	Amount      DecimalString  `json:"amount"`
-	CompletedAt *time.Time     `json:"completed_at"`
-	FromAccount UUID           `json:"from_account"`
+	Amount DecimalString `json:"amount"`
+
+	// ApprovalExpiresAt When a transfer awaiting approval fails unless a co-signer approves it.
+	ApprovalExpiresAt *time.Time `json:"approval_expires_at,omitempty"`
+	CompletedAt       *time.Time `json:"completed_at"`
+
+	// ConvertedAmount Decimal encoded as string (shopspring/decimal)
+	ConvertedAmount *DecimalString `json:"converted_amount,omitempty"`
+
+	// ConvertedCurrency Currency the amount was converted to, for transfers between accounts of different currencies.
+	ConvertedCurrency *string `json:"converted_currency,omitempty"`
+
+	// Currency Currency of amount, the one of the sending account.
+	Currency    *string `json:"currency,omitempty"`
+	Description string  `json:"description"`
+
+	// Direction Set on listed transfers only, relative to the listed account; `in` when it received the transfer.
+	Direction *TransferDirection `json:"direction,omitempty"`
+	ExecuteAt *time.Time         `json:"execute_at"`
+
+	// Fee Decimal encoded as string (shopspring/decimal)
+	Fee          *DecimalString `json:"fee,omitempty"`
+	FeeBreakdown *FeeBreakdown  `json:"fee_breakdown,omitempty"`
+	FromAccount  UUID           `json:"from_account"`
+
+	// FxRate Decimal encoded as string (shopspring/decimal)
+	FxRate      *DecimalString `json:"fx_rate,omitempty"`
 	Id          int64          `json:"id"`
 	InitiatedAt DateTime       `json:"initiated_at"`
-	Status      TransferStatus `json:"status"`
-	ToAccount   UUID           `json:"to_account"`
+	QuoteId     *UUID          `json:"quote_id,omitempty"`
+
+	// ReversalOf ID of the transfer this one reverses.
+	ReversalOf *int64 `json:"reversal_of"`
+
+	// ReversedAmount Decimal encoded as string (shopspring/decimal)
+	ReversedAmount DecimalString  `json:"reversed_amount"`
+	Status         TransferStatus `json:"status"`
+	ToAccount      UUID           `json:"to_account"`
 }
 
+// TransferDirection Set on listed transfers only, relative to the listed account; `in` when it received the transfer.
+type TransferDirection string
+
 // TransferStatus defines model for Transfer.Status.
 type TransferStatus string
 
-// TransferRequest defines model for TransferRequest.
-type TransferRequest struct {
+// TransferAllowance defines model for TransferAllowance.
+type TransferAllowance struct {
+	// Currency Currency of the limits; transfers from accounts of other currencies count at the current exchange rates.
+	Currency string `json:"currency"`
+
+	// DailyLimit Decimal encoded as string (shopspring/decimal)
+	DailyLimit DecimalString `json:"daily_limit"`
+
+	// DailyRemaining Decimal encoded as string (shopspring/decimal)
+	DailyRemaining DecimalString `json:"daily_remaining"`
+
+	// DailyUsed Decimal encoded as string (shopspring/decimal)
+	DailyUsed DecimalString `json:"daily_used"`
+
+	// MonthlyLimit Decimal encoded as string (shopspring/decimal)
+	MonthlyLimit DecimalString `json:"monthly_limit"`
+
+	// MonthlyRemaining Decimal encoded as string (shopspring/decimal)
+	MonthlyRemaining DecimalString `json:"monthly_remaining"`
+
+	// MonthlyUsed Decimal encoded as string (shopspring/decimal)
+	MonthlyUsed DecimalString `json:"monthly_used"`
+
+	// PerTransactionLimit Decimal encoded as string (shopspring/decimal)
+	PerTransactionLimit DecimalString `json:"per_transaction_limit"`
+}
+
+// TransferApproval defines model for TransferApproval.
+type TransferApproval struct {
+	CreatedAt  DateTime                 `json:"created_at"`
+	Decision   TransferApprovalDecision `json:"decision"`
+	Id         int64                    `json:"id"`
+	Note       string                   `json:"note"`
+	TransferId int64                    `json:"transfer_id"`
+
+	// UserId User who made the decision, null when the approval expired.
+	UserId *UUID `json:"user_id"`
+}
+
+// TransferApprovalDecision defines model for TransferApproval.Decision.
+type TransferApprovalDecision string
+
+// TransferApprovalsResponse defines model for TransferApprovalsResponse.
+type TransferApprovalsResponse struct {
+	Approvals []TransferApproval `json:"approvals"`
+}
+
+// TransferBatch defines model for TransferBatch.
+type TransferBatch struct {
+	CompletedAt    *time.Time `json:"completed_at"`
+	CompletedCount int        `json:"completed_count"`
+	CreatedAt      DateTime   `json:"created_at"`
+	FailedCount    int        `json:"failed_count"`
+	FromAccount    UUID       `json:"from_account"`
+
+	// HoldId Hold reserving the total of the batch until it is processed.
+	HoldId    *int64 `json:"hold_id"`
+	Id        int64  `json:"id"`
+	ItemCount int    `json:"item_count"`
+
+	// Items Transfers of the batch in line order; only returned when getting a single batch.
+	Items  *[]TransferBatchItem `json:"items,omitempty"`
+	Mode   TransferBatchMode    `json:"mode"`
+	Status TransferBatchStatus  `json:"status"`
+
+	// TotalAmount Decimal encoded as string (shopspring/decimal)
+	TotalAmount DecimalString `json:"total_amount"`
+	UpdatedAt   DateTime      `json:"updated_at"`
+}
+
+// TransferBatchMode defines model for TransferBatch.Mode.
+type TransferBatchMode string
+
+// TransferBatchStatus defines model for TransferBatch.Status.
+type TransferBatchStatus string
+
+// TransferBatchItem defines model for TransferBatchItem.
+type TransferBatchItem struct {
+	// Amount Decimal encoded as string (shopspring/decimal)
+	Amount      DecimalString `json:"amount"`
+	Description string        `json:"description"`
+
+	// Error Why the transfer failed.
+	Error *string `json:"error,omitempty"`
+
+	// Fee Decimal encoded as string (shopspring/decimal)
+	Fee DecimalString `json:"fee"`
+
+	// Line 1-based position of the transfer within the batch.
+	Line       int                     `json:"line"`
+	Status     TransferBatchItemStatus `json:"status"`
+	ToAccount  UUID                    `json:"to_account"`
+	TransferId *int64                  `json:"transfer_id"`
+}
+
+// TransferBatchItemStatus defines model for TransferBatchItem.Status.
+type TransferBatchItemStatus string
+
+// TransferBatchLine A transfer of a batch; exactly one of `to_account` and `to_iban` is required.
+type TransferBatchLine struct {
 	// Amount Decimal encoded as string (shopspring/decimal)
 	Amount      DecimalString `json:"amount"`
 	Description *string       `json:"description,omitempty"`
-	ToAccount   UUID          `json:"to_account"`
+	ToAccount   *UUID         `json:"to_account,omitempty"`
+
+	// ToIban International Bank Account Number; spaces are ignored on input
+	ToIban *IBAN `json:"to_iban,omitempty"`
+}
+
+// TransferDecisionRequest defines model for TransferDecisionRequest.
+type TransferDecisionRequest struct {
+	Note *string `json:"note,omitempty"`
+}
+
+// TransferDetails defines model for TransferDetails.
+type TransferDetails struct {
+	// Amount Decimal encoded as string (shopspring/decimal)
+	Amount DecimalString `json:"amount"`
+
+	// ApprovalExpiresAt When a transfer awaiting approval fails unless a co-signer approves it.
+	ApprovalExpiresAt *time.Time `json:"approval_expires_at,omitempty"`
+	CompletedAt       *time.Time `json:"completed_at"`
+
+	// ConvertedAmount Decimal encoded as string (shopspring/decimal)
+	ConvertedAmount *DecimalString `json:"converted_amount,omitempty"`
+
+	// ConvertedCurrency Currency the amount was converted to, for transfers between accounts of different currencies.
+	ConvertedCurrency *string `json:"converted_currency,omitempty"`
+
+	// CounterpartyName Masked name of the owner of the account on the other side, the caller's own for transfers between their accounts.
+	CounterpartyName string `json:"counterparty_name"`
+
+	// Currency Currency of amount, the one of the sending account.
+	Currency    *string `json:"currency,omitempty"`
+	Description string  `json:"description"`
+
+	// Direction Set on listed transfers only, relative to the listed account; `in` when it received the transfer.
+	Direction *TransferDetailsDirection `json:"direction,omitempty"`
+	ExecuteAt *time.Time                `json:"execute_at"`
+
+	// Fee Decimal encoded as string (shopspring/decimal)
+	Fee          *DecimalString `json:"fee,omitempty"`
+	FeeBreakdown *FeeBreakdown  `json:"fee_breakdown,omitempty"`
+	FromAccount  UUID           `json:"from_account"`
+
+	// FxRate Decimal encoded as string (shopspring/decimal)
+	FxRate      *DecimalString `json:"fx_rate,omitempty"`
+	Id          int64          `json:"id"`
+	InitiatedAt DateTime       `json:"initiated_at"`
+
+	// Movements Movements posted by the transfer on the accounts the caller can see.
+	Movements []Movement `json:"movements"`
+	QuoteId   *UUID      `json:"quote_id,omitempty"`
+
+	// ReversalOf ID of the transfer this one reverses.
+	ReversalOf *int64 `json:"reversal_of"`
+
+	// ReversedAmount Decimal encoded as string (shopspring/decimal)
+	ReversedAmount DecimalString         `json:"reversed_amount"`
+	Status         TransferDetailsStatus `json:"status"`
+	ToAccount      UUID                  `json:"to_account"`
+}
+
+// TransferDetailsDirection Set on listed transfers only, relative to the listed account; `in` when it received the transfer.
+type TransferDetailsDirection string
+
+// TransferDetailsStatus defines model for TransferDetails.Status.
+type TransferDetailsStatus string
+
+// TransferLimitsUpdateRequest Limits to lower; omitted limits are left untouched.
+type TransferLimitsUpdateRequest struct {
+	// Daily Decimal encoded as string (shopspring/decimal)
+	Daily *DecimalString `json:"daily,omitempty"`
+
+	// Monthly Decimal encoded as string (shopspring/decimal)
+	Monthly *DecimalString `json:"monthly,omitempty"`
+
+	// PerTransaction Decimal encoded as string (shopspring/decimal)
+	PerTransaction *DecimalString `json:"per_transaction,omitempty"`
+}
+
+// TransferRequest The recipient is given by exactly one of to_account, to_iban, beneficiary_id, to_username and to_email.
+type TransferRequest struct {
+	// Amount Decimal encoded as string (shopspring/decimal)
+	Amount DecimalString `json:"amount"`
+
+	// BeneficiaryId Saved beneficiary of the user to send the transfer to.
+	BeneficiaryId *int64  `json:"beneficiary_id,omitempty"`
+	Description   *string `json:"description,omitempty"`
+
+	// ExecuteAt Future execution date. When set, the transfer is scheduled instead of executed immediately.
+	ExecuteAt   *time.Time `json:"execute_at,omitempty"`
+	FromAccount *UUID      `json:"from_account,omitempty"`
+	QuoteId     *UUID      `json:"quote_id,omitempty"`
+	ToAccount   *UUID      `json:"to_account,omitempty"`
+
+	// ToEmail Email of the user whose default account receives the transfer.
+	ToEmail *openapi_types.Email `json:"to_email,omitempty"`
+
+	// ToIban International Bank Account Number; spaces are ignored on input
+	ToIban *IBAN `json:"to_iban,omitempty"`
+
+	// ToUsername Username of the user whose default account receives the transfer.
+	ToUsername *string `json:"to_username,omitempty"`
 }
 
 // UUID defines model for UUID.
 type UUID = openapi_types.UUID
 
+// UpdateAccountStatusRequest defines model for UpdateAccountStatusRequest.
+type UpdateAccountStatusRequest struct {
+	Status UpdateAccountStatusRequestStatus `json:"status"`
+}
+
+// UpdateAccountStatusRequestStatus defines model for UpdateAccountStatusRequest.Status.
+type UpdateAccountStatusRequestStatus string
+
+// UpdateBeneficiaryRequest defines model for UpdateBeneficiaryRequest.
+type UpdateBeneficiaryRequest struct {
+	Nickname string `json:"nickname"`
+}
+
 // User defines model for User.
 type User struct {
 	CreatedAt  DateTime            `json:"created_at"`
@@ -171,41 +1254,256 @@ type User struct {
 	FiscalCode string              `json:"fiscal_code"`
 	Id         UUID                `json:"id"`
 	LastName   string              `json:"last_name"`
+	Role       UserRole            `json:"role"`
 	UpdatedAt  DateTime            `json:"updated_at"`
 	Username   string              `json:"username"`
 }
 
+// UserRole defines model for User.Role.
+type UserRole string
+
+// AccountIdParam defines model for AccountIdParam.
+type AccountIdParam = openapi_types.UUID
+
+// AccountIdQueryParam defines model for AccountIdQueryParam.
+type AccountIdQueryParam = openapi_types.UUID
+
+// AmountQueryParam defines model for AmountQueryParam.
+type AmountQueryParam = string
+
+// BeneficiaryIdParam defines model for BeneficiaryIdParam.
+type BeneficiaryIdParam = int64
+
+// CosignerUserIdParam defines model for CosignerUserIdParam.
+type CosignerUserIdParam = openapi_types.UUID
+
+// CounterpartyAccountQueryParam defines model for CounterpartyAccountQueryParam.
+type CounterpartyAccountQueryParam = openapi_types.UUID
+
+// CursorQueryParam defines model for CursorQueryParam.
+type CursorQueryParam = string
+
+// FeeOperationQueryParam defines model for FeeOperationQueryParam.
+type FeeOperationQueryParam string
+
+// FromQueryParam defines model for FromQueryParam.
+type FromQueryParam = time.Time
+
+// HoldIdParam defines model for HoldIdParam.
+type HoldIdParam = int64
+
+// IdempotencyKeyHeader defines model for IdempotencyKeyHeader.
+type IdempotencyKeyHeader = string
+
 // LimitParam defines model for LimitParam.
 type LimitParam = int
 
+// MaxAmountQueryParam defines model for MaxAmountQueryParam.
+type MaxAmountQueryParam = string
+
+// MinAmountQueryParam defines model for MinAmountQueryParam.
+type MinAmountQueryParam = string
+
+// MovementIdParam defines model for MovementIdParam.
+type MovementIdParam = int64
+
+// MovementSortQueryParam defines model for MovementSortQueryParam.
+type MovementSortQueryParam string
+
+// MovementTypeQueryParam defines model for MovementTypeQueryParam.
+type MovementTypeQueryParam string
+
 // OAuthCodeParam defines model for OAuthCodeParam.
 type OAuthCodeParam = string
 
 // OAuthStateParam defines model for OAuthStateParam.
 type OAuthStateParam = string
 
+// OrderQueryParam defines model for OrderQueryParam.
+type OrderQueryParam string
+
 // PageParam defines model for PageParam.
 type PageParam = int
 
+// PaymentRequestIdParam defines model for PaymentRequestIdParam.
+type PaymentRequestIdParam = int64
+
+// PaymentRequestStatusQueryParam defines model for PaymentRequestStatusQueryParam.
+type PaymentRequestStatusQueryParam string
+
+// SearchQueryParam defines model for SearchQueryParam.
+type SearchQueryParam = string
+
+// StandingOrderIdParam defines model for StandingOrderIdParam.
+type StandingOrderIdParam = int64
+
+// ToEmailQueryParam defines model for ToEmailQueryParam.
+type ToEmailQueryParam = string
+
+// ToQueryParam defines model for ToQueryParam.
+type ToQueryParam = time.Time
+
+// ToUsernameQueryParam defines model for ToUsernameQueryParam.
+type ToUsernameQueryParam = string
+
+// TransferBatchIdParam defines model for TransferBatchIdParam.
+type TransferBatchIdParam = int64
+
+// TransferBatchModeQueryParam defines model for TransferBatchModeQueryParam.
+type TransferBatchModeQueryParam string
+
+// TransferDirectionQueryParam defines model for TransferDirectionQueryParam.
+type TransferDirectionQueryParam string
+
+// TransferIdParam defines model for TransferIdParam.
+type TransferIdParam = int64
+
+// TransferStatusQueryParam defines model for TransferStatusQueryParam.
+type TransferStatusQueryParam string
+
 // BadRequestError Current error envelope from `internal/util/errors.go`.
 // Note: for non-*util.APIError errors, the server responds with code=500 and message="internal server error".
 type BadRequestError = ErrorResponse
 
+// ConflictError Current error envelope from `internal/util/errors.go`.
+// Note: for non-*util.APIError errors, the server responds with code=500 and message="internal server error".
+type ConflictError = ErrorResponse
+
+// ForbiddenError Current error envelope from `internal/util/errors.go`.
+// Note: for non-*util.APIError errors, the server responds with code=500 and message="internal server error".
+type ForbiddenError = ErrorResponse
+
 // InternalServerError Current error envelope from `internal/util/errors.go`.
 // Note: for non-*util.APIError errors, the server responds with code=500 and message="internal server error".
 type InternalServerError = ErrorResponse
 
+// NotFoundError Current error envelope from `internal/util/errors.go`.
+// Note: for non-*util.APIError errors, the server responds with code=500 and message="internal server error".
+type NotFoundError = ErrorResponse
+
 // UnauthorizedError Current error envelope from `internal/util/errors.go`.
 // Note: for non-*util.APIError errors, the server responds with code=500 and message="internal server error".
 type UnauthorizedError = ErrorResponse
 
+// UnprocessableEntityError Current error envelope from `internal/util/errors.go`.
+// Note: for non-*util.APIError errors, the server responds with code=500 and message="internal server error".
+type UnprocessableEntityError = ErrorResponse
+
+// AccountsCreateParams defines parameters for AccountsCreate.
+type AccountsCreateParams struct {
+	// IdempotencyKey Client-generated key that makes the request safe to retry. A retry with the same key
+	// replays the first response (with `Idempotent-Replayed: true`) instead of executing again.
+	IdempotencyKey *IdempotencyKeyHeader `json:"Idempotency-Key,omitempty"`
+}
+
+// AccountsGetBalanceParams defines parameters for AccountsGetBalance.
+type AccountsGetBalanceParams struct {
+	// AccountId Account of the user to use (default: the user's default account)
+	AccountId *AccountIdQueryParam `form:"account_id,omitempty" json:"account_id,omitempty"`
+}
+
+// HoldsListParams defines parameters for HoldsList.
+type HoldsListParams struct {
+	// Page Page number (default: 1)
+	Page *PageParam `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Items per page (default: 10, max: 100)
+	Limit *LimitParam `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// AccountId Account of the user to use (default: the user's default account)
+	AccountId *AccountIdQueryParam `form:"account_id,omitempty" json:"account_id,omitempty"`
+}
+
+// HoldsCreateParams defines parameters for HoldsCreate.
+type HoldsCreateParams struct {
+	// IdempotencyKey Client-generated key that makes the request safe to retry. A retry with the same key
+	// replays the first response (with `Idempotent-Replayed: true`) instead of executing again.
+	IdempotencyKey *IdempotencyKeyHeader `json:"Idempotency-Key,omitempty"`
+}
+
+// HoldsGetParams defines parameters for HoldsGet.
+type HoldsGetParams struct {
+	// AccountId Account of the user to use (default: the user's default account)
+	AccountId *AccountIdQueryParam `form:"account_id,omitempty" json:"account_id,omitempty"`
+}
+
+// HoldsCaptureParams defines parameters for HoldsCapture.
+type HoldsCaptureParams struct {
+	// AccountId Account of the user to use (default: the user's default account)
+	AccountId *AccountIdQueryParam `form:"account_id,omitempty" json:"account_id,omitempty"`
+
+	// IdempotencyKey Client-generated key that makes the request safe to retry. A retry with the same key
+	// replays the first response (with `Idempotent-Replayed: true`) instead of executing again.
+	IdempotencyKey *IdempotencyKeyHeader `json:"Idempotency-Key,omitempty"`
+}
+
+// HoldsReleaseParams defines parameters for HoldsRelease.
+type HoldsReleaseParams struct {
+	// AccountId Account of the user to use (default: the user's default account)
+	AccountId *AccountIdQueryParam `form:"account_id,omitempty" json:"account_id,omitempty"`
+}
+
 // AccountsListMovementsParams defines parameters for AccountsListMovements.
 type AccountsListMovementsParams struct {
 	// Page Page number (default: 1)
 	Page *PageParam `form:"page,omitempty" json:"page,omitempty"`
 
+	// Cursor Opaque cursor of the page, from `next_cursor` or `prev_cursor` of the previous one; give it empty for the
+	// first page. Switches the list to keyset pagination, without a total count; cannot be combined with `page`.
+	Cursor *CursorQueryParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+
 	// Limit Items per page (default: 10, max: 100)
 	Limit *LimitParam `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// AccountId Account of the user to use (default: the user's default account)
+	AccountId *AccountIdQueryParam `form:"account_id,omitempty" json:"account_id,omitempty"`
+
+	// From Only items that occurred at or after this date-time
+	From *FromQueryParam `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Only items that occurred before this date-time
+	To *ToQueryParam `form:"to,omitempty" json:"to,omitempty"`
+
+	// Type Only movements of this type
+	Type *AccountsListMovementsParamsType `form:"type,omitempty" json:"type,omitempty"`
+
+	// MinAmount Only items of at least this amount
+	MinAmount *MinAmountQueryParam `form:"min_amount,omitempty" json:"min_amount,omitempty"`
+
+	// MaxAmount Only items of at most this amount
+	MaxAmount *MaxAmountQueryParam `form:"max_amount,omitempty" json:"max_amount,omitempty"`
+
+	// Search Text to find in the description, ignoring case
+	Search *SearchQueryParam `form:"search,omitempty" json:"search,omitempty"`
+
+	// Sort Field to sort movements by
+	Sort *AccountsListMovementsParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Order Sort direction
+	Order *AccountsListMovementsParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+}
+
+// AccountsListMovementsParamsType defines parameters for AccountsListMovements.
+type AccountsListMovementsParamsType string
+
+// AccountsListMovementsParamsSort defines parameters for AccountsListMovements.
+type AccountsListMovementsParamsSort string
+
+// AccountsListMovementsParamsOrder defines parameters for AccountsListMovements.
+type AccountsListMovementsParamsOrder string
+
+// AccountsCreateMovementParams defines parameters for AccountsCreateMovement.
+type AccountsCreateMovementParams struct {
+	// IdempotencyKey Client-generated key that makes the request safe to retry. A retry with the same key
+	// replays the first response (with `Idempotent-Replayed: true`) instead of executing again.
+	IdempotencyKey *IdempotencyKeyHeader `json:"Idempotency-Key,omitempty"`
+}
+
+// AccountsCloseParams defines parameters for AccountsClose.
+type AccountsCloseParams struct {
+	// IdempotencyKey Client-generated key that makes the request safe to retry. A retry with the same key
+	// replays the first response (with `Idempotent-Replayed: true`) instead of executing again.
+	IdempotencyKey *IdempotencyKeyHeader `json:"Idempotency-Key,omitempty"`
 }
 
 // AuthGoogleCallbackParams defines parameters for AuthGoogleCallback.
@@ -217,8 +1515,8 @@ type AuthGoogleCallbackParams struct {
 	State OAuthStateParam `form:"state" json:"state"`
 }
 
-// TransfersListParams defines parameters for TransfersList.
-type TransfersListParams struct {
+// BeneficiariesListParams defines parameters for BeneficiariesList.
+type BeneficiariesListParams struct {
 	// Page Page number (default: 1)
 	Page *PageParam `form:"page,omitempty" json:"page,omitempty"`
 
@@ -226,71 +1524,2422 @@ type TransfersListParams struct {
 	Limit *LimitParam `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
-// AccountsCreateMovementJSONRequestBody defines body for AccountsCreateMovement for application/json ContentType.
-type AccountsCreateMovementJSONRequestBody = CreateMovementRequest
+// BeneficiariesCreateParams defines parameters for BeneficiariesCreate.
+type BeneficiariesCreateParams struct {
+	// IdempotencyKey Client-generated key that makes the request safe to retry. A retry with the same key
+	// replays the first response (with `Idempotent-Replayed: true`) instead of executing again.
+	IdempotencyKey *IdempotencyKeyHeader `json:"Idempotency-Key,omitempty"`
+}
 
-// AuthLoginJSONRequestBody defines body for AuthLogin for application/json ContentType.
-type AuthLoginJSONRequestBody = LoginRequest
+// FeesPreviewParams defines parameters for FeesPreview.
+type FeesPreviewParams struct {
+	// Operation Operation type to preview the fee of
+	Operation FeesPreviewParamsOperation `form:"operation" json:"operation"`
 
-// AuthSignUpJSONRequestBody defines body for AuthSignUp for application/json ContentType.
-type AuthSignUpJSONRequestBody = SignUpRequest
+	// Amount Amount of the operation
+	Amount AmountQueryParam `form:"amount" json:"amount"`
 
-// TransfersCreateJSONRequestBody defines body for TransfersCreate for application/json ContentType.
-type TransfersCreateJSONRequestBody = TransferRequest
+	// AccountId Account of the user to use (default: the user's default account)
+	AccountId *AccountIdQueryParam `form:"account_id,omitempty" json:"account_id,omitempty"`
+}
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Get account balance
-	// (GET /api/v1/accounts/balance)
-	AccountsGetBalance(c *gin.Context)
-	// List account movements (paginated)
-	// (GET /api/v1/accounts/movements)
-	AccountsListMovements(c *gin.Context, params AccountsListMovementsParams)
-	// Create account movement
-	// (POST /api/v1/accounts/movements)
-	AccountsCreateMovement(c *gin.Context)
-	// Start Google OAuth flow
-	// (GET /api/v1/auth/google)
-	AuthGoogle(c *gin.Context)
-	// Handle Google OAuth callback
-	// (GET /api/v1/auth/google/callback)
-	AuthGoogleCallback(c *gin.Context, params AuthGoogleCallbackParams)
-	// Login
-	// (POST /api/v1/auth/login)
-	AuthLogin(c *gin.Context)
-	// Register a new user
-	// (POST /api/v1/auth/signup)
-	AuthSignUp(c *gin.Context)
-	// List transfers (paginated)
-	// (GET /api/v1/transfers)
-	TransfersList(c *gin.Context, params TransfersListParams)
-	// Create a transfer
-	// (POST /api/v1/transfers)
-	TransfersCreate(c *gin.Context)
-	// Health check
-	// (GET /health)
-	HealthCheck(c *gin.Context)
-	// Prometheus metrics
-	// (GET /metrics)
-	Metrics(c *gin.Context)
+// FeesPreviewParamsOperation defines parameters for FeesPreview.
+type FeesPreviewParamsOperation string
+
+// FxCreateQuoteParams defines parameters for FxCreateQuote.
+type FxCreateQuoteParams struct {
+	// IdempotencyKey Client-generated key that makes the request safe to retry. A retry with the same key
+	// replays the first response (with `Idempotent-Replayed: true`) instead of executing again.
+	IdempotencyKey *IdempotencyKeyHeader `json:"Idempotency-Key,omitempty"`
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandler       func(*gin.Context, error, int)
+// PaymentRequestsCreateParams defines parameters for PaymentRequestsCreate.
+type PaymentRequestsCreateParams struct {
+	// IdempotencyKey Client-generated key that makes the request safe to retry. A retry with the same key
+	// replays the first response (with `Idempotent-Replayed: true`) instead of executing again.
+	IdempotencyKey *IdempotencyKeyHeader `json:"Idempotency-Key,omitempty"`
+}
+
+// PaymentRequestsListIncomingParams defines parameters for PaymentRequestsListIncoming.
+type PaymentRequestsListIncomingParams struct {
+	// Status Only return the requests in this status
+	Status *PaymentRequestsListIncomingParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Page Page number (default: 1)
+	Page *PageParam `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Items per page (default: 10, max: 100)
+	Limit *LimitParam `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// PaymentRequestsListIncomingParamsStatus defines parameters for PaymentRequestsListIncoming.
+type PaymentRequestsListIncomingParamsStatus string
+
+// PaymentRequestsListOutgoingParams defines parameters for PaymentRequestsListOutgoing.
+type PaymentRequestsListOutgoingParams struct {
+	// Status Only return the requests in this status
+	Status *PaymentRequestsListOutgoingParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Page Page number (default: 1)
+	Page *PageParam `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Items per page (default: 10, max: 100)
+	Limit *LimitParam `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// PaymentRequestsListOutgoingParamsStatus defines parameters for PaymentRequestsListOutgoing.
+type PaymentRequestsListOutgoingParamsStatus string
+
+// PaymentRequestsPayParams defines parameters for PaymentRequestsPay.
+type PaymentRequestsPayParams struct {
+	// IdempotencyKey Client-generated key that makes the request safe to retry. A retry with the same key
+	// replays the first response (with `Idempotent-Replayed: true`) instead of executing again.
+	IdempotencyKey *IdempotencyKeyHeader `json:"Idempotency-Key,omitempty"`
+}
+
+// TransfersListParams defines parameters for TransfersList.
+type TransfersListParams struct {
+	// Page Page number (default: 1)
+	Page *PageParam `form:"page,omitempty" json:"page,omitempty"`
+
+	// Cursor Opaque cursor of the page, from `next_cursor` or `prev_cursor` of the previous one; give it empty for the
+	// first page. Switches the list to keyset pagination, without a total count; cannot be combined with `page`.
+	Cursor *CursorQueryParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit Items per page (default: 10, max: 100)
+	Limit *LimitParam `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// AccountId Account of the user to use (default: the user's default account)
+	AccountId *AccountIdQueryParam `form:"account_id,omitempty" json:"account_id,omitempty"`
+
+	// Direction Only transfers received by the account (in) or sent by it (out)
+	Direction *TransfersListParamsDirection `form:"direction,omitempty" json:"direction,omitempty"`
+
+	// Status Only transfers in this status
+	Status *TransfersListParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// From Only items that occurred at or after this date-time
+	From *FromQueryParam `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Only items that occurred before this date-time
+	To *ToQueryParam `form:"to,omitempty" json:"to,omitempty"`
+
+	// MinAmount Only items of at least this amount
+	MinAmount *MinAmountQueryParam `form:"min_amount,omitempty" json:"min_amount,omitempty"`
+
+	// MaxAmount Only items of at most this amount
+	MaxAmount *MaxAmountQueryParam `form:"max_amount,omitempty" json:"max_amount,omitempty"`
+
+	// CounterpartyAccount Only transfers with this account on the other side
+	CounterpartyAccount *CounterpartyAccountQueryParam `form:"counterparty_account,omitempty" json:"counterparty_account,omitempty"`
+}
+
+// TransfersListParamsDirection defines parameters for TransfersList.
+type TransfersListParamsDirection string
+
+// TransfersListParamsStatus defines parameters for TransfersList.
+type TransfersListParamsStatus string
+
+// TransfersCreateParams defines parameters for TransfersCreate.
+type TransfersCreateParams struct {
+	// IdempotencyKey Client-generated key that makes the request safe to retry. A retry with the same key
+	// replays the first response (with `Idempotent-Replayed: true`) instead of executing again.
+	IdempotencyKey *IdempotencyKeyHeader `json:"Idempotency-Key,omitempty"`
+}
+
+// TransfersListAwaitingApprovalParams defines parameters for TransfersListAwaitingApproval.
+type TransfersListAwaitingApprovalParams struct {
+	// Page Page number (default: 1)
+	Page *PageParam `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Items per page (default: 10, max: 100)
+	Limit *LimitParam `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// TransfersCreateBatchParams defines parameters for TransfersCreateBatch.
+type TransfersCreateBatchParams struct {
+	// AccountId Account of the user to use (default: the user's default account)
+	AccountId *AccountIdQueryParam `form:"account_id,omitempty" json:"account_id,omitempty"`
+
+	// Mode Execution mode of a CSV batch (default: all_or_nothing); JSON batches set it in the body
+	Mode *TransfersCreateBatchParamsMode `form:"mode,omitempty" json:"mode,omitempty"`
+
+	// IdempotencyKey Client-generated key that makes the request safe to retry. A retry with the same key
+	// replays the first response (with `Idempotent-Replayed: true`) instead of executing again.
+	IdempotencyKey *IdempotencyKeyHeader `json:"Idempotency-Key,omitempty"`
+}
+
+// TransfersCreateBatchParamsMode defines parameters for TransfersCreateBatch.
+type TransfersCreateBatchParamsMode string
+
+// TransfersGetBatchParams defines parameters for TransfersGetBatch.
+type TransfersGetBatchParams struct {
+	// AccountId Account of the user to use (default: the user's default account)
+	AccountId *AccountIdQueryParam `form:"account_id,omitempty" json:"account_id,omitempty"`
+}
+
+// TransfersPreviewRecipientParams defines parameters for TransfersPreviewRecipient.
+type TransfersPreviewRecipientParams struct {
+	// ToUsername Username of the recipient user
+	ToUsername *ToUsernameQueryParam `form:"to_username,omitempty" json:"to_username,omitempty"`
+
+	// ToEmail Email of the recipient user
+	ToEmail *ToEmailQueryParam `form:"to_email,omitempty" json:"to_email,omitempty"`
+}
+
+// TransfersListScheduledParams defines parameters for TransfersListScheduled.
+type TransfersListScheduledParams struct {
+	// Page Page number (default: 1)
+	Page *PageParam `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Items per page (default: 10, max: 100)
+	Limit *LimitParam `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// AccountId Account of the user to use (default: the user's default account)
+	AccountId *AccountIdQueryParam `form:"account_id,omitempty" json:"account_id,omitempty"`
+}
+
+// StandingOrdersListParams defines parameters for StandingOrdersList.
+type StandingOrdersListParams struct {
+	// Page Page number (default: 1)
+	Page *PageParam `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Items per page (default: 10, max: 100)
+	Limit *LimitParam `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// AccountId Account of the user to use (default: the user's default account)
+	AccountId *AccountIdQueryParam `form:"account_id,omitempty" json:"account_id,omitempty"`
+}
+
+// StandingOrdersCreateParams defines parameters for StandingOrdersCreate.
+type StandingOrdersCreateParams struct {
+	// IdempotencyKey Client-generated key that makes the request safe to retry. A retry with the same key
+	// replays the first response (with `Idempotent-Replayed: true`) instead of executing again.
+	IdempotencyKey *IdempotencyKeyHeader `json:"Idempotency-Key,omitempty"`
+}
+
+// StandingOrdersCancelParams defines parameters for StandingOrdersCancel.
+type StandingOrdersCancelParams struct {
+	// AccountId Account of the user to use (default: the user's default account)
+	AccountId *AccountIdQueryParam `form:"account_id,omitempty" json:"account_id,omitempty"`
+}
+
+// StandingOrdersGetParams defines parameters for StandingOrdersGet.
+type StandingOrdersGetParams struct {
+	// AccountId Account of the user to use (default: the user's default account)
+	AccountId *AccountIdQueryParam `form:"account_id,omitempty" json:"account_id,omitempty"`
+}
+
+// StandingOrdersUpdateParams defines parameters for StandingOrdersUpdate.
+type StandingOrdersUpdateParams struct {
+	// AccountId Account of the user to use (default: the user's default account)
+	AccountId *AccountIdQueryParam `form:"account_id,omitempty" json:"account_id,omitempty"`
+}
+
+// TransfersApproveParams defines parameters for TransfersApprove.
+type TransfersApproveParams struct {
+	// IdempotencyKey Client-generated key that makes the request safe to retry. A retry with the same key
+	// replays the first response (with `Idempotent-Replayed: true`) instead of executing again.
+	IdempotencyKey *IdempotencyKeyHeader `json:"Idempotency-Key,omitempty"`
+}
+
+// TransfersCancelParams defines parameters for TransfersCancel.
+type TransfersCancelParams struct {
+	// AccountId Account of the user to use (default: the user's default account)
+	AccountId *AccountIdQueryParam `form:"account_id,omitempty" json:"account_id,omitempty"`
+}
+
+// TransfersReverseParams defines parameters for TransfersReverse.
+type TransfersReverseParams struct {
+	// AccountId Account of the user to use (default: the user's default account)
+	AccountId *AccountIdQueryParam `form:"account_id,omitempty" json:"account_id,omitempty"`
+
+	// IdempotencyKey Client-generated key that makes the request safe to retry. A retry with the same key
+	// replays the first response (with `Idempotent-Replayed: true`) instead of executing again.
+	IdempotencyKey *IdempotencyKeyHeader `json:"Idempotency-Key,omitempty"`
+}
+
+// AccountsCreateJSONRequestBody defines body for AccountsCreate for application/json ContentType.
+type AccountsCreateJSONRequestBody = CreateAccountRequest
+
+// HoldsCreateJSONRequestBody defines body for HoldsCreate for application/json ContentType.
+type HoldsCreateJSONRequestBody = CreateHoldRequest
+
+// HoldsCaptureJSONRequestBody defines body for HoldsCapture for application/json ContentType.
+type HoldsCaptureJSONRequestBody = CaptureHoldRequest
+
+// AccountsCreateMovementJSONRequestBody defines body for AccountsCreateMovement for application/json ContentType.
+type AccountsCreateMovementJSONRequestBody = CreateMovementRequest
+
+// AccountsCloseJSONRequestBody defines body for AccountsClose for application/json ContentType.
+type AccountsCloseJSONRequestBody = CloseAccountRequest
+
+// AccountsAddCosignerJSONRequestBody defines body for AccountsAddCosigner for application/json ContentType.
+type AccountsAddCosignerJSONRequestBody = AddCosignerRequest
+
+// AccountsSetOverdraftJSONRequestBody defines body for AccountsSetOverdraft for application/json ContentType.
+type AccountsSetOverdraftJSONRequestBody = SetOverdraftRequest
+
+// AccountsUpdateStatusJSONRequestBody defines body for AccountsUpdateStatus for application/json ContentType.
+type AccountsUpdateStatusJSONRequestBody = UpdateAccountStatusRequest
+
+// AuthLoginJSONRequestBody defines body for AuthLogin for application/json ContentType.
+type AuthLoginJSONRequestBody = LoginRequest
+
+// AuthSignUpJSONRequestBody defines body for AuthSignUp for application/json ContentType.
+type AuthSignUpJSONRequestBody = SignUpRequest
+
+// BeneficiariesCreateJSONRequestBody defines body for BeneficiariesCreate for application/json ContentType.
+type BeneficiariesCreateJSONRequestBody = CreateBeneficiaryRequest
+
+// BeneficiariesUpdateJSONRequestBody defines body for BeneficiariesUpdate for application/json ContentType.
+type BeneficiariesUpdateJSONRequestBody = UpdateBeneficiaryRequest
+
+// FxCreateQuoteJSONRequestBody defines body for FxCreateQuote for application/json ContentType.
+type FxCreateQuoteJSONRequestBody = FXQuoteRequest
+
+// FxSetRatesJSONRequestBody defines body for FxSetRates for application/json ContentType.
+type FxSetRatesJSONRequestBody = SetFXRatesRequest
+
+// PaymentRequestsCreateJSONRequestBody defines body for PaymentRequestsCreate for application/json ContentType.
+type PaymentRequestsCreateJSONRequestBody = CreatePaymentRequestRequest
+
+// PaymentRequestsPayJSONRequestBody defines body for PaymentRequestsPay for application/json ContentType.
+type PaymentRequestsPayJSONRequestBody = PayPaymentRequestRequest
+
+// TransfersCreateJSONRequestBody defines body for TransfersCreate for application/json ContentType.
+type TransfersCreateJSONRequestBody = TransferRequest
+
+// TransfersCreateBatchJSONRequestBody defines body for TransfersCreateBatch for application/json ContentType.
+type TransfersCreateBatchJSONRequestBody = CreateTransferBatchRequest
+
+// TransferLimitsUpdateJSONRequestBody defines body for TransferLimitsUpdate for application/json ContentType.
+type TransferLimitsUpdateJSONRequestBody = TransferLimitsUpdateRequest
+
+// StandingOrdersCreateJSONRequestBody defines body for StandingOrdersCreate for application/json ContentType.
+type StandingOrdersCreateJSONRequestBody = StandingOrderRequest
+
+// StandingOrdersUpdateJSONRequestBody defines body for StandingOrdersUpdate for application/json ContentType.
+type StandingOrdersUpdateJSONRequestBody = StandingOrderUpdateRequest
+
+// TransfersApproveJSONRequestBody defines body for TransfersApprove for application/json ContentType.
+type TransfersApproveJSONRequestBody = TransferDecisionRequest
+
+// TransfersRejectJSONRequestBody defines body for TransfersReject for application/json ContentType.
+type TransfersRejectJSONRequestBody = TransferDecisionRequest
+
+// TransfersReverseJSONRequestBody defines body for TransfersReverse for application/json ContentType.
+type TransfersReverseJSONRequestBody = ReverseTransferRequest
+
+// AsPaginationMeta returns the union data inside the PaginatedMovementsResponse_Pagination as a PaginationMeta
+func (t PaginatedMovementsResponse_Pagination) AsPaginationMeta() (PaginationMeta, error) {
+	var body PaginationMeta
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromPaginationMeta overwrites any union data inside the PaginatedMovementsResponse_Pagination as the provided PaginationMeta
+func (t *PaginatedMovementsResponse_Pagination) FromPaginationMeta(v PaginationMeta) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergePaginationMeta performs a merge with any union data inside the PaginatedMovementsResponse_Pagination, using the provided PaginationMeta
+func (t *PaginatedMovementsResponse_Pagination) MergePaginationMeta(v PaginationMeta) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsCursorPaginationMeta returns the union data inside the PaginatedMovementsResponse_Pagination as a CursorPaginationMeta
+func (t PaginatedMovementsResponse_Pagination) AsCursorPaginationMeta() (CursorPaginationMeta, error) {
+	var body CursorPaginationMeta
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromCursorPaginationMeta overwrites any union data inside the PaginatedMovementsResponse_Pagination as the provided CursorPaginationMeta
+func (t *PaginatedMovementsResponse_Pagination) FromCursorPaginationMeta(v CursorPaginationMeta) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeCursorPaginationMeta performs a merge with any union data inside the PaginatedMovementsResponse_Pagination, using the provided CursorPaginationMeta
+func (t *PaginatedMovementsResponse_Pagination) MergeCursorPaginationMeta(v CursorPaginationMeta) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t PaginatedMovementsResponse_Pagination) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *PaginatedMovementsResponse_Pagination) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsPaginationMeta returns the union data inside the PaginatedTransfersResponse_Pagination as a PaginationMeta
+func (t PaginatedTransfersResponse_Pagination) AsPaginationMeta() (PaginationMeta, error) {
+	var body PaginationMeta
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromPaginationMeta overwrites any union data inside the PaginatedTransfersResponse_Pagination as the provided PaginationMeta
+func (t *PaginatedTransfersResponse_Pagination) FromPaginationMeta(v PaginationMeta) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergePaginationMeta performs a merge with any union data inside the PaginatedTransfersResponse_Pagination, using the provided PaginationMeta
+func (t *PaginatedTransfersResponse_Pagination) MergePaginationMeta(v PaginationMeta) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsCursorPaginationMeta returns the union data inside the PaginatedTransfersResponse_Pagination as a CursorPaginationMeta
+func (t PaginatedTransfersResponse_Pagination) AsCursorPaginationMeta() (CursorPaginationMeta, error) {
+	var body CursorPaginationMeta
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromCursorPaginationMeta overwrites any union data inside the PaginatedTransfersResponse_Pagination as the provided CursorPaginationMeta
+func (t *PaginatedTransfersResponse_Pagination) FromCursorPaginationMeta(v CursorPaginationMeta) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeCursorPaginationMeta performs a merge with any union data inside the PaginatedTransfersResponse_Pagination, using the provided CursorPaginationMeta
+func (t *PaginatedTransfersResponse_Pagination) MergeCursorPaginationMeta(v CursorPaginationMeta) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t PaginatedTransfersResponse_Pagination) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *PaginatedTransfersResponse_Pagination) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// List the user's accounts
+	// (GET /api/v1/accounts)
+	AccountsList(c *gin.Context)
+	// Open an account
+	// (POST /api/v1/accounts)
+	AccountsCreate(c *gin.Context, params AccountsCreateParams)
+	// Get account balance
+	// (GET /api/v1/accounts/balance)
+	AccountsGetBalance(c *gin.Context, params AccountsGetBalanceParams)
+	// List holds (paginated)
+	// (GET /api/v1/accounts/holds)
+	HoldsList(c *gin.Context, params HoldsListParams)
+	// Place a hold
+	// (POST /api/v1/accounts/holds)
+	HoldsCreate(c *gin.Context, params HoldsCreateParams)
+	// Get a hold
+	// (GET /api/v1/accounts/holds/{id})
+	HoldsGet(c *gin.Context, id HoldIdParam, params HoldsGetParams)
+	// Capture a hold
+	// (POST /api/v1/accounts/holds/{id}/capture)
+	HoldsCapture(c *gin.Context, id HoldIdParam, params HoldsCaptureParams)
+	// Release a hold
+	// (POST /api/v1/accounts/holds/{id}/release)
+	HoldsRelease(c *gin.Context, id HoldIdParam, params HoldsReleaseParams)
+	// List account movements (paginated)
+	// (GET /api/v1/accounts/movements)
+	AccountsListMovements(c *gin.Context, params AccountsListMovementsParams)
+	// Create account movement
+	// (POST /api/v1/accounts/movements)
+	AccountsCreateMovement(c *gin.Context, params AccountsCreateMovementParams)
+	// Get an account movement
+	// (GET /api/v1/accounts/movements/{id})
+	AccountsGetMovement(c *gin.Context, id MovementIdParam)
+	// Close an account
+	// (POST /api/v1/accounts/{id}/close)
+	AccountsClose(c *gin.Context, id AccountIdParam, params AccountsCloseParams)
+	// List the co-signers of an account
+	// (GET /api/v1/accounts/{id}/cosigners)
+	AccountsListCosigners(c *gin.Context, id AccountIdParam)
+	// Add a co-signer to a business account
+	// (POST /api/v1/accounts/{id}/cosigners)
+	AccountsAddCosigner(c *gin.Context, id AccountIdParam)
+	// Remove a co-signer from an account
+	// (DELETE /api/v1/accounts/{id}/cosigners/{user_id})
+	AccountsRemoveCosigner(c *gin.Context, id AccountIdParam, userId CosignerUserIdParam)
+	// Set the default account
+	// (POST /api/v1/accounts/{id}/default)
+	AccountsSetDefault(c *gin.Context, id AccountIdParam)
+	// Set the overdraft of an account
+	// (PUT /api/v1/accounts/{id}/overdraft)
+	AccountsSetOverdraft(c *gin.Context, id AccountIdParam)
+	// Change the status of an account
+	// (POST /api/v1/accounts/{id}/status)
+	AccountsUpdateStatus(c *gin.Context, id AccountIdParam)
+	// Start Google OAuth flow
+	// (GET /api/v1/auth/google)
+	AuthGoogle(c *gin.Context)
+	// Handle Google OAuth callback
+	// (GET /api/v1/auth/google/callback)
+	AuthGoogleCallback(c *gin.Context, params AuthGoogleCallbackParams)
+	// Login
+	// (POST /api/v1/auth/login)
+	AuthLogin(c *gin.Context)
+	// Register a new user
+	// (POST /api/v1/auth/signup)
+	AuthSignUp(c *gin.Context)
+	// List beneficiaries (paginated)
+	// (GET /api/v1/beneficiaries)
+	BeneficiariesList(c *gin.Context, params BeneficiariesListParams)
+	// Save a beneficiary
+	// (POST /api/v1/beneficiaries)
+	BeneficiariesCreate(c *gin.Context, params BeneficiariesCreateParams)
+	// Delete a beneficiary
+	// (DELETE /api/v1/beneficiaries/{id})
+	BeneficiariesDelete(c *gin.Context, id BeneficiaryIdParam)
+	// Get a beneficiary
+	// (GET /api/v1/beneficiaries/{id})
+	BeneficiariesGet(c *gin.Context, id BeneficiaryIdParam)
+	// Rename a beneficiary
+	// (PATCH /api/v1/beneficiaries/{id})
+	BeneficiariesUpdate(c *gin.Context, id BeneficiaryIdParam)
+	// Preview the fee of an operation
+	// (GET /api/v1/fees/preview)
+	FeesPreview(c *gin.Context, params FeesPreviewParams)
+	// Quote a currency conversion
+	// (POST /api/v1/fx/quotes)
+	FxCreateQuote(c *gin.Context, params FxCreateQuoteParams)
+	// List exchange rates
+	// (GET /api/v1/fx/rates)
+	FxListRates(c *gin.Context)
+	// Create or replace exchange rates (admin only)
+	// (PUT /api/v1/fx/rates)
+	FxSetRates(c *gin.Context)
+	// Request money from another user
+	// (POST /api/v1/payment-requests)
+	PaymentRequestsCreate(c *gin.Context, params PaymentRequestsCreateParams)
+	// List incoming payment requests (paginated)
+	// (GET /api/v1/payment-requests/incoming)
+	PaymentRequestsListIncoming(c *gin.Context, params PaymentRequestsListIncomingParams)
+	// List outgoing payment requests (paginated)
+	// (GET /api/v1/payment-requests/outgoing)
+	PaymentRequestsListOutgoing(c *gin.Context, params PaymentRequestsListOutgoingParams)
+	// Get a payment request sent or received by the user
+	// (GET /api/v1/payment-requests/{id})
+	PaymentRequestsGet(c *gin.Context, id PaymentRequestIdParam)
+	// Cancel a payment request
+	// (POST /api/v1/payment-requests/{id}/cancel)
+	PaymentRequestsCancel(c *gin.Context, id PaymentRequestIdParam)
+	// Decline a payment request
+	// (POST /api/v1/payment-requests/{id}/decline)
+	PaymentRequestsDecline(c *gin.Context, id PaymentRequestIdParam)
+	// Pay a payment request
+	// (POST /api/v1/payment-requests/{id}/pay)
+	PaymentRequestsPay(c *gin.Context, id PaymentRequestIdParam, params PaymentRequestsPayParams)
+	// List transfers (paginated)
+	// (GET /api/v1/transfers)
+	TransfersList(c *gin.Context, params TransfersListParams)
+	// Create a transfer
+	// (POST /api/v1/transfers)
+	TransfersCreate(c *gin.Context, params TransfersCreateParams)
+	// List the transfers awaiting the user's approval (paginated)
+	// (GET /api/v1/transfers/approvals)
+	TransfersListAwaitingApproval(c *gin.Context, params TransfersListAwaitingApprovalParams)
+	// Upload a batch of transfers
+	// (POST /api/v1/transfers/batches)
+	TransfersCreateBatch(c *gin.Context, params TransfersCreateBatchParams)
+	// Get a transfer batch and the outcome of its transfers
+	// (GET /api/v1/transfers/batches/{id})
+	TransfersGetBatch(c *gin.Context, id TransferBatchIdParam, params TransfersGetBatchParams)
+	// Get the transfer limits of the user and the remaining allowance
+	// (GET /api/v1/transfers/limits)
+	TransferLimitsGet(c *gin.Context)
+	// Lower the transfer limits of the user
+	// (PATCH /api/v1/transfers/limits)
+	TransferLimitsUpdate(c *gin.Context)
+	// Preview the recipient of a transfer to a username or email
+	// (GET /api/v1/transfers/recipient)
+	TransfersPreviewRecipient(c *gin.Context, params TransfersPreviewRecipientParams)
+	// List scheduled transfers (paginated)
+	// (GET /api/v1/transfers/scheduled)
+	TransfersListScheduled(c *gin.Context, params TransfersListScheduledParams)
+	// List standing orders (paginated)
+	// (GET /api/v1/transfers/standing-orders)
+	StandingOrdersList(c *gin.Context, params StandingOrdersListParams)
+	// Create a standing order
+	// (POST /api/v1/transfers/standing-orders)
+	StandingOrdersCreate(c *gin.Context, params StandingOrdersCreateParams)
+	// Cancel a standing order
+	// (DELETE /api/v1/transfers/standing-orders/{id})
+	StandingOrdersCancel(c *gin.Context, id StandingOrderIdParam, params StandingOrdersCancelParams)
+	// Get a standing order
+	// (GET /api/v1/transfers/standing-orders/{id})
+	StandingOrdersGet(c *gin.Context, id StandingOrderIdParam, params StandingOrdersGetParams)
+	// Update, pause or resume a standing order
+	// (PATCH /api/v1/transfers/standing-orders/{id})
+	StandingOrdersUpdate(c *gin.Context, id StandingOrderIdParam, params StandingOrdersUpdateParams)
+	// Get a transfer
+	// (GET /api/v1/transfers/{id})
+	TransfersGet(c *gin.Context, id TransferIdParam)
+	// List the approval history of a transfer
+	// (GET /api/v1/transfers/{id}/approvals)
+	TransfersListApprovals(c *gin.Context, id TransferIdParam)
+	// Approve a transfer awaiting approval
+	// (POST /api/v1/transfers/{id}/approve)
+	TransfersApprove(c *gin.Context, id TransferIdParam, params TransfersApproveParams)
+	// Cancel a scheduled transfer
+	// (POST /api/v1/transfers/{id}/cancel)
+	TransfersCancel(c *gin.Context, id TransferIdParam, params TransfersCancelParams)
+	// Reject a transfer awaiting approval
+	// (POST /api/v1/transfers/{id}/reject)
+	TransfersReject(c *gin.Context, id TransferIdParam)
+	// Reverse a transfer
+	// (POST /api/v1/transfers/{id}/reverse)
+	TransfersReverse(c *gin.Context, id TransferIdParam, params TransfersReverseParams)
+	// Health check
+	// (GET /health)
+	HealthCheck(c *gin.Context)
+	// Prometheus metrics
+	// (GET /metrics)
+	Metrics(c *gin.Context)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandler       func(*gin.Context, error, int)
+}
+
+type MiddlewareFunc func(c *gin.Context)
+
+// AccountsList operation middleware
+func (siw *ServerInterfaceWrapper) AccountsList(c *gin.Context) {
+
+	c.Set(BearerJWTScopes, []string{})
+
+	c.Set(BearerPASETOScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.AccountsList(c)
+}
+
+// AccountsCreate operation middleware
+func (siw *ServerInterfaceWrapper) AccountsCreate(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerJWTScopes, []string{})
+
+	c.Set(BearerPASETOScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AccountsCreateParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKeyHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Idempotency-Key, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Idempotency-Key: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.AccountsCreate(c, params)
+}
+
+// AccountsGetBalance operation middleware
+func (siw *ServerInterfaceWrapper) AccountsGetBalance(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerJWTScopes, []string{})
+
+	c.Set(BearerPASETOScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AccountsGetBalanceParams
+
+	// ------------- Optional query parameter "account_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "account_id", c.Request.URL.Query(), &params.AccountId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter account_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.AccountsGetBalance(c, params)
+}
+
+// HoldsList operation middleware
+func (siw *ServerInterfaceWrapper) HoldsList(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerJWTScopes, []string{})
+
+	c.Set(BearerPASETOScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params HoldsListParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "account_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "account_id", c.Request.URL.Query(), &params.AccountId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter account_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.HoldsList(c, params)
+}
+
+// HoldsCreate operation middleware
+func (siw *ServerInterfaceWrapper) HoldsCreate(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerJWTScopes, []string{})
+
+	c.Set(BearerPASETOScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params HoldsCreateParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKeyHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Idempotency-Key, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Idempotency-Key: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.HoldsCreate(c, params)
+}
+
+// HoldsGet operation middleware
+func (siw *ServerInterfaceWrapper) HoldsGet(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id HoldIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerJWTScopes, []string{})
+
+	c.Set(BearerPASETOScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params HoldsGetParams
+
+	// ------------- Optional query parameter "account_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "account_id", c.Request.URL.Query(), &params.AccountId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter account_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.HoldsGet(c, id, params)
+}
+
+// HoldsCapture operation middleware
+func (siw *ServerInterfaceWrapper) HoldsCapture(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id HoldIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerJWTScopes, []string{})
+
+	c.Set(BearerPASETOScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params HoldsCaptureParams
+
+	// ------------- Optional query parameter "account_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "account_id", c.Request.URL.Query(), &params.AccountId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter account_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKeyHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Idempotency-Key, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Idempotency-Key: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.HoldsCapture(c, id, params)
+}
+
+// HoldsRelease operation middleware
+func (siw *ServerInterfaceWrapper) HoldsRelease(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id HoldIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerJWTScopes, []string{})
+
+	c.Set(BearerPASETOScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params HoldsReleaseParams
+
+	// ------------- Optional query parameter "account_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "account_id", c.Request.URL.Query(), &params.AccountId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter account_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.HoldsRelease(c, id, params)
+}
+
+// AccountsListMovements operation middleware
+func (siw *ServerInterfaceWrapper) AccountsListMovements(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerJWTScopes, []string{})
+
+	c.Set(BearerPASETOScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AccountsListMovementsParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "account_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "account_id", c.Request.URL.Query(), &params.AccountId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter account_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", c.Request.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter type: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "min_amount" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "min_amount", c.Request.URL.Query(), &params.MinAmount)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter min_amount: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "max_amount" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max_amount", c.Request.URL.Query(), &params.MaxAmount)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter max_amount: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search", c.Request.URL.Query(), &params.Search)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter search: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", c.Request.URL.Query(), &params.Order)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter order: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.AccountsListMovements(c, params)
+}
+
+// AccountsCreateMovement operation middleware
+func (siw *ServerInterfaceWrapper) AccountsCreateMovement(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerJWTScopes, []string{})
+
+	c.Set(BearerPASETOScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AccountsCreateMovementParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKeyHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Idempotency-Key, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Idempotency-Key: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.AccountsCreateMovement(c, params)
+}
+
+// AccountsGetMovement operation middleware
+func (siw *ServerInterfaceWrapper) AccountsGetMovement(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id MovementIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerJWTScopes, []string{})
+
+	c.Set(BearerPASETOScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.AccountsGetMovement(c, id)
+}
+
+// AccountsClose operation middleware
+func (siw *ServerInterfaceWrapper) AccountsClose(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id AccountIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerJWTScopes, []string{})
+
+	c.Set(BearerPASETOScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AccountsCloseParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKeyHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Idempotency-Key, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Idempotency-Key: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.AccountsClose(c, id, params)
+}
+
+// AccountsListCosigners operation middleware
+func (siw *ServerInterfaceWrapper) AccountsListCosigners(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id AccountIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerJWTScopes, []string{})
+
+	c.Set(BearerPASETOScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.AccountsListCosigners(c, id)
+}
+
+// AccountsAddCosigner operation middleware
+func (siw *ServerInterfaceWrapper) AccountsAddCosigner(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id AccountIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerJWTScopes, []string{})
+
+	c.Set(BearerPASETOScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.AccountsAddCosigner(c, id)
+}
+
+// AccountsRemoveCosigner operation middleware
+func (siw *ServerInterfaceWrapper) AccountsRemoveCosigner(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id AccountIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "user_id" -------------
+	var userId CosignerUserIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerJWTScopes, []string{})
+
+	c.Set(BearerPASETOScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.AccountsRemoveCosigner(c, id, userId)
+}
+
+// AccountsSetDefault operation middleware
+func (siw *ServerInterfaceWrapper) AccountsSetDefault(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id AccountIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerJWTScopes, []string{})
+
+	c.Set(BearerPASETOScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.AccountsSetDefault(c, id)
+}
+
+// AccountsSetOverdraft operation middleware
+func (siw *ServerInterfaceWrapper) AccountsSetOverdraft(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id AccountIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerJWTScopes, []string{})
+
+	c.Set(BearerPASETOScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.AccountsSetOverdraft(c, id)
+}
+
+// AccountsUpdateStatus operation middleware
+func (siw *ServerInterfaceWrapper) AccountsUpdateStatus(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id AccountIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerJWTScopes, []string{})
+
+	c.Set(BearerPASETOScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.AccountsUpdateStatus(c, id)
+}
+
+// AuthGoogle operation middleware
+func (siw *ServerInterfaceWrapper) AuthGoogle(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.AuthGoogle(c)
+}
+
+// AuthGoogleCallback operation middleware
+func (siw *ServerInterfaceWrapper) AuthGoogleCallback(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AuthGoogleCallbackParams
+
+	// ------------- Required query parameter "code" -------------
+
+	if paramValue := c.Query("code"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument code is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "code", c.Request.URL.Query(), &params.Code)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter code: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required query parameter "state" -------------
+
+	if paramValue := c.Query("state"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument state is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "state", c.Request.URL.Query(), &params.State)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter state: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.AuthGoogleCallback(c, params)
+}
+
+// AuthLogin operation middleware
+func (siw *ServerInterfaceWrapper) AuthLogin(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.AuthLogin(c)
+}
+
+// AuthSignUp operation middleware
+func (siw *ServerInterfaceWrapper) AuthSignUp(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.AuthSignUp(c)
+}
+
+// BeneficiariesList operation middleware
+func (siw *ServerInterfaceWrapper) BeneficiariesList(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerJWTScopes, []string{})
+
+	c.Set(BearerPASETOScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params BeneficiariesListParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.BeneficiariesList(c, params)
+}
+
+// BeneficiariesCreate operation middleware
+func (siw *ServerInterfaceWrapper) BeneficiariesCreate(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerJWTScopes, []string{})
+
+	c.Set(BearerPASETOScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params BeneficiariesCreateParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKeyHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Idempotency-Key, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Idempotency-Key: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.BeneficiariesCreate(c, params)
+}
+
+// BeneficiariesDelete operation middleware
+func (siw *ServerInterfaceWrapper) BeneficiariesDelete(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id BeneficiaryIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerJWTScopes, []string{})
+
+	c.Set(BearerPASETOScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.BeneficiariesDelete(c, id)
+}
+
+// BeneficiariesGet operation middleware
+func (siw *ServerInterfaceWrapper) BeneficiariesGet(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id BeneficiaryIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerJWTScopes, []string{})
+
+	c.Set(BearerPASETOScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.BeneficiariesGet(c, id)
+}
+
+// BeneficiariesUpdate operation middleware
+func (siw *ServerInterfaceWrapper) BeneficiariesUpdate(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id BeneficiaryIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerJWTScopes, []string{})
+
+	c.Set(BearerPASETOScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.BeneficiariesUpdate(c, id)
+}
+
+// FeesPreview operation middleware
+func (siw *ServerInterfaceWrapper) FeesPreview(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerJWTScopes, []string{})
+
+	c.Set(BearerPASETOScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params FeesPreviewParams
+
+	// ------------- Required query parameter "operation" -------------
+
+	if paramValue := c.Query("operation"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument operation is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "operation", c.Request.URL.Query(), &params.Operation)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter operation: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required query parameter "amount" -------------
+
+	if paramValue := c.Query("amount"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument amount is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "amount", c.Request.URL.Query(), &params.Amount)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter amount: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "account_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "account_id", c.Request.URL.Query(), &params.AccountId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter account_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.FeesPreview(c, params)
+}
+
+// FxCreateQuote operation middleware
+func (siw *ServerInterfaceWrapper) FxCreateQuote(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerJWTScopes, []string{})
+
+	c.Set(BearerPASETOScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params FxCreateQuoteParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKeyHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Idempotency-Key, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Idempotency-Key: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.FxCreateQuote(c, params)
+}
+
+// FxListRates operation middleware
+func (siw *ServerInterfaceWrapper) FxListRates(c *gin.Context) {
+
+	c.Set(BearerJWTScopes, []string{})
+
+	c.Set(BearerPASETOScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.FxListRates(c)
+}
+
+// FxSetRates operation middleware
+func (siw *ServerInterfaceWrapper) FxSetRates(c *gin.Context) {
+
+	c.Set(BearerJWTScopes, []string{})
+
+	c.Set(BearerPASETOScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.FxSetRates(c)
+}
+
+// PaymentRequestsCreate operation middleware
+func (siw *ServerInterfaceWrapper) PaymentRequestsCreate(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerJWTScopes, []string{})
+
+	c.Set(BearerPASETOScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PaymentRequestsCreateParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKeyHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Idempotency-Key, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Idempotency-Key: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PaymentRequestsCreate(c, params)
+}
+
+// PaymentRequestsListIncoming operation middleware
+func (siw *ServerInterfaceWrapper) PaymentRequestsListIncoming(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerJWTScopes, []string{})
+
+	c.Set(BearerPASETOScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PaymentRequestsListIncomingParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PaymentRequestsListIncoming(c, params)
+}
+
+// PaymentRequestsListOutgoing operation middleware
+func (siw *ServerInterfaceWrapper) PaymentRequestsListOutgoing(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerJWTScopes, []string{})
+
+	c.Set(BearerPASETOScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PaymentRequestsListOutgoingParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PaymentRequestsListOutgoing(c, params)
+}
+
+// PaymentRequestsGet operation middleware
+func (siw *ServerInterfaceWrapper) PaymentRequestsGet(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id PaymentRequestIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerJWTScopes, []string{})
+
+	c.Set(BearerPASETOScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PaymentRequestsGet(c, id)
+}
+
+// PaymentRequestsCancel operation middleware
+func (siw *ServerInterfaceWrapper) PaymentRequestsCancel(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id PaymentRequestIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerJWTScopes, []string{})
+
+	c.Set(BearerPASETOScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PaymentRequestsCancel(c, id)
+}
+
+// PaymentRequestsDecline operation middleware
+func (siw *ServerInterfaceWrapper) PaymentRequestsDecline(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id PaymentRequestIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerJWTScopes, []string{})
+
+	c.Set(BearerPASETOScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PaymentRequestsDecline(c, id)
+}
+
+// PaymentRequestsPay operation middleware
+func (siw *ServerInterfaceWrapper) PaymentRequestsPay(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id PaymentRequestIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerJWTScopes, []string{})
+
+	c.Set(BearerPASETOScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PaymentRequestsPayParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKeyHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Idempotency-Key, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Idempotency-Key: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PaymentRequestsPay(c, id, params)
+}
+
+// TransfersList operation middleware
+func (siw *ServerInterfaceWrapper) TransfersList(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerJWTScopes, []string{})
+
+	c.Set(BearerPASETOScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TransfersListParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "account_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "account_id", c.Request.URL.Query(), &params.AccountId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter account_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "direction" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "direction", c.Request.URL.Query(), &params.Direction)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter direction: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "min_amount" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "min_amount", c.Request.URL.Query(), &params.MinAmount)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter min_amount: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "max_amount" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max_amount", c.Request.URL.Query(), &params.MaxAmount)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter max_amount: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "counterparty_account" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "counterparty_account", c.Request.URL.Query(), &params.CounterpartyAccount)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter counterparty_account: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.TransfersList(c, params)
+}
+
+// TransfersCreate operation middleware
+func (siw *ServerInterfaceWrapper) TransfersCreate(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerJWTScopes, []string{})
+
+	c.Set(BearerPASETOScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TransfersCreateParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKeyHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Idempotency-Key, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Idempotency-Key: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.TransfersCreate(c, params)
+}
+
+// TransfersListAwaitingApproval operation middleware
+func (siw *ServerInterfaceWrapper) TransfersListAwaitingApproval(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerJWTScopes, []string{})
+
+	c.Set(BearerPASETOScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TransfersListAwaitingApprovalParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.TransfersListAwaitingApproval(c, params)
+}
+
+// TransfersCreateBatch operation middleware
+func (siw *ServerInterfaceWrapper) TransfersCreateBatch(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerJWTScopes, []string{})
+
+	c.Set(BearerPASETOScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TransfersCreateBatchParams
+
+	// ------------- Optional query parameter "account_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "account_id", c.Request.URL.Query(), &params.AccountId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter account_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "mode" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "mode", c.Request.URL.Query(), &params.Mode)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter mode: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKeyHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Idempotency-Key, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Idempotency-Key: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.TransfersCreateBatch(c, params)
+}
+
+// TransfersGetBatch operation middleware
+func (siw *ServerInterfaceWrapper) TransfersGetBatch(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id TransferBatchIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerJWTScopes, []string{})
+
+	c.Set(BearerPASETOScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TransfersGetBatchParams
+
+	// ------------- Optional query parameter "account_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "account_id", c.Request.URL.Query(), &params.AccountId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter account_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.TransfersGetBatch(c, id, params)
+}
+
+// TransferLimitsGet operation middleware
+func (siw *ServerInterfaceWrapper) TransferLimitsGet(c *gin.Context) {
+
+	c.Set(BearerJWTScopes, []string{})
+
+	c.Set(BearerPASETOScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.TransferLimitsGet(c)
+}
+
+// TransferLimitsUpdate operation middleware
+func (siw *ServerInterfaceWrapper) TransferLimitsUpdate(c *gin.Context) {
+
+	c.Set(BearerJWTScopes, []string{})
+
+	c.Set(BearerPASETOScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.TransferLimitsUpdate(c)
+}
+
+// TransfersPreviewRecipient operation middleware
+func (siw *ServerInterfaceWrapper) TransfersPreviewRecipient(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerJWTScopes, []string{})
+
+	c.Set(BearerPASETOScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TransfersPreviewRecipientParams
+
+	// ------------- Optional query parameter "to_username" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to_username", c.Request.URL.Query(), &params.ToUsername)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to_username: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "to_email" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to_email", c.Request.URL.Query(), &params.ToEmail)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to_email: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.TransfersPreviewRecipient(c, params)
 }
 
-type MiddlewareFunc func(c *gin.Context)
+// TransfersListScheduled operation middleware
+func (siw *ServerInterfaceWrapper) TransfersListScheduled(c *gin.Context) {
 
-// AccountsGetBalance operation middleware
-func (siw *ServerInterfaceWrapper) AccountsGetBalance(c *gin.Context) {
+	var err error
 
 	c.Set(BearerJWTScopes, []string{})
 
 	c.Set(BearerPASETOScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TransfersListScheduledParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "account_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "account_id", c.Request.URL.Query(), &params.AccountId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter account_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -298,11 +3947,11 @@ func (siw *ServerInterfaceWrapper) AccountsGetBalance(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.AccountsGetBalance(c)
+	siw.Handler.TransfersListScheduled(c, params)
 }
 
-// AccountsListMovements operation middleware
-func (siw *ServerInterfaceWrapper) AccountsListMovements(c *gin.Context) {
+// StandingOrdersList operation middleware
+func (siw *ServerInterfaceWrapper) StandingOrdersList(c *gin.Context) {
 
 	var err error
 
@@ -311,7 +3960,7 @@ func (siw *ServerInterfaceWrapper) AccountsListMovements(c *gin.Context) {
 	c.Set(BearerPASETOScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params AccountsListMovementsParams
+	var params StandingOrdersListParams
 
 	// ------------- Optional query parameter "page" -------------
 
@@ -329,6 +3978,14 @@ func (siw *ServerInterfaceWrapper) AccountsListMovements(c *gin.Context) {
 		return
 	}
 
+	// ------------- Optional query parameter "account_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "account_id", c.Request.URL.Query(), &params.AccountId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter account_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -336,16 +3993,42 @@ func (siw *ServerInterfaceWrapper) AccountsListMovements(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.AccountsListMovements(c, params)
+	siw.Handler.StandingOrdersList(c, params)
 }
 
-// AccountsCreateMovement operation middleware
-func (siw *ServerInterfaceWrapper) AccountsCreateMovement(c *gin.Context) {
+// StandingOrdersCreate operation middleware
+func (siw *ServerInterfaceWrapper) StandingOrdersCreate(c *gin.Context) {
+
+	var err error
 
 	c.Set(BearerJWTScopes, []string{})
 
 	c.Set(BearerPASETOScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StandingOrdersCreateParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKeyHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Idempotency-Key, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Idempotency-Key: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -353,11 +4036,37 @@ func (siw *ServerInterfaceWrapper) AccountsCreateMovement(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.AccountsCreateMovement(c)
+	siw.Handler.StandingOrdersCreate(c, params)
 }
 
-// AuthGoogle operation middleware
-func (siw *ServerInterfaceWrapper) AuthGoogle(c *gin.Context) {
+// StandingOrdersCancel operation middleware
+func (siw *ServerInterfaceWrapper) StandingOrdersCancel(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id StandingOrderIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerJWTScopes, []string{})
+
+	c.Set(BearerPASETOScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StandingOrdersCancelParams
+
+	// ------------- Optional query parameter "account_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "account_id", c.Request.URL.Query(), &params.AccountId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter account_id: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -366,47 +4075,105 @@ func (siw *ServerInterfaceWrapper) AuthGoogle(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.AuthGoogle(c)
+	siw.Handler.StandingOrdersCancel(c, id, params)
 }
 
-// AuthGoogleCallback operation middleware
-func (siw *ServerInterfaceWrapper) AuthGoogleCallback(c *gin.Context) {
+// StandingOrdersGet operation middleware
+func (siw *ServerInterfaceWrapper) StandingOrdersGet(c *gin.Context) {
 
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params AuthGoogleCallbackParams
+	// ------------- Path parameter "id" -------------
+	var id StandingOrderIdParam
 
-	// ------------- Required query parameter "code" -------------
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	if paramValue := c.Query("code"); paramValue != "" {
+	c.Set(BearerJWTScopes, []string{})
 
-	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Query argument code is required, but not found"), http.StatusBadRequest)
+	c.Set(BearerPASETOScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StandingOrdersGetParams
+
+	// ------------- Optional query parameter "account_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "account_id", c.Request.URL.Query(), &params.AccountId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter account_id: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "code", c.Request.URL.Query(), &params.Code)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.StandingOrdersGet(c, id, params)
+}
+
+// StandingOrdersUpdate operation middleware
+func (siw *ServerInterfaceWrapper) StandingOrdersUpdate(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id StandingOrderIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter code: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Required query parameter "state" -------------
+	c.Set(BearerJWTScopes, []string{})
 
-	if paramValue := c.Query("state"); paramValue != "" {
+	c.Set(BearerPASETOScopes, []string{})
 
-	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Query argument state is required, but not found"), http.StatusBadRequest)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StandingOrdersUpdateParams
+
+	// ------------- Optional query parameter "account_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "account_id", c.Request.URL.Query(), &params.AccountId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter account_id: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "state", c.Request.URL.Query(), &params.State)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.StandingOrdersUpdate(c, id, params)
+}
+
+// TransfersGet operation middleware
+func (siw *ServerInterfaceWrapper) TransfersGet(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id TransferIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter state: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
 		return
 	}
 
+	c.Set(BearerJWTScopes, []string{})
+
+	c.Set(BearerPASETOScopes, []string{})
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -414,11 +4181,26 @@ func (siw *ServerInterfaceWrapper) AuthGoogleCallback(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.AuthGoogleCallback(c, params)
+	siw.Handler.TransfersGet(c, id)
 }
 
-// AuthLogin operation middleware
-func (siw *ServerInterfaceWrapper) AuthLogin(c *gin.Context) {
+// TransfersListApprovals operation middleware
+func (siw *ServerInterfaceWrapper) TransfersListApprovals(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id TransferIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerJWTScopes, []string{})
+
+	c.Set(BearerPASETOScopes, []string{})
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -427,11 +4209,50 @@ func (siw *ServerInterfaceWrapper) AuthLogin(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.AuthLogin(c)
+	siw.Handler.TransfersListApprovals(c, id)
 }
 
-// AuthSignUp operation middleware
-func (siw *ServerInterfaceWrapper) AuthSignUp(c *gin.Context) {
+// TransfersApprove operation middleware
+func (siw *ServerInterfaceWrapper) TransfersApprove(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id TransferIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerJWTScopes, []string{})
+
+	c.Set(BearerPASETOScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TransfersApproveParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKeyHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Idempotency-Key, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Idempotency-Key: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -440,37 +4261,66 @@ func (siw *ServerInterfaceWrapper) AuthSignUp(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.AuthSignUp(c)
+	siw.Handler.TransfersApprove(c, id, params)
 }
 
-// TransfersList operation middleware
-func (siw *ServerInterfaceWrapper) TransfersList(c *gin.Context) {
+// TransfersCancel operation middleware
+func (siw *ServerInterfaceWrapper) TransfersCancel(c *gin.Context) {
 
 	var err error
 
+	// ------------- Path parameter "id" -------------
+	var id TransferIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	c.Set(BearerJWTScopes, []string{})
 
 	c.Set(BearerPASETOScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params TransfersListParams
+	var params TransfersCancelParams
 
-	// ------------- Optional query parameter "page" -------------
+	// ------------- Optional query parameter "account_id" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	err = runtime.BindQueryParameter("form", true, false, "account_id", c.Request.URL.Query(), &params.AccountId)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter account_id: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "limit" -------------
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	siw.Handler.TransfersCancel(c, id, params)
+}
+
+// TransfersReject operation middleware
+func (siw *ServerInterfaceWrapper) TransfersReject(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id TransferIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
 		return
 	}
 
+	c.Set(BearerJWTScopes, []string{})
+
+	c.Set(BearerPASETOScopes, []string{})
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -478,16 +4328,59 @@ func (siw *ServerInterfaceWrapper) TransfersList(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.TransfersList(c, params)
+	siw.Handler.TransfersReject(c, id)
 }
 
-// TransfersCreate operation middleware
-func (siw *ServerInterfaceWrapper) TransfersCreate(c *gin.Context) {
+// TransfersReverse operation middleware
+func (siw *ServerInterfaceWrapper) TransfersReverse(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id TransferIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	c.Set(BearerJWTScopes, []string{})
 
 	c.Set(BearerPASETOScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TransfersReverseParams
+
+	// ------------- Optional query parameter "account_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "account_id", c.Request.URL.Query(), &params.AccountId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter account_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKeyHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Idempotency-Key, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Idempotency-Key: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -495,7 +4388,7 @@ func (siw *ServerInterfaceWrapper) TransfersCreate(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.TransfersCreate(c)
+	siw.Handler.TransfersReverse(c, id, params)
 }
 
 // HealthCheck operation middleware
@@ -551,15 +4444,64 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 		ErrorHandler:       errorHandler,
 	}
 
+	router.GET(options.BaseURL+"/api/v1/accounts", wrapper.AccountsList)
+	router.POST(options.BaseURL+"/api/v1/accounts", wrapper.AccountsCreate)
 	router.GET(options.BaseURL+"/api/v1/accounts/balance", wrapper.AccountsGetBalance)
+	router.GET(options.BaseURL+"/api/v1/accounts/holds", wrapper.HoldsList)
+	router.POST(options.BaseURL+"/api/v1/accounts/holds", wrapper.HoldsCreate)
+	router.GET(options.BaseURL+"/api/v1/accounts/holds/:id", wrapper.HoldsGet)
+	router.POST(options.BaseURL+"/api/v1/accounts/holds/:id/capture", wrapper.HoldsCapture)
+	router.POST(options.BaseURL+"/api/v1/accounts/holds/:id/release", wrapper.HoldsRelease)
 	router.GET(options.BaseURL+"/api/v1/accounts/movements", wrapper.AccountsListMovements)
 	router.POST(options.BaseURL+"/api/v1/accounts/movements", wrapper.AccountsCreateMovement)
+	router.GET(options.BaseURL+"/api/v1/accounts/movements/:id", wrapper.AccountsGetMovement)
+	router.POST(options.BaseURL+"/api/v1/accounts/:id/close", wrapper.AccountsClose)
+	router.GET(options.BaseURL+"/api/v1/accounts/:id/cosigners", wrapper.AccountsListCosigners)
+	router.POST(options.BaseURL+"/api/v1/accounts/:id/cosigners", wrapper.AccountsAddCosigner)
+	router.DELETE(options.BaseURL+"/api/v1/accounts/:id/cosigners/:user_id", wrapper.AccountsRemoveCosigner)
+	router.POST(options.BaseURL+"/api/v1/accounts/:id/default", wrapper.AccountsSetDefault)
+	router.PUT(options.BaseURL+"/api/v1/accounts/:id/overdraft", wrapper.AccountsSetOverdraft)
+	router.POST(options.BaseURL+"/api/v1/accounts/:id/status", wrapper.AccountsUpdateStatus)
 	router.GET(options.BaseURL+"/api/v1/auth/google", wrapper.AuthGoogle)
 	router.GET(options.BaseURL+"/api/v1/auth/google/callback", wrapper.AuthGoogleCallback)
 	router.POST(options.BaseURL+"/api/v1/auth/login", wrapper.AuthLogin)
 	router.POST(options.BaseURL+"/api/v1/auth/signup", wrapper.AuthSignUp)
+	router.GET(options.BaseURL+"/api/v1/beneficiaries", wrapper.BeneficiariesList)
+	router.POST(options.BaseURL+"/api/v1/beneficiaries", wrapper.BeneficiariesCreate)
+	router.DELETE(options.BaseURL+"/api/v1/beneficiaries/:id", wrapper.BeneficiariesDelete)
+	router.GET(options.BaseURL+"/api/v1/beneficiaries/:id", wrapper.BeneficiariesGet)
+	router.PATCH(options.BaseURL+"/api/v1/beneficiaries/:id", wrapper.BeneficiariesUpdate)
+	router.GET(options.BaseURL+"/api/v1/fees/preview", wrapper.FeesPreview)
+	router.POST(options.BaseURL+"/api/v1/fx/quotes", wrapper.FxCreateQuote)
+	router.GET(options.BaseURL+"/api/v1/fx/rates", wrapper.FxListRates)
+	router.PUT(options.BaseURL+"/api/v1/fx/rates", wrapper.FxSetRates)
+	router.POST(options.BaseURL+"/api/v1/payment-requests", wrapper.PaymentRequestsCreate)
+	router.GET(options.BaseURL+"/api/v1/payment-requests/incoming", wrapper.PaymentRequestsListIncoming)
+	router.GET(options.BaseURL+"/api/v1/payment-requests/outgoing", wrapper.PaymentRequestsListOutgoing)
+	router.GET(options.BaseURL+"/api/v1/payment-requests/:id", wrapper.PaymentRequestsGet)
+	router.POST(options.BaseURL+"/api/v1/payment-requests/:id/cancel", wrapper.PaymentRequestsCancel)
+	router.POST(options.BaseURL+"/api/v1/payment-requests/:id/decline", wrapper.PaymentRequestsDecline)
+	router.POST(options.BaseURL+"/api/v1/payment-requests/:id/pay", wrapper.PaymentRequestsPay)
 	router.GET(options.BaseURL+"/api/v1/transfers", wrapper.TransfersList)
 	router.POST(options.BaseURL+"/api/v1/transfers", wrapper.TransfersCreate)
+	router.GET(options.BaseURL+"/api/v1/transfers/approvals", wrapper.TransfersListAwaitingApproval)
+	router.POST(options.BaseURL+"/api/v1/transfers/batches", wrapper.TransfersCreateBatch)
+	router.GET(options.BaseURL+"/api/v1/transfers/batches/:id", wrapper.TransfersGetBatch)
+	router.GET(options.BaseURL+"/api/v1/transfers/limits", wrapper.TransferLimitsGet)
+	router.PATCH(options.BaseURL+"/api/v1/transfers/limits", wrapper.TransferLimitsUpdate)
+	router.GET(options.BaseURL+"/api/v1/transfers/recipient", wrapper.TransfersPreviewRecipient)
+	router.GET(options.BaseURL+"/api/v1/transfers/scheduled", wrapper.TransfersListScheduled)
+	router.GET(options.BaseURL+"/api/v1/transfers/standing-orders", wrapper.StandingOrdersList)
+	router.POST(options.BaseURL+"/api/v1/transfers/standing-orders", wrapper.StandingOrdersCreate)
+	router.DELETE(options.BaseURL+"/api/v1/transfers/standing-orders/:id", wrapper.StandingOrdersCancel)
+	router.GET(options.BaseURL+"/api/v1/transfers/standing-orders/:id", wrapper.StandingOrdersGet)
+	router.PATCH(options.BaseURL+"/api/v1/transfers/standing-orders/:id", wrapper.StandingOrdersUpdate)
+	router.GET(options.BaseURL+"/api/v1/transfers/:id", wrapper.TransfersGet)
+	router.GET(options.BaseURL+"/api/v1/transfers/:id/approvals", wrapper.TransfersListApprovals)
+	router.POST(options.BaseURL+"/api/v1/transfers/:id/approve", wrapper.TransfersApprove)
+	router.POST(options.BaseURL+"/api/v1/transfers/:id/cancel", wrapper.TransfersCancel)
+	router.POST(options.BaseURL+"/api/v1/transfers/:id/reject", wrapper.TransfersReject)
+	router.POST(options.BaseURL+"/api/v1/transfers/:id/reverse", wrapper.TransfersReverse)
 	router.GET(options.BaseURL+"/health", wrapper.HealthCheck)
 	router.GET(options.BaseURL+"/metrics", wrapper.Metrics)
 }
@@ -1,10 +1,13 @@
 package handler
 
 import (
+	"io"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 
 	"VDM2-BankBE/internal/model"
 	"VDM2-BankBE/internal/service"
@@ -14,20 +17,65 @@ import (
 // AccountHandler handles account-related requests
 type AccountHandler struct {
 	accountService service.AccountService
+	validator      *validator.Validate
 }
 
 // NewAccountHandler creates a new account handler
 func NewAccountHandler(accountService service.AccountService) *AccountHandler {
 	return &AccountHandler{
 		accountService: accountService,
+		validator:      validator.New(),
 	}
 }
 
-// BalanceResponse represents an account balance response
+// CreateAccountRequest represents a request to open a new account
+type CreateAccountRequest struct {
+	Type string `json:"type" validate:"omitempty,oneof=checking savings pocket business"`
+	Name string `json:"name" validate:"max=100"`
+	// Currency is an ISO 4217 code, EUR if empty
+	Currency string `json:"currency" validate:"omitempty,len=3"`
+}
+
+// CloseAccountRequest represents a request to close an account; SweepTo receives a non-zero balance
+type CloseAccountRequest struct {
+	SweepTo string `json:"sweep_to"`
+}
+
+// UpdateAccountStatusRequest represents a request to change the status of an account
+type UpdateAccountStatusRequest struct {
+	Status string `json:"status" validate:"required,oneof=active frozen debit_blocked"`
+}
+
+// AccountsResponse represents the list of a user's accounts
+type AccountsResponse struct {
+	Accounts []*model.Account `json:"accounts"`
+}
+
+// SetOverdraftRequest represents a request to set the overdraft of an account; Rate is a yearly percentage
+type SetOverdraftRequest struct {
+	Limit string `json:"limit" validate:"required"`
+	Rate  string `json:"rate"`
+}
+
+// AddCosignerRequest represents a request to add a co-signer to a business account
+type AddCosignerRequest struct {
+	Username string `json:"username" validate:"required,max=100"`
+}
+
+// CosignersResponse represents the list of the co-signers of an account
+type CosignersResponse struct {
+	Cosigners []*model.AccountCosigner `json:"cosigners"`
+}
+
+// BalanceResponse represents an account balance response. Balance is the ledger balance; AvailableBalance takes
+// the active holds and the overdraft into account.
 type BalanceResponse struct {
-	AccountID uuid.UUID `json:"account_id"`
-	Balance   string    `json:"balance"`
-	Currency  string    `json:"currency"`
+	AccountID        uuid.UUID `json:"account_id"`
+	Balance          string    `json:"balance"`
+	HeldAmount       string    `json:"held_amount"`
+	OverdraftLimit   string    `json:"overdraft_limit"`
+	AvailableBalance string    `json:"available_balance"`
+	Currency         string    `json:"currency"`
 }
 
 // Balance gets the balance of the authenticated user's account
@@ -36,6 +84,7 @@ type BalanceResponse struct {
 // @Tags accounts
 // @Produce json
 // @Security BearerAuth
+// @Param account_id query string false "Account to use (default: the user's default account)"
 // @Success 200 {object} BalanceResponse
 // @Failure 401 {object} util.ErrorResponse
 // @Failure 500 {object} util.ErrorResponse
@@ -59,13 +108,13 @@ func (h *AccountHandler) Balance(c *gin.Context) {
 	}
 
 	// Get account
-	account, err := h.accountService.GetByUserID(c, userModel.ID)
+	account, err := selectAccount(c, h.accountService, userModel.ID, c.Query("account_id"))
 	if err != nil {
 		util.HandleError(c, err)
 		return
 	}
 
-	// Get balance (uses cache when available)
+	// Get ledger and available balances
 	balance, err := h.accountService.GetBalance(c, account.ID)
 	if err != nil {
 		util.HandleError(c, err)
@@ -74,11 +123,619 @@ func (h *AccountHandler) Balance(c *gin.Context) {
 
 	// Create response
 	response := BalanceResponse{
-		AccountID: account.ID,
-		Balance:   balance.String(),
-		Currency:  account.Currency,
+		AccountID:        balance.AccountID,
+		Balance:          balance.Ledger.String(),
+		HeldAmount:       balance.Held.String(),
+		OverdraftLimit:   balance.OverdraftLimit.String(),
+		AvailableBalance: balance.Available.String(),
+		Currency:         balance.Currency,
 	}
 
 	// Return response
 	c.JSON(http.StatusOK, response)
 }
+
+// List returns all the accounts of the authenticated user
+// @Summary List accounts
+// @Description Get all the accounts of the authenticated user, oldest first
+// @Tags accounts
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} AccountsResponse
+// @Failure 401 {object} util.ErrorResponse
+// @Failure 500 {object} util.ErrorResponse
+// @Router /accounts [get]
+func (h *AccountHandler) List(c *gin.Context) {
+	// Get user from context (set by auth middleware)
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, util.ErrorResponse{
+			Error: util.NewUnauthorizedError("unauthorized"),
+		})
+		return
+	}
+
+	userModel, ok := user.(*model.User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, util.ErrorResponse{
+			Error: util.NewInternalServerError("user context invalid"),
+		})
+		return
+	}
+
+	// Get accounts
+	accounts, err := h.accountService.ListByUserID(c, userModel.ID)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, AccountsResponse{Accounts: accounts})
+}
+
+// Create opens a new account for the authenticated user
+// @Summary Open an account
+// @Description Open an additional checking, savings or pocket account for the authenticated user
+// @Tags accounts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param account body CreateAccountRequest true "Account details"
+// @Success 201 {object} model.Account
+// @Failure 400 {object} util.ErrorResponse
+// @Failure 401 {object} util.ErrorResponse
+// @Failure 500 {object} util.ErrorResponse
+// @Router /accounts [post]
+func (h *AccountHandler) Create(c *gin.Context) {
+	// Get user from context (set by auth middleware)
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, util.ErrorResponse{
+			Error: util.NewUnauthorizedError("unauthorized"),
+		})
+		return
+	}
+
+	userModel, ok := user.(*model.User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, util.ErrorResponse{
+			Error: util.NewInternalServerError("user context invalid"),
+		})
+		return
+	}
+
+	// Parse and validate request
+	var req CreateAccountRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError("invalid request body"),
+		})
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError(err.Error()),
+		})
+		return
+	}
+
+	// Create account
+	account, err := h.accountService.Create(c, userModel.ID, req.Type, req.Name, req.Currency)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusCreated, account)
+}
+
+// SetDefault makes one of the authenticated user's accounts the default one
+// @Summary Set the default account
+// @Description Use the account when a request does not pick one
+// @Tags accounts
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Account ID"
+// @Success 200 {object} model.Account
+// @Failure 400 {object} util.ErrorResponse
+// @Failure 401 {object} util.ErrorResponse
+// @Failure 404 {object} util.ErrorResponse
+// @Failure 500 {object} util.ErrorResponse
+// @Router /accounts/{id}/default [post]
+func (h *AccountHandler) SetDefault(c *gin.Context) {
+	// Get user from context (set by auth middleware)
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, util.ErrorResponse{
+			Error: util.NewUnauthorizedError("unauthorized"),
+		})
+		return
+	}
+
+	userModel, ok := user.(*model.User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, util.ErrorResponse{
+			Error: util.NewInternalServerError("user context invalid"),
+		})
+		return
+	}
+
+	// Parse account ID
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError("invalid account id"),
+		})
+		return
+	}
+
+	// Set default account
+	account, err := h.accountService.SetDefault(c, userModel.ID, id)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, account)
+}
+
+// Close closes one of the authenticated user's accounts
+// @Summary Close an account
+// @Description Close an account, sweeping a non-zero balance to sweep_to; closed accounts stay readable for statements
+// @Tags accounts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Account ID"
+// @Param closure body CloseAccountRequest false "Closure details"
+// @Success 200 {object} model.Account
+// @Failure 400 {object} util.ErrorResponse
+// @Failure 401 {object} util.ErrorResponse
+// @Failure 404 {object} util.ErrorResponse
+// @Failure 409 {object} util.ErrorResponse
+// @Failure 500 {object} util.ErrorResponse
+// @Router /accounts/{id}/close [post]
+func (h *AccountHandler) Close(c *gin.Context) {
+	// Get user from context (set by auth middleware)
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, util.ErrorResponse{
+			Error: util.NewUnauthorizedError("unauthorized"),
+		})
+		return
+	}
+
+	userModel, ok := user.(*model.User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, util.ErrorResponse{
+			Error: util.NewInternalServerError("user context invalid"),
+		})
+		return
+	}
+
+	// Parse account ID
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError("invalid account id"),
+		})
+		return
+	}
+
+	// Parse request, the body is optional
+	var req CloseAccountRequest
+	if err := c.ShouldBindJSON(&req); err != nil && err != io.EOF {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError("invalid request body"),
+		})
+		return
+	}
+
+	var sweepTo *uuid.UUID
+	if req.SweepTo != "" {
+		to, err := uuid.Parse(req.SweepTo)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, util.ErrorResponse{
+				Error: util.NewBadRequestError("invalid sweep_to"),
+			})
+			return
+		}
+		sweepTo = &to
+	}
+
+	// Close account
+	account, err := h.accountService.Close(c, userModel.ID, id, sweepTo)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, account)
+}
+
+// UpdateStatus freezes, debit-blocks or reactivates an account
+// @Summary Change the status of an account
+// @Description Freeze, debit-block or reactivate any account. Requires the admin role.
+// @Tags accounts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Account ID"
+// @Param status body UpdateAccountStatusRequest true "New status"
+// @Success 200 {object} model.Account
+// @Failure 400 {object} util.ErrorResponse
+// @Failure 401 {object} util.ErrorResponse
+// @Failure 403 {object} util.ErrorResponse
+// @Failure 404 {object} util.ErrorResponse
+// @Failure 409 {object} util.ErrorResponse
+// @Failure 500 {object} util.ErrorResponse
+// @Router /accounts/{id}/status [post]
+func (h *AccountHandler) UpdateStatus(c *gin.Context) {
+	// Get user from context (set by auth middleware)
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, util.ErrorResponse{
+			Error: util.NewUnauthorizedError("unauthorized"),
+		})
+		return
+	}
+
+	userModel, ok := user.(*model.User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, util.ErrorResponse{
+			Error: util.NewInternalServerError("user context invalid"),
+		})
+		return
+	}
+
+	if userModel.Role != "admin" {
+		c.JSON(http.StatusForbidden, util.ErrorResponse{
+			Error: util.NewForbiddenError("only admins can change the status of an account"),
+		})
+		return
+	}
+
+	// Parse account ID
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError("invalid account id"),
+		})
+		return
+	}
+
+	// Parse and validate request
+	var req UpdateAccountStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError("invalid request body"),
+		})
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError(err.Error()),
+		})
+		return
+	}
+
+	// Update status
+	account, err := h.accountService.UpdateStatus(c, id, req.Status)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, account)
+}
+
+// SetOverdraft sets the overdraft limit and interest rate of an account
+// @Summary Set the overdraft of an account
+// @Description Set how far below zero an account can go and the yearly interest rate charged on the overdrawn balance. Requires the admin role.
+// @Tags accounts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Account ID"
+// @Param overdraft body SetOverdraftRequest true "Overdraft details"
+// @Success 200 {object} model.Account
+// @Failure 400 {object} util.ErrorResponse
+// @Failure 401 {object} util.ErrorResponse
+// @Failure 403 {object} util.ErrorResponse
+// @Failure 404 {object} util.ErrorResponse
+// @Failure 409 {object} util.ErrorResponse
+// @Failure 500 {object} util.ErrorResponse
+// @Router /accounts/{id}/overdraft [put]
+func (h *AccountHandler) SetOverdraft(c *gin.Context) {
+	// Get user from context (set by auth middleware)
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, util.ErrorResponse{
+			Error: util.NewUnauthorizedError("unauthorized"),
+		})
+		return
+	}
+
+	userModel, ok := user.(*model.User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, util.ErrorResponse{
+			Error: util.NewInternalServerError("user context invalid"),
+		})
+		return
+	}
+
+	if userModel.Role != "admin" {
+		c.JSON(http.StatusForbidden, util.ErrorResponse{
+			Error: util.NewForbiddenError("only admins can set the overdraft of an account"),
+		})
+		return
+	}
+
+	// Parse account ID
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError("invalid account id"),
+		})
+		return
+	}
+
+	// Parse and validate request
+	var req SetOverdraftRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError("invalid request body"),
+		})
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError(err.Error()),
+		})
+		return
+	}
+
+	// Parse limit and rate
+	limit, err := decimal.NewFromString(req.Limit)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError("invalid limit"),
+		})
+		return
+	}
+
+	rate := decimal.Zero
+	if req.Rate != "" {
+		rate, err = decimal.NewFromString(req.Rate)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, util.ErrorResponse{
+				Error: util.NewBadRequestError("invalid rate"),
+			})
+			return
+		}
+	}
+
+	// Set overdraft
+	account, err := h.accountService.SetOverdraft(c, id, limit, rate)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, account)
+}
+
+// ListCosigners returns the co-signers of one of the authenticated user's accounts
+// @Summary List the co-signers of an account
+// @Description Get the users who approve the large transfers sent from a business account
+// @Tags accounts
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Account ID"
+// @Success 200 {object} CosignersResponse
+// @Failure 400 {object} util.ErrorResponse
+// @Failure 401 {object} util.ErrorResponse
+// @Failure 404 {object} util.ErrorResponse
+// @Failure 500 {object} util.ErrorResponse
+// @Router /accounts/{id}/cosigners [get]
+func (h *AccountHandler) ListCosigners(c *gin.Context) {
+	// Get user from context (set by auth middleware)
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, util.ErrorResponse{
+			Error: util.NewUnauthorizedError("unauthorized"),
+		})
+		return
+	}
+
+	userModel, ok := user.(*model.User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, util.ErrorResponse{
+			Error: util.NewInternalServerError("user context invalid"),
+		})
+		return
+	}
+
+	// Parse account ID
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError("invalid account id"),
+		})
+		return
+	}
+
+	// List co-signers
+	cosigners, err := h.accountService.ListCosigners(c, userModel.ID, id)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, CosignersResponse{Cosigners: cosigners})
+}
+
+// AddCosigner makes another user a co-signer of one of the authenticated user's business accounts
+// @Summary Add a co-signer to an account
+// @Description Let another user approve the transfers above the approval threshold sent from a business account
+// @Tags accounts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Account ID"
+// @Param cosigner body AddCosignerRequest true "Co-signer details"
+// @Success 201 {object} model.AccountCosigner
+// @Failure 400 {object} util.ErrorResponse
+// @Failure 401 {object} util.ErrorResponse
+// @Failure 404 {object} util.ErrorResponse
+// @Failure 409 {object} util.ErrorResponse
+// @Failure 500 {object} util.ErrorResponse
+// @Router /accounts/{id}/cosigners [post]
+func (h *AccountHandler) AddCosigner(c *gin.Context) {
+	// Get user from context (set by auth middleware)
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, util.ErrorResponse{
+			Error: util.NewUnauthorizedError("unauthorized"),
+		})
+		return
+	}
+
+	userModel, ok := user.(*model.User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, util.ErrorResponse{
+			Error: util.NewInternalServerError("user context invalid"),
+		})
+		return
+	}
+
+	// Parse account ID
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError("invalid account id"),
+		})
+		return
+	}
+
+	// Parse and validate request
+	var req AddCosignerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError("invalid request body"),
+		})
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError(err.Error()),
+		})
+		return
+	}
+
+	// Add co-signer
+	cosigner, err := h.accountService.AddCosigner(c, userModel.ID, id, req.Username)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusCreated, cosigner)
+}
+
+// RemoveCosigner removes a co-signer from one of the authenticated user's accounts
+// @Summary Remove a co-signer from an account
+// @Description The user can no longer approve the transfers sent from the account
+// @Tags accounts
+// @Security BearerAuth
+// @Param id path string true "Account ID"
+// @Param user_id path string true "User ID of the co-signer"
+// @Success 204
+// @Failure 400 {object} util.ErrorResponse
+// @Failure 401 {object} util.ErrorResponse
+// @Failure 404 {object} util.ErrorResponse
+// @Failure 500 {object} util.ErrorResponse
+// @Router /accounts/{id}/cosigners/{user_id} [delete]
+func (h *AccountHandler) RemoveCosigner(c *gin.Context) {
+	// Get user from context (set by auth middleware)
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, util.ErrorResponse{
+			Error: util.NewUnauthorizedError("unauthorized"),
+		})
+		return
+	}
+
+	userModel, ok := user.(*model.User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, util.ErrorResponse{
+			Error: util.NewInternalServerError("user context invalid"),
+		})
+		return
+	}
+
+	// Parse account and co-signer IDs
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError("invalid account id"),
+		})
+		return
+	}
+
+	cosignerID, err := uuid.Parse(c.Param("user_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError("invalid user id"),
+		})
+		return
+	}
+
+	// Remove co-signer
+	if err := h.accountService.RemoveCosigner(c, userModel.ID, id, cosignerID); err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// selectAccount returns the account of the user with the given ID, or the user's default account when the ID is empty
+func selectAccount(c *gin.Context, accountService service.AccountService, userID uuid.UUID, accountID string) (*model.Account, error) {
+	if accountID == "" {
+		return accountService.GetByUserID(c, userID)
+	}
+
+	id, err := uuid.Parse(accountID)
+	if err != nil {
+		return nil, util.NewBadRequestError("invalid account id")
+	}
+
+	return accountService.GetForUser(c, userID, id)
+}
+
+// cursorQuery returns the cursor query param and whether a list is paginated by cursor rather than by page, which
+// it is when the param is given, even empty for the first page. It writes a 400 response when a page is given too.
+func cursorQuery(c *gin.Context) (string, bool, bool) {
+	cursor, byCursor := c.GetQuery("cursor")
+	if byCursor && c.Query("page") != "" {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError("page and cursor cannot be combined"),
+		})
+		return "", false, false
+	}
+
+	return cursor, byCursor, true
+}
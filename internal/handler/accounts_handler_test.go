@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
@@ -43,7 +44,7 @@ func TestAccounts_Balance(t *testing.T) {
 
 				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
 				accountSvc.EXPECT().GetByUserID(gomock.Any(), userID).Return(account, nil)
-				accountSvc.EXPECT().GetBalance(gomock.Any(), accountID).Return(mustDecimal(t, "10.50"), nil)
+				accountSvc.EXPECT().GetBalance(gomock.Any(), accountID).Return(&model.Balance{AccountID: accountID, Ledger: mustDecimal(t, "10.50"), Available: mustDecimal(t, "10.50"), Currency: "EUR"}, nil)
 
 				return authSvc, accountSvc
 			},
@@ -193,7 +194,7 @@ func TestAccounts_ListMovements(t *testing.T) {
 
 				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
 				accountSvc.EXPECT().GetByUserID(gomock.Any(), userID).Return(account, nil)
-				movementSvc.EXPECT().GetByAccountID(gomock.Any(), accountID, 1, 100).Return(&util.PaginatedResponse{}, nil)
+				movementSvc.EXPECT().GetByAccountID(gomock.Any(), accountID, &model.MovementFilter{}, 1, 100).Return(&util.PaginatedResponse{}, nil)
 
 				return authSvc, accountSvc, movementSvc
 			},
@@ -202,6 +203,78 @@ func TestAccounts_ListMovements(t *testing.T) {
 				testutil.AssertHTTPStatus(t, rec, http.StatusOK)
 			},
 		},
+		{
+			name: "filter query params are passed to the service",
+			path: "/api/v1/accounts/movements?from=2026-01-01T00:00:00Z&type=debit&min_amount=10.50&search=%20rent%20&sort=amount&order=asc",
+			setupAuth: func(headers map[string]string) {
+				headers["Authorization"] = "Bearer " + token
+			},
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockMovementService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				accountSvc := servicemocks.NewMockAccountService(ctrl)
+				movementSvc := servicemocks.NewMockMovementService(ctrl)
+
+				from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+				minAmount := decimal.RequireFromString("10.50")
+				filter := &model.MovementFilter{
+					From:      &from,
+					Type:      "debit",
+					MinAmount: &minAmount,
+					Search:    "rent",
+					Sort:      "amount",
+					Order:     "asc",
+				}
+
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				accountSvc.EXPECT().GetByUserID(gomock.Any(), userID).Return(account, nil)
+				movementSvc.EXPECT().GetByAccountID(gomock.Any(), accountID, filter, 1, 10).Return(&util.PaginatedResponse{}, nil)
+
+				return authSvc, accountSvc, movementSvc
+			},
+			expectedStatus: http.StatusOK,
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPStatus(t, rec, http.StatusOK)
+			},
+		},
+		{
+			name: "cursor pages by cursor with the filters",
+			path: "/api/v1/accounts/movements?cursor=abc&type=credit",
+			setupAuth: func(headers map[string]string) {
+				headers["Authorization"] = "Bearer " + token
+			},
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockMovementService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				accountSvc := servicemocks.NewMockAccountService(ctrl)
+				movementSvc := servicemocks.NewMockMovementService(ctrl)
+
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				accountSvc.EXPECT().GetByUserID(gomock.Any(), userID).Return(account, nil)
+				movementSvc.EXPECT().GetByAccountIDWithCursor(gomock.Any(), accountID, &model.MovementFilter{Type: "credit"}, "abc", 10).
+					Return(&util.CursorResponse{}, nil)
+
+				return authSvc, accountSvc, movementSvc
+			},
+			expectedStatus: http.StatusOK,
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPStatus(t, rec, http.StatusOK)
+			},
+		},
+		{
+			name: "invalid min_amount returns 400",
+			path: "/api/v1/accounts/movements?min_amount=abc",
+			setupAuth: func(headers map[string]string) {
+				headers["Authorization"] = "Bearer " + token
+			},
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockMovementService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				return authSvc, servicemocks.NewMockAccountService(ctrl), servicemocks.NewMockMovementService(ctrl)
+			},
+			expectedStatus: http.StatusBadRequest,
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPError(t, rec, http.StatusBadRequest, "invalid min_amount")
+			},
+		},
 		{
 			name:      "missing token returns 401",
 			path:      "/api/v1/accounts/movements?page=1&limit=10",
@@ -237,6 +310,80 @@ func TestAccounts_ListMovements(t *testing.T) {
 	}
 }
 
+func TestAccounts_GetMovement(t *testing.T) {
+	t.Parallel()
+
+	token := "header.payload.sig"
+	userID := uuid.MustParse("00000000-0000-0000-0000-000000000022")
+	user := &model.User{ID: userID}
+
+	tests := []struct {
+		name           string
+		path           string
+		buildMocks     func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockMovementService)
+		assertResponse func(t *testing.T, rec *httptest.ResponseRecorder)
+	}{
+		{
+			name: "movement is returned with its transfer and counterparty",
+			path: "/api/v1/accounts/movements/5",
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockMovementService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				movementSvc := servicemocks.NewMockMovementService(ctrl)
+
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				movementSvc.EXPECT().GetForUser(gomock.Any(), userID, uint64(5)).Return(&model.MovementDetails{
+					Movement:         model.Movement{ID: 5, Type: "credit"},
+					Transfer:         &model.Transfer{ID: 9, Status: "completed"},
+					CounterpartyName: "Ma*** R***",
+				}, nil)
+
+				return authSvc, movementSvc
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPStatus(t, rec, http.StatusOK)
+				got := testutil.DecodeJSONResponse[model.MovementDetails](t, rec)
+				if got.ID != 5 || got.Transfer == nil || got.Transfer.ID != 9 || got.CounterpartyName != "Ma*** R***" {
+					t.Fatalf("unexpected movement: %+v", got)
+				}
+			},
+		},
+		{
+			name: "movement of another user maps to 404",
+			path: "/api/v1/accounts/movements/6",
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockMovementService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				movementSvc := servicemocks.NewMockMovementService(ctrl)
+
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				movementSvc.EXPECT().GetForUser(gomock.Any(), userID, uint64(6)).Return(nil, util.NewNotFoundError("movement not found"))
+
+				return authSvc, movementSvc
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPError(t, rec, http.StatusNotFound, "movement not found")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authSvc, movementSvc := tc.buildMocks(ctrl)
+			r := newTestRouter(t, ctrl, authSvc, nil, movementSvc, nil)
+
+			req := testutil.NewJSONRequest(http.MethodGet, tc.path, nil, map[string]string{"Authorization": "Bearer " + token})
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, req)
+
+			tc.assertResponse(t, rec)
+		})
+	}
+}
+
 func TestAccounts_CreateMovement(t *testing.T) {
 	t.Parallel()
 
@@ -377,6 +524,352 @@ func TestAccounts_CreateMovement(t *testing.T) {
 	}
 }
 
+func TestAccounts_Create(t *testing.T) {
+	t.Parallel()
+
+	token := "header.payload.sig"
+	userID := uuid.MustParse("00000000-0000-0000-0000-000000000040")
+	user := &model.User{ID: userID}
+
+	tests := []struct {
+		name           string
+		requestBody    any
+		buildMocks     func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService)
+		assertResponse func(t *testing.T, rec *httptest.ResponseRecorder)
+	}{
+		{
+			name:        "success",
+			requestBody: map[string]any{"type": "savings", "name": "Holidays", "currency": "USD"},
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				accountSvc := servicemocks.NewMockAccountService(ctrl)
+
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				accountSvc.EXPECT().Create(gomock.Any(), userID, "savings", "Holidays", "USD").
+					Return(&model.Account{ID: uuid.New(), UserID: userID, Type: "savings", Name: "Holidays", Currency: "USD"}, nil)
+
+				return authSvc, accountSvc
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPStatus(t, rec, http.StatusCreated)
+			},
+		},
+		{
+			name:        "unknown type returns 400",
+			requestBody: map[string]any{"type": "brokerage"},
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				return authSvc, servicemocks.NewMockAccountService(ctrl)
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPStatus(t, rec, http.StatusBadRequest)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authSvc, accountSvc := tc.buildMocks(ctrl)
+			r := newTestRouter(t, ctrl, authSvc, accountSvc, nil, nil)
+
+			req := testutil.NewJSONRequest(http.MethodPost, "/api/v1/accounts", tc.requestBody, map[string]string{
+				"Authorization": "Bearer " + token,
+			})
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, req)
+
+			tc.assertResponse(t, rec)
+		})
+	}
+}
+
+func TestAccounts_SelectAccount(t *testing.T) {
+	t.Parallel()
+
+	token := "header.payload.sig"
+	userID := uuid.MustParse("00000000-0000-0000-0000-000000000050")
+	accountID := uuid.MustParse("00000000-0000-0000-0000-000000000051")
+	user := &model.User{ID: userID}
+
+	tests := []struct {
+		name           string
+		accountID      string
+		buildMocks     func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService)
+		assertResponse func(t *testing.T, rec *httptest.ResponseRecorder)
+	}{
+		{
+			name:      "explicit account is used",
+			accountID: accountID.String(),
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				accountSvc := servicemocks.NewMockAccountService(ctrl)
+
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				accountSvc.EXPECT().GetForUser(gomock.Any(), userID, accountID).Return(&model.Account{ID: accountID, UserID: userID}, nil)
+				accountSvc.EXPECT().GetBalance(gomock.Any(), accountID).Return(&model.Balance{AccountID: accountID, Ledger: mustDecimal(t, "3.00"), Available: mustDecimal(t, "3.00")}, nil)
+
+				return authSvc, accountSvc
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPStatus(t, rec, http.StatusOK)
+			},
+		},
+		{
+			name:      "account of another user maps to 404",
+			accountID: accountID.String(),
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				accountSvc := servicemocks.NewMockAccountService(ctrl)
+
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				accountSvc.EXPECT().GetForUser(gomock.Any(), userID, accountID).Return(nil, util.NewNotFoundError("account not found"))
+
+				return authSvc, accountSvc
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPError(t, rec, http.StatusNotFound, "account not found")
+			},
+		},
+		{
+			name:      "malformed account id returns 400",
+			accountID: "not-a-uuid",
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService) {
+				return servicemocks.NewMockAuthService(ctrl), servicemocks.NewMockAccountService(ctrl)
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPStatus(t, rec, http.StatusBadRequest)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authSvc, accountSvc := tc.buildMocks(ctrl)
+			r := newTestRouter(t, ctrl, authSvc, accountSvc, nil, nil)
+
+			req := testutil.NewJSONRequest(http.MethodGet, "/api/v1/accounts/balance?account_id="+tc.accountID, nil, map[string]string{
+				"Authorization": "Bearer " + token,
+			})
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, req)
+
+			tc.assertResponse(t, rec)
+		})
+	}
+}
+
+func TestAccounts_Close(t *testing.T) {
+	t.Parallel()
+
+	token := "header.payload.sig"
+	userID := uuid.MustParse("00000000-0000-0000-0000-000000000060")
+	accountID := uuid.MustParse("00000000-0000-0000-0000-000000000061")
+	sweepTo := uuid.MustParse("00000000-0000-0000-0000-000000000062")
+	user := &model.User{ID: userID}
+
+	tests := []struct {
+		name           string
+		requestBody    any
+		buildMocks     func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService)
+		assertResponse func(t *testing.T, rec *httptest.ResponseRecorder)
+	}{
+		{
+			name:        "balance is swept to the nominated account",
+			requestBody: map[string]any{"sweep_to": sweepTo.String()},
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				accountSvc := servicemocks.NewMockAccountService(ctrl)
+
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				accountSvc.EXPECT().Close(gomock.Any(), userID, accountID, &sweepTo).
+					Return(&model.Account{ID: accountID, UserID: userID, Status: "closed"}, nil)
+
+				return authSvc, accountSvc
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPStatus(t, rec, http.StatusOK)
+			},
+		},
+		{
+			name: "non-empty account without a sweep account maps to 409",
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				accountSvc := servicemocks.NewMockAccountService(ctrl)
+
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				accountSvc.EXPECT().Close(gomock.Any(), userID, accountID, nil).
+					Return(nil, util.NewConflictError("account balance must be zero or swept to another account"))
+
+				return authSvc, accountSvc
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPError(t, rec, http.StatusConflict, "account balance must be zero or swept to another account")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authSvc, accountSvc := tc.buildMocks(ctrl)
+			r := newTestRouter(t, ctrl, authSvc, accountSvc, nil, nil)
+
+			req := testutil.NewJSONRequest(http.MethodPost, "/api/v1/accounts/"+accountID.String()+"/close", tc.requestBody, map[string]string{
+				"Authorization": "Bearer " + token,
+			})
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, req)
+
+			tc.assertResponse(t, rec)
+		})
+	}
+}
+
+func TestAccounts_UpdateStatus(t *testing.T) {
+	t.Parallel()
+
+	token := "header.payload.sig"
+	accountID := uuid.MustParse("00000000-0000-0000-0000-000000000071")
+	admin := &model.User{ID: uuid.MustParse("00000000-0000-0000-0000-000000000070"), Role: "admin"}
+	customer := &model.User{ID: uuid.MustParse("00000000-0000-0000-0000-000000000072"), Role: "user"}
+
+	tests := []struct {
+		name           string
+		buildMocks     func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService)
+		assertResponse func(t *testing.T, rec *httptest.ResponseRecorder)
+	}{
+		{
+			name: "admin freezes an account",
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				accountSvc := servicemocks.NewMockAccountService(ctrl)
+
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(admin, nil)
+				accountSvc.EXPECT().UpdateStatus(gomock.Any(), accountID, "frozen").Return(&model.Account{ID: accountID, Status: "frozen"}, nil)
+
+				return authSvc, accountSvc
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPStatus(t, rec, http.StatusOK)
+			},
+		},
+		{
+			name: "customer is forbidden",
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(customer, nil)
+
+				return authSvc, servicemocks.NewMockAccountService(ctrl)
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPError(t, rec, http.StatusForbidden, "only admins can change the status of an account")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authSvc, accountSvc := tc.buildMocks(ctrl)
+			r := newTestRouter(t, ctrl, authSvc, accountSvc, nil, nil)
+
+			req := testutil.NewJSONRequest(http.MethodPost, "/api/v1/accounts/"+accountID.String()+"/status", map[string]any{"status": "frozen"}, map[string]string{
+				"Authorization": "Bearer " + token,
+			})
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, req)
+
+			tc.assertResponse(t, rec)
+		})
+	}
+}
+
+func TestAccounts_SetOverdraft(t *testing.T) {
+	t.Parallel()
+
+	token := "header.payload.sig"
+	accountID := uuid.MustParse("00000000-0000-0000-0000-000000000081")
+	admin := &model.User{ID: uuid.MustParse("00000000-0000-0000-0000-000000000080"), Role: "admin"}
+	customer := &model.User{ID: uuid.MustParse("00000000-0000-0000-0000-000000000082"), Role: "user"}
+
+	tests := []struct {
+		name           string
+		body           any
+		buildMocks     func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService)
+		assertResponse func(t *testing.T, rec *httptest.ResponseRecorder)
+	}{
+		{
+			name: "admin sets the overdraft",
+			body: map[string]any{"limit": "500.00", "rate": "9.50"},
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				accountSvc := servicemocks.NewMockAccountService(ctrl)
+
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(admin, nil)
+				accountSvc.EXPECT().SetOverdraft(gomock.Any(), accountID, mustDecimal(t, "500.00"), mustDecimal(t, "9.50")).
+					Return(&model.Account{ID: accountID, Status: "active", OverdraftLimit: mustDecimal(t, "500.00")}, nil)
+
+				return authSvc, accountSvc
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPStatus(t, rec, http.StatusOK)
+			},
+		},
+		{
+			name: "customer is forbidden",
+			body: map[string]any{"limit": "500.00"},
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(customer, nil)
+
+				return authSvc, servicemocks.NewMockAccountService(ctrl)
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPError(t, rec, http.StatusForbidden, "only admins can set the overdraft of an account")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authSvc, accountSvc := tc.buildMocks(ctrl)
+			r := newTestRouter(t, ctrl, authSvc, accountSvc, nil, nil)
+
+			req := testutil.NewJSONRequest(http.MethodPut, "/api/v1/accounts/"+accountID.String()+"/overdraft", tc.body, map[string]string{
+				"Authorization": "Bearer " + token,
+			})
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, req)
+
+			tc.assertResponse(t, rec)
+		})
+	}
+}
+
 func mustDecimal(t *testing.T, s string) decimal.Decimal {
 	t.Helper()
 	d, err := decimal.NewFromString(s)
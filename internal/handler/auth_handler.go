@@ -32,6 +32,8 @@ type SignUpRequest struct {
 	FirstName  string `json:"first_name" validate:"required"`
 	LastName   string `json:"last_name" validate:"required"`
 	FiscalCode string `json:"fiscal_code" validate:"required"`
+	// Currency of the first account, EUR if empty
+	Currency string `json:"currency,omitempty"`
 }
 
 // LoginRequest represents a login request
@@ -83,6 +85,7 @@ func (h *AuthHandler) SignUp(c *gin.Context) {
 		req.LastName,
 		req.FiscalCode,
 		req.Password,
+		req.Currency,
 	)
 	if err != nil {
 		util.HandleError(c, err)
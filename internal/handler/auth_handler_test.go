@@ -162,7 +162,7 @@ func TestAuth_SignUp(t *testing.T) {
 			buildMocks: func(ctrl *gomock.Controller) *servicemocks.MockAuthService {
 				m := servicemocks.NewMockAuthService(ctrl)
 				m.EXPECT().
-					SignUp(gomock.Any(), "a@example.com", "alice", "Alice", "A", "FC1", "SecurePass123!").
+					SignUp(gomock.Any(), "a@example.com", "alice", "Alice", "A", "FC1", "SecurePass123!", "").
 					Return(returnedUser, nil)
 				return m
 			},
@@ -220,7 +220,7 @@ func TestAuth_SignUp(t *testing.T) {
 			buildMocks: func(ctrl *gomock.Controller) *servicemocks.MockAuthService {
 				m := servicemocks.NewMockAuthService(ctrl)
 				m.EXPECT().
-					SignUp(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					SignUp(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(nil, util.NewBadRequestError("email already in use"))
 				return m
 			},
@@ -277,19 +277,24 @@ func newTestRouter(
 	authHandler := handler.NewAuthHandler(authSvc)
 	accountHandler := handler.NewAccountHandler(accountSvc)
 	movementHandler := handler.NewMovementHandler(movementSvc, accountSvc)
-	transferHandler := handler.NewTransferHandler(transferSvc, accountSvc)
+	transferHandler := handler.NewTransferHandler(transferSvc, accountSvc, servicemocks.NewMockBeneficiaryService(ctrl))
 
-	authMw := middleware.NewAuthMiddleware(authSvc, zap.NewNop())
+	return newHandlerTestRouter(t, authSvc, testutil.RouterDeps{
+		AuthHandler:     authHandler,
+		AccountHandler:  accountHandler,
+		MovementHandler: movementHandler,
+		TransferHandler: transferHandler,
+	})
+}
+
+// newHandlerTestRouter serves the handlers set in deps behind the authentication of authSvc, without rate limiting
+func newHandlerTestRouter(t *testing.T, authSvc *servicemocks.MockAuthService, deps testutil.RouterDeps) http.Handler {
+	t.Helper()
+
+	deps.AuthMiddleware = middleware.NewAuthMiddleware(authSvc, zap.NewNop())
 	rlCfg := &config.RateLimitConfig{Enabled: false}
-	rlMw := middleware.NewRateLimitMiddleware(nil, rlCfg, zap.NewNop())
+	deps.RateLimitMiddleware = middleware.NewRateLimitMiddleware(nil, rlCfg, zap.NewNop())
 
-	return testutil.SetupGinRouter(t, testutil.RouterDeps{
-		AuthHandler:          authHandler,
-		AccountHandler:       accountHandler,
-		MovementHandler:      movementHandler,
-		TransferHandler:      transferHandler,
-		AuthMiddleware:       authMw,
-		RateLimitMiddleware:  rlMw,
-	})
+	return testutil.SetupGinRouter(t, deps)
 }
 
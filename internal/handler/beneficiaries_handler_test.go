@@ -0,0 +1,205 @@
+package handler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+
+	"VDM2-BankBE/internal/handler"
+	"VDM2-BankBE/internal/model"
+	servicemocks "VDM2-BankBE/internal/service/mocks"
+	"VDM2-BankBE/internal/testutil"
+	"VDM2-BankBE/internal/util"
+)
+
+func TestBeneficiaries_Create(t *testing.T) {
+	t.Parallel()
+
+	token := "header.payload.sig"
+	userID := uuid.MustParse("00000000-0000-0000-0000-0000000000a0")
+	accountID := uuid.MustParse("550e8400-e29b-41d4-a716-4466554400a1")
+
+	user := &model.User{ID: userID}
+
+	tests := []struct {
+		name           string
+		body           any
+		buildMocks     func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockBeneficiaryService)
+		assertResponse func(t *testing.T, rec *httptest.ResponseRecorder)
+	}{
+		{
+			name: "success",
+			body: map[string]any{"nickname": "Rent", "iban": "IT60X0542811101000000123456"},
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockBeneficiaryService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				beneficiarySvc := servicemocks.NewMockBeneficiaryService(ctrl)
+
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				beneficiarySvc.EXPECT().
+					Create(gomock.Any(), &model.Beneficiary{UserID: userID, Nickname: "Rent", IBAN: "IT60X0542811101000000123456"}).
+					Return(&model.Beneficiary{ID: 1, UserID: userID, Nickname: "Rent", AccountID: accountID, IBAN: "IT60X0542811101000000123456"}, nil)
+
+				return authSvc, beneficiarySvc
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPStatus(t, rec, http.StatusCreated)
+			},
+		},
+		{
+			name: "both account_id and iban returns 400",
+			body: map[string]any{"nickname": "Rent", "account_id": accountID.String(), "iban": "IT60X0542811101000000123456"},
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockBeneficiaryService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				return authSvc, servicemocks.NewMockBeneficiaryService(ctrl)
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPStatus(t, rec, http.StatusBadRequest)
+			},
+		},
+		{
+			name: "account already saved returns 409",
+			body: map[string]any{"nickname": "Rent", "account_id": accountID.String()},
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockBeneficiaryService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				beneficiarySvc := servicemocks.NewMockBeneficiaryService(ctrl)
+
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				beneficiarySvc.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil, util.NewConflictError("account is already a beneficiary"))
+
+				return authSvc, beneficiarySvc
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPError(t, rec, http.StatusConflict, "account is already a beneficiary")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authSvc, beneficiarySvc := tc.buildMocks(ctrl)
+			r := newHandlerTestRouter(t, authSvc, testutil.RouterDeps{
+				BeneficiaryHandler: handler.NewBeneficiaryHandler(beneficiarySvc),
+			})
+
+			req := testutil.NewJSONRequest(http.MethodPost, "/api/v1/beneficiaries", tc.body, map[string]string{
+				"Authorization": "Bearer " + token,
+			})
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, req)
+
+			tc.assertResponse(t, rec)
+		})
+	}
+}
+
+func TestBeneficiaries_Transfer(t *testing.T) {
+	t.Parallel()
+
+	token := "header.payload.sig"
+	userID := uuid.MustParse("00000000-0000-0000-0000-0000000000a2")
+	fromAccountID := uuid.MustParse("00000000-0000-0000-0000-0000000000a3")
+	toAccountID := uuid.MustParse("00000000-0000-0000-0000-0000000000a4")
+
+	user := &model.User{ID: userID}
+	fromAccount := &model.Account{ID: fromAccountID, UserID: userID, Currency: "EUR"}
+	beneficiary := &model.Beneficiary{ID: 4, UserID: userID, Nickname: "Rent", AccountID: toAccountID}
+
+	tests := []struct {
+		name           string
+		body           any
+		buildMocks     func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockTransferService, *servicemocks.MockBeneficiaryService)
+		assertResponse func(t *testing.T, rec *httptest.ResponseRecorder)
+	}{
+		{
+			name: "beneficiary_id resolves the recipient and marks it used",
+			body: map[string]any{"beneficiary_id": 4, "amount": "25.00", "description": "rent"},
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockTransferService, *servicemocks.MockBeneficiaryService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				accountSvc := servicemocks.NewMockAccountService(ctrl)
+				transferSvc := servicemocks.NewMockTransferService(ctrl)
+				beneficiarySvc := servicemocks.NewMockBeneficiaryService(ctrl)
+
+				amount := mustDecimal(t, "25.00")
+
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				beneficiarySvc.EXPECT().GetByID(gomock.Any(), userID, uint64(4)).Return(beneficiary, nil)
+				accountSvc.EXPECT().GetByUserID(gomock.Any(), userID).Return(fromAccount, nil)
+				transferSvc.EXPECT().
+					Submit(gomock.Any(), fromAccountID, toAccountID, amount, "rent").
+					Return(&model.Transfer{ID: 1, FromAccount: fromAccountID, ToAccount: toAccountID, Amount: amount, Status: "pending"}, nil)
+				beneficiarySvc.EXPECT().MarkUsed(gomock.Any(), uint64(4)).Return(nil)
+
+				return authSvc, accountSvc, transferSvc, beneficiarySvc
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPStatus(t, rec, http.StatusAccepted)
+			},
+		},
+		{
+			name: "cooling-off limit returns 422",
+			body: map[string]any{"beneficiary_id": 4, "amount": "900.00"},
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockTransferService, *servicemocks.MockBeneficiaryService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				accountSvc := servicemocks.NewMockAccountService(ctrl)
+				transferSvc := servicemocks.NewMockTransferService(ctrl)
+				beneficiarySvc := servicemocks.NewMockBeneficiaryService(ctrl)
+
+				// The transfer service enforces the cooling-off limit, whichever way the recipient is given
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				beneficiarySvc.EXPECT().GetByID(gomock.Any(), userID, uint64(4)).Return(beneficiary, nil)
+				accountSvc.EXPECT().GetByUserID(gomock.Any(), userID).Return(fromAccount, nil)
+				transferSvc.EXPECT().
+					Submit(gomock.Any(), fromAccountID, toAccountID, gomock.Any(), "").
+					Return(nil, util.NewUnprocessableEntityError("transfers to this beneficiary are limited to 500.00 EUR until 2030-01-15T09:00:00Z"))
+
+				return authSvc, accountSvc, transferSvc, beneficiarySvc
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPStatus(t, rec, http.StatusUnprocessableEntity)
+			},
+		},
+		{
+			name: "beneficiary_id together with to_account returns 400",
+			body: map[string]any{"beneficiary_id": 4, "to_account": toAccountID.String(), "amount": "25.00"},
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockTransferService, *servicemocks.MockBeneficiaryService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				return authSvc, servicemocks.NewMockAccountService(ctrl), servicemocks.NewMockTransferService(ctrl), servicemocks.NewMockBeneficiaryService(ctrl)
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPStatus(t, rec, http.StatusBadRequest)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authSvc, accountSvc, transferSvc, beneficiarySvc := tc.buildMocks(ctrl)
+			r := newHandlerTestRouter(t, authSvc, testutil.RouterDeps{
+				TransferHandler: handler.NewTransferHandler(transferSvc, accountSvc, beneficiarySvc),
+			})
+
+			req := testutil.NewJSONRequest(http.MethodPost, "/api/v1/transfers", tc.body, map[string]string{
+				"Authorization": "Bearer " + token,
+			})
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, req)
+
+			tc.assertResponse(t, rec)
+		})
+	}
+}
@@ -0,0 +1,338 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+
+	"VDM2-BankBE/internal/model"
+	"VDM2-BankBE/internal/service"
+	"VDM2-BankBE/internal/util"
+)
+
+// BeneficiaryHandler handles beneficiary requests
+type BeneficiaryHandler struct {
+	beneficiaryService service.BeneficiaryService
+	validator          *validator.Validate
+}
+
+// NewBeneficiaryHandler creates a new beneficiary handler
+func NewBeneficiaryHandler(beneficiaryService service.BeneficiaryService) *BeneficiaryHandler {
+	return &BeneficiaryHandler{
+		beneficiaryService: beneficiaryService,
+		validator:          validator.New(),
+	}
+}
+
+// CreateBeneficiaryRequest represents a request to add an account to the user's address book
+type CreateBeneficiaryRequest struct {
+	Nickname  string `json:"nickname" validate:"required,max=100"`
+	AccountID string `json:"account_id" validate:"required_without=IBAN,excluded_with=IBAN,omitempty,uuid4"`
+	IBAN      string `json:"iban" validate:"required_without=AccountID,omitempty,max=42"`
+}
+
+// UpdateBeneficiaryRequest represents a request to rename a beneficiary
+type UpdateBeneficiaryRequest struct {
+	Nickname string `json:"nickname" validate:"required,max=100"`
+}
+
+// List returns a paginated list of the user's beneficiaries
+// @Summary List beneficiaries
+// @Description Get the address book of the authenticated user, sorted by nickname
+// @Tags beneficiaries
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Items per page (default: 10, max: 100)"
+// @Success 200 {object} util.PaginatedResponse
+// @Failure 401 {object} util.ErrorResponse
+// @Failure 500 {object} util.ErrorResponse
+// @Router /beneficiaries [get]
+func (h *BeneficiaryHandler) List(c *gin.Context) {
+	// Get user from context (set by auth middleware)
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, util.ErrorResponse{
+			Error: util.NewUnauthorizedError("unauthorized"),
+		})
+		return
+	}
+
+	userModel, ok := user.(*model.User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, util.ErrorResponse{
+			Error: util.NewInternalServerError("user context invalid"),
+		})
+		return
+	}
+
+	// Get pagination parameters
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if page <= 0 {
+		page = 1
+	}
+
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+	if limit <= 0 {
+		limit = 10
+	}
+	if limit > 100 {
+		limit = 100
+	}
+
+	// Get beneficiaries
+	response, err := h.beneficiaryService.GetByUserID(c, userModel.ID, page, limit)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, response)
+}
+
+// Create adds an account to the user's address book
+// @Summary Add a beneficiary
+// @Description Add an account, given by ID or IBAN, to the address book of the authenticated user
+// @Tags beneficiaries
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param beneficiary body CreateBeneficiaryRequest true "Beneficiary details"
+// @Success 201 {object} model.Beneficiary
+// @Failure 400 {object} util.ErrorResponse
+// @Failure 401 {object} util.ErrorResponse
+// @Failure 404 {object} util.ErrorResponse
+// @Failure 409 {object} util.ErrorResponse
+// @Failure 500 {object} util.ErrorResponse
+// @Router /beneficiaries [post]
+func (h *BeneficiaryHandler) Create(c *gin.Context) {
+	// Get user from context (set by auth middleware)
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, util.ErrorResponse{
+			Error: util.NewUnauthorizedError("unauthorized"),
+		})
+		return
+	}
+
+	userModel, ok := user.(*model.User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, util.ErrorResponse{
+			Error: util.NewInternalServerError("user context invalid"),
+		})
+		return
+	}
+
+	// Parse and validate request
+	var req CreateBeneficiaryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError("invalid request body"),
+		})
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError(err.Error()),
+		})
+		return
+	}
+
+	beneficiary := &model.Beneficiary{
+		UserID:   userModel.ID,
+		Nickname: req.Nickname,
+		IBAN:     req.IBAN,
+	}
+	if req.AccountID != "" {
+		accountID, err := uuid.Parse(req.AccountID)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, util.ErrorResponse{
+				Error: util.NewBadRequestError("invalid account_id"),
+			})
+			return
+		}
+		beneficiary.AccountID = accountID
+	}
+
+	// Create beneficiary
+	created, err := h.beneficiaryService.Create(c, beneficiary)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusCreated, created)
+}
+
+// Get returns a beneficiary of the user
+// @Summary Get a beneficiary
+// @Tags beneficiaries
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Beneficiary ID"
+// @Success 200 {object} model.Beneficiary
+// @Failure 400 {object} util.ErrorResponse
+// @Failure 401 {object} util.ErrorResponse
+// @Failure 404 {object} util.ErrorResponse
+// @Failure 500 {object} util.ErrorResponse
+// @Router /beneficiaries/{id} [get]
+func (h *BeneficiaryHandler) Get(c *gin.Context) {
+	// Get user from context (set by auth middleware)
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, util.ErrorResponse{
+			Error: util.NewUnauthorizedError("unauthorized"),
+		})
+		return
+	}
+
+	userModel, ok := user.(*model.User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, util.ErrorResponse{
+			Error: util.NewInternalServerError("user context invalid"),
+		})
+		return
+	}
+
+	id, ok := parseBeneficiaryID(c)
+	if !ok {
+		return
+	}
+
+	// Get beneficiary
+	beneficiary, err := h.beneficiaryService.GetByID(c, userModel.ID, id)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, beneficiary)
+}
+
+// Update renames a beneficiary of the user
+// @Summary Rename a beneficiary
+// @Tags beneficiaries
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Beneficiary ID"
+// @Param beneficiary body UpdateBeneficiaryRequest true "New nickname"
+// @Success 200 {object} model.Beneficiary
+// @Failure 400 {object} util.ErrorResponse
+// @Failure 401 {object} util.ErrorResponse
+// @Failure 404 {object} util.ErrorResponse
+// @Failure 500 {object} util.ErrorResponse
+// @Router /beneficiaries/{id} [patch]
+func (h *BeneficiaryHandler) Update(c *gin.Context) {
+	// Get user from context (set by auth middleware)
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, util.ErrorResponse{
+			Error: util.NewUnauthorizedError("unauthorized"),
+		})
+		return
+	}
+
+	userModel, ok := user.(*model.User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, util.ErrorResponse{
+			Error: util.NewInternalServerError("user context invalid"),
+		})
+		return
+	}
+
+	id, ok := parseBeneficiaryID(c)
+	if !ok {
+		return
+	}
+
+	// Parse and validate request
+	var req UpdateBeneficiaryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError("invalid request body"),
+		})
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError(err.Error()),
+		})
+		return
+	}
+
+	// Rename beneficiary
+	beneficiary, err := h.beneficiaryService.Rename(c, userModel.ID, id, req.Nickname)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, beneficiary)
+}
+
+// Delete removes a beneficiary of the user
+// @Summary Delete a beneficiary
+// @Description Remove a beneficiary from the address book. Transfers already sent to it are not affected.
+// @Tags beneficiaries
+// @Security BearerAuth
+// @Param id path int true "Beneficiary ID"
+// @Success 204
+// @Failure 400 {object} util.ErrorResponse
+// @Failure 401 {object} util.ErrorResponse
+// @Failure 404 {object} util.ErrorResponse
+// @Failure 500 {object} util.ErrorResponse
+// @Router /beneficiaries/{id} [delete]
+func (h *BeneficiaryHandler) Delete(c *gin.Context) {
+	// Get user from context (set by auth middleware)
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, util.ErrorResponse{
+			Error: util.NewUnauthorizedError("unauthorized"),
+		})
+		return
+	}
+
+	userModel, ok := user.(*model.User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, util.ErrorResponse{
+			Error: util.NewInternalServerError("user context invalid"),
+		})
+		return
+	}
+
+	id, ok := parseBeneficiaryID(c)
+	if !ok {
+		return
+	}
+
+	// Delete beneficiary
+	if err := h.beneficiaryService.Delete(c, userModel.ID, id); err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// parseBeneficiaryID parses the beneficiary ID path param, writing a 400 response when it is invalid
+func parseBeneficiaryID(c *gin.Context) (uint64, bool) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError("invalid beneficiary id"),
+		})
+		return 0, false
+	}
+
+	return id, true
+}
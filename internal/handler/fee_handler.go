@@ -0,0 +1,95 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+
+	"VDM2-BankBE/internal/model"
+	"VDM2-BankBE/internal/service"
+	"VDM2-BankBE/internal/util"
+)
+
+// FeeHandler handles fee requests
+type FeeHandler struct {
+	feeService     service.FeeService
+	accountService service.AccountService
+}
+
+// NewFeeHandler creates a new fee handler
+func NewFeeHandler(feeService service.FeeService, accountService service.AccountService) *FeeHandler {
+	return &FeeHandler{
+		feeService:     feeService,
+		accountService: accountService,
+	}
+}
+
+// Preview returns the fee an operation would be charged
+// @Summary Preview a fee
+// @Description Compute the fee of an operation for an amount in the currency of the account, without charging it.
+// @Description Transfers between accounts of the same user are not charged.
+// @Tags fees
+// @Produce json
+// @Security BearerAuth
+// @Param operation query string true "Operation type (instant_transfer, scheduled_transfer, cross_currency_transfer, withdrawal)"
+// @Param amount query string true "Amount of the operation"
+// @Param account_id query string false "Account to use (default: the user's default account)"
+// @Success 200 {object} model.FeeBreakdown
+// @Failure 400 {object} util.ErrorResponse
+// @Failure 401 {object} util.ErrorResponse
+// @Failure 404 {object} util.ErrorResponse
+// @Failure 500 {object} util.ErrorResponse
+// @Router /fees/preview [get]
+func (h *FeeHandler) Preview(c *gin.Context) {
+	// Get user from context (set by auth middleware)
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, util.ErrorResponse{
+			Error: util.NewUnauthorizedError("unauthorized"),
+		})
+		return
+	}
+
+	userModel, ok := user.(*model.User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, util.ErrorResponse{
+			Error: util.NewInternalServerError("user context invalid"),
+		})
+		return
+	}
+
+	// Parse query
+	operation := c.Query("operation")
+	if operation == "" {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError("operation is required"),
+		})
+		return
+	}
+
+	amount, err := decimal.NewFromString(c.Query("amount"))
+	if err != nil || !amount.IsPositive() {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError("invalid amount"),
+		})
+		return
+	}
+
+	// Get account, whose currency the fee is charged in
+	account, err := selectAccount(c, h.accountService, userModel.ID, c.Query("account_id"))
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Calculate fee
+	breakdown, err := h.feeService.Calculate(c, operation, amount, account.Currency)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, breakdown)
+}
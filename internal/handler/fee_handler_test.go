@@ -0,0 +1,112 @@
+package handler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+
+	"VDM2-BankBE/internal/config"
+	"VDM2-BankBE/internal/handler"
+	"VDM2-BankBE/internal/middleware"
+	"VDM2-BankBE/internal/model"
+	servicemocks "VDM2-BankBE/internal/service/mocks"
+	"VDM2-BankBE/internal/testutil"
+	"VDM2-BankBE/internal/util"
+)
+
+func TestFee_Preview(t *testing.T) {
+	t.Parallel()
+
+	token := "header.payload.sig"
+	userID := uuid.MustParse("00000000-0000-0000-0000-0000000000d0")
+	user := &model.User{ID: userID}
+	account := &model.Account{ID: uuid.MustParse("00000000-0000-0000-0000-0000000000d1"), UserID: userID, Currency: "USD"}
+
+	tests := []struct {
+		name           string
+		query          string
+		buildMocks     func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockFeeService, *servicemocks.MockAccountService)
+		assertResponse func(t *testing.T, rec *httptest.ResponseRecorder)
+	}{
+		{
+			name:  "success in the currency of the account",
+			query: "?operation=instant_transfer&amount=100.00",
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockFeeService, *servicemocks.MockAccountService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				feeSvc := servicemocks.NewMockFeeService(ctrl)
+				accountSvc := servicemocks.NewMockAccountService(ctrl)
+
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				accountSvc.EXPECT().GetByUserID(gomock.Any(), userID).Return(account, nil)
+				feeSvc.EXPECT().Calculate(gomock.Any(), "instant_transfer", decimal.RequireFromString("100.00"), "USD").
+					Return(&model.FeeBreakdown{Operation: "instant_transfer", Rule: "flat", Currency: "USD", Total: decimal.RequireFromString("0.50")}, nil)
+
+				return authSvc, feeSvc, accountSvc
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPStatus(t, rec, http.StatusOK)
+			},
+		},
+		{
+			name:  "invalid amount returns 400",
+			query: "?operation=withdrawal&amount=-5",
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockFeeService, *servicemocks.MockAccountService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+
+				return authSvc, servicemocks.NewMockFeeService(ctrl), servicemocks.NewMockAccountService(ctrl)
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPError(t, rec, http.StatusBadRequest, "invalid amount")
+			},
+		},
+		{
+			name:  "unknown account returns 404",
+			query: "?operation=withdrawal&amount=5&account_id=00000000-0000-4000-8000-0000000000d2",
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockFeeService, *servicemocks.MockAccountService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				accountSvc := servicemocks.NewMockAccountService(ctrl)
+
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				accountSvc.EXPECT().GetForUser(gomock.Any(), userID, uuid.MustParse("00000000-0000-4000-8000-0000000000d2")).
+					Return(nil, util.NewNotFoundError("account not found"))
+
+				return authSvc, servicemocks.NewMockFeeService(ctrl), accountSvc
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPError(t, rec, http.StatusNotFound, "account not found")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authSvc, feeSvc, accountSvc := tc.buildMocks(ctrl)
+			authMw := middleware.NewAuthMiddleware(authSvc, zap.NewNop())
+			rlMw := middleware.NewRateLimitMiddleware(nil, &config.RateLimitConfig{Enabled: false}, zap.NewNop())
+			r := testutil.SetupGinRouter(t, testutil.RouterDeps{
+				FeeHandler:          handler.NewFeeHandler(feeSvc, accountSvc),
+				AuthMiddleware:      authMw,
+				RateLimitMiddleware: rlMw,
+			})
+
+			req := testutil.NewJSONRequest(http.MethodGet, "/api/v1/fees/preview"+tc.query, nil, map[string]string{
+				"Authorization": "Bearer " + token,
+			})
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, req)
+
+			tc.assertResponse(t, rec)
+		})
+	}
+}
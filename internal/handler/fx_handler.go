@@ -0,0 +1,224 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+
+	"VDM2-BankBE/internal/model"
+	"VDM2-BankBE/internal/service"
+	"VDM2-BankBE/internal/util"
+)
+
+// FXHandler handles exchange rate and FX quote requests
+type FXHandler struct {
+	fxService service.FXService
+	validator *validator.Validate
+}
+
+// NewFXHandler creates a new FX handler
+func NewFXHandler(fxService service.FXService) *FXHandler {
+	return &FXHandler{
+		fxService: fxService,
+		validator: validator.New(),
+	}
+}
+
+// FXRateRequest represents an exchange rate to create or replace
+type FXRateRequest struct {
+	BaseCurrency  string `json:"base_currency" validate:"required,len=3"`
+	QuoteCurrency string `json:"quote_currency" validate:"required,len=3"`
+	Rate          string `json:"rate" validate:"required"`
+}
+
+// SetFXRatesRequest represents a request to create or replace exchange rates
+type SetFXRatesRequest struct {
+	Rates []FXRateRequest `json:"rates" validate:"required,min=1,dive"`
+}
+
+// FXRatesResponse represents the list of exchange rates
+type FXRatesResponse struct {
+	Rates []*model.FXRate `json:"rates"`
+}
+
+// FXQuoteRequest represents a request to quote a currency conversion
+type FXQuoteRequest struct {
+	FromCurrency string `json:"from_currency" validate:"required,len=3"`
+	ToCurrency   string `json:"to_currency" validate:"required,len=3"`
+	Amount       string `json:"amount" validate:"required"`
+}
+
+// ListRates returns the exchange rates
+// @Summary List exchange rates
+// @Description List the exchange rates transfers between accounts of different currencies are quoted at
+// @Tags fx
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} FXRatesResponse
+// @Failure 401 {object} util.ErrorResponse
+// @Failure 500 {object} util.ErrorResponse
+// @Router /fx/rates [get]
+func (h *FXHandler) ListRates(c *gin.Context) {
+	rates, err := h.fxService.ListRates(c)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, FXRatesResponse{Rates: rates})
+}
+
+// SetRates creates or replaces exchange rates
+// @Summary Set exchange rates
+// @Description Create or replace exchange rates; rates not listed are kept. Requires the admin role.
+// @Tags fx
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param rates body SetFXRatesRequest true "Rates to set"
+// @Success 200 {object} FXRatesResponse
+// @Failure 400 {object} util.ErrorResponse
+// @Failure 401 {object} util.ErrorResponse
+// @Failure 403 {object} util.ErrorResponse
+// @Failure 500 {object} util.ErrorResponse
+// @Router /fx/rates [put]
+func (h *FXHandler) SetRates(c *gin.Context) {
+	// Get user from context (set by auth middleware)
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, util.ErrorResponse{
+			Error: util.NewUnauthorizedError("unauthorized"),
+		})
+		return
+	}
+
+	userModel, ok := user.(*model.User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, util.ErrorResponse{
+			Error: util.NewInternalServerError("user context invalid"),
+		})
+		return
+	}
+
+	if userModel.Role != "admin" {
+		c.JSON(http.StatusForbidden, util.ErrorResponse{
+			Error: util.NewForbiddenError("only admins can set exchange rates"),
+		})
+		return
+	}
+
+	// Parse and validate request
+	var req SetFXRatesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError("invalid request body"),
+		})
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError(err.Error()),
+		})
+		return
+	}
+
+	// Parse rates
+	updates := make([]service.FXRateUpdate, 0, len(req.Rates))
+	for _, rate := range req.Rates {
+		value, err := decimal.NewFromString(rate.Rate)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, util.ErrorResponse{
+				Error: util.NewBadRequestError("invalid rate"),
+			})
+			return
+		}
+		updates = append(updates, service.FXRateUpdate{
+			BaseCurrency:  rate.BaseCurrency,
+			QuoteCurrency: rate.QuoteCurrency,
+			Rate:          value,
+		})
+	}
+
+	// Set rates
+	rates, err := h.fxService.SetRates(c, updates)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, FXRatesResponse{Rates: rates})
+}
+
+// CreateQuote quotes a currency conversion
+// @Summary Quote a currency conversion
+// @Description Lock the rate for converting an amount; pass the quote id with a transfer between accounts of these
+// @Description currencies before the quote expires
+// @Tags fx
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param quote body FXQuoteRequest true "Conversion to quote"
+// @Success 201 {object} model.FXQuote
+// @Failure 400 {object} util.ErrorResponse
+// @Failure 401 {object} util.ErrorResponse
+// @Failure 422 {object} util.ErrorResponse
+// @Failure 500 {object} util.ErrorResponse
+// @Router /fx/quotes [post]
+func (h *FXHandler) CreateQuote(c *gin.Context) {
+	// Get user from context (set by auth middleware)
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, util.ErrorResponse{
+			Error: util.NewUnauthorizedError("unauthorized"),
+		})
+		return
+	}
+
+	userModel, ok := user.(*model.User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, util.ErrorResponse{
+			Error: util.NewInternalServerError("user context invalid"),
+		})
+		return
+	}
+
+	// Parse and validate request
+	var req FXQuoteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError("invalid request body"),
+		})
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError(err.Error()),
+		})
+		return
+	}
+
+	// Parse amount
+	amount, err := decimal.NewFromString(req.Amount)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError("invalid amount"),
+		})
+		return
+	}
+
+	// Quote conversion
+	quote, err := h.fxService.Quote(c, userModel.ID, req.FromCurrency, req.ToCurrency, amount)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusCreated, quote)
+}
@@ -0,0 +1,172 @@
+package handler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"VDM2-BankBE/internal/handler"
+	"VDM2-BankBE/internal/model"
+	"VDM2-BankBE/internal/service"
+	servicemocks "VDM2-BankBE/internal/service/mocks"
+	"VDM2-BankBE/internal/testutil"
+	"VDM2-BankBE/internal/util"
+)
+
+func TestFX_SetRates(t *testing.T) {
+	t.Parallel()
+
+	token := "header.payload.sig"
+	admin := &model.User{ID: uuid.MustParse("00000000-0000-0000-0000-0000000000c0"), Role: "admin"}
+	user := &model.User{ID: uuid.MustParse("00000000-0000-0000-0000-0000000000c1"), Role: "user"}
+	body := map[string]any{"rates": []map[string]any{{"base_currency": "EUR", "quote_currency": "USD", "rate": "1.09"}}}
+
+	tests := []struct {
+		name           string
+		body           any
+		buildMocks     func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockFXService)
+		assertResponse func(t *testing.T, rec *httptest.ResponseRecorder)
+	}{
+		{
+			name: "admin sets rates",
+			body: body,
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockFXService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				fxSvc := servicemocks.NewMockFXService(ctrl)
+
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(admin, nil)
+				fxSvc.EXPECT().SetRates(gomock.Any(), gomock.Any()).DoAndReturn(func(_ any, updates []service.FXRateUpdate) ([]*model.FXRate, error) {
+					if len(updates) != 1 || updates[0].BaseCurrency != "EUR" || !updates[0].Rate.Equal(decimal.RequireFromString("1.09")) {
+						t.Fatalf("unexpected updates: %+v", updates)
+					}
+					return []*model.FXRate{{BaseCurrency: "EUR", QuoteCurrency: "USD", Rate: updates[0].Rate}}, nil
+				})
+
+				return authSvc, fxSvc
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPStatus(t, rec, http.StatusOK)
+			},
+		},
+		{
+			name: "non-admin gets 403",
+			body: body,
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockFXService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+
+				return authSvc, servicemocks.NewMockFXService(ctrl)
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPError(t, rec, http.StatusForbidden, "only admins can set exchange rates")
+			},
+		},
+		{
+			name: "invalid rate returns 400",
+			body: map[string]any{"rates": []map[string]any{{"base_currency": "EUR", "quote_currency": "USD", "rate": "high"}}},
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockFXService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(admin, nil)
+
+				return authSvc, servicemocks.NewMockFXService(ctrl)
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPError(t, rec, http.StatusBadRequest, "invalid rate")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authSvc, fxSvc := tc.buildMocks(ctrl)
+			r := newHandlerTestRouter(t, authSvc, testutil.RouterDeps{FXHandler: handler.NewFXHandler(fxSvc)})
+
+			req := testutil.NewJSONRequest(http.MethodPut, "/api/v1/fx/rates", tc.body, map[string]string{
+				"Authorization": "Bearer " + token,
+			})
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, req)
+
+			tc.assertResponse(t, rec)
+		})
+	}
+}
+
+func TestFX_CreateQuote(t *testing.T) {
+	t.Parallel()
+
+	token := "header.payload.sig"
+	userID := uuid.MustParse("00000000-0000-0000-0000-0000000000c2")
+	user := &model.User{ID: userID}
+
+	tests := []struct {
+		name           string
+		body           any
+		buildMocks     func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockFXService)
+		assertResponse func(t *testing.T, rec *httptest.ResponseRecorder)
+	}{
+		{
+			name: "success",
+			body: map[string]any{"from_currency": "EUR", "to_currency": "USD", "amount": "100.00"},
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockFXService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				fxSvc := servicemocks.NewMockFXService(ctrl)
+
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				fxSvc.EXPECT().Quote(gomock.Any(), userID, "EUR", "USD", decimal.RequireFromString("100.00")).
+					Return(&model.FXQuote{ID: uuid.New(), FromCurrency: "EUR", ToCurrency: "USD"}, nil)
+
+				return authSvc, fxSvc
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPStatus(t, rec, http.StatusCreated)
+			},
+		},
+		{
+			name: "missing rate maps to 422",
+			body: map[string]any{"from_currency": "USD", "to_currency": "GBP", "amount": "100.00"},
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockFXService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				fxSvc := servicemocks.NewMockFXService(ctrl)
+
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				fxSvc.EXPECT().Quote(gomock.Any(), userID, "USD", "GBP", gomock.Any()).
+					Return(nil, util.NewUnprocessableEntityError("no exchange rate from USD to GBP"))
+
+				return authSvc, fxSvc
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPError(t, rec, http.StatusUnprocessableEntity, "no exchange rate from USD to GBP")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authSvc, fxSvc := tc.buildMocks(ctrl)
+			r := newHandlerTestRouter(t, authSvc, testutil.RouterDeps{FXHandler: handler.NewFXHandler(fxSvc)})
+
+			req := testutil.NewJSONRequest(http.MethodPost, "/api/v1/fx/quotes", tc.body, map[string]string{
+				"Authorization": "Bearer " + token,
+			})
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, req)
+
+			tc.assertResponse(t, rec)
+		})
+	}
+}
@@ -0,0 +1,392 @@
+package handler
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
+
+	"VDM2-BankBE/internal/model"
+	"VDM2-BankBE/internal/service"
+	"VDM2-BankBE/internal/util"
+)
+
+// HoldHandler handles authorization hold requests
+type HoldHandler struct {
+	holdService    service.HoldService
+	accountService service.AccountService
+	validator      *validator.Validate
+}
+
+// NewHoldHandler creates a new hold handler
+func NewHoldHandler(
+	holdService service.HoldService,
+	accountService service.AccountService,
+) *HoldHandler {
+	return &HoldHandler{
+		holdService:    holdService,
+		accountService: accountService,
+		validator:      validator.New(),
+	}
+}
+
+// CreateHoldRequest represents a request to place a hold on an account
+type CreateHoldRequest struct {
+	AccountID   string     `json:"account_id" validate:"omitempty,uuid"`
+	Amount      string     `json:"amount" validate:"required"`
+	Description string     `json:"description"`
+	ExpiresAt   *time.Time `json:"expires_at"`
+}
+
+// CaptureHoldRequest represents a request to capture a hold; an empty amount captures the whole hold
+type CaptureHoldRequest struct {
+	Amount      string `json:"amount"`
+	Description string `json:"description"`
+}
+
+// List returns a paginated list of the holds of the user's account
+// @Summary List holds
+// @Description Get a paginated list of the authorization holds of the authenticated user's account, newest first
+// @Tags accounts
+// @Produce json
+// @Security BearerAuth
+// @Param account_id query string false "Account to use (default: the user's default account)"
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Items per page (default: 10, max: 100)"
+// @Success 200 {object} util.PaginatedResponse
+// @Failure 401 {object} util.ErrorResponse
+// @Failure 500 {object} util.ErrorResponse
+// @Router /accounts/holds [get]
+func (h *HoldHandler) List(c *gin.Context) {
+	// Get user from context (set by auth middleware)
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, util.ErrorResponse{
+			Error: util.NewUnauthorizedError("unauthorized"),
+		})
+		return
+	}
+
+	userModel, ok := user.(*model.User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, util.ErrorResponse{
+			Error: util.NewInternalServerError("user context invalid"),
+		})
+		return
+	}
+
+	// Get account
+	account, err := selectAccount(c, h.accountService, userModel.ID, c.Query("account_id"))
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Get pagination parameters
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if page <= 0 {
+		page = 1
+	}
+
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+	if limit <= 0 {
+		limit = 10
+	}
+	if limit > 100 {
+		limit = 100
+	}
+
+	// Get holds
+	response, err := h.holdService.GetByAccountID(c, account.ID, page, limit)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, response)
+}
+
+// Create places a hold on the user's account
+// @Summary Place a hold
+// @Description Reserve funds of the account until the hold is captured, released or expires
+// @Tags accounts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param hold body CreateHoldRequest true "Hold details"
+// @Success 201 {object} model.Hold
+// @Failure 400 {object} util.ErrorResponse
+// @Failure 401 {object} util.ErrorResponse
+// @Failure 404 {object} util.ErrorResponse
+// @Failure 409 {object} util.ErrorResponse
+// @Failure 500 {object} util.ErrorResponse
+// @Router /accounts/holds [post]
+func (h *HoldHandler) Create(c *gin.Context) {
+	// Get user from context (set by auth middleware)
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, util.ErrorResponse{
+			Error: util.NewUnauthorizedError("unauthorized"),
+		})
+		return
+	}
+
+	userModel, ok := user.(*model.User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, util.ErrorResponse{
+			Error: util.NewInternalServerError("user context invalid"),
+		})
+		return
+	}
+
+	// Parse and validate request
+	var req CreateHoldRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError("invalid request body"),
+		})
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError(err.Error()),
+		})
+		return
+	}
+
+	// Parse amount
+	amount, err := decimal.NewFromString(req.Amount)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError("invalid amount"),
+		})
+		return
+	}
+
+	// Get account
+	account, err := selectAccount(c, h.accountService, userModel.ID, req.AccountID)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Place hold
+	hold, err := h.holdService.Place(c, account.ID, amount, req.Description, req.ExpiresAt)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusCreated, hold)
+}
+
+// Get returns a hold of the user's account
+// @Summary Get a hold
+// @Tags accounts
+// @Produce json
+// @Security BearerAuth
+// @Param account_id query string false "Account to use (default: the user's default account)"
+// @Param id path int true "Hold ID"
+// @Success 200 {object} model.Hold
+// @Failure 400 {object} util.ErrorResponse
+// @Failure 401 {object} util.ErrorResponse
+// @Failure 404 {object} util.ErrorResponse
+// @Failure 500 {object} util.ErrorResponse
+// @Router /accounts/holds/{id} [get]
+func (h *HoldHandler) Get(c *gin.Context) {
+	// Get user from context (set by auth middleware)
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, util.ErrorResponse{
+			Error: util.NewUnauthorizedError("unauthorized"),
+		})
+		return
+	}
+
+	userModel, ok := user.(*model.User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, util.ErrorResponse{
+			Error: util.NewInternalServerError("user context invalid"),
+		})
+		return
+	}
+
+	id, ok := parseHoldID(c)
+	if !ok {
+		return
+	}
+
+	// Get account
+	account, err := selectAccount(c, h.accountService, userModel.ID, c.Query("account_id"))
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Get hold
+	hold, err := h.holdService.GetByID(c, account.ID, id)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, hold)
+}
+
+// Capture turns a hold of the user's account into a debit movement
+// @Summary Capture a hold
+// @Description Debit the account for the whole hold, or for part of it when `amount` is given; the rest of the hold
+// @Description goes back to the available balance
+// @Tags accounts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param account_id query string false "Account to use (default: the user's default account)"
+// @Param id path int true "Hold ID"
+// @Param capture body CaptureHoldRequest false "Captured amount and movement description"
+// @Success 200 {object} model.Hold
+// @Failure 400 {object} util.ErrorResponse
+// @Failure 401 {object} util.ErrorResponse
+// @Failure 404 {object} util.ErrorResponse
+// @Failure 409 {object} util.ErrorResponse
+// @Failure 500 {object} util.ErrorResponse
+// @Router /accounts/holds/{id}/capture [post]
+func (h *HoldHandler) Capture(c *gin.Context) {
+	// Get user from context (set by auth middleware)
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, util.ErrorResponse{
+			Error: util.NewUnauthorizedError("unauthorized"),
+		})
+		return
+	}
+
+	userModel, ok := user.(*model.User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, util.ErrorResponse{
+			Error: util.NewInternalServerError("user context invalid"),
+		})
+		return
+	}
+
+	id, ok := parseHoldID(c)
+	if !ok {
+		return
+	}
+
+	// Parse request; the body is optional
+	var req CaptureHoldRequest
+	if err := c.ShouldBindJSON(&req); err != nil && err != io.EOF {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError("invalid request body"),
+		})
+		return
+	}
+
+	// Parse amount
+	amount := decimal.Zero
+	if req.Amount != "" {
+		var err error
+		amount, err = decimal.NewFromString(req.Amount)
+		if err != nil || !amount.IsPositive() {
+			c.JSON(http.StatusBadRequest, util.ErrorResponse{
+				Error: util.NewBadRequestError("invalid amount"),
+			})
+			return
+		}
+	}
+
+	// Get account
+	account, err := selectAccount(c, h.accountService, userModel.ID, c.Query("account_id"))
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Capture hold
+	hold, err := h.holdService.Capture(c, account.ID, id, amount, req.Description)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, hold)
+}
+
+// Release cancels a hold of the user's account
+// @Summary Release a hold
+// @Description Cancel an active hold, giving the held amount back to the available balance
+// @Tags accounts
+// @Produce json
+// @Security BearerAuth
+// @Param account_id query string false "Account to use (default: the user's default account)"
+// @Param id path int true "Hold ID"
+// @Success 200 {object} model.Hold
+// @Failure 400 {object} util.ErrorResponse
+// @Failure 401 {object} util.ErrorResponse
+// @Failure 404 {object} util.ErrorResponse
+// @Failure 409 {object} util.ErrorResponse
+// @Failure 500 {object} util.ErrorResponse
+// @Router /accounts/holds/{id}/release [post]
+func (h *HoldHandler) Release(c *gin.Context) {
+	// Get user from context (set by auth middleware)
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, util.ErrorResponse{
+			Error: util.NewUnauthorizedError("unauthorized"),
+		})
+		return
+	}
+
+	userModel, ok := user.(*model.User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, util.ErrorResponse{
+			Error: util.NewInternalServerError("user context invalid"),
+		})
+		return
+	}
+
+	id, ok := parseHoldID(c)
+	if !ok {
+		return
+	}
+
+	// Get account
+	account, err := selectAccount(c, h.accountService, userModel.ID, c.Query("account_id"))
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Release hold
+	hold, err := h.holdService.Release(c, account.ID, id)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, hold)
+}
+
+// parseHoldID parses the hold ID path param, writing a 400 response when it is invalid
+func parseHoldID(c *gin.Context) (uint64, bool) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError("invalid hold id"),
+		})
+		return 0, false
+	}
+
+	return id, true
+}
@@ -0,0 +1,185 @@
+package handler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"VDM2-BankBE/internal/handler"
+	"VDM2-BankBE/internal/model"
+	servicemocks "VDM2-BankBE/internal/service/mocks"
+	"VDM2-BankBE/internal/testutil"
+	"VDM2-BankBE/internal/util"
+)
+
+func TestHolds_Create(t *testing.T) {
+	t.Parallel()
+
+	token := "header.payload.sig"
+	userID := uuid.MustParse("00000000-0000-0000-0000-000000000090")
+	accountID := uuid.MustParse("00000000-0000-0000-0000-000000000091")
+
+	user := &model.User{ID: userID}
+	account := &model.Account{ID: accountID, UserID: userID, Currency: "EUR"}
+
+	tests := []struct {
+		name           string
+		body           any
+		buildMocks     func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockHoldService)
+		assertResponse func(t *testing.T, rec *httptest.ResponseRecorder)
+	}{
+		{
+			name: "success",
+			body: map[string]any{"amount": "40.00", "description": "hotel deposit"},
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockHoldService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				accountSvc := servicemocks.NewMockAccountService(ctrl)
+				holdSvc := servicemocks.NewMockHoldService(ctrl)
+
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				accountSvc.EXPECT().GetByUserID(gomock.Any(), userID).Return(account, nil)
+				holdSvc.EXPECT().
+					Place(gomock.Any(), accountID, decimal.RequireFromString("40.00"), "hotel deposit", nil).
+					Return(&model.Hold{ID: 1, AccountID: accountID, Amount: decimal.RequireFromString("40.00"), Status: "active"}, nil)
+
+				return authSvc, accountSvc, holdSvc
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPStatus(t, rec, http.StatusCreated)
+			},
+		},
+		{
+			name: "insufficient available funds returns 400",
+			body: map[string]any{"amount": "4000.00"},
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockHoldService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				accountSvc := servicemocks.NewMockAccountService(ctrl)
+				holdSvc := servicemocks.NewMockHoldService(ctrl)
+
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				accountSvc.EXPECT().GetByUserID(gomock.Any(), userID).Return(account, nil)
+				holdSvc.EXPECT().Place(gomock.Any(), accountID, gomock.Any(), "", nil).Return(nil, util.NewBadRequestError("insufficient funds"))
+
+				return authSvc, accountSvc, holdSvc
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPError(t, rec, http.StatusBadRequest, "insufficient funds")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authSvc, accountSvc, holdSvc := tc.buildMocks(ctrl)
+			r := newHandlerTestRouter(t, authSvc, testutil.RouterDeps{HoldHandler: handler.NewHoldHandler(holdSvc, accountSvc)})
+
+			req := testutil.NewJSONRequest(http.MethodPost, "/api/v1/accounts/holds", tc.body, map[string]string{
+				"Authorization": "Bearer " + token,
+			})
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, req)
+
+			tc.assertResponse(t, rec)
+		})
+	}
+}
+
+func TestHolds_Capture(t *testing.T) {
+	t.Parallel()
+
+	token := "header.payload.sig"
+	userID := uuid.MustParse("00000000-0000-0000-0000-000000000092")
+	accountID := uuid.MustParse("00000000-0000-0000-0000-000000000093")
+
+	user := &model.User{ID: userID}
+	account := &model.Account{ID: accountID, UserID: userID, Currency: "EUR"}
+
+	tests := []struct {
+		name           string
+		body           any
+		buildMocks     func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockHoldService)
+		assertResponse func(t *testing.T, rec *httptest.ResponseRecorder)
+	}{
+		{
+			name: "without body captures the whole hold",
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockHoldService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				accountSvc := servicemocks.NewMockAccountService(ctrl)
+				holdSvc := servicemocks.NewMockHoldService(ctrl)
+
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				accountSvc.EXPECT().GetByUserID(gomock.Any(), userID).Return(account, nil)
+				holdSvc.EXPECT().
+					Capture(gomock.Any(), accountID, uint64(5), decimal.Zero, "").
+					Return(&model.Hold{ID: 5, AccountID: accountID, Status: "captured"}, nil)
+
+				return authSvc, accountSvc, holdSvc
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPStatus(t, rec, http.StatusOK)
+			},
+		},
+		{
+			name: "negative amount returns 400",
+			body: map[string]any{"amount": "-5.00"},
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockHoldService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+
+				return authSvc, servicemocks.NewMockAccountService(ctrl), servicemocks.NewMockHoldService(ctrl)
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPError(t, rec, http.StatusBadRequest, "invalid amount")
+			},
+		},
+		{
+			name: "released hold maps to 409",
+			body: map[string]any{"amount": "5.00"},
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockHoldService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				accountSvc := servicemocks.NewMockAccountService(ctrl)
+				holdSvc := servicemocks.NewMockHoldService(ctrl)
+
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				accountSvc.EXPECT().GetByUserID(gomock.Any(), userID).Return(account, nil)
+				holdSvc.EXPECT().
+					Capture(gomock.Any(), accountID, uint64(5), decimal.RequireFromString("5.00"), "").
+					Return(nil, util.NewConflictError("hold is released"))
+
+				return authSvc, accountSvc, holdSvc
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPError(t, rec, http.StatusConflict, "hold is released")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authSvc, accountSvc, holdSvc := tc.buildMocks(ctrl)
+			r := newHandlerTestRouter(t, authSvc, testutil.RouterDeps{HoldHandler: handler.NewHoldHandler(holdSvc, accountSvc)})
+
+			req := testutil.NewJSONRequest(http.MethodPost, "/api/v1/accounts/holds/5/capture", tc.body, map[string]string{
+				"Authorization": "Bearer " + token,
+			})
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, req)
+
+			tc.assertResponse(t, rec)
+		})
+	}
+}
@@ -3,6 +3,8 @@ package handler
 import (
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/shopspring/decimal"
@@ -34,6 +36,7 @@ func NewMovementHandler(
 
 // CreateMovementRequest represents a request to create a new movement
 type CreateMovementRequest struct {
+	AccountID   string `json:"account_id" validate:"omitempty,uuid"`
 	Amount      string `json:"amount" validate:"required"`
 	Type        string `json:"type" validate:"required,oneof=credit debit"`
 	Description string `json:"description"`
@@ -41,13 +44,25 @@ type CreateMovementRequest struct {
 
 // List returns a paginated list of movements for the user's account
 // @Summary List account movements
-// @Description Get a paginated list of account movements
+// @Description Get a paginated list of account movements, optionally filtered and sorted
 // @Tags accounts
 // @Produce json
 // @Security BearerAuth
+// @Param account_id query string false "Account to use (default: the user's default account)"
+// @Param from query string false "Movements that occurred at or after this date-time (RFC 3339)"
+// @Param to query string false "Movements that occurred before this date-time (RFC 3339)"
+// @Param type query string false "credit or debit"
+// @Param min_amount query string false "Minimum amount"
+// @Param max_amount query string false "Maximum amount"
+// @Param search query string false "Text to find in the description, ignoring case"
+// @Param sort query string false "occurred_at (default) or amount"
+// @Param order query string false "desc (default) or asc"
 // @Param page query int false "Page number (default: 1)"
+// @Param cursor query string false "Cursor of the page, empty for the first one; replaces page, without a total count"
 // @Param limit query int false "Items per page (default: 10, max: 100)"
-// @Success 200 {object} util.PaginatedResponse
+// @Success 200 {object} util.PaginatedResponse "By page"
+// @Success 200 {object} util.CursorResponse "By cursor"
+// @Failure 400 {object} util.ErrorResponse
 // @Failure 401 {object} util.ErrorResponse
 // @Failure 500 {object} util.ErrorResponse
 // @Router /accounts/movements [get]
@@ -69,19 +84,25 @@ func (h *MovementHandler) List(c *gin.Context) {
 		return
 	}
 
+	// Get filter
+	filter, ok := parseMovementFilter(c)
+	if !ok {
+		return
+	}
+
+	cursor, byCursor, ok := cursorQuery(c)
+	if !ok {
+		return
+	}
+
 	// Get account
-	account, err := h.accountService.GetByUserID(c, userModel.ID)
+	account, err := selectAccount(c, h.accountService, userModel.ID, c.Query("account_id"))
 	if err != nil {
 		util.HandleError(c, err)
 		return
 	}
 
 	// Get pagination parameters
-	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
-	if page <= 0 {
-		page = 1
-	}
-
 	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
 	if limit <= 0 {
 		limit = 10
@@ -90,8 +111,25 @@ func (h *MovementHandler) List(c *gin.Context) {
 		limit = 100
 	}
 
+	// Get movements by cursor, without a total count
+	if byCursor {
+		response, err := h.movementService.GetByAccountIDWithCursor(c, account.ID, filter, cursor, limit)
+		if err != nil {
+			util.HandleError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, response)
+		return
+	}
+
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if page <= 0 {
+		page = 1
+	}
+
 	// Get movements
-	response, err := h.movementService.GetByAccountID(c, account.ID, page, limit)
+	response, err := h.movementService.GetByAccountID(c, account.ID, filter, page, limit)
 	if err != nil {
 		util.HandleError(c, err)
 		return
@@ -101,6 +139,58 @@ func (h *MovementHandler) List(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
+// Get returns the details of a movement on one of the user's accounts
+// @Summary Get an account movement
+// @Description Get a movement of one of the user's accounts, with the transfer that posted it and the masked name
+// @Description of the counterparty, if any
+// @Tags accounts
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Movement ID"
+// @Success 200 {object} model.MovementDetails
+// @Failure 400 {object} util.ErrorResponse
+// @Failure 401 {object} util.ErrorResponse
+// @Failure 404 {object} util.ErrorResponse
+// @Failure 500 {object} util.ErrorResponse
+// @Router /accounts/movements/{id} [get]
+func (h *MovementHandler) Get(c *gin.Context) {
+	// Get user from context (set by auth middleware)
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, util.ErrorResponse{
+			Error: util.NewUnauthorizedError("unauthorized"),
+		})
+		return
+	}
+
+	userModel, ok := user.(*model.User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, util.ErrorResponse{
+			Error: util.NewInternalServerError("user context invalid"),
+		})
+		return
+	}
+
+	// Parse movement ID
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError("invalid movement id"),
+		})
+		return
+	}
+
+	// Get movement details
+	movement, err := h.movementService.GetForUser(c, userModel.ID, id)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, movement)
+}
+
 // Create creates a new movement for the user's account
 // @Summary Create account movement
 // @Description Create a new movement (credit or debit) in the account
@@ -158,7 +248,7 @@ func (h *MovementHandler) Create(c *gin.Context) {
 	}
 
 	// Get account
-	account, err := h.accountService.GetByUserID(c, userModel.ID)
+	account, err := selectAccount(c, h.accountService, userModel.ID, req.AccountID)
 	if err != nil {
 		util.HandleError(c, err)
 		return
@@ -180,3 +270,66 @@ func (h *MovementHandler) Create(c *gin.Context) {
 	// Return response
 	c.JSON(http.StatusCreated, movement)
 }
+
+// parseMovementFilter parses the movement filter from the query string, writing a 400 response when a date-time or
+// an amount is invalid. The service validates the rest.
+func parseMovementFilter(c *gin.Context) (*model.MovementFilter, bool) {
+	filter := &model.MovementFilter{
+		Type:   c.Query("type"),
+		Search: strings.TrimSpace(c.Query("search")),
+		Sort:   c.Query("sort"),
+		Order:  c.Query("order"),
+	}
+
+	var ok bool
+	if filter.From, ok = parseQueryTime(c, "from"); !ok {
+		return nil, false
+	}
+	if filter.To, ok = parseQueryTime(c, "to"); !ok {
+		return nil, false
+	}
+	if filter.MinAmount, ok = parseQueryDecimal(c, "min_amount"); !ok {
+		return nil, false
+	}
+	if filter.MaxAmount, ok = parseQueryDecimal(c, "max_amount"); !ok {
+		return nil, false
+	}
+
+	return filter, true
+}
+
+// parseQueryTime parses an optional RFC 3339 date-time query param, writing a 400 response when it is invalid
+func parseQueryTime(c *gin.Context, name string) (*time.Time, bool) {
+	value := c.Query(name)
+	if value == "" {
+		return nil, true
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError("invalid " + name + " date-time"),
+		})
+		return nil, false
+	}
+
+	return &t, true
+}
+
+// parseQueryDecimal parses an optional decimal query param, writing a 400 response when it is invalid
+func parseQueryDecimal(c *gin.Context, name string) (*decimal.Decimal, bool) {
+	value := c.Query(name)
+	if value == "" {
+		return nil, true
+	}
+
+	amount, err := decimal.NewFromString(value)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError("invalid " + name),
+		})
+		return nil, false
+	}
+
+	return &amount, true
+}
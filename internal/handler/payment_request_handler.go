@@ -0,0 +1,425 @@
+package handler
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"VDM2-BankBE/internal/model"
+	"VDM2-BankBE/internal/service"
+	"VDM2-BankBE/internal/util"
+)
+
+// PaymentRequestHandler handles requests for money between users
+type PaymentRequestHandler struct {
+	paymentRequestService service.PaymentRequestService
+	accountService        service.AccountService
+	validator             *validator.Validate
+}
+
+// NewPaymentRequestHandler creates a new payment request handler
+func NewPaymentRequestHandler(
+	paymentRequestService service.PaymentRequestService,
+	accountService service.AccountService,
+) *PaymentRequestHandler {
+	return &PaymentRequestHandler{
+		paymentRequestService: paymentRequestService,
+		accountService:        accountService,
+		validator:             validator.New(),
+	}
+}
+
+// CreatePaymentRequestRequest represents a request for money to the user given by exactly one of
+// from_username and from_email
+type CreatePaymentRequestRequest struct {
+	FromUsername string     `json:"from_username" validate:"required_without=FromEmail,excluded_with=FromEmail,omitempty,max=100"`
+	FromEmail    string     `json:"from_email" validate:"required_without=FromUsername,omitempty,email"`
+	AccountID    string     `json:"account_id" validate:"omitempty,uuid"`
+	Amount       string     `json:"amount" validate:"required"`
+	Message      string     `json:"message" validate:"max=500"`
+	ExpiresAt    *time.Time `json:"expires_at"`
+}
+
+// PayPaymentRequestRequest represents the account a payment request is paid from
+type PayPaymentRequestRequest struct {
+	AccountID string `json:"account_id" validate:"omitempty,uuid"`
+}
+
+// ListIncoming returns a paginated list of the payment requests received by the user
+// @Summary List incoming payment requests
+// @Description Get a paginated list of the payment requests received by the authenticated user, newest first
+// @Tags payment-requests
+// @Produce json
+// @Security BearerAuth
+// @Param status query string false "Only return requests in this status"
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Items per page (default: 10, max: 100)"
+// @Success 200 {object} util.PaginatedResponse
+// @Failure 400 {object} util.ErrorResponse
+// @Failure 401 {object} util.ErrorResponse
+// @Failure 500 {object} util.ErrorResponse
+// @Router /payment-requests/incoming [get]
+func (h *PaymentRequestHandler) ListIncoming(c *gin.Context) {
+	h.list(c, h.paymentRequestService.GetIncoming)
+}
+
+// ListOutgoing returns a paginated list of the payment requests sent by the user
+// @Summary List outgoing payment requests
+// @Description Get a paginated list of the payment requests sent by the authenticated user, newest first
+// @Tags payment-requests
+// @Produce json
+// @Security BearerAuth
+// @Param status query string false "Only return requests in this status"
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Items per page (default: 10, max: 100)"
+// @Success 200 {object} util.PaginatedResponse
+// @Failure 400 {object} util.ErrorResponse
+// @Failure 401 {object} util.ErrorResponse
+// @Failure 500 {object} util.ErrorResponse
+// @Router /payment-requests/outgoing [get]
+func (h *PaymentRequestHandler) ListOutgoing(c *gin.Context) {
+	h.list(c, h.paymentRequestService.GetOutgoing)
+}
+
+// list returns the page of payment requests of the user retrieved by get
+func (h *PaymentRequestHandler) list(
+	c *gin.Context,
+	get func(ctx context.Context, userID uuid.UUID, status string, page, limit int) (*util.PaginatedResponse, error),
+) {
+	// Get user from context (set by auth middleware)
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, util.ErrorResponse{
+			Error: util.NewUnauthorizedError("unauthorized"),
+		})
+		return
+	}
+
+	userModel, ok := user.(*model.User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, util.ErrorResponse{
+			Error: util.NewInternalServerError("user context invalid"),
+		})
+		return
+	}
+
+	// Get pagination parameters
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if page <= 0 {
+		page = 1
+	}
+
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+	if limit <= 0 {
+		limit = 10
+	}
+	if limit > 100 {
+		limit = 100
+	}
+
+	// Get payment requests
+	response, err := get(c, userModel.ID, c.Query("status"), page, limit)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, response)
+}
+
+// Create sends a request for money to another user
+// @Summary Request money
+// @Description Ask the user given by from_username or from_email to pay amount into the user's account
+// @Tags payment-requests
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body CreatePaymentRequestRequest true "Payment request details"
+// @Success 201 {object} model.PaymentRequest
+// @Failure 400 {object} util.ErrorResponse
+// @Failure 401 {object} util.ErrorResponse
+// @Failure 404 {object} util.ErrorResponse
+// @Failure 500 {object} util.ErrorResponse
+// @Router /payment-requests [post]
+func (h *PaymentRequestHandler) Create(c *gin.Context) {
+	// Get user from context (set by auth middleware)
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, util.ErrorResponse{
+			Error: util.NewUnauthorizedError("unauthorized"),
+		})
+		return
+	}
+
+	userModel, ok := user.(*model.User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, util.ErrorResponse{
+			Error: util.NewInternalServerError("user context invalid"),
+		})
+		return
+	}
+
+	// Parse and validate request
+	var req CreatePaymentRequestRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError("invalid request body"),
+		})
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError(err.Error()),
+		})
+		return
+	}
+
+	// Parse amount
+	amount, err := decimal.NewFromString(req.Amount)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError("invalid amount"),
+		})
+		return
+	}
+
+	// Get the account to be paid into
+	account, err := selectAccount(c, h.accountService, userModel.ID, req.AccountID)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Create payment request
+	request, err := h.paymentRequestService.Create(c, &model.PaymentRequest{
+		RequesterID: userModel.ID,
+		ToAccount:   account.ID,
+		Amount:      amount,
+		Message:     req.Message,
+	}, req.FromUsername, req.FromEmail, req.ExpiresAt)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusCreated, request)
+}
+
+// Get returns a payment request sent or received by the user
+// @Summary Get a payment request
+// @Tags payment-requests
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Payment request ID"
+// @Success 200 {object} model.PaymentRequest
+// @Failure 400 {object} util.ErrorResponse
+// @Failure 401 {object} util.ErrorResponse
+// @Failure 404 {object} util.ErrorResponse
+// @Failure 500 {object} util.ErrorResponse
+// @Router /payment-requests/{id} [get]
+func (h *PaymentRequestHandler) Get(c *gin.Context) {
+	// Get user from context (set by auth middleware)
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, util.ErrorResponse{
+			Error: util.NewUnauthorizedError("unauthorized"),
+		})
+		return
+	}
+
+	userModel, ok := user.(*model.User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, util.ErrorResponse{
+			Error: util.NewInternalServerError("user context invalid"),
+		})
+		return
+	}
+
+	id, ok := parsePaymentRequestID(c)
+	if !ok {
+		return
+	}
+
+	// Get payment request
+	request, err := h.paymentRequestService.GetByID(c, userModel.ID, id)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, request)
+}
+
+// Pay pays a payment request received by the user with a transfer
+// @Summary Pay a payment request
+// @Description Transfer the requested amount from the user's account (default: the default account) to the
+// @Description requester, linking the transfer to the request
+// @Tags payment-requests
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Payment request ID"
+// @Param pay body PayPaymentRequestRequest false "Account to pay from"
+// @Success 200 {object} model.PaymentRequest
+// @Failure 400 {object} util.ErrorResponse
+// @Failure 401 {object} util.ErrorResponse
+// @Failure 403 {object} util.ErrorResponse
+// @Failure 404 {object} util.ErrorResponse
+// @Failure 409 {object} util.ErrorResponse
+// @Failure 422 {object} util.ErrorResponse
+// @Failure 500 {object} util.ErrorResponse
+// @Router /payment-requests/{id}/pay [post]
+func (h *PaymentRequestHandler) Pay(c *gin.Context) {
+	// Get user from context (set by auth middleware)
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, util.ErrorResponse{
+			Error: util.NewUnauthorizedError("unauthorized"),
+		})
+		return
+	}
+
+	userModel, ok := user.(*model.User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, util.ErrorResponse{
+			Error: util.NewInternalServerError("user context invalid"),
+		})
+		return
+	}
+
+	id, ok := parsePaymentRequestID(c)
+	if !ok {
+		return
+	}
+
+	// Parse request; the body is optional
+	var req PayPaymentRequestRequest
+	if err := c.ShouldBindJSON(&req); err != nil && err != io.EOF {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError("invalid request body"),
+		})
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError(err.Error()),
+		})
+		return
+	}
+
+	// Get the account to pay from
+	account, err := selectAccount(c, h.accountService, userModel.ID, req.AccountID)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Pay payment request
+	request, err := h.paymentRequestService.Pay(c, userModel.ID, id, account.ID)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, request)
+}
+
+// Decline refuses a payment request received by the user
+// @Summary Decline a payment request
+// @Tags payment-requests
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Payment request ID"
+// @Success 200 {object} model.PaymentRequest
+// @Failure 400 {object} util.ErrorResponse
+// @Failure 401 {object} util.ErrorResponse
+// @Failure 403 {object} util.ErrorResponse
+// @Failure 404 {object} util.ErrorResponse
+// @Failure 409 {object} util.ErrorResponse
+// @Failure 500 {object} util.ErrorResponse
+// @Router /payment-requests/{id}/decline [post]
+func (h *PaymentRequestHandler) Decline(c *gin.Context) {
+	h.close(c, h.paymentRequestService.Decline)
+}
+
+// Cancel withdraws a payment request sent by the user
+// @Summary Cancel a payment request
+// @Tags payment-requests
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Payment request ID"
+// @Success 200 {object} model.PaymentRequest
+// @Failure 400 {object} util.ErrorResponse
+// @Failure 401 {object} util.ErrorResponse
+// @Failure 403 {object} util.ErrorResponse
+// @Failure 404 {object} util.ErrorResponse
+// @Failure 409 {object} util.ErrorResponse
+// @Failure 500 {object} util.ErrorResponse
+// @Router /payment-requests/{id}/cancel [post]
+func (h *PaymentRequestHandler) Cancel(c *gin.Context) {
+	h.close(c, h.paymentRequestService.Cancel)
+}
+
+// close closes the payment request of the path with closeFn
+func (h *PaymentRequestHandler) close(
+	c *gin.Context,
+	closeFn func(ctx context.Context, userID uuid.UUID, id uint64) (*model.PaymentRequest, error),
+) {
+	// Get user from context (set by auth middleware)
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, util.ErrorResponse{
+			Error: util.NewUnauthorizedError("unauthorized"),
+		})
+		return
+	}
+
+	userModel, ok := user.(*model.User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, util.ErrorResponse{
+			Error: util.NewInternalServerError("user context invalid"),
+		})
+		return
+	}
+
+	id, ok := parsePaymentRequestID(c)
+	if !ok {
+		return
+	}
+
+	// Close payment request
+	request, err := closeFn(c, userModel.ID, id)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, request)
+}
+
+// parsePaymentRequestID parses the payment request ID path param, writing a 400 response when it is invalid
+func parsePaymentRequestID(c *gin.Context) (uint64, bool) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError("invalid payment request id"),
+		})
+		return 0, false
+	}
+
+	return id, true
+}
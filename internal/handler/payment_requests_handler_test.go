@@ -0,0 +1,235 @@
+package handler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+
+	"VDM2-BankBE/internal/handler"
+	"VDM2-BankBE/internal/model"
+	servicemocks "VDM2-BankBE/internal/service/mocks"
+	"VDM2-BankBE/internal/testutil"
+	"VDM2-BankBE/internal/util"
+)
+
+func TestPaymentRequests_Create(t *testing.T) {
+	t.Parallel()
+
+	token := "header.payload.sig"
+	userID := uuid.MustParse("00000000-0000-0000-0000-0000000000b0")
+	accountID := uuid.MustParse("00000000-0000-0000-0000-0000000000b1")
+
+	user := &model.User{ID: userID}
+	account := &model.Account{ID: accountID, UserID: userID, Currency: "EUR"}
+
+	tests := []struct {
+		name           string
+		body           any
+		buildMocks     func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockPaymentRequestService)
+		assertResponse func(t *testing.T, rec *httptest.ResponseRecorder)
+	}{
+		{
+			name: "success",
+			body: map[string]any{"from_username": "mrossi", "amount": "30.00", "message": "dinner"},
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockPaymentRequestService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				accountSvc := servicemocks.NewMockAccountService(ctrl)
+				paymentRequestSvc := servicemocks.NewMockPaymentRequestService(ctrl)
+
+				amount := mustDecimal(t, "30.00")
+
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				accountSvc.EXPECT().GetByUserID(gomock.Any(), userID).Return(account, nil)
+				paymentRequestSvc.EXPECT().
+					Create(gomock.Any(), &model.PaymentRequest{RequesterID: userID, ToAccount: accountID, Amount: amount, Message: "dinner"}, "mrossi", "", nil).
+					Return(&model.PaymentRequest{ID: 1, RequesterID: userID, ToAccount: accountID, Amount: amount, Status: "open"}, nil)
+
+				return authSvc, accountSvc, paymentRequestSvc
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPStatus(t, rec, http.StatusCreated)
+			},
+		},
+		{
+			name: "both from_username and from_email returns 400",
+			body: map[string]any{"from_username": "mrossi", "from_email": "mario@example.com", "amount": "30.00"},
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockPaymentRequestService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				return authSvc, servicemocks.NewMockAccountService(ctrl), servicemocks.NewMockPaymentRequestService(ctrl)
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPStatus(t, rec, http.StatusBadRequest)
+			},
+		},
+		{
+			name: "unknown payer returns 404",
+			body: map[string]any{"from_email": "nobody@example.com", "amount": "30.00"},
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockPaymentRequestService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				accountSvc := servicemocks.NewMockAccountService(ctrl)
+				paymentRequestSvc := servicemocks.NewMockPaymentRequestService(ctrl)
+
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				accountSvc.EXPECT().GetByUserID(gomock.Any(), userID).Return(account, nil)
+				paymentRequestSvc.EXPECT().
+					Create(gomock.Any(), gomock.Any(), "", "nobody@example.com", nil).
+					Return(nil, util.NewNotFoundError("payer not found"))
+
+				return authSvc, accountSvc, paymentRequestSvc
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPError(t, rec, http.StatusNotFound, "payer not found")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authSvc, accountSvc, paymentRequestSvc := tc.buildMocks(ctrl)
+			r := newHandlerTestRouter(t, authSvc, testutil.RouterDeps{
+				PaymentRequestHandler: handler.NewPaymentRequestHandler(paymentRequestSvc, accountSvc),
+			})
+
+			req := testutil.NewJSONRequest(http.MethodPost, "/api/v1/payment-requests", tc.body, map[string]string{
+				"Authorization": "Bearer " + token,
+			})
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, req)
+
+			tc.assertResponse(t, rec)
+		})
+	}
+}
+
+func TestPaymentRequests_Actions(t *testing.T) {
+	t.Parallel()
+
+	token := "header.payload.sig"
+	userID := uuid.MustParse("00000000-0000-0000-0000-0000000000b2")
+	accountID := uuid.MustParse("00000000-0000-0000-0000-0000000000b3")
+
+	user := &model.User{ID: userID}
+	account := &model.Account{ID: accountID, UserID: userID, Currency: "EUR"}
+
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		buildMocks     func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockPaymentRequestService)
+		assertResponse func(t *testing.T, rec *httptest.ResponseRecorder)
+	}{
+		{
+			name:   "incoming list is not routed to get by id",
+			method: http.MethodGet,
+			path:   "/api/v1/payment-requests/incoming?status=open",
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockPaymentRequestService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				paymentRequestSvc := servicemocks.NewMockPaymentRequestService(ctrl)
+
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				paymentRequestSvc.EXPECT().
+					GetIncoming(gomock.Any(), userID, "open", 1, 10).
+					Return(&util.PaginatedResponse{Data: []model.PaymentRequest{}}, nil)
+
+				return authSvc, servicemocks.NewMockAccountService(ctrl), paymentRequestSvc
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPStatus(t, rec, http.StatusOK)
+			},
+		},
+		{
+			name:   "pay from the default account",
+			method: http.MethodPost,
+			path:   "/api/v1/payment-requests/5/pay",
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockPaymentRequestService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				accountSvc := servicemocks.NewMockAccountService(ctrl)
+				paymentRequestSvc := servicemocks.NewMockPaymentRequestService(ctrl)
+
+				transferID := uint64(9)
+
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				accountSvc.EXPECT().GetByUserID(gomock.Any(), userID).Return(account, nil)
+				paymentRequestSvc.EXPECT().
+					Pay(gomock.Any(), userID, uint64(5), accountID).
+					Return(&model.PaymentRequest{ID: 5, PayerID: userID, Status: "paid", TransferID: &transferID}, nil)
+
+				return authSvc, accountSvc, paymentRequestSvc
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPStatus(t, rec, http.StatusOK)
+			},
+		},
+		{
+			name:   "paying a closed request returns 409",
+			method: http.MethodPost,
+			path:   "/api/v1/payment-requests/5/pay",
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockPaymentRequestService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				accountSvc := servicemocks.NewMockAccountService(ctrl)
+				paymentRequestSvc := servicemocks.NewMockPaymentRequestService(ctrl)
+
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				accountSvc.EXPECT().GetByUserID(gomock.Any(), userID).Return(account, nil)
+				paymentRequestSvc.EXPECT().
+					Pay(gomock.Any(), userID, uint64(5), accountID).
+					Return(nil, util.NewConflictError("payment request is declined"))
+
+				return authSvc, accountSvc, paymentRequestSvc
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPError(t, rec, http.StatusConflict, "payment request is declined")
+			},
+		},
+		{
+			name:   "decline",
+			method: http.MethodPost,
+			path:   "/api/v1/payment-requests/5/decline",
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockPaymentRequestService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				paymentRequestSvc := servicemocks.NewMockPaymentRequestService(ctrl)
+
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				paymentRequestSvc.EXPECT().
+					Decline(gomock.Any(), userID, uint64(5)).
+					Return(&model.PaymentRequest{ID: 5, PayerID: userID, Status: "declined"}, nil)
+
+				return authSvc, servicemocks.NewMockAccountService(ctrl), paymentRequestSvc
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPStatus(t, rec, http.StatusOK)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authSvc, accountSvc, paymentRequestSvc := tc.buildMocks(ctrl)
+			r := newHandlerTestRouter(t, authSvc, testutil.RouterDeps{
+				PaymentRequestHandler: handler.NewPaymentRequestHandler(paymentRequestSvc, accountSvc),
+			})
+
+			req := testutil.NewJSONRequest(tc.method, tc.path, nil, map[string]string{
+				"Authorization": "Bearer " + token,
+			})
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, req)
+
+			tc.assertResponse(t, rec)
+		})
+	}
+}
@@ -0,0 +1,426 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"VDM2-BankBE/internal/model"
+	"VDM2-BankBE/internal/service"
+	"VDM2-BankBE/internal/util"
+)
+
+// StandingOrderHandler handles standing order requests
+type StandingOrderHandler struct {
+	standingOrderService service.StandingOrderService
+	accountService       service.AccountService
+	validator            *validator.Validate
+}
+
+// NewStandingOrderHandler creates a new standing order handler
+func NewStandingOrderHandler(
+	standingOrderService service.StandingOrderService,
+	accountService service.AccountService,
+) *StandingOrderHandler {
+	return &StandingOrderHandler{
+		standingOrderService: standingOrderService,
+		accountService:       accountService,
+		validator:            validator.New(),
+	}
+}
+
+// CreateStandingOrderRequest represents a request to create a new standing order
+type CreateStandingOrderRequest struct {
+	FromAccount string     `json:"from_account" validate:"omitempty,uuid"`
+	ToAccount   string     `json:"to_account" validate:"required,uuid4"`
+	Amount      string     `json:"amount" validate:"required"`
+	Description string     `json:"description"`
+	Frequency   string     `json:"frequency" validate:"required,oneof=weekly monthly"`
+	Interval    int        `json:"interval" validate:"omitempty,min=1,max=52"`
+	StartDate   time.Time  `json:"start_date" validate:"required"`
+	EndDate     *time.Time `json:"end_date"`
+}
+
+// UpdateStandingOrderRequest represents a request to update a standing order
+type UpdateStandingOrderRequest struct {
+	Amount      *string    `json:"amount"`
+	Description *string    `json:"description"`
+	EndDate     *time.Time `json:"end_date"`
+	Status      *string    `json:"status" validate:"omitempty,oneof=active suspended"`
+}
+
+// Create creates a standing order from the user's account
+// @Summary Create a standing order
+// @Description Create a recurring transfer executed every `interval` weeks or months from `start_date`
+// @Tags transfers
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param standing_order body CreateStandingOrderRequest true "Standing order details"
+// @Success 201 {object} model.StandingOrder
+// @Failure 400 {object} util.ErrorResponse
+// @Failure 401 {object} util.ErrorResponse
+// @Failure 500 {object} util.ErrorResponse
+// @Router /transfers/standing-orders [post]
+func (h *StandingOrderHandler) Create(c *gin.Context) {
+	// Get user from context (set by auth middleware)
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, util.ErrorResponse{
+			Error: util.NewUnauthorizedError("unauthorized"),
+		})
+		return
+	}
+
+	userModel, ok := user.(*model.User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, util.ErrorResponse{
+			Error: util.NewInternalServerError("user context invalid"),
+		})
+		return
+	}
+
+	// Parse and validate request
+	var req CreateStandingOrderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError("invalid request body"),
+		})
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError(err.Error()),
+		})
+		return
+	}
+
+	// Parse amount
+	amount, err := decimal.NewFromString(req.Amount)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError("invalid amount"),
+		})
+		return
+	}
+
+	// Parse to account ID
+	toAccountID, err := uuid.Parse(req.ToAccount)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError("invalid to_account"),
+		})
+		return
+	}
+
+	// Get from account (the selected or default account of the user)
+	fromAccount, err := selectAccount(c, h.accountService, userModel.ID, req.FromAccount)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Create standing order
+	order, err := h.standingOrderService.Create(c, &model.StandingOrder{
+		FromAccount: fromAccount.ID,
+		ToAccount:   toAccountID,
+		Amount:      amount,
+		Description: req.Description,
+		Frequency:   req.Frequency,
+		Interval:    req.Interval,
+		StartDate:   req.StartDate,
+		EndDate:     req.EndDate,
+	})
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusCreated, order)
+}
+
+// List returns a paginated list of the standing orders of the user's account
+// @Summary List standing orders
+// @Description Get a paginated list of the standing orders of the authenticated user's account
+// @Tags transfers
+// @Produce json
+// @Security BearerAuth
+// @Param account_id query string false "Account to use (default: the user's default account)"
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Items per page (default: 10, max: 100)"
+// @Success 200 {object} util.PaginatedResponse
+// @Failure 401 {object} util.ErrorResponse
+// @Failure 500 {object} util.ErrorResponse
+// @Router /transfers/standing-orders [get]
+func (h *StandingOrderHandler) List(c *gin.Context) {
+	// Get user from context (set by auth middleware)
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, util.ErrorResponse{
+			Error: util.NewUnauthorizedError("unauthorized"),
+		})
+		return
+	}
+
+	userModel, ok := user.(*model.User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, util.ErrorResponse{
+			Error: util.NewInternalServerError("user context invalid"),
+		})
+		return
+	}
+
+	// Get account
+	account, err := selectAccount(c, h.accountService, userModel.ID, c.Query("account_id"))
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Get pagination parameters
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if page <= 0 {
+		page = 1
+	}
+
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+	if limit <= 0 {
+		limit = 10
+	}
+	if limit > 100 {
+		limit = 100
+	}
+
+	// Get standing orders
+	response, err := h.standingOrderService.GetByAccountID(c, account.ID, page, limit)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, response)
+}
+
+// Get returns a standing order of the user's account
+// @Summary Get a standing order
+// @Tags transfers
+// @Produce json
+// @Security BearerAuth
+// @Param account_id query string false "Account to use (default: the user's default account)"
+// @Param id path int true "Standing order ID"
+// @Success 200 {object} model.StandingOrder
+// @Failure 400 {object} util.ErrorResponse
+// @Failure 401 {object} util.ErrorResponse
+// @Failure 404 {object} util.ErrorResponse
+// @Failure 500 {object} util.ErrorResponse
+// @Router /transfers/standing-orders/{id} [get]
+func (h *StandingOrderHandler) Get(c *gin.Context) {
+	// Get user from context (set by auth middleware)
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, util.ErrorResponse{
+			Error: util.NewUnauthorizedError("unauthorized"),
+		})
+		return
+	}
+
+	userModel, ok := user.(*model.User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, util.ErrorResponse{
+			Error: util.NewInternalServerError("user context invalid"),
+		})
+		return
+	}
+
+	id, ok := parseStandingOrderID(c)
+	if !ok {
+		return
+	}
+
+	// Get account
+	account, err := selectAccount(c, h.accountService, userModel.ID, c.Query("account_id"))
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Get standing order
+	order, err := h.standingOrderService.GetByID(c, account.ID, id)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, order)
+}
+
+// Update changes a standing order of the user's account
+// @Summary Update a standing order
+// @Description Change the amount, description or end date of a standing order, or pause/resume it via `status`.
+// @Description Resuming skips the occurrences missed while the order was suspended.
+// @Tags transfers
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param account_id query string false "Account to use (default: the user's default account)"
+// @Param id path int true "Standing order ID"
+// @Param standing_order body UpdateStandingOrderRequest true "Fields to update"
+// @Success 200 {object} model.StandingOrder
+// @Failure 400 {object} util.ErrorResponse
+// @Failure 401 {object} util.ErrorResponse
+// @Failure 404 {object} util.ErrorResponse
+// @Failure 409 {object} util.ErrorResponse
+// @Failure 500 {object} util.ErrorResponse
+// @Router /transfers/standing-orders/{id} [patch]
+func (h *StandingOrderHandler) Update(c *gin.Context) {
+	// Get user from context (set by auth middleware)
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, util.ErrorResponse{
+			Error: util.NewUnauthorizedError("unauthorized"),
+		})
+		return
+	}
+
+	userModel, ok := user.(*model.User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, util.ErrorResponse{
+			Error: util.NewInternalServerError("user context invalid"),
+		})
+		return
+	}
+
+	id, ok := parseStandingOrderID(c)
+	if !ok {
+		return
+	}
+
+	// Parse and validate request
+	var req UpdateStandingOrderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError("invalid request body"),
+		})
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError(err.Error()),
+		})
+		return
+	}
+
+	update := service.StandingOrderUpdate{
+		Description: req.Description,
+		EndDate:     req.EndDate,
+		Status:      req.Status,
+	}
+
+	// Parse amount
+	if req.Amount != nil {
+		amount, err := decimal.NewFromString(*req.Amount)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, util.ErrorResponse{
+				Error: util.NewBadRequestError("invalid amount"),
+			})
+			return
+		}
+		update.Amount = &amount
+	}
+
+	// Get account
+	account, err := selectAccount(c, h.accountService, userModel.ID, c.Query("account_id"))
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Update standing order
+	order, err := h.standingOrderService.Update(c, account.ID, id, update)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, order)
+}
+
+// Cancel cancels a standing order of the user's account
+// @Summary Cancel a standing order
+// @Description Stop a standing order for good. Transfers already made are not affected.
+// @Tags transfers
+// @Produce json
+// @Security BearerAuth
+// @Param account_id query string false "Account to use (default: the user's default account)"
+// @Param id path int true "Standing order ID"
+// @Success 200 {object} model.StandingOrder
+// @Failure 400 {object} util.ErrorResponse
+// @Failure 401 {object} util.ErrorResponse
+// @Failure 404 {object} util.ErrorResponse
+// @Failure 409 {object} util.ErrorResponse
+// @Failure 500 {object} util.ErrorResponse
+// @Router /transfers/standing-orders/{id} [delete]
+func (h *StandingOrderHandler) Cancel(c *gin.Context) {
+	// Get user from context (set by auth middleware)
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, util.ErrorResponse{
+			Error: util.NewUnauthorizedError("unauthorized"),
+		})
+		return
+	}
+
+	userModel, ok := user.(*model.User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, util.ErrorResponse{
+			Error: util.NewInternalServerError("user context invalid"),
+		})
+		return
+	}
+
+	id, ok := parseStandingOrderID(c)
+	if !ok {
+		return
+	}
+
+	// Get account
+	account, err := selectAccount(c, h.accountService, userModel.ID, c.Query("account_id"))
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Cancel standing order
+	order, err := h.standingOrderService.Cancel(c, account.ID, id)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, order)
+}
+
+// parseStandingOrderID parses the standing order ID path param, writing a 400 response when it is invalid
+func parseStandingOrderID(c *gin.Context) (uint64, bool) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError("invalid standing order id"),
+		})
+		return 0, false
+	}
+
+	return id, true
+}
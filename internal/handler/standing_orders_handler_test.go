@@ -0,0 +1,182 @@
+package handler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+
+	"VDM2-BankBE/internal/handler"
+	"VDM2-BankBE/internal/model"
+	servicemocks "VDM2-BankBE/internal/service/mocks"
+	"VDM2-BankBE/internal/testutil"
+	"VDM2-BankBE/internal/util"
+)
+
+func TestStandingOrders_Create(t *testing.T) {
+	t.Parallel()
+
+	token := "header.payload.sig"
+	userID := uuid.MustParse("00000000-0000-0000-0000-000000000070")
+	accountID := uuid.MustParse("00000000-0000-0000-0000-000000000071")
+	toAccountID := uuid.MustParse("00000000-0000-4000-8000-000000000072")
+
+	user := &model.User{ID: userID}
+	account := &model.Account{ID: accountID, UserID: userID, Currency: "EUR"}
+	startDate := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Second)
+
+	tests := []struct {
+		name           string
+		body           any
+		buildMocks     func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockStandingOrderService)
+		assertResponse func(t *testing.T, rec *httptest.ResponseRecorder)
+	}{
+		{
+			name: "success",
+			body: map[string]any{
+				"to_account": toAccountID.String(),
+				"amount":     "25.00",
+				"frequency":  "monthly",
+				"start_date": startDate.Format(time.RFC3339),
+			},
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockStandingOrderService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				accountSvc := servicemocks.NewMockAccountService(ctrl)
+				standingOrderSvc := servicemocks.NewMockStandingOrderService(ctrl)
+
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				accountSvc.EXPECT().GetByUserID(gomock.Any(), userID).Return(account, nil)
+				standingOrderSvc.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ any, order *model.StandingOrder) (*model.StandingOrder, error) {
+					if order.FromAccount != accountID || order.ToAccount != toAccountID || order.Frequency != "monthly" || !order.StartDate.Equal(startDate) {
+						t.Fatalf("unexpected standing order: %+v", order)
+					}
+					order.ID = 1
+					order.Status = "active"
+					return order, nil
+				})
+
+				return authSvc, accountSvc, standingOrderSvc
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPStatus(t, rec, http.StatusCreated)
+			},
+		},
+		{
+			name: "unknown frequency returns 400",
+			body: map[string]any{
+				"to_account": toAccountID.String(),
+				"amount":     "25.00",
+				"frequency":  "daily",
+				"start_date": startDate.Format(time.RFC3339),
+			},
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockStandingOrderService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+
+				return authSvc, servicemocks.NewMockAccountService(ctrl), servicemocks.NewMockStandingOrderService(ctrl)
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPStatus(t, rec, http.StatusBadRequest)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authSvc, accountSvc, standingOrderSvc := tc.buildMocks(ctrl)
+			r := newHandlerTestRouter(t, authSvc, testutil.RouterDeps{
+				StandingOrderHandler: handler.NewStandingOrderHandler(standingOrderSvc, accountSvc),
+			})
+
+			req := testutil.NewJSONRequest(http.MethodPost, "/api/v1/transfers/standing-orders", tc.body, map[string]string{
+				"Authorization": "Bearer " + token,
+			})
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, req)
+
+			tc.assertResponse(t, rec)
+		})
+	}
+}
+
+func TestStandingOrders_Cancel(t *testing.T) {
+	t.Parallel()
+
+	token := "header.payload.sig"
+	userID := uuid.MustParse("00000000-0000-0000-0000-000000000080")
+	accountID := uuid.MustParse("00000000-0000-0000-0000-000000000081")
+
+	user := &model.User{ID: userID}
+	account := &model.Account{ID: accountID, UserID: userID, Currency: "EUR"}
+
+	tests := []struct {
+		name           string
+		buildMocks     func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockStandingOrderService)
+		assertResponse func(t *testing.T, rec *httptest.ResponseRecorder)
+	}{
+		{
+			name: "success",
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockStandingOrderService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				accountSvc := servicemocks.NewMockAccountService(ctrl)
+				standingOrderSvc := servicemocks.NewMockStandingOrderService(ctrl)
+
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				accountSvc.EXPECT().GetByUserID(gomock.Any(), userID).Return(account, nil)
+				standingOrderSvc.EXPECT().Cancel(gomock.Any(), accountID, uint64(3)).Return(&model.StandingOrder{ID: 3, FromAccount: accountID, Status: "cancelled"}, nil)
+
+				return authSvc, accountSvc, standingOrderSvc
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPStatus(t, rec, http.StatusOK)
+			},
+		},
+		{
+			name: "order of another account maps to 404",
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockStandingOrderService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				accountSvc := servicemocks.NewMockAccountService(ctrl)
+				standingOrderSvc := servicemocks.NewMockStandingOrderService(ctrl)
+
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				accountSvc.EXPECT().GetByUserID(gomock.Any(), userID).Return(account, nil)
+				standingOrderSvc.EXPECT().Cancel(gomock.Any(), accountID, uint64(3)).Return(nil, util.NewNotFoundError("standing order not found"))
+
+				return authSvc, accountSvc, standingOrderSvc
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPError(t, rec, http.StatusNotFound, "standing order not found")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authSvc, accountSvc, standingOrderSvc := tc.buildMocks(ctrl)
+			r := newHandlerTestRouter(t, authSvc, testutil.RouterDeps{
+				StandingOrderHandler: handler.NewStandingOrderHandler(standingOrderSvc, accountSvc),
+			})
+
+			req := testutil.NewJSONRequest(http.MethodDelete, "/api/v1/transfers/standing-orders/3", nil, map[string]string{
+				"Authorization": "Bearer " + token,
+			})
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, req)
+
+			tc.assertResponse(t, rec)
+		})
+	}
+}
@@ -0,0 +1,272 @@
+package handler
+
+import (
+	"encoding/csv"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+
+	"VDM2-BankBE/internal/model"
+	"VDM2-BankBE/internal/service"
+	"VDM2-BankBE/internal/util"
+)
+
+// TransferBatchHandler handles bulk transfer requests
+type TransferBatchHandler struct {
+	transferBatchService service.TransferBatchService
+	accountService       service.AccountService
+	validator            *validator.Validate
+}
+
+// NewTransferBatchHandler creates a new transfer batch handler
+func NewTransferBatchHandler(
+	transferBatchService service.TransferBatchService,
+	accountService service.AccountService,
+) *TransferBatchHandler {
+	return &TransferBatchHandler{
+		transferBatchService: transferBatchService,
+		accountService:       accountService,
+		validator:            validator.New(),
+	}
+}
+
+// CreateTransferBatchRequest represents a batch of transfers sent as JSON
+type CreateTransferBatchRequest struct {
+	AccountID string                     `json:"account_id" validate:"omitempty,uuid"`
+	Mode      string                     `json:"mode" validate:"omitempty,oneof=all_or_nothing best_effort"`
+	Transfers []TransferBatchLineRequest `json:"transfers" validate:"required,min=1"`
+}
+
+// TransferBatchLineRequest represents a transfer of a batch. Lines are validated by the service, so that the
+// errors of all the lines are reported together.
+type TransferBatchLineRequest struct {
+	ToAccount   string `json:"to_account"`
+	ToIBAN      string `json:"to_iban"`
+	Amount      string `json:"amount"`
+	Description string `json:"description"`
+}
+
+// Create uploads a batch of transfers to be executed asynchronously
+// @Summary Create a transfer batch
+// @Description Upload up to thousands of transfers from one account, as JSON or as CSV (`text/csv`, with a header
+// @Description row naming the to_account, to_iban, amount and description columns; account_id and mode are then
+// @Description given as query parameters). Every line is validated up front and the total is reserved on the
+// @Description account; the transfers are executed in the background.
+// @Tags transfers
+// @Accept json
+// @Accept text/csv
+// @Produce json
+// @Security BearerAuth
+// @Param account_id query string false "Account to send from when uploading CSV (default: the user's default account)"
+// @Param mode query string false "all_or_nothing (default) or best_effort, when uploading CSV"
+// @Param batch body CreateTransferBatchRequest true "Batch details"
+// @Success 202 {object} model.TransferBatch
+// @Failure 400 {object} util.ErrorResponse
+// @Failure 401 {object} util.ErrorResponse
+// @Failure 404 {object} util.ErrorResponse
+// @Failure 409 {object} util.ErrorResponse
+// @Failure 422 {object} util.ErrorResponse
+// @Failure 500 {object} util.ErrorResponse
+// @Router /transfers/batches [post]
+func (h *TransferBatchHandler) Create(c *gin.Context) {
+	// Get user from context (set by auth middleware)
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, util.ErrorResponse{
+			Error: util.NewUnauthorizedError("unauthorized"),
+		})
+		return
+	}
+
+	userModel, ok := user.(*model.User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, util.ErrorResponse{
+			Error: util.NewInternalServerError("user context invalid"),
+		})
+		return
+	}
+
+	// Parse and validate request, either CSV or JSON
+	var req CreateTransferBatchRequest
+	var lines []service.TransferBatchLine
+	if c.ContentType() == "text/csv" {
+		req.AccountID = c.Query("account_id")
+		req.Mode = c.Query("mode")
+
+		var err error
+		lines, err = parseTransferBatchCSV(c.Request.Body)
+		if err != nil {
+			util.HandleError(c, err)
+			return
+		}
+	} else {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, util.ErrorResponse{
+				Error: util.NewBadRequestError("invalid request body"),
+			})
+			return
+		}
+
+		if err := h.validator.Struct(req); err != nil {
+			c.JSON(http.StatusBadRequest, util.ErrorResponse{
+				Error: util.NewBadRequestError(err.Error()),
+			})
+			return
+		}
+
+		lines = make([]service.TransferBatchLine, len(req.Transfers))
+		for i, transfer := range req.Transfers {
+			lines[i] = service.TransferBatchLine{
+				ToAccount:   transfer.ToAccount,
+				ToIBAN:      transfer.ToIBAN,
+				Amount:      transfer.Amount,
+				Description: transfer.Description,
+			}
+		}
+	}
+
+	// Get account
+	account, err := selectAccount(c, h.accountService, userModel.ID, req.AccountID)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Create batch
+	batch, err := h.transferBatchService.Create(c, account.ID, req.Mode, lines)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Return response; the transfers are executed in the background
+	c.JSON(http.StatusAccepted, batch)
+}
+
+// Get returns a batch sent from the user's account with the outcome of each of its transfers
+// @Summary Get a transfer batch
+// @Description Track the progress of a batch: completed_count and failed_count grow as its transfers are executed
+// @Tags transfers
+// @Produce json
+// @Security BearerAuth
+// @Param account_id query string false "Account to use (default: the user's default account)"
+// @Param id path int true "Batch ID"
+// @Success 200 {object} model.TransferBatch
+// @Failure 400 {object} util.ErrorResponse
+// @Failure 401 {object} util.ErrorResponse
+// @Failure 404 {object} util.ErrorResponse
+// @Failure 500 {object} util.ErrorResponse
+// @Router /transfers/batches/{id} [get]
+func (h *TransferBatchHandler) Get(c *gin.Context) {
+	// Get user from context (set by auth middleware)
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, util.ErrorResponse{
+			Error: util.NewUnauthorizedError("unauthorized"),
+		})
+		return
+	}
+
+	userModel, ok := user.(*model.User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, util.ErrorResponse{
+			Error: util.NewInternalServerError("user context invalid"),
+		})
+		return
+	}
+
+	id, ok := parseTransferBatchID(c)
+	if !ok {
+		return
+	}
+
+	// Get account
+	account, err := selectAccount(c, h.accountService, userModel.ID, c.Query("account_id"))
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Get batch
+	batch, err := h.transferBatchService.GetByID(c, account.ID, id)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, batch)
+}
+
+// parseTransferBatchCSV reads the lines of a batch from CSV. The first row names the columns, in any order, among
+// to_account, to_iban, amount and description.
+func parseTransferBatchCSV(r io.Reader) ([]service.TransferBatchLine, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if err == io.EOF {
+			return nil, util.NewBadRequestError("csv is empty")
+		}
+		return nil, util.NewBadRequestError("invalid csv: " + err.Error())
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		switch name {
+		case "to_account", "to_iban", "amount", "description":
+		default:
+			return nil, util.NewBadRequestError("unknown csv column " + name)
+		}
+		columns[name] = i
+	}
+	if _, ok := columns["amount"]; !ok {
+		return nil, util.NewBadRequestError("csv has no amount column")
+	}
+
+	field := func(record []string, name string) string {
+		if i, ok := columns[name]; ok {
+			return record[i]
+		}
+		return ""
+	}
+
+	var lines []service.TransferBatchLine
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, util.NewBadRequestError("invalid csv: " + err.Error())
+		}
+
+		lines = append(lines, service.TransferBatchLine{
+			ToAccount:   field(record, "to_account"),
+			ToIBAN:      field(record, "to_iban"),
+			Amount:      field(record, "amount"),
+			Description: field(record, "description"),
+		})
+	}
+
+	return lines, nil
+}
+
+// parseTransferBatchID parses the batch ID path param, writing a 400 response when it is invalid
+func parseTransferBatchID(c *gin.Context) (uint64, bool) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError("invalid transfer batch id"),
+		})
+		return 0, false
+	}
+
+	return id, true
+}
@@ -0,0 +1,259 @@
+package handler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+
+	"VDM2-BankBE/internal/handler"
+	"VDM2-BankBE/internal/model"
+	"VDM2-BankBE/internal/service"
+	servicemocks "VDM2-BankBE/internal/service/mocks"
+	"VDM2-BankBE/internal/testutil"
+	"VDM2-BankBE/internal/util"
+)
+
+func TestTransferBatches_Create(t *testing.T) {
+	t.Parallel()
+
+	token := "header.payload.sig"
+	userID := uuid.MustParse("550e8400-e29b-41d4-a716-4466554400b0")
+	accountID := uuid.MustParse("550e8400-e29b-41d4-a716-4466554400b1")
+	toAccountID := uuid.MustParse("550e8400-e29b-41d4-a716-4466554400b2")
+
+	user := &model.User{ID: userID}
+	account := &model.Account{ID: accountID, UserID: userID}
+	lines := []service.TransferBatchLine{
+		{ToAccount: toAccountID.String(), Amount: "10.00", Description: "rent"},
+		{ToIBAN: "IT60X0542811101000000123456", Amount: "5.00"},
+	}
+
+	tests := []struct {
+		name           string
+		contentType    string
+		body           string
+		buildMocks     func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockTransferBatchService)
+		assertResponse func(t *testing.T, rec *httptest.ResponseRecorder)
+	}{
+		{
+			name:        "json batch is accepted",
+			contentType: "application/json",
+			body: `{"mode":"best_effort","transfers":[` +
+				`{"to_account":"` + toAccountID.String() + `","amount":"10.00","description":"rent"},` +
+				`{"to_iban":"IT60X0542811101000000123456","amount":"5.00"}]}`,
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockTransferBatchService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				accountSvc := servicemocks.NewMockAccountService(ctrl)
+				batchSvc := servicemocks.NewMockTransferBatchService(ctrl)
+
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				accountSvc.EXPECT().GetByUserID(gomock.Any(), userID).Return(account, nil)
+				batchSvc.EXPECT().
+					Create(gomock.Any(), accountID, "best_effort", lines).
+					Return(&model.TransferBatch{ID: 1, FromAccount: accountID, Mode: "best_effort", Status: "pending", ItemCount: 2}, nil)
+
+				return authSvc, accountSvc, batchSvc
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPStatus(t, rec, http.StatusAccepted)
+			},
+		},
+		{
+			name:        "csv batch is accepted",
+			contentType: "text/csv",
+			body:        "amount,to_account,to_iban,description\n10.00," + toAccountID.String() + ",,rent\n5.00,,IT60X0542811101000000123456,\n",
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockTransferBatchService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				accountSvc := servicemocks.NewMockAccountService(ctrl)
+				batchSvc := servicemocks.NewMockTransferBatchService(ctrl)
+
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				accountSvc.EXPECT().GetByUserID(gomock.Any(), userID).Return(account, nil)
+				batchSvc.EXPECT().
+					Create(gomock.Any(), accountID, "", lines).
+					Return(&model.TransferBatch{ID: 2, FromAccount: accountID, Mode: "all_or_nothing", Status: "pending", ItemCount: 2}, nil)
+
+				return authSvc, accountSvc, batchSvc
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPStatus(t, rec, http.StatusAccepted)
+			},
+		},
+		{
+			name:        "csv with an unknown column returns 400",
+			contentType: "text/csv",
+			body:        "amount,iban\n10.00,IT60X0542811101000000123456\n",
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockTransferBatchService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				return authSvc, servicemocks.NewMockAccountService(ctrl), servicemocks.NewMockTransferBatchService(ctrl)
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPError(t, rec, http.StatusBadRequest, "unknown csv column iban")
+			},
+		},
+		{
+			name:        "invalid lines return 422 with details",
+			contentType: "application/json",
+			body:        `{"transfers":[{"to_account":"` + toAccountID.String() + `","amount":"-1"}]}`,
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockTransferBatchService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				accountSvc := servicemocks.NewMockAccountService(ctrl)
+				batchSvc := servicemocks.NewMockTransferBatchService(ctrl)
+
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				accountSvc.EXPECT().GetByUserID(gomock.Any(), userID).Return(account, nil)
+				batchSvc.EXPECT().
+					Create(gomock.Any(), accountID, "", gomock.Any()).
+					Return(nil, util.NewUnprocessableEntityError("1 of 1 transfers are invalid").WithDetails([]util.ErrorDetail{
+						{Line: 1, Message: "amount must be greater than zero"},
+					}))
+
+				return authSvc, accountSvc, batchSvc
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				if !strings.Contains(rec.Body.String(), `"details":[{"line":1,"message":"amount must be greater than zero"}]`) {
+					t.Fatalf("expected line details, got %s", rec.Body.String())
+				}
+				testutil.AssertHTTPError(t, rec, http.StatusUnprocessableEntity, "1 of 1 transfers are invalid")
+			},
+		},
+		{
+			name:        "batch over the monthly limit returns the exceeded window",
+			contentType: "application/json",
+			body:        `{"transfers":[{"to_account":"` + toAccountID.String() + `","amount":"6000"}]}`,
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockTransferBatchService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				accountSvc := servicemocks.NewMockAccountService(ctrl)
+				batchSvc := servicemocks.NewMockTransferBatchService(ctrl)
+
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				accountSvc.EXPECT().GetByUserID(gomock.Any(), userID).Return(account, nil)
+				batchSvc.EXPECT().Create(gomock.Any(), accountID, "", gomock.Any()).Return(nil, util.NewTransferLimitError("monthly"))
+
+				return authSvc, accountSvc, batchSvc
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				if !strings.Contains(rec.Body.String(), `"reason":"transfer_limit_exceeded","window":"monthly"`) {
+					t.Fatalf("expected the exceeded limit, got %s", rec.Body.String())
+				}
+				testutil.AssertHTTPError(t, rec, http.StatusUnprocessableEntity, "monthly transfer limit exceeded")
+			},
+		},
+		{
+			name:        "empty json batch returns 400",
+			contentType: "application/json",
+			body:        `{"transfers":[]}`,
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockTransferBatchService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				return authSvc, servicemocks.NewMockAccountService(ctrl), servicemocks.NewMockTransferBatchService(ctrl)
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPStatus(t, rec, http.StatusBadRequest)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authSvc, accountSvc, batchSvc := tc.buildMocks(ctrl)
+			r := newHandlerTestRouter(t, authSvc, testutil.RouterDeps{
+				TransferBatchHandler: handler.NewTransferBatchHandler(batchSvc, accountSvc),
+			})
+
+			req, _ := http.NewRequest(http.MethodPost, "/api/v1/transfers/batches", strings.NewReader(tc.body))
+			req.Header.Set("Content-Type", tc.contentType)
+			req.Header.Set("Authorization", "Bearer "+token)
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, req)
+
+			tc.assertResponse(t, rec)
+		})
+	}
+}
+
+func TestTransferBatches_Get(t *testing.T) {
+	t.Parallel()
+
+	token := "header.payload.sig"
+	userID := uuid.MustParse("550e8400-e29b-41d4-a716-4466554400b3")
+	accountID := uuid.MustParse("550e8400-e29b-41d4-a716-4466554400b4")
+
+	user := &model.User{ID: userID}
+	account := &model.Account{ID: accountID, UserID: userID}
+
+	tests := []struct {
+		name           string
+		buildMocks     func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockTransferBatchService)
+		assertResponse func(t *testing.T, rec *httptest.ResponseRecorder)
+	}{
+		{
+			name: "success",
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockTransferBatchService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				accountSvc := servicemocks.NewMockAccountService(ctrl)
+				batchSvc := servicemocks.NewMockTransferBatchService(ctrl)
+
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				accountSvc.EXPECT().GetByUserID(gomock.Any(), userID).Return(account, nil)
+				batchSvc.EXPECT().
+					GetByID(gomock.Any(), accountID, uint64(7)).
+					Return(&model.TransferBatch{ID: 7, FromAccount: accountID, Status: "partially_completed", CompletedCount: 1, FailedCount: 1}, nil)
+
+				return authSvc, accountSvc, batchSvc
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPStatus(t, rec, http.StatusOK)
+			},
+		},
+		{
+			name: "batch of another account returns 404",
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockTransferBatchService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				accountSvc := servicemocks.NewMockAccountService(ctrl)
+				batchSvc := servicemocks.NewMockTransferBatchService(ctrl)
+
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				accountSvc.EXPECT().GetByUserID(gomock.Any(), userID).Return(account, nil)
+				batchSvc.EXPECT().GetByID(gomock.Any(), accountID, uint64(7)).Return(nil, util.NewNotFoundError("transfer batch not found"))
+
+				return authSvc, accountSvc, batchSvc
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPError(t, rec, http.StatusNotFound, "transfer batch not found")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authSvc, accountSvc, batchSvc := tc.buildMocks(ctrl)
+			r := newHandlerTestRouter(t, authSvc, testutil.RouterDeps{
+				TransferBatchHandler: handler.NewTransferBatchHandler(batchSvc, accountSvc),
+			})
+
+			req := testutil.NewJSONRequest(http.MethodGet, "/api/v1/transfers/batches/7", nil, map[string]string{
+				"Authorization": "Bearer " + token,
+			})
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, req)
+
+			tc.assertResponse(t, rec)
+		})
+	}
+}
@@ -1,8 +1,11 @@
 package handler
 
 import (
+	"context"
+	"io"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/go-playground/validator/v10"
@@ -16,41 +19,78 @@ import (
 
 // TransferHandler handles transfer-related requests
 type TransferHandler struct {
-	transferService service.TransferService
-	accountService  service.AccountService
-	validator       *validator.Validate
+	transferService    service.TransferService
+	accountService     service.AccountService
+	beneficiaryService service.BeneficiaryService
+	validator          *validator.Validate
 }
 
 // NewTransferHandler creates a new transfer handler
 func NewTransferHandler(
 	transferService service.TransferService,
 	accountService service.AccountService,
+	beneficiaryService service.BeneficiaryService,
 ) *TransferHandler {
 	return &TransferHandler{
-		transferService: transferService,
-		accountService:  accountService,
-		validator:       validator.New(),
+		transferService:    transferService,
+		accountService:     accountService,
+		beneficiaryService: beneficiaryService,
+		validator:          validator.New(),
 	}
 }
 
 // TransferRequest represents a request to create a new transfer
 type TransferRequest struct {
-	ToAccount   string `json:"to_account" validate:"required,uuid4"`
-	Amount      string `json:"amount" validate:"required"`
+	FromAccount   string     `json:"from_account" validate:"omitempty,uuid"`
+	ToAccount     string     `json:"to_account" validate:"required_without_all=ToIBAN BeneficiaryID ToUsername ToEmail,excluded_with=ToIBAN BeneficiaryID ToUsername ToEmail,omitempty,uuid4"`
+	ToIBAN        string     `json:"to_iban" validate:"excluded_with=BeneficiaryID ToUsername ToEmail,omitempty,max=42"`
+	BeneficiaryID *uint64    `json:"beneficiary_id" validate:"omitempty,min=1"`
+	ToUsername    string     `json:"to_username" validate:"excluded_with=BeneficiaryID ToEmail,omitempty,max=100"`
+	ToEmail       string     `json:"to_email" validate:"excluded_with=BeneficiaryID,omitempty,email"`
+	Amount        string     `json:"amount" validate:"required"`
+	Description   string     `json:"description"`
+	ExecuteAt     *time.Time `json:"execute_at" validate:"excluded_with=QuoteID"`
+	QuoteID       string     `json:"quote_id" validate:"omitempty,uuid"`
+}
+
+// ReverseTransferRequest represents a request to reverse a transfer; an empty amount reverses all that is left
+type ReverseTransferRequest struct {
+	Amount      string `json:"amount"`
 	Description string `json:"description"`
 }
 
+// TransferDecisionRequest represents a co-signer's approval or rejection of a transfer
+type TransferDecisionRequest struct {
+	Note string `json:"note" validate:"max=255"`
+}
+
+// TransferApprovalsResponse represents the approval history of a transfer
+type TransferApprovalsResponse struct {
+	Approvals []*model.TransferApproval `json:"approvals"`
+}
+
 // Transfer performs a transfer from the user's account to another account
 // @Summary Create a transfer
-// @Description Transfer funds from the authenticated user's account to another account
+// @Description Transfer funds from the authenticated user's account to another account.
+// @Description When execute_at is set the transfer is scheduled and executed at that date.
+// @Description The recipient is given either as to_account (account ID), as to_iban, as beneficiary_id or as the
+// @Description to_username or to_email of another user, whose default account receives the transfer.
+// @Description Transfers to an account of another currency need the quote_id of an FX quote for the amount.
+// @Description Immediate transfers are executed in the background: they are returned with status pending and 202,
+// @Description and GET /transfers/{id} tells when they are completed or failed.
+// @Description Transfers from a business account above the approval threshold are returned with status
+// @Description awaiting_approval and 202 until a co-signer approves them.
 // @Tags transfers
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param transfer body TransferRequest true "Transfer details"
 // @Success 201 {object} model.Transfer
+// @Success 202 {object} model.Transfer
 // @Failure 400 {object} util.ErrorResponse
 // @Failure 401 {object} util.ErrorResponse
+// @Failure 404 {object} util.ErrorResponse
+// @Failure 422 {object} util.ErrorResponse
 // @Failure 500 {object} util.ErrorResponse
 // @Router /transfers [post]
 func (h *TransferHandler) Transfer(c *gin.Context) {
@@ -96,48 +136,208 @@ func (h *TransferHandler) Transfer(c *gin.Context) {
 		return
 	}
 
-	// Parse to account ID
-	toAccountID, err := uuid.Parse(req.ToAccount)
+	// Resolve the recipient from its account ID, IBAN, beneficiary or alias
+	var toAccountID uuid.UUID
+	var beneficiary *model.Beneficiary
+	if req.ToUsername != "" || req.ToEmail != "" {
+		recipient, err := h.accountService.GetRecipient(c, req.ToUsername, req.ToEmail)
+		if err != nil {
+			util.HandleError(c, err)
+			return
+		}
+		toAccountID = recipient.AccountID
+	} else if req.BeneficiaryID != nil {
+		beneficiary, err = h.beneficiaryService.GetByID(c, userModel.ID, *req.BeneficiaryID)
+		if err != nil {
+			util.HandleError(c, err)
+			return
+		}
+		toAccountID = beneficiary.AccountID
+	} else if req.ToIBAN != "" {
+		toAccount, err := h.accountService.GetByIBAN(c, req.ToIBAN)
+		if err != nil {
+			util.HandleError(c, err)
+			return
+		}
+		toAccountID = toAccount.ID
+	} else {
+		toAccountID, err = uuid.Parse(req.ToAccount)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, util.ErrorResponse{
+				Error: util.NewBadRequestError("invalid to_account"),
+			})
+			return
+		}
+	}
+
+	// Get from account (the selected or default account of the user)
+	fromAccount, err := selectAccount(c, h.accountService, userModel.ID, req.FromAccount)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Schedule future-dated transfers, perform the others immediately, converted at the quoted rate if any
+	var transfer *model.Transfer
+	if req.QuoteID != "" {
+		quoteID, err := uuid.Parse(req.QuoteID)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, util.ErrorResponse{
+				Error: util.NewBadRequestError("invalid quote_id"),
+			})
+			return
+		}
+		transfer, err = h.transferService.TransferConverted(
+			c,
+			fromAccount.ID,
+			toAccountID,
+			amount,
+			req.Description,
+			quoteID,
+		)
+	} else if req.ExecuteAt != nil {
+		transfer, err = h.transferService.Schedule(
+			c,
+			fromAccount.ID,
+			toAccountID,
+			amount,
+			req.Description,
+			*req.ExecuteAt,
+		)
+	} else {
+		transfer, err = h.transferService.Submit(
+			c,
+			fromAccount.ID,
+			toAccountID,
+			amount,
+			req.Description,
+		)
+	}
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Record when the beneficiary was last used, best effort
+	if beneficiary != nil {
+		_ = h.beneficiaryService.MarkUsed(c, beneficiary.ID)
+	}
+
+	// Return response, accepted while the transfer is queued for execution or waits for a co-signer
+	if transfer.Status == "pending" || transfer.Status == "awaiting_approval" {
+		c.JSON(http.StatusAccepted, transfer)
+		return
+	}
+	c.JSON(http.StatusCreated, transfer)
+}
+
+// Get returns the details of a transfer of the user, also used to poll the status of a transfer being processed
+// @Summary Get a transfer
+// @Description Get a transfer sent or received by one of the user's accounts, or sent from an account the user co-signs,
+// @Description with the masked name of the counterparty and the movements it posted on the user's accounts
+// @Tags transfers
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Transfer ID"
+// @Success 200 {object} model.TransferDetails
+// @Failure 400 {object} util.ErrorResponse
+// @Failure 401 {object} util.ErrorResponse
+// @Failure 404 {object} util.ErrorResponse
+// @Failure 500 {object} util.ErrorResponse
+// @Router /transfers/{id} [get]
+func (h *TransferHandler) Get(c *gin.Context) {
+	// Get user from context (set by auth middleware)
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, util.ErrorResponse{
+			Error: util.NewUnauthorizedError("unauthorized"),
+		})
+		return
+	}
+
+	userModel, ok := user.(*model.User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, util.ErrorResponse{
+			Error: util.NewInternalServerError("user context invalid"),
+		})
+		return
+	}
+
+	// Parse transfer ID
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
 	if err != nil {
 		c.JSON(http.StatusBadRequest, util.ErrorResponse{
-			Error: util.NewBadRequestError("invalid to_account"),
+			Error: util.NewBadRequestError("invalid transfer id"),
 		})
 		return
 	}
 
-	// Get from account (user's account)
-	fromAccount, err := h.accountService.GetByUserID(c, userModel.ID)
+	// Get transfer details
+	transfer, err := h.transferService.GetForUser(c, userModel.ID, id)
 	if err != nil {
 		util.HandleError(c, err)
 		return
 	}
 
-	// Perform transfer
-	transfer, err := h.transferService.Transfer(
-		c,
-		fromAccount.ID,
-		toAccountID,
-		amount,
-		req.Description,
-	)
+	// Return response
+	c.JSON(http.StatusOK, transfer)
+}
+
+// PreviewRecipient resolves a username or email to the user who would receive a transfer, for confirmation
+// @Summary Preview a transfer recipient
+// @Description Resolve to_username or to_email as POST /transfers would, returning the masked name of the recipient
+// @Tags transfers
+// @Produce json
+// @Security BearerAuth
+// @Param to_username query string false "Username of the recipient"
+// @Param to_email query string false "Email of the recipient"
+// @Success 200 {object} model.Recipient
+// @Failure 400 {object} util.ErrorResponse
+// @Failure 401 {object} util.ErrorResponse
+// @Failure 404 {object} util.ErrorResponse
+// @Failure 500 {object} util.ErrorResponse
+// @Router /transfers/recipient [get]
+func (h *TransferHandler) PreviewRecipient(c *gin.Context) {
+	// Check that the user is authenticated (set by auth middleware)
+	if _, exists := c.Get("user"); !exists {
+		c.JSON(http.StatusUnauthorized, util.ErrorResponse{
+			Error: util.NewUnauthorizedError("unauthorized"),
+		})
+		return
+	}
+
+	// Resolve recipient
+	recipient, err := h.accountService.GetRecipient(c, c.Query("to_username"), c.Query("to_email"))
 	if err != nil {
 		util.HandleError(c, err)
 		return
 	}
 
 	// Return response
-	c.JSON(http.StatusCreated, transfer)
+	c.JSON(http.StatusOK, recipient)
 }
 
 // List returns a paginated list of transfers for the user's account
 // @Summary List transfers
-// @Description Get a paginated list of transfers for the authenticated user's account
+// @Description Get a paginated list of transfers for the authenticated user's account, optionally filtered, each
+// @Description with its direction relative to the account
 // @Tags transfers
 // @Produce json
 // @Security BearerAuth
+// @Param account_id query string false "Account to use (default: the user's default account)"
+// @Param direction query string false "in (received) or out (sent)"
+// @Param status query string false "Transfer status"
+// @Param from query string false "Transfers initiated at or after this date-time (RFC 3339)"
+// @Param to query string false "Transfers initiated before this date-time (RFC 3339)"
+// @Param min_amount query string false "Minimum amount"
+// @Param max_amount query string false "Maximum amount"
+// @Param counterparty_account query string false "Account on the other side of the transfers"
 // @Param page query int false "Page number (default: 1)"
+// @Param cursor query string false "Cursor of the page, empty for the first one; replaces page, without a total count"
 // @Param limit query int false "Items per page (default: 10, max: 100)"
-// @Success 200 {object} util.PaginatedResponse
+// @Success 200 {object} util.PaginatedResponse "By page"
+// @Success 200 {object} util.CursorResponse "By cursor"
+// @Failure 400 {object} util.ErrorResponse
 // @Failure 401 {object} util.ErrorResponse
 // @Failure 500 {object} util.ErrorResponse
 // @Router /transfers [get]
@@ -159,8 +359,129 @@ func (h *TransferHandler) List(c *gin.Context) {
 		return
 	}
 
+	// Get filter
+	filter, ok := parseTransferFilter(c)
+	if !ok {
+		return
+	}
+
+	cursor, byCursor, ok := cursorQuery(c)
+	if !ok {
+		return
+	}
+
+	// Get account
+	account, err := selectAccount(c, h.accountService, userModel.ID, c.Query("account_id"))
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Get pagination parameters
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+	if limit <= 0 {
+		limit = 10
+	}
+	if limit > 100 {
+		limit = 100
+	}
+
+	// Get transfers by cursor, without a total count
+	if byCursor {
+		response, err := h.transferService.GetByAccountIDWithCursor(c, account.ID, filter, cursor, limit)
+		if err != nil {
+			util.HandleError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, response)
+		return
+	}
+
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if page <= 0 {
+		page = 1
+	}
+
+	// Get transfers
+	response, err := h.transferService.GetByAccountID(c, account.ID, filter, page, limit)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, response)
+}
+
+// parseTransferFilter reads the filter of a transfer list from the query, writing a 400 response when it is invalid
+func parseTransferFilter(c *gin.Context) (*model.TransferFilter, bool) {
+	filter := &model.TransferFilter{
+		Direction: c.Query("direction"),
+		Status:    c.Query("status"),
+	}
+
+	var ok bool
+	if filter.From, ok = parseQueryTime(c, "from"); !ok {
+		return nil, false
+	}
+	if filter.To, ok = parseQueryTime(c, "to"); !ok {
+		return nil, false
+	}
+	if filter.MinAmount, ok = parseQueryDecimal(c, "min_amount"); !ok {
+		return nil, false
+	}
+	if filter.MaxAmount, ok = parseQueryDecimal(c, "max_amount"); !ok {
+		return nil, false
+	}
+
+	if counterparty := c.Query("counterparty_account"); counterparty != "" {
+		id, err := uuid.Parse(counterparty)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, util.ErrorResponse{
+				Error: util.NewBadRequestError("invalid counterparty_account"),
+			})
+			return nil, false
+		}
+		filter.Counterparty = &id
+	}
+
+	return filter, true
+}
+
+// ListScheduled returns a paginated list of the scheduled transfers of the user's account
+// @Summary List scheduled transfers
+// @Description Get a paginated list of the transfers waiting for their execution date, soonest first
+// @Tags transfers
+// @Produce json
+// @Security BearerAuth
+// @Param account_id query string false "Account to use (default: the user's default account)"
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Items per page (default: 10, max: 100)"
+// @Success 200 {object} util.PaginatedResponse
+// @Failure 401 {object} util.ErrorResponse
+// @Failure 500 {object} util.ErrorResponse
+// @Router /transfers/scheduled [get]
+func (h *TransferHandler) ListScheduled(c *gin.Context) {
+	// Get user from context (set by auth middleware)
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, util.ErrorResponse{
+			Error: util.NewUnauthorizedError("unauthorized"),
+		})
+		return
+	}
+
+	userModel, ok := user.(*model.User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, util.ErrorResponse{
+			Error: util.NewInternalServerError("user context invalid"),
+		})
+		return
+	}
+
 	// Get account
-	account, err := h.accountService.GetByUserID(c, userModel.ID)
+	account, err := selectAccount(c, h.accountService, userModel.ID, c.Query("account_id"))
 	if err != nil {
 		util.HandleError(c, err)
 		return
@@ -180,8 +501,8 @@ func (h *TransferHandler) List(c *gin.Context) {
 		limit = 100
 	}
 
-	// Get transfers
-	response, err := h.transferService.GetByAccountID(c, account.ID, page, limit)
+	// Get scheduled transfers
+	response, err := h.transferService.GetScheduledByAccountID(c, account.ID, page, limit)
 	if err != nil {
 		util.HandleError(c, err)
 		return
@@ -190,3 +511,361 @@ func (h *TransferHandler) List(c *gin.Context) {
 	// Return response
 	c.JSON(http.StatusOK, response)
 }
+
+// Cancel cancels a scheduled transfer of the user's account
+// @Summary Cancel a scheduled transfer
+// @Description Cancel a transfer that is still waiting for its execution date
+// @Tags transfers
+// @Produce json
+// @Security BearerAuth
+// @Param account_id query string false "Account to use (default: the user's default account)"
+// @Param id path int true "Transfer ID"
+// @Success 200 {object} model.Transfer
+// @Failure 400 {object} util.ErrorResponse
+// @Failure 401 {object} util.ErrorResponse
+// @Failure 404 {object} util.ErrorResponse
+// @Failure 409 {object} util.ErrorResponse
+// @Failure 500 {object} util.ErrorResponse
+// @Router /transfers/{id}/cancel [post]
+func (h *TransferHandler) Cancel(c *gin.Context) {
+	// Get user from context (set by auth middleware)
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, util.ErrorResponse{
+			Error: util.NewUnauthorizedError("unauthorized"),
+		})
+		return
+	}
+
+	userModel, ok := user.(*model.User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, util.ErrorResponse{
+			Error: util.NewInternalServerError("user context invalid"),
+		})
+		return
+	}
+
+	// Parse transfer ID
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError("invalid transfer id"),
+		})
+		return
+	}
+
+	// Get account
+	account, err := selectAccount(c, h.accountService, userModel.ID, c.Query("account_id"))
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Cancel transfer
+	transfer, err := h.transferService.Cancel(c, account.ID, id)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, transfer)
+}
+
+// Reverse gives back a completed transfer received by the user's account, fully or partially
+// @Summary Reverse a transfer
+// @Description Create a compensating transfer back to the sender. Admins can reverse any transfer.
+// @Description Partial refunds are allowed until the original amount has been given back.
+// @Tags transfers
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param account_id query string false "Account to use (default: the user's default account)"
+// @Param id path int true "Transfer ID"
+// @Param reversal body ReverseTransferRequest false "Reversal details"
+// @Success 201 {object} model.Transfer
+// @Failure 400 {object} util.ErrorResponse
+// @Failure 401 {object} util.ErrorResponse
+// @Failure 403 {object} util.ErrorResponse
+// @Failure 404 {object} util.ErrorResponse
+// @Failure 409 {object} util.ErrorResponse
+// @Failure 500 {object} util.ErrorResponse
+// @Router /transfers/{id}/reverse [post]
+func (h *TransferHandler) Reverse(c *gin.Context) {
+	// Get user from context (set by auth middleware)
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, util.ErrorResponse{
+			Error: util.NewUnauthorizedError("unauthorized"),
+		})
+		return
+	}
+
+	userModel, ok := user.(*model.User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, util.ErrorResponse{
+			Error: util.NewInternalServerError("user context invalid"),
+		})
+		return
+	}
+
+	// Parse transfer ID
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError("invalid transfer id"),
+		})
+		return
+	}
+
+	// Parse request, the body is optional
+	var req ReverseTransferRequest
+	if err := c.ShouldBindJSON(&req); err != nil && err != io.EOF {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError("invalid request body"),
+		})
+		return
+	}
+
+	// Parse amount
+	amount := decimal.Zero
+	if req.Amount != "" {
+		amount, err = decimal.NewFromString(req.Amount)
+		if err != nil || amount.LessThanOrEqual(decimal.Zero) {
+			c.JSON(http.StatusBadRequest, util.ErrorResponse{
+				Error: util.NewBadRequestError("invalid amount"),
+			})
+			return
+		}
+	}
+
+	// Admins can reverse any transfer, users only the ones received by their account
+	var accountID *uuid.UUID
+	if userModel.Role != "admin" {
+		account, err := selectAccount(c, h.accountService, userModel.ID, c.Query("account_id"))
+		if err != nil {
+			util.HandleError(c, err)
+			return
+		}
+		accountID = &account.ID
+	}
+
+	// Reverse transfer
+	reversal, err := h.transferService.Reverse(c, accountID, id, amount, req.Description)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusCreated, reversal)
+}
+
+// ListAwaitingApproval returns a paginated list of the transfers the user can approve as a co-signer
+// @Summary List transfers awaiting approval
+// @Description Get a paginated list of the transfers awaiting the approval of the authenticated user, oldest first
+// @Tags transfers
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Items per page (default: 10, max: 100)"
+// @Success 200 {object} util.PaginatedResponse
+// @Failure 401 {object} util.ErrorResponse
+// @Failure 500 {object} util.ErrorResponse
+// @Router /transfers/approvals [get]
+func (h *TransferHandler) ListAwaitingApproval(c *gin.Context) {
+	// Get user from context (set by auth middleware)
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, util.ErrorResponse{
+			Error: util.NewUnauthorizedError("unauthorized"),
+		})
+		return
+	}
+
+	userModel, ok := user.(*model.User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, util.ErrorResponse{
+			Error: util.NewInternalServerError("user context invalid"),
+		})
+		return
+	}
+
+	// Get pagination parameters
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if page <= 0 {
+		page = 1
+	}
+
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+	if limit <= 0 {
+		limit = 10
+	}
+	if limit > 100 {
+		limit = 100
+	}
+
+	// Get transfers awaiting approval
+	response, err := h.transferService.GetAwaitingApproval(c, userModel.ID, page, limit)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, response)
+}
+
+// ListApprovals returns the approval history of a transfer
+// @Summary List the approvals of a transfer
+// @Description Get who requested, approved, rejected or let expire a transfer; visible to the sender and the co-signers
+// @Tags transfers
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Transfer ID"
+// @Success 200 {object} TransferApprovalsResponse
+// @Failure 400 {object} util.ErrorResponse
+// @Failure 401 {object} util.ErrorResponse
+// @Failure 404 {object} util.ErrorResponse
+// @Failure 500 {object} util.ErrorResponse
+// @Router /transfers/{id}/approvals [get]
+func (h *TransferHandler) ListApprovals(c *gin.Context) {
+	// Get user from context (set by auth middleware)
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, util.ErrorResponse{
+			Error: util.NewUnauthorizedError("unauthorized"),
+		})
+		return
+	}
+
+	userModel, ok := user.(*model.User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, util.ErrorResponse{
+			Error: util.NewInternalServerError("user context invalid"),
+		})
+		return
+	}
+
+	// Parse transfer ID
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError("invalid transfer id"),
+		})
+		return
+	}
+
+	// Get approvals
+	approvals, err := h.transferService.GetApprovals(c, userModel.ID, id)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, TransferApprovalsResponse{Approvals: approvals})
+}
+
+// Approve approves a transfer awaiting the approval of the user, queueing its execution
+// @Summary Approve a transfer
+// @Description Approve, as a co-signer of the sending account, a transfer awaiting approval; the transfer is queued for execution
+// @Tags transfers
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Transfer ID"
+// @Param decision body TransferDecisionRequest false "Decision details"
+// @Success 202 {object} model.Transfer
+// @Failure 400 {object} util.ErrorResponse
+// @Failure 401 {object} util.ErrorResponse
+// @Failure 404 {object} util.ErrorResponse
+// @Failure 409 {object} util.ErrorResponse
+// @Failure 422 {object} util.ErrorResponse
+// @Failure 500 {object} util.ErrorResponse
+// @Router /transfers/{id}/approve [post]
+func (h *TransferHandler) Approve(c *gin.Context) {
+	h.decide(c, h.transferService.Approve)
+}
+
+// Reject rejects a transfer awaiting the approval of the user
+// @Summary Reject a transfer
+// @Description Reject, as a co-signer of the sending account, a transfer awaiting approval; the transfer fails
+// @Tags transfers
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Transfer ID"
+// @Param decision body TransferDecisionRequest false "Decision details"
+// @Success 200 {object} model.Transfer
+// @Failure 400 {object} util.ErrorResponse
+// @Failure 401 {object} util.ErrorResponse
+// @Failure 404 {object} util.ErrorResponse
+// @Failure 409 {object} util.ErrorResponse
+// @Failure 500 {object} util.ErrorResponse
+// @Router /transfers/{id}/reject [post]
+func (h *TransferHandler) Reject(c *gin.Context) {
+	h.decide(c, h.transferService.Reject)
+}
+
+// decide records the decision of a co-signer on a transfer through the given service method
+func (h *TransferHandler) decide(
+	c *gin.Context,
+	decision func(ctx context.Context, userID uuid.UUID, id uint64, note string) (*model.Transfer, error),
+) {
+	// Get user from context (set by auth middleware)
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, util.ErrorResponse{
+			Error: util.NewUnauthorizedError("unauthorized"),
+		})
+		return
+	}
+
+	userModel, ok := user.(*model.User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, util.ErrorResponse{
+			Error: util.NewInternalServerError("user context invalid"),
+		})
+		return
+	}
+
+	// Parse transfer ID
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError("invalid transfer id"),
+		})
+		return
+	}
+
+	// Parse request, the body is optional
+	var req TransferDecisionRequest
+	if err := c.ShouldBindJSON(&req); err != nil && err != io.EOF {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError("invalid request body"),
+		})
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError(err.Error()),
+		})
+		return
+	}
+
+	// Record decision
+	transfer, err := decision(c, userModel.ID, id, req.Note)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Return response, accepted while an approved transfer is queued for execution
+	if transfer.Status == "pending" {
+		c.JSON(http.StatusAccepted, transfer)
+		return
+	}
+	c.JSON(http.StatusOK, transfer)
+}
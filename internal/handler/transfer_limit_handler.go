@@ -0,0 +1,158 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+
+	"VDM2-BankBE/internal/model"
+	"VDM2-BankBE/internal/service"
+	"VDM2-BankBE/internal/util"
+)
+
+// TransferLimitHandler handles transfer limit requests
+type TransferLimitHandler struct {
+	transferLimitService service.TransferLimitService
+}
+
+// NewTransferLimitHandler creates a new transfer limit handler
+func NewTransferLimitHandler(transferLimitService service.TransferLimitService) *TransferLimitHandler {
+	return &TransferLimitHandler{
+		transferLimitService: transferLimitService,
+	}
+}
+
+// UpdateTransferLimitsRequest represents a request to lower the transfer limits of the user
+type UpdateTransferLimitsRequest struct {
+	PerTransaction *string `json:"per_transaction"`
+	Daily          *string `json:"daily"`
+	Monthly        *string `json:"monthly"`
+}
+
+// Get returns the transfer limits of the user and the remaining allowance
+// @Summary Get transfer limits
+// @Description Get the per-transaction, daily and monthly transfer limits of the authenticated user and how much
+// @Description of them is left
+// @Tags transfers
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} model.TransferAllowance
+// @Failure 401 {object} util.ErrorResponse
+// @Failure 500 {object} util.ErrorResponse
+// @Router /transfers/limits [get]
+func (h *TransferLimitHandler) Get(c *gin.Context) {
+	// Get user from context (set by auth middleware)
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, util.ErrorResponse{
+			Error: util.NewUnauthorizedError("unauthorized"),
+		})
+		return
+	}
+
+	userModel, ok := user.(*model.User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, util.ErrorResponse{
+			Error: util.NewInternalServerError("user context invalid"),
+		})
+		return
+	}
+
+	// Get allowance
+	allowance, err := h.transferLimitService.GetAllowance(c, userModel.ID, "")
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, allowance)
+}
+
+// Update lowers the transfer limits of the user
+// @Summary Lower transfer limits
+// @Description Lower the transfer limits of the authenticated user; limits cannot be raised
+// @Tags transfers
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param limits body UpdateTransferLimitsRequest true "Limits to lower"
+// @Success 200 {object} model.TransferAllowance
+// @Failure 400 {object} util.ErrorResponse
+// @Failure 401 {object} util.ErrorResponse
+// @Failure 403 {object} util.ErrorResponse
+// @Failure 500 {object} util.ErrorResponse
+// @Router /transfers/limits [patch]
+func (h *TransferLimitHandler) Update(c *gin.Context) {
+	// Get user from context (set by auth middleware)
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, util.ErrorResponse{
+			Error: util.NewUnauthorizedError("unauthorized"),
+		})
+		return
+	}
+
+	userModel, ok := user.(*model.User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, util.ErrorResponse{
+			Error: util.NewInternalServerError("user context invalid"),
+		})
+		return
+	}
+
+	// Parse request
+	var req UpdateTransferLimitsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{
+			Error: util.NewBadRequestError("invalid request body"),
+		})
+		return
+	}
+
+	// Parse amounts
+	perTransaction, err := parseOptionalAmount(req.PerTransaction)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+	daily, err := parseOptionalAmount(req.Daily)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+	monthly, err := parseOptionalAmount(req.Monthly)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Update limits
+	allowance, err := h.transferLimitService.Update(c, userModel.ID, service.TransferLimitUpdate{
+		PerTransaction: perTransaction,
+		Daily:          daily,
+		Monthly:        monthly,
+	})
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, allowance)
+}
+
+// parseOptionalAmount parses an amount that can be omitted from a request
+func parseOptionalAmount(value *string) (*decimal.Decimal, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	amount, err := decimal.NewFromString(*value)
+	if err != nil {
+		return nil, util.NewBadRequestError("invalid amount")
+	}
+
+	return &amount, nil
+}
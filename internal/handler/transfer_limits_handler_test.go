@@ -0,0 +1,106 @@
+package handler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"VDM2-BankBE/internal/handler"
+	"VDM2-BankBE/internal/model"
+	"VDM2-BankBE/internal/service"
+	servicemocks "VDM2-BankBE/internal/service/mocks"
+	"VDM2-BankBE/internal/testutil"
+	"VDM2-BankBE/internal/util"
+)
+
+func TestTransferLimits_Update(t *testing.T) {
+	t.Parallel()
+
+	token := "header.payload.sig"
+	userID := uuid.MustParse("00000000-0000-0000-0000-0000000000a0")
+	user := &model.User{ID: userID}
+
+	tests := []struct {
+		name           string
+		body           any
+		buildMocks     func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockTransferLimitService)
+		assertResponse func(t *testing.T, rec *httptest.ResponseRecorder)
+	}{
+		{
+			name: "success",
+			body: map[string]any{"daily": "500.00"},
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockTransferLimitService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				limitSvc := servicemocks.NewMockTransferLimitService(ctrl)
+
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				limitSvc.EXPECT().Update(gomock.Any(), userID, gomock.Any()).DoAndReturn(func(_ any, _ uuid.UUID, update service.TransferLimitUpdate) (*model.TransferAllowance, error) {
+					if update.Daily == nil || !update.Daily.Equal(decimal.RequireFromString("500.00")) || update.PerTransaction != nil || update.Monthly != nil {
+						t.Fatalf("unexpected update: %+v", update)
+					}
+					return &model.TransferAllowance{DailyLimit: *update.Daily}, nil
+				})
+
+				return authSvc, limitSvc
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPStatus(t, rec, http.StatusOK)
+			},
+		},
+		{
+			name: "invalid amount returns 400",
+			body: map[string]any{"monthly": "lots"},
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockTransferLimitService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+
+				return authSvc, servicemocks.NewMockTransferLimitService(ctrl)
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPError(t, rec, http.StatusBadRequest, "invalid amount")
+			},
+		},
+		{
+			name: "raising a limit maps to 403",
+			body: map[string]any{"per_transaction": "99999.00"},
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockTransferLimitService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				limitSvc := servicemocks.NewMockTransferLimitService(ctrl)
+
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				limitSvc.EXPECT().Update(gomock.Any(), userID, gomock.Any()).Return(nil, util.NewForbiddenError("per_transaction limit can only be lowered"))
+
+				return authSvc, limitSvc
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPError(t, rec, http.StatusForbidden, "per_transaction limit can only be lowered")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authSvc, limitSvc := tc.buildMocks(ctrl)
+			r := newHandlerTestRouter(t, authSvc, testutil.RouterDeps{
+				TransferLimitHandler: handler.NewTransferLimitHandler(limitSvc),
+			})
+
+			req := testutil.NewJSONRequest(http.MethodPatch, "/api/v1/transfers/limits", tc.body, map[string]string{
+				"Authorization": "Bearer " + token,
+			})
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, req)
+
+			tc.assertResponse(t, rec)
+		})
+	}
+}
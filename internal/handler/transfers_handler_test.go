@@ -1,12 +1,16 @@
 package handler_test
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 
 	"VDM2-BankBE/internal/model"
 	servicemocks "VDM2-BankBE/internal/service/mocks"
@@ -21,9 +25,11 @@ func TestTransfers_Create(t *testing.T) {
 	userID := uuid.MustParse("00000000-0000-0000-0000-000000000040")
 	fromAccountID := uuid.MustParse("00000000-0000-0000-0000-000000000041")
 	toAccountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440000")
+	quoteID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440001")
 
 	user := &model.User{ID: userID}
 	fromAccount := &model.Account{ID: fromAccountID, UserID: userID, Currency: "EUR"}
+	executeAt := time.Date(2030, 1, 15, 9, 0, 0, 0, time.UTC)
 
 	tests := []struct {
 		name           string
@@ -34,7 +40,7 @@ func TestTransfers_Create(t *testing.T) {
 		assertResponse func(t *testing.T, rec *httptest.ResponseRecorder)
 	}{
 		{
-			name: "success",
+			name: "success queues the transfer",
 			setupAuth: func(headers map[string]string) {
 				headers["Authorization"] = "Bearer " + token
 			},
@@ -45,11 +51,197 @@ func TestTransfers_Create(t *testing.T) {
 				transferSvc := servicemocks.NewMockTransferService(ctrl)
 
 				amount := mustDecimal(t, "25.00")
-				transfer := &model.Transfer{ID: 1, FromAccount: fromAccountID, ToAccount: toAccountID, Amount: amount, Status: "completed"}
+				transfer := &model.Transfer{ID: 1, FromAccount: fromAccountID, ToAccount: toAccountID, Amount: amount, Status: "pending"}
+
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				accountSvc.EXPECT().GetByUserID(gomock.Any(), userID).Return(fromAccount, nil)
+				transferSvc.EXPECT().Submit(gomock.Any(), fromAccountID, toAccountID, amount, "test").Return(transfer, nil)
+
+				return authSvc, accountSvc, transferSvc
+			},
+			expectedStatus: http.StatusAccepted,
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPStatus(t, rec, http.StatusAccepted)
+			},
+		},
+		{
+			name: "to_iban resolves the recipient",
+			setupAuth: func(headers map[string]string) {
+				headers["Authorization"] = "Bearer " + token
+			},
+			requestBody: map[string]any{"to_iban": "IT60 X054 2811 1010 0000 0123 456", "amount": "25.00", "description": "test"},
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockTransferService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				accountSvc := servicemocks.NewMockAccountService(ctrl)
+				transferSvc := servicemocks.NewMockTransferService(ctrl)
+
+				amount := mustDecimal(t, "25.00")
+				transfer := &model.Transfer{ID: 1, FromAccount: fromAccountID, ToAccount: toAccountID, Amount: amount, Status: "pending"}
+
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				accountSvc.EXPECT().GetByIBAN(gomock.Any(), "IT60 X054 2811 1010 0000 0123 456").Return(&model.Account{ID: toAccountID}, nil)
+				accountSvc.EXPECT().GetByUserID(gomock.Any(), userID).Return(fromAccount, nil)
+				transferSvc.EXPECT().Submit(gomock.Any(), fromAccountID, toAccountID, amount, "test").Return(transfer, nil)
+
+				return authSvc, accountSvc, transferSvc
+			},
+			expectedStatus: http.StatusAccepted,
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPStatus(t, rec, http.StatusAccepted)
+			},
+		},
+		{
+			name: "transfers awaiting approval return 202",
+			setupAuth: func(headers map[string]string) {
+				headers["Authorization"] = "Bearer " + token
+			},
+			requestBody: map[string]any{"to_account": toAccountID.String(), "amount": "15000.00", "description": "invoice"},
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockTransferService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				accountSvc := servicemocks.NewMockAccountService(ctrl)
+				transferSvc := servicemocks.NewMockTransferService(ctrl)
+
+				amount := mustDecimal(t, "15000.00")
+				transfer := &model.Transfer{ID: 1, FromAccount: fromAccountID, ToAccount: toAccountID, Amount: amount, Status: "awaiting_approval"}
+
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				accountSvc.EXPECT().GetByUserID(gomock.Any(), userID).Return(fromAccount, nil)
+				transferSvc.EXPECT().Submit(gomock.Any(), fromAccountID, toAccountID, amount, "invoice").Return(transfer, nil)
+
+				return authSvc, accountSvc, transferSvc
+			},
+			expectedStatus: http.StatusAccepted,
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPStatus(t, rec, http.StatusAccepted)
+			},
+		},
+		{
+			name: "to_username resolves to the default account of the user",
+			setupAuth: func(headers map[string]string) {
+				headers["Authorization"] = "Bearer " + token
+			},
+			requestBody: map[string]any{"to_username": "mrossi", "amount": "25.00", "description": "test"},
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockTransferService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				accountSvc := servicemocks.NewMockAccountService(ctrl)
+				transferSvc := servicemocks.NewMockTransferService(ctrl)
+
+				amount := mustDecimal(t, "25.00")
+				transfer := &model.Transfer{ID: 1, FromAccount: fromAccountID, ToAccount: toAccountID, Amount: amount, Status: "pending"}
+
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				accountSvc.EXPECT().GetRecipient(gomock.Any(), "mrossi", "").Return(&model.Recipient{AccountID: toAccountID, Name: "Ma*** R***"}, nil)
+				accountSvc.EXPECT().GetByUserID(gomock.Any(), userID).Return(fromAccount, nil)
+				transferSvc.EXPECT().Submit(gomock.Any(), fromAccountID, toAccountID, amount, "test").Return(transfer, nil)
+
+				return authSvc, accountSvc, transferSvc
+			},
+			expectedStatus: http.StatusAccepted,
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPStatus(t, rec, http.StatusAccepted)
+			},
+		},
+		{
+			name: "to_username and to_email together return 400",
+			setupAuth: func(headers map[string]string) {
+				headers["Authorization"] = "Bearer " + token
+			},
+			requestBody: map[string]any{"to_username": "mrossi", "to_email": "mario@example.com", "amount": "25.00"},
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockTransferService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				return authSvc, servicemocks.NewMockAccountService(ctrl), servicemocks.NewMockTransferService(ctrl)
+			},
+			expectedStatus: http.StatusBadRequest,
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPStatus(t, rec, http.StatusBadRequest)
+			},
+		},
+		{
+			name: "unknown iban maps to 404",
+			setupAuth: func(headers map[string]string) {
+				headers["Authorization"] = "Bearer " + token
+			},
+			requestBody: map[string]any{"to_iban": "IT60X0542811101000000123456", "amount": "25.00"},
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockTransferService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				accountSvc := servicemocks.NewMockAccountService(ctrl)
+
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				accountSvc.EXPECT().GetByIBAN(gomock.Any(), "IT60X0542811101000000123456").Return(nil, util.NewNotFoundError("account not found"))
+
+				return authSvc, accountSvc, servicemocks.NewMockTransferService(ctrl)
+			},
+			expectedStatus: http.StatusNotFound,
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPError(t, rec, http.StatusNotFound, "account not found")
+			},
+		},
+		{
+			name: "to_account and to_iban together return 400",
+			setupAuth: func(headers map[string]string) {
+				headers["Authorization"] = "Bearer " + token
+			},
+			requestBody: map[string]any{"to_account": toAccountID.String(), "to_iban": "IT60X0542811101000000123456", "amount": "25.00"},
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockTransferService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+
+				return authSvc, servicemocks.NewMockAccountService(ctrl), servicemocks.NewMockTransferService(ctrl)
+			},
+			expectedStatus: http.StatusBadRequest,
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPStatus(t, rec, http.StatusBadRequest)
+			},
+		},
+		{
+			name: "execute_at schedules the transfer",
+			setupAuth: func(headers map[string]string) {
+				headers["Authorization"] = "Bearer " + token
+			},
+			requestBody: map[string]any{"to_account": toAccountID.String(), "amount": "25.00", "description": "rent", "execute_at": executeAt.Format(time.RFC3339)},
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockTransferService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				accountSvc := servicemocks.NewMockAccountService(ctrl)
+				transferSvc := servicemocks.NewMockTransferService(ctrl)
+
+				amount := mustDecimal(t, "25.00")
+				transfer := &model.Transfer{ID: 2, FromAccount: fromAccountID, ToAccount: toAccountID, Amount: amount, Status: "scheduled", ExecuteAt: &executeAt}
+
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				accountSvc.EXPECT().GetByUserID(gomock.Any(), userID).Return(fromAccount, nil)
+				transferSvc.EXPECT().Schedule(gomock.Any(), fromAccountID, toAccountID, amount, "rent", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _, _ uuid.UUID, _ decimal.Decimal, _ string, at time.Time) (*model.Transfer, error) {
+						if !at.Equal(executeAt) {
+							t.Fatalf("unexpected execute_at: got=%s want=%s", at, executeAt)
+						}
+						return transfer, nil
+					})
+
+				return authSvc, accountSvc, transferSvc
+			},
+			expectedStatus: http.StatusCreated,
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPStatus(t, rec, http.StatusCreated)
+			},
+		},
+		{
+			name: "quote_id converts the transfer",
+			setupAuth: func(headers map[string]string) {
+				headers["Authorization"] = "Bearer " + token
+			},
+			requestBody: map[string]any{"to_account": toAccountID.String(), "amount": "25.00", "description": "test", "quote_id": quoteID.String()},
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockTransferService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				accountSvc := servicemocks.NewMockAccountService(ctrl)
+				transferSvc := servicemocks.NewMockTransferService(ctrl)
+
+				amount := mustDecimal(t, "25.00")
+				transfer := &model.Transfer{ID: 3, FromAccount: fromAccountID, ToAccount: toAccountID, Amount: amount, Status: "completed", QuoteID: &quoteID}
 
 				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
 				accountSvc.EXPECT().GetByUserID(gomock.Any(), userID).Return(fromAccount, nil)
-				transferSvc.EXPECT().Transfer(gomock.Any(), fromAccountID, toAccountID, amount, "test").Return(transfer, nil)
+				transferSvc.EXPECT().TransferConverted(gomock.Any(), fromAccountID, toAccountID, amount, "test", quoteID).Return(transfer, nil)
 
 				return authSvc, accountSvc, transferSvc
 			},
@@ -142,7 +334,7 @@ func TestTransfers_Create(t *testing.T) {
 
 				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
 				accountSvc.EXPECT().GetByUserID(gomock.Any(), userID).Return(fromAccount, nil)
-				transferSvc.EXPECT().Transfer(gomock.Any(), fromAccountID, toAccountID, amount, "").Return(nil, util.NewBadRequestError("insufficient funds"))
+				transferSvc.EXPECT().Submit(gomock.Any(), fromAccountID, toAccountID, amount, "").Return(nil, util.NewBadRequestError("insufficient funds"))
 				return authSvc, accountSvc, transferSvc
 			},
 			expectedStatus: http.StatusBadRequest,
@@ -150,6 +342,32 @@ func TestTransfers_Create(t *testing.T) {
 				testutil.AssertHTTPError(t, rec, http.StatusBadRequest, "insufficient funds")
 			},
 		},
+		{
+			name: "transfer over a limit returns the exceeded window",
+			setupAuth: func(headers map[string]string) {
+				headers["Authorization"] = "Bearer " + token
+			},
+			requestBody: map[string]any{"to_account": toAccountID.String(), "amount": "2500.00"},
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockTransferService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				accountSvc := servicemocks.NewMockAccountService(ctrl)
+				transferSvc := servicemocks.NewMockTransferService(ctrl)
+
+				amount := mustDecimal(t, "2500.00")
+
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				accountSvc.EXPECT().GetByUserID(gomock.Any(), userID).Return(fromAccount, nil)
+				transferSvc.EXPECT().Submit(gomock.Any(), fromAccountID, toAccountID, amount, "").Return(nil, util.NewTransferLimitError("daily"))
+				return authSvc, accountSvc, transferSvc
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				if !strings.Contains(rec.Body.String(), `"reason":"transfer_limit_exceeded","window":"daily"`) {
+					t.Fatalf("expected the exceeded limit, got %s", rec.Body.String())
+				}
+				testutil.AssertHTTPError(t, rec, http.StatusUnprocessableEntity, "daily transfer limit exceeded")
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -173,6 +391,79 @@ func TestTransfers_Create(t *testing.T) {
 	}
 }
 
+func TestTransfers_PreviewRecipient(t *testing.T) {
+	t.Parallel()
+
+	token := "header.payload.sig"
+	userID := uuid.MustParse("00000000-0000-0000-0000-0000000000b0")
+	user := &model.User{ID: userID}
+
+	tests := []struct {
+		name           string
+		query          string
+		buildMocks     func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService)
+		assertResponse func(t *testing.T, rec *httptest.ResponseRecorder)
+	}{
+		{
+			name:  "success returns the masked name",
+			query: "?to_email=mario@example.com",
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				accountSvc := servicemocks.NewMockAccountService(ctrl)
+
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				accountSvc.EXPECT().
+					GetRecipient(gomock.Any(), "", "mario@example.com").
+					Return(&model.Recipient{AccountID: uuid.MustParse("00000000-0000-0000-0000-0000000000b1"), Name: "Ma*** R***"}, nil)
+
+				return authSvc, accountSvc
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPStatus(t, rec, http.StatusOK)
+				if body := rec.Body.String(); body != `{"name":"Ma*** R***"}` {
+					t.Fatalf("unexpected body: %s", body)
+				}
+			},
+		},
+		{
+			name:  "unknown user returns 404",
+			query: "?to_username=nobody",
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				accountSvc := servicemocks.NewMockAccountService(ctrl)
+
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				accountSvc.EXPECT().GetRecipient(gomock.Any(), "nobody", "").Return(nil, util.NewNotFoundError("recipient not found"))
+
+				return authSvc, accountSvc
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPError(t, rec, http.StatusNotFound, "recipient not found")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authSvc, accountSvc := tc.buildMocks(ctrl)
+			r := newTestRouter(t, ctrl, authSvc, accountSvc, nil, servicemocks.NewMockTransferService(ctrl))
+
+			req := testutil.NewJSONRequest(http.MethodGet, "/api/v1/transfers/recipient"+tc.query, nil, map[string]string{
+				"Authorization": "Bearer " + token,
+			})
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, req)
+
+			tc.assertResponse(t, rec)
+		})
+	}
+}
+
 func TestTransfers_List(t *testing.T) {
 	t.Parallel()
 
@@ -218,7 +509,7 @@ func TestTransfers_List(t *testing.T) {
 
 				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
 				accountSvc.EXPECT().GetByUserID(gomock.Any(), userID).Return(account, nil)
-				transferSvc.EXPECT().GetByAccountID(gomock.Any(), accountID, 1, 100).Return(&util.PaginatedResponse{}, nil)
+				transferSvc.EXPECT().GetByAccountID(gomock.Any(), accountID, &model.TransferFilter{}, 1, 100).Return(&util.PaginatedResponse{}, nil)
 
 				return authSvc, accountSvc, transferSvc
 			},
@@ -228,33 +519,208 @@ func TestTransfers_List(t *testing.T) {
 			},
 		},
 		{
-			name:      "missing token returns 401",
-			path:      "/api/v1/transfers?page=1&limit=10",
-			setupAuth: func(headers map[string]string) {},
+			name: "empty cursor requests the first page by cursor",
+			path: "/api/v1/transfers?cursor=&limit=20",
+			setupAuth: func(headers map[string]string) {
+				headers["Authorization"] = "Bearer " + token
+			},
 			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockTransferService) {
-				return servicemocks.NewMockAuthService(ctrl), servicemocks.NewMockAccountService(ctrl), servicemocks.NewMockTransferService(ctrl)
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				accountSvc := servicemocks.NewMockAccountService(ctrl)
+				transferSvc := servicemocks.NewMockTransferService(ctrl)
+
+				resp := &util.CursorResponse{Data: []*model.Transfer{}}
+				resp.Pagination.PerPage = 20
+				resp.Pagination.NextCursor = "next"
+
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				accountSvc.EXPECT().GetByUserID(gomock.Any(), userID).Return(account, nil)
+				transferSvc.EXPECT().GetByAccountIDWithCursor(gomock.Any(), accountID, &model.TransferFilter{}, "", 20).Return(resp, nil)
+
+				return authSvc, accountSvc, transferSvc
 			},
-			expectedStatus: http.StatusUnauthorized,
+			expectedStatus: http.StatusOK,
 			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
-				testutil.AssertHTTPError(t, rec, http.StatusUnauthorized, "missing authorization token")
+				testutil.AssertHTTPStatus(t, rec, http.StatusOK)
+				got := testutil.DecodeJSONResponse[struct {
+					Pagination map[string]interface{} `json:"pagination"`
+				}](t, rec)
+				if got.Pagination["next_cursor"] != "next" {
+					t.Fatalf("unexpected pagination: %v", got.Pagination)
+				}
+				if _, ok := got.Pagination["total_items"]; ok {
+					t.Fatalf("unexpected total count in cursor mode: %v", got.Pagination)
+				}
 			},
 		},
-	}
+		{
+			name: "page and cursor together return 400",
+			path: "/api/v1/transfers?page=2&cursor=abc",
+			setupAuth: func(headers map[string]string) {
+				headers["Authorization"] = "Bearer " + token
+			},
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockTransferService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				return authSvc, servicemocks.NewMockAccountService(ctrl), servicemocks.NewMockTransferService(ctrl)
+			},
+			expectedStatus: http.StatusBadRequest,
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPError(t, rec, http.StatusBadRequest, "page and cursor cannot be combined")
+			},
+		},
+		{
+			name: "filter query params are passed to the service",
+			path: "/api/v1/transfers?direction=out&status=completed&to=2026-02-01T00:00:00Z&max_amount=99.99&counterparty_account=00000000-0000-0000-0000-000000000052",
+			setupAuth: func(headers map[string]string) {
+				headers["Authorization"] = "Bearer " + token
+			},
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockTransferService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				accountSvc := servicemocks.NewMockAccountService(ctrl)
+				transferSvc := servicemocks.NewMockTransferService(ctrl)
 
-	for _, tc := range tests {
-		tc := tc
-		t.Run(tc.name, func(t *testing.T) {
-			t.Parallel()
-			ctrl := gomock.NewController(t)
-			defer ctrl.Finish()
+				to := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+				maxAmount := decimal.RequireFromString("99.99")
+				counterparty := uuid.MustParse("00000000-0000-0000-0000-000000000052")
+				filter := &model.TransferFilter{
+					Direction:    "out",
+					Status:       "completed",
+					To:           &to,
+					MaxAmount:    &maxAmount,
+					Counterparty: &counterparty,
+				}
 
-			authSvc, accountSvc, transferSvc := tc.buildMocks(ctrl)
-			r := newTestRouter(t, ctrl, authSvc, accountSvc, nil, transferSvc)
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				accountSvc.EXPECT().GetByUserID(gomock.Any(), userID).Return(account, nil)
+				transferSvc.EXPECT().GetByAccountID(gomock.Any(), accountID, filter, 1, 10).Return(&util.PaginatedResponse{}, nil)
 
-			headers := map[string]string{}
-			tc.setupAuth(headers)
-			req := testutil.NewJSONRequest(http.MethodGet, tc.path, nil, headers)
-			rec := httptest.NewRecorder()
+				return authSvc, accountSvc, transferSvc
+			},
+			expectedStatus: http.StatusOK,
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPStatus(t, rec, http.StatusOK)
+			},
+		},
+		{
+			name: "invalid max_amount returns 400",
+			path: "/api/v1/transfers?max_amount=lots",
+			setupAuth: func(headers map[string]string) {
+				headers["Authorization"] = "Bearer " + token
+			},
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockTransferService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				return authSvc, servicemocks.NewMockAccountService(ctrl), servicemocks.NewMockTransferService(ctrl)
+			},
+			expectedStatus: http.StatusBadRequest,
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPError(t, rec, http.StatusBadRequest, "invalid max_amount")
+			},
+		},
+		{
+			name:      "missing token returns 401",
+			path:      "/api/v1/transfers?page=1&limit=10",
+			setupAuth: func(headers map[string]string) {},
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockTransferService) {
+				return servicemocks.NewMockAuthService(ctrl), servicemocks.NewMockAccountService(ctrl), servicemocks.NewMockTransferService(ctrl)
+			},
+			expectedStatus: http.StatusUnauthorized,
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPError(t, rec, http.StatusUnauthorized, "missing authorization token")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authSvc, accountSvc, transferSvc := tc.buildMocks(ctrl)
+			r := newTestRouter(t, ctrl, authSvc, accountSvc, nil, transferSvc)
+
+			headers := map[string]string{}
+			tc.setupAuth(headers)
+			req := testutil.NewJSONRequest(http.MethodGet, tc.path, nil, headers)
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, req)
+
+			tc.assertResponse(t, rec)
+		})
+	}
+}
+
+func TestTransfers_Get(t *testing.T) {
+	t.Parallel()
+
+	token := "header.payload.sig"
+	userID := uuid.MustParse("00000000-0000-0000-0000-000000000068")
+
+	user := &model.User{ID: userID}
+
+	tests := []struct {
+		name           string
+		path           string
+		buildMocks     func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockTransferService)
+		assertResponse func(t *testing.T, rec *httptest.ResponseRecorder)
+	}{
+		{
+			name: "transfer is returned with its counterparty and movements",
+			path: "/api/v1/transfers/7",
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockTransferService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				transferSvc := servicemocks.NewMockTransferService(ctrl)
+
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				transferSvc.EXPECT().GetForUser(gomock.Any(), userID, uint64(7)).Return(&model.TransferDetails{
+					Transfer:         model.Transfer{ID: 7, Status: "completed"},
+					CounterpartyName: "Ma*** R***",
+					Movements:        []*model.Movement{{ID: 3, Type: "debit"}},
+				}, nil)
+
+				return authSvc, transferSvc
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPStatus(t, rec, http.StatusOK)
+				got := testutil.DecodeJSONResponse[model.TransferDetails](t, rec)
+				if got.ID != 7 || got.Status != "completed" || got.CounterpartyName != "Ma*** R***" || len(got.Movements) != 1 {
+					t.Fatalf("unexpected transfer: %+v", got)
+				}
+			},
+		},
+		{
+			name: "transfer of another user maps to 404",
+			path: "/api/v1/transfers/8",
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockTransferService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				transferSvc := servicemocks.NewMockTransferService(ctrl)
+
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				transferSvc.EXPECT().GetForUser(gomock.Any(), userID, uint64(8)).Return(nil, util.NewNotFoundError("transfer not found"))
+
+				return authSvc, transferSvc
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPError(t, rec, http.StatusNotFound, "transfer not found")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authSvc, transferSvc := tc.buildMocks(ctrl)
+			r := newTestRouter(t, ctrl, authSvc, nil, nil, transferSvc)
+
+			req := testutil.NewJSONRequest(http.MethodGet, tc.path, nil, map[string]string{"Authorization": "Bearer " + token})
+			rec := httptest.NewRecorder()
 			r.ServeHTTP(rec, req)
 
 			tc.assertResponse(t, rec)
@@ -262,3 +728,287 @@ func TestTransfers_List(t *testing.T) {
 	}
 }
 
+func TestTransfers_Cancel(t *testing.T) {
+	t.Parallel()
+
+	token := "header.payload.sig"
+	userID := uuid.MustParse("00000000-0000-0000-0000-000000000060")
+	accountID := uuid.MustParse("00000000-0000-0000-0000-000000000061")
+
+	user := &model.User{ID: userID}
+	account := &model.Account{ID: accountID, UserID: userID, Currency: "EUR"}
+
+	tests := []struct {
+		name           string
+		path           string
+		setupAuth      func(headers map[string]string)
+		buildMocks     func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockTransferService)
+		assertResponse func(t *testing.T, rec *httptest.ResponseRecorder)
+	}{
+		{
+			name: "success",
+			path: "/api/v1/transfers/7/cancel",
+			setupAuth: func(headers map[string]string) {
+				headers["Authorization"] = "Bearer " + token
+			},
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockTransferService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				accountSvc := servicemocks.NewMockAccountService(ctrl)
+				transferSvc := servicemocks.NewMockTransferService(ctrl)
+
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				accountSvc.EXPECT().GetByUserID(gomock.Any(), userID).Return(account, nil)
+				transferSvc.EXPECT().Cancel(gomock.Any(), accountID, uint64(7)).Return(&model.Transfer{ID: 7, FromAccount: accountID, Status: "cancelled"}, nil)
+
+				return authSvc, accountSvc, transferSvc
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPStatus(t, rec, http.StatusOK)
+			},
+		},
+		{
+			name: "transfer already executed maps to 409",
+			path: "/api/v1/transfers/7/cancel",
+			setupAuth: func(headers map[string]string) {
+				headers["Authorization"] = "Bearer " + token
+			},
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockTransferService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				accountSvc := servicemocks.NewMockAccountService(ctrl)
+				transferSvc := servicemocks.NewMockTransferService(ctrl)
+
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				accountSvc.EXPECT().GetByUserID(gomock.Any(), userID).Return(account, nil)
+				transferSvc.EXPECT().Cancel(gomock.Any(), accountID, uint64(7)).Return(nil, util.NewConflictError("only scheduled transfers can be cancelled"))
+
+				return authSvc, accountSvc, transferSvc
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPError(t, rec, http.StatusConflict, "only scheduled transfers can be cancelled")
+			},
+		},
+		{
+			name: "non-int id uses router ErrorHandler envelope",
+			path: "/api/v1/transfers/abc/cancel",
+			setupAuth: func(headers map[string]string) {
+				// binding fails before auth middleware runs
+			},
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockTransferService) {
+				return servicemocks.NewMockAuthService(ctrl), servicemocks.NewMockAccountService(ctrl), servicemocks.NewMockTransferService(ctrl)
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPError(t, rec, http.StatusBadRequest, http.StatusBadRequest)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authSvc, accountSvc, transferSvc := tc.buildMocks(ctrl)
+			r := newTestRouter(t, ctrl, authSvc, accountSvc, nil, transferSvc)
+
+			headers := map[string]string{}
+			tc.setupAuth(headers)
+			req := testutil.NewJSONRequest(http.MethodPost, tc.path, nil, headers)
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, req)
+
+			tc.assertResponse(t, rec)
+		})
+	}
+}
+
+func TestTransfers_Reverse(t *testing.T) {
+	t.Parallel()
+
+	token := "header.payload.sig"
+	userID := uuid.MustParse("00000000-0000-0000-0000-000000000090")
+	accountID := uuid.MustParse("00000000-0000-0000-0000-000000000091")
+	partial, _ := decimal.NewFromString("10.00")
+
+	user := &model.User{ID: userID, Role: "user"}
+	admin := &model.User{ID: userID, Role: "admin"}
+	account := &model.Account{ID: accountID, UserID: userID, Currency: "EUR"}
+
+	tests := []struct {
+		name           string
+		body           any
+		buildMocks     func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockTransferService)
+		assertResponse func(t *testing.T, rec *httptest.ResponseRecorder)
+	}{
+		{
+			name: "receiver reverses part of a transfer",
+			body: map[string]any{"amount": "10.00"},
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockTransferService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				accountSvc := servicemocks.NewMockAccountService(ctrl)
+				transferSvc := servicemocks.NewMockTransferService(ctrl)
+
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				accountSvc.EXPECT().GetByUserID(gomock.Any(), userID).Return(account, nil)
+				transferSvc.EXPECT().Reverse(gomock.Any(), &accountID, uint64(7), partial, "").Return(&model.Transfer{ID: 8, Status: "completed"}, nil)
+
+				return authSvc, accountSvc, transferSvc
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPStatus(t, rec, http.StatusCreated)
+			},
+		},
+		{
+			name: "admin reverses without an account and body",
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockTransferService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				transferSvc := servicemocks.NewMockTransferService(ctrl)
+
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(admin, nil)
+				transferSvc.EXPECT().Reverse(gomock.Any(), nil, uint64(7), decimal.Zero, "").Return(&model.Transfer{ID: 8, Status: "completed"}, nil)
+
+				return authSvc, servicemocks.NewMockAccountService(ctrl), transferSvc
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPStatus(t, rec, http.StatusCreated)
+			},
+		},
+		{
+			name: "sender is forbidden",
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockTransferService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				accountSvc := servicemocks.NewMockAccountService(ctrl)
+				transferSvc := servicemocks.NewMockTransferService(ctrl)
+
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				accountSvc.EXPECT().GetByUserID(gomock.Any(), userID).Return(account, nil)
+				transferSvc.EXPECT().Reverse(gomock.Any(), &accountID, uint64(7), decimal.Zero, "").Return(nil, util.NewForbiddenError("only the receiving account can reverse a transfer"))
+
+				return authSvc, accountSvc, transferSvc
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPError(t, rec, http.StatusForbidden, "only the receiving account can reverse a transfer")
+			},
+		},
+		{
+			name: "negative amount returns 400",
+			body: map[string]any{"amount": "-5"},
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockAccountService, *servicemocks.MockTransferService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+
+				return authSvc, servicemocks.NewMockAccountService(ctrl), servicemocks.NewMockTransferService(ctrl)
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPError(t, rec, http.StatusBadRequest, "invalid amount")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authSvc, accountSvc, transferSvc := tc.buildMocks(ctrl)
+			r := newTestRouter(t, ctrl, authSvc, accountSvc, nil, transferSvc)
+
+			req := testutil.NewJSONRequest(http.MethodPost, "/api/v1/transfers/7/reverse", tc.body, map[string]string{
+				"Authorization": "Bearer " + token,
+			})
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, req)
+
+			tc.assertResponse(t, rec)
+		})
+	}
+}
+
+func TestTransfers_Decide(t *testing.T) {
+	t.Parallel()
+
+	token := "header.payload.sig"
+	userID := uuid.MustParse("00000000-0000-0000-0000-000000000095")
+	user := &model.User{ID: userID}
+
+	tests := []struct {
+		name           string
+		path           string
+		body           any
+		buildMocks     func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockTransferService)
+		assertResponse func(t *testing.T, rec *httptest.ResponseRecorder)
+	}{
+		{
+			name: "co-signer approves a transfer",
+			path: "/api/v1/transfers/7/approve",
+			body: map[string]any{"note": "checked the invoice"},
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockTransferService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				transferSvc := servicemocks.NewMockTransferService(ctrl)
+
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				transferSvc.EXPECT().Approve(gomock.Any(), userID, uint64(7), "checked the invoice").Return(&model.Transfer{ID: 7, Status: "pending"}, nil)
+
+				return authSvc, transferSvc
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPStatus(t, rec, http.StatusAccepted)
+			},
+		},
+		{
+			name: "co-signer rejects a transfer without a note",
+			path: "/api/v1/transfers/7/reject",
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockTransferService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				transferSvc := servicemocks.NewMockTransferService(ctrl)
+
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				transferSvc.EXPECT().Reject(gomock.Any(), userID, uint64(7), "").Return(&model.Transfer{ID: 7, Status: "failed"}, nil)
+
+				return authSvc, transferSvc
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPStatus(t, rec, http.StatusOK)
+			},
+		},
+		{
+			name: "expired approval maps to 409",
+			path: "/api/v1/transfers/7/approve",
+			buildMocks: func(ctrl *gomock.Controller) (*servicemocks.MockAuthService, *servicemocks.MockTransferService) {
+				authSvc := servicemocks.NewMockAuthService(ctrl)
+				transferSvc := servicemocks.NewMockTransferService(ctrl)
+
+				authSvc.EXPECT().VerifyToken(gomock.Any(), token).Return(user, nil)
+				transferSvc.EXPECT().Approve(gomock.Any(), userID, uint64(7), "").Return(nil, util.NewConflictError("approval has expired"))
+
+				return authSvc, transferSvc
+			},
+			assertResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				testutil.AssertHTTPError(t, rec, http.StatusConflict, "approval has expired")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authSvc, transferSvc := tc.buildMocks(ctrl)
+			r := newTestRouter(t, ctrl, authSvc, nil, nil, transferSvc)
+
+			req := testutil.NewJSONRequest(http.MethodPost, tc.path, tc.body, map[string]string{
+				"Authorization": "Bearer " + token,
+			})
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, req)
+
+			tc.assertResponse(t, rec)
+		})
+	}
+}
@@ -0,0 +1,216 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"VDM2-BankBE/internal/model"
+	"VDM2-BankBE/internal/service"
+	"VDM2-BankBE/internal/util"
+)
+
+// IdempotencyKeyHeader is the request header carrying the client-generated idempotency key
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// maxIdempotencyKeyLength bounds the size of stored keys
+const maxIdempotencyKeyLength = 255
+
+// idempotencyReservationKey is the Gin context key set once a key has been reserved for a request
+const idempotencyReservationKey = "idempotency_reservation"
+
+// idempotencyReservation identifies a reserved key whose response still has to be stored
+type idempotencyReservation struct {
+	userID uuid.UUID
+	key    string
+}
+
+// IdempotencyMiddleware replays stored responses for retried mutating requests
+type IdempotencyMiddleware struct {
+	idempotencyService service.IdempotencyService
+	logger             *zap.Logger
+}
+
+// NewIdempotencyMiddleware creates a new idempotency middleware
+func NewIdempotencyMiddleware(idempotencyService service.IdempotencyService, logger *zap.Logger) *IdempotencyMiddleware {
+	return &IdempotencyMiddleware{
+		idempotencyService: idempotencyService,
+		logger:             logger,
+	}
+}
+
+// CheckFunc looks up the Idempotency-Key of mutating requests and replays the stored response when present.
+//
+// IMPORTANT: This is intended for `oapi-codegen` generated HandlerMiddlewares, so it must NOT call c.Next().
+// It must run after authentication because keys are scoped per user.
+func (m *IdempotencyMiddleware) CheckFunc() func(c *gin.Context) {
+	return func(c *gin.Context) {
+		if !isMutatingMethod(c.Request.Method) {
+			return
+		}
+
+		key := strings.TrimSpace(c.GetHeader(IdempotencyKeyHeader))
+		if key == "" {
+			return
+		}
+		if len(key) > maxIdempotencyKeyLength {
+			c.AbortWithStatusJSON(http.StatusBadRequest, util.ErrorResponse{
+				Error: util.NewBadRequestError("idempotency key is too long"),
+			})
+			return
+		}
+
+		// Keys are only honoured for authenticated users
+		user, exists := c.Get("user")
+		if !exists {
+			return
+		}
+		userModel, ok := user.(*model.User)
+		if !ok {
+			m.logger.Error("failed to get user from context")
+			return
+		}
+
+		// Read the body for hashing and restore it for the handler
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, util.ErrorResponse{
+				Error: util.NewBadRequestError("invalid request body"),
+			})
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		record, err := m.idempotencyService.Begin(c, userModel.ID, key, hashRequest(c.Request, body))
+		if err != nil {
+			apiErr, ok := err.(*util.APIError)
+			if !ok {
+				m.logger.Error("failed to check idempotency key", zap.Error(err))
+				apiErr = util.NewInternalServerError("internal server error")
+			}
+			c.AbortWithStatusJSON(apiErr.Code, util.ErrorResponse{Error: apiErr})
+			return
+		}
+
+		// Replay the stored response
+		if record != nil {
+			c.Header("Idempotent-Replayed", "true")
+			c.Data(record.StatusCode, "application/json; charset=utf-8", record.ResponseBody)
+			c.Abort()
+			return
+		}
+
+		c.Set(idempotencyReservationKey, idempotencyReservation{userID: userModel.ID, key: key})
+	}
+}
+
+// Capture records the response of requests that reserved an idempotency key in CheckFunc.
+// It must be registered on the engine so that it wraps the generated handlers.
+func (m *IdempotencyMiddleware) Capture() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// Only requests that may reserve a key need their response buffered
+		if !isMutatingMethod(c.Request.Method) || c.GetHeader(IdempotencyKeyHeader) == "" {
+			c.Next()
+			return
+		}
+
+		writer := &bodyCaptureWriter{ResponseWriter: c.Writer}
+		c.Writer = writer
+
+		finished := false
+		defer func() {
+			// The handler panicked: free the key so the client can retry
+			if !finished {
+				m.release(c)
+			}
+		}()
+
+		// Process request
+		c.Next()
+		finished = true
+
+		// Server errors are not stored so that retries can succeed
+		if writer.Status() >= http.StatusInternalServerError {
+			m.release(c)
+			return
+		}
+
+		reservation, ok := getReservation(c)
+		if !ok {
+			return
+		}
+		if err := m.idempotencyService.Complete(c, reservation.userID, reservation.key, writer.Status(), writer.body.Bytes()); err != nil {
+			m.logger.Error("failed to store idempotent response",
+				zap.String("user_id", reservation.userID.String()),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+// release frees a reserved key, if any
+func (m *IdempotencyMiddleware) release(c *gin.Context) {
+	reservation, ok := getReservation(c)
+	if !ok {
+		return
+	}
+	if err := m.idempotencyService.Release(c, reservation.userID, reservation.key); err != nil {
+		m.logger.Error("failed to release idempotency key",
+			zap.String("user_id", reservation.userID.String()),
+			zap.Error(err),
+		)
+	}
+}
+
+// getReservation returns the reservation made by CheckFunc for the current request
+func getReservation(c *gin.Context) (idempotencyReservation, bool) {
+	value, exists := c.Get(idempotencyReservationKey)
+	if !exists {
+		return idempotencyReservation{}, false
+	}
+	reservation, ok := value.(idempotencyReservation)
+	return reservation, ok
+}
+
+// isMutatingMethod reports whether requests with this method change server state
+func isMutatingMethod(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// hashRequest fingerprints the method, path, query and body of a request
+func hashRequest(r *http.Request, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(r.Method + " " + r.URL.Path + "?" + r.URL.RawQuery + "\n"))
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// bodyCaptureWriter copies everything written to the response into a buffer
+type bodyCaptureWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+// Write writes the data to the connection and the buffer
+func (w *bodyCaptureWriter) Write(data []byte) (int, error) {
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+// WriteString writes the string to the connection and the buffer
+func (w *bodyCaptureWriter) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
@@ -0,0 +1,151 @@
+package middleware_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"VDM2-BankBE/internal/middleware"
+	"VDM2-BankBE/internal/model"
+	servicemocks "VDM2-BankBE/internal/service/mocks"
+	"VDM2-BankBE/internal/testutil"
+	"VDM2-BankBE/internal/util"
+)
+
+func TestIdempotencyMiddleware(t *testing.T) {
+	t.Parallel()
+
+	user := &model.User{ID: uuid.MustParse("00000000-0000-0000-0000-000000000002")}
+	headers := map[string]string{middleware.IdempotencyKeyHeader: "key-1"}
+
+	type request struct {
+		query string
+		body  any
+	}
+	first := request{query: "account_id=1&mode=best_effort", body: map[string]string{"amount": "10.00"}}
+
+	tests := []struct {
+		name string
+		// firstStatus is the status the handler answers the first request with
+		firstStatus  int
+		retry        request
+		wantStatus   int
+		wantReplayed bool
+		wantCalls    int
+		wantReleases int
+	}{
+		{
+			name:         "retried request replays the stored response",
+			firstStatus:  http.StatusCreated,
+			retry:        first,
+			wantStatus:   http.StatusCreated,
+			wantReplayed: true,
+			wantCalls:    1,
+		},
+		{
+			name:        "key reused with a different body returns 422",
+			firstStatus: http.StatusCreated,
+			retry:       request{query: first.query, body: map[string]string{"amount": "20.00"}},
+			wantStatus:  http.StatusUnprocessableEntity,
+			wantCalls:   1,
+		},
+		{
+			name:        "key reused with a different query returns 422",
+			firstStatus: http.StatusCreated,
+			retry:       request{query: "account_id=1&mode=all_or_nothing", body: first.body},
+			wantStatus:  http.StatusUnprocessableEntity,
+			wantCalls:   1,
+		},
+		{
+			name:         "server error releases the key for the retry",
+			firstStatus:  http.StatusInternalServerError,
+			retry:        first,
+			wantStatus:   http.StatusCreated,
+			wantCalls:    2,
+			wantReleases: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// The service keeps the keys in memory, rejecting a key reused with another fingerprint
+			records := make(map[string]*model.IdempotencyKey)
+			idempotencySvc := servicemocks.NewMockIdempotencyService(ctrl)
+			idempotencySvc.EXPECT().Begin(gomock.Any(), user.ID, "key-1", gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ uuid.UUID, key, requestHash string) (*model.IdempotencyKey, error) {
+					record, ok := records[key]
+					if !ok {
+						records[key] = &model.IdempotencyKey{UserID: user.ID, Key: key, RequestHash: requestHash}
+						return nil, nil
+					}
+					if record.RequestHash != requestHash {
+						return nil, util.NewUnprocessableEntityError("idempotency key reused with a different request")
+					}
+					return record, nil
+				}).
+				Times(2)
+			idempotencySvc.EXPECT().Complete(gomock.Any(), user.ID, "key-1", gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ uuid.UUID, key string, statusCode int, body []byte) error {
+					records[key].StatusCode = statusCode
+					records[key].ResponseBody = body
+					return nil
+				}).
+				AnyTimes()
+			idempotencySvc.EXPECT().Release(gomock.Any(), user.ID, "key-1").
+				DoAndReturn(func(_ context.Context, _ uuid.UUID, key string) error {
+					delete(records, key)
+					return nil
+				}).
+				Times(tc.wantReleases)
+
+			mw := middleware.NewIdempotencyMiddleware(idempotencySvc, zap.NewNop())
+			checkFn := mw.CheckFunc()
+
+			calls := 0
+			r := gin.New()
+			r.Use(mw.Capture())
+			r.POST("/transfers/batches", func(c *gin.Context) {
+				c.Set("user", user)
+
+				checkFn(c)
+				if c.IsAborted() {
+					return
+				}
+
+				calls++
+				status := http.StatusCreated
+				if calls == 1 {
+					status = tc.firstStatus
+				}
+				c.JSON(status, gin.H{"call": calls})
+			})
+
+			for i, req := range []request{first, tc.retry} {
+				rec := httptest.NewRecorder()
+				r.ServeHTTP(rec, testutil.NewJSONRequest(http.MethodPost, "/transfers/batches?"+req.query, req.body, headers))
+				if i == 1 {
+					testutil.AssertHTTPStatus(t, rec, tc.wantStatus)
+					if replayed := rec.Header().Get("Idempotent-Replayed") == "true"; replayed != tc.wantReplayed {
+						t.Fatalf("unexpected replay: got=%v want=%v", replayed, tc.wantReplayed)
+					}
+				}
+			}
+
+			if calls != tc.wantCalls {
+				t.Fatalf("unexpected handler calls: got=%d want=%d", calls, tc.wantCalls)
+			}
+		})
+	}
+}
@@ -16,30 +16,197 @@ type User struct {
 	LastName     string    `gorm:"not null" json:"last_name"`
 	FiscalCode   string    `gorm:"uniqueIndex;not null" json:"fiscal_code"`
 	PasswordHash string    `gorm:"not null" json:"-"`
+	Role         string    `gorm:"type:text;not null;default:'user';check:role IN ('user','admin')" json:"role"`
 	CreatedAt    time.Time `json:"created_at"`
 	UpdatedAt    time.Time `json:"updated_at"`
 }
 
-// Account represents a user's bank account
+// Account represents a user's bank account.
+// A user can hold several accounts; the default one is used whenever a request does not pick an account.
+// Frozen accounts can neither send nor receive funds, debit_blocked accounts can only receive them and
+// closed accounts are kept read-only for statements.
+// Debits can take the balance down to -OverdraftLimit; OverdraftRate is the yearly interest rate, in percent,
+// charged on the overdrawn balance.
+// HeldAmount is the total of the active holds of the account, reserved out of its available balance.
 type Account struct {
-	ID        uuid.UUID       `gorm:"type:uuid;primaryKey" json:"id"`
-	UserID    uuid.UUID       `gorm:"type:uuid;not null" json:"user_id"`
-	User      User            `gorm:"foreignKey:UserID" json:"-"`
-	Balance   decimal.Decimal `gorm:"type:numeric(18,2);not null;default:0" json:"balance"`
-	Currency  string          `gorm:"type:text;not null;default:'EUR'" json:"currency"`
-	CreatedAt time.Time       `json:"created_at"`
-	UpdatedAt time.Time       `json:"updated_at"`
+	ID             uuid.UUID       `gorm:"type:uuid;primaryKey" json:"id"`
+	UserID         uuid.UUID       `gorm:"type:uuid;not null" json:"user_id"`
+	User           User            `gorm:"foreignKey:UserID" json:"-"`
+	Type           string          `gorm:"type:text;not null;default:'checking';check:type IN ('checking','savings','pocket','business')" json:"type"`
+	Name           string          `gorm:"type:text;not null;default:''" json:"name"`
+	IsDefault      bool            `gorm:"not null;default:false" json:"is_default"`
+	IBAN           string          `gorm:"type:varchar(34);uniqueIndex;not null" json:"iban"`
+	Status         string          `gorm:"type:text;not null;default:'active';check:status IN ('active','frozen','debit_blocked','closed')" json:"status"`
+	Balance        decimal.Decimal `gorm:"type:numeric(19,3);not null;default:0" json:"balance"`
+	Currency       string          `gorm:"type:text;not null;default:'EUR'" json:"currency"`
+	OverdraftLimit decimal.Decimal `gorm:"type:numeric(19,3);not null;default:0;check:overdraft_limit >= 0" json:"overdraft_limit"`
+	OverdraftRate  decimal.Decimal `gorm:"type:numeric(5,2);not null;default:0;check:overdraft_rate >= 0" json:"overdraft_rate"`
+	HeldAmount     decimal.Decimal `gorm:"type:numeric(19,3);not null;default:0;check:held_amount >= 0" json:"held_amount"`
+	ClosedAt       *time.Time      `json:"closed_at"`
+	CreatedAt      time.Time       `json:"created_at"`
+	UpdatedAt      time.Time       `json:"updated_at"`
+}
+
+// AvailableBalance returns the funds that can be taken from the account: the ledger balance less the active holds,
+// overdraft included
+func (a *Account) AvailableBalance() decimal.Decimal {
+	return a.Balance.Sub(a.HeldAmount).Add(a.OverdraftLimit)
+}
+
+// CanSend reports whether funds can be taken from the account
+func (a *Account) CanSend() bool {
+	return a.Status == "active"
+}
+
+// CanReceive reports whether funds can be added to the account
+func (a *Account) CanReceive() bool {
+	return a.Status == "active" || a.Status == "debit_blocked"
 }
 
-// Movement represents a transaction within an account
+// Balance is a snapshot of the balances of an account
+type Balance struct {
+	AccountID uuid.UUID
+	// Ledger is the balance of the account as booked in the ledger
+	Ledger decimal.Decimal
+	// Held is the total of the active holds
+	Held           decimal.Decimal
+	OverdraftLimit decimal.Decimal
+	// Available is what can be spent: Ledger - Held + OverdraftLimit
+	Available decimal.Decimal
+	Currency  string
+}
+
+// Recipient is the default account of the user a transfer alias (username or email) resolves to.
+// Only the masked name of the user is shown to the sender, to confirm the recipient before transferring.
+type Recipient struct {
+	AccountID uuid.UUID `json:"-"`
+	Name      string    `json:"name"`
+}
+
+// Movement represents a transaction within an account, as shown on its statement.
+// JournalEntryID links it to the ledger entry that moved the funds; HoldID to the hold it captured, if any.
+// Amount is in the currency of the account. Movements of transfers between accounts of different currencies also
+// record the amount sent (OriginalAmount), the amount received (ConvertedAmount) and the rate it was converted at.
 type Movement struct {
+	ID             uint64          `gorm:"primaryKey;autoIncrement" json:"id"`
+	AccountID      uuid.UUID       `gorm:"type:uuid;not null" json:"account_id"`
+	Account        Account         `gorm:"foreignKey:AccountID" json:"-"`
+	Amount         decimal.Decimal `gorm:"type:numeric(19,3);not null" json:"amount"`
+	Type           string          `gorm:"type:text;not null;check:type IN ('credit','debit')" json:"type"`
+	Description    string          `gorm:"type:text" json:"description"`
+	OccurredAt     time.Time       `gorm:"not null;default:now()" json:"occurred_at"`
+	BalanceAfter   decimal.Decimal `gorm:"type:numeric(19,3);not null" json:"balance_after"`
+	JournalEntryID *uint64         `json:"journal_entry_id"`
+	HoldID         *uint64         `json:"hold_id"`
+	// Set for converted transfers only
+	OriginalAmount    *decimal.Decimal `gorm:"type:numeric(19,3)" json:"original_amount,omitempty"`
+	OriginalCurrency  *string          `gorm:"type:text" json:"original_currency,omitempty"`
+	ConvertedAmount   *decimal.Decimal `gorm:"type:numeric(19,3)" json:"converted_amount,omitempty"`
+	ConvertedCurrency *string          `gorm:"type:text" json:"converted_currency,omitempty"`
+	FXRate            *decimal.Decimal `gorm:"column:fx_rate;type:numeric(20,10)" json:"fx_rate,omitempty"`
+}
+
+// MovementFilter narrows down and sorts the movements of an account. Zero values do not filter; From is inclusive
+// and To exclusive. Search matches a part of the description, ignoring case. Movements are sorted by Sort
+// (occurred_at or amount) in Order (asc or desc), the most recent first by default.
+type MovementFilter struct {
+	From      *time.Time
+	To        *time.Time
+	Type      string
+	MinAmount *decimal.Decimal
+	MaxAmount *decimal.Decimal
+	Search    string
+	Sort      string
+	Order     string
+}
+
+// MovementDetails is a movement with the transfer that posted it, if any, and the masked name of the owner of the
+// account on the other side of that transfer
+type MovementDetails struct {
+	Movement
+	Transfer         *Transfer `json:"transfer"`
+	CounterpartyName string    `json:"counterparty_name,omitempty"`
+}
+
+// Hold reserves funds of an account, e.g. for a card authorization, until it is captured into a debit movement,
+// released or it expires. Active holds reduce the available balance of the account but not its ledger balance.
+// A capture can take less than the held amount; the rest is given back to the available balance.
+type Hold struct {
+	ID             uint64          `gorm:"primaryKey;autoIncrement" json:"id"`
+	AccountID      uuid.UUID       `gorm:"type:uuid;not null" json:"account_id"`
+	Account        Account         `gorm:"foreignKey:AccountID" json:"-"`
+	Amount         decimal.Decimal `gorm:"type:numeric(19,3);not null;check:amount > 0" json:"amount"`
+	CapturedAmount decimal.Decimal `gorm:"type:numeric(19,3);not null;default:0" json:"captured_amount"`
+	Description    string          `gorm:"type:text;not null;default:''" json:"description"`
+	Status         string          `gorm:"type:text;not null;default:'active';check:status IN ('active','captured','released','expired')" json:"status"`
+	ExpiresAt      time.Time       `gorm:"not null" json:"expires_at"`
+	CreatedAt      time.Time       `json:"created_at"`
+	UpdatedAt      time.Time       `json:"updated_at"`
+}
+
+// PaymentRequest is a request for money sent by a user (the requester) to another user (the payer).
+// Paying an open request executes a regular transfer from an account of the payer to ToAccount, an account of
+// the requester, and links it through TransferID. Open requests expire at ExpiresAt.
+type PaymentRequest struct {
 	ID          uint64          `gorm:"primaryKey;autoIncrement" json:"id"`
-	AccountID   uuid.UUID       `gorm:"type:uuid;not null" json:"account_id"`
-	Account     Account         `gorm:"foreignKey:AccountID" json:"-"`
-	Amount      decimal.Decimal `gorm:"type:numeric(18,2);not null" json:"amount"`
-	Type        string          `gorm:"type:text;not null;check:type IN ('credit','debit')" json:"type"`
-	Description string          `gorm:"type:text" json:"description"`
-	OccurredAt  time.Time       `gorm:"not null;default:now()" json:"occurred_at"`
+	RequesterID uuid.UUID       `gorm:"type:uuid;not null;index" json:"requester_id"`
+	Requester   User            `gorm:"foreignKey:RequesterID" json:"-"`
+	PayerID     uuid.UUID       `gorm:"type:uuid;not null;index" json:"payer_id"`
+	Payer       User            `gorm:"foreignKey:PayerID" json:"-"`
+	ToAccount   uuid.UUID       `gorm:"type:uuid;not null" json:"to_account"`
+	Amount      decimal.Decimal `gorm:"type:numeric(19,3);not null;check:amount > 0" json:"amount"`
+	Message     string          `gorm:"type:text;not null;default:''" json:"message"`
+	Status      string          `gorm:"type:text;not null;default:'open';check:status IN ('open','paid','declined','expired','cancelled')" json:"status"`
+	ExpiresAt   time.Time       `gorm:"not null" json:"expires_at"`
+	TransferID  *uint64         `json:"transfer_id"`
+	CreatedAt   time.Time       `json:"created_at"`
+	UpdatedAt   time.Time       `json:"updated_at"`
+}
+
+// Beneficiary is an entry of a user's address book naming an account the user sends transfers to.
+// IBAN is the IBAN of the account, kept so that the entry can be displayed without loading the account.
+type Beneficiary struct {
+	ID         uint64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	UserID     uuid.UUID  `gorm:"type:uuid;not null;uniqueIndex:idx_beneficiaries_user_account" json:"user_id"`
+	User       User       `gorm:"foreignKey:UserID" json:"-"`
+	Nickname   string     `gorm:"type:text;not null" json:"nickname"`
+	AccountID  uuid.UUID  `gorm:"type:uuid;not null;uniqueIndex:idx_beneficiaries_user_account" json:"account_id"`
+	Account    Account    `gorm:"foreignKey:AccountID" json:"-"`
+	IBAN       string     `gorm:"type:varchar(34);not null" json:"iban"`
+	CreatedAt  time.Time  `json:"created_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+}
+
+// AccountCosigner is a user other than the owner of a business account who can approve the transfers sent from it
+type AccountCosigner struct {
+	AccountID uuid.UUID `gorm:"type:uuid;primaryKey" json:"account_id"`
+	UserID    uuid.UUID `gorm:"type:uuid;primaryKey" json:"user_id"`
+	Username  string    `gorm:"->;-:migration" json:"username"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// TransferLimit holds the transfer limits a user lowered for themselves. Nil limits fall back to the configured
+// defaults; limits can be lowered but never raised above the defaults.
+type TransferLimit struct {
+	UserID         uuid.UUID        `gorm:"type:uuid;primaryKey" json:"user_id"`
+	User           User             `gorm:"foreignKey:UserID" json:"-"`
+	PerTransaction *decimal.Decimal `gorm:"type:numeric(18,2);check:per_transaction > 0" json:"per_transaction"`
+	Daily          *decimal.Decimal `gorm:"type:numeric(18,2);check:daily > 0" json:"daily"`
+	Monthly        *decimal.Decimal `gorm:"type:numeric(18,2);check:monthly > 0" json:"monthly"`
+	UpdatedAt      time.Time        `json:"updated_at"`
+}
+
+// TransferAllowance reports the transfer limits in force for a user and how much of them is left.
+// Outgoing completed and pending transfers to other users count against the daily and monthly limits.
+type TransferAllowance struct {
+	Currency            string          `json:"currency"`
+	PerTransactionLimit decimal.Decimal `json:"per_transaction_limit"`
+	DailyLimit          decimal.Decimal `json:"daily_limit"`
+	DailyUsed           decimal.Decimal `json:"daily_used"`
+	DailyRemaining      decimal.Decimal `json:"daily_remaining"`
+	MonthlyLimit        decimal.Decimal `json:"monthly_limit"`
+	MonthlyUsed         decimal.Decimal `json:"monthly_used"`
+	MonthlyRemaining    decimal.Decimal `json:"monthly_remaining"`
 }
 
 // OAuthToken represents an OAuth token for a user
@@ -52,15 +219,301 @@ type OAuthToken struct {
 	ExpiresAt    time.Time `json:"expires_at"`
 }
 
-// Transfer represents a transfer between two accounts
+// Transfer represents a transfer between two accounts.
+// Future-dated transfers start as `scheduled` and move to `pending` when they are picked up for execution.
+// A reversal is a compensating transfer in the opposite direction linked to the original through ReversalOf;
+// ReversedAmount tracks how much of a transfer has been given back so far.
+// Amount is in Currency, the currency of the sending account. Transfers to an account of another currency are
+// converted at the rate of the FX quote QuoteID; ConvertedAmount is what the receiving account gets.
+// Fee is charged to the sending account on top of Amount, as a movement of its own.
+// Transfers above the approval threshold from business accounts start as `awaiting_approval` and move to
+// `pending` once a co-signer approves them, or to `failed` when rejected or not decided by ApprovalExpiresAt.
+// Transfers submitted through the API stay `pending` until their TransferOutbox message is processed.
 type Transfer struct {
-	ID          uint64          `gorm:"primaryKey;autoIncrement" json:"id"`
-	FromAccount uuid.UUID       `gorm:"type:uuid;not null" json:"from_account"`
-	ToAccount   uuid.UUID       `gorm:"type:uuid;not null" json:"to_account"`
-	Amount      decimal.Decimal `gorm:"type:numeric(18,2);not null" json:"amount"`
-	Status      string          `gorm:"type:text;not null;check:status IN ('pending','completed','failed')" json:"status"`
-	InitiatedAt time.Time       `gorm:"not null;default:now()" json:"initiated_at"`
-	CompletedAt *time.Time      `json:"completed_at"`
+	ID             uint64          `gorm:"primaryKey;autoIncrement" json:"id"`
+	FromAccount    uuid.UUID       `gorm:"type:uuid;not null" json:"from_account"`
+	ToAccount      uuid.UUID       `gorm:"type:uuid;not null" json:"to_account"`
+	Amount         decimal.Decimal `gorm:"type:numeric(19,3);not null" json:"amount"`
+	Description    string          `gorm:"type:text;not null;default:''" json:"description"`
+	Status         string          `gorm:"type:text;not null;check:status IN ('scheduled','awaiting_approval','pending','completed','failed','cancelled')" json:"status"`
+	InitiatedAt    time.Time       `gorm:"not null;default:now()" json:"initiated_at"`
+	ExecuteAt      *time.Time      `json:"execute_at"`
+	CompletedAt    *time.Time      `json:"completed_at"`
+	ReversalOf     *uint64         `json:"reversal_of"`
+	ReversedAmount decimal.Decimal `gorm:"type:numeric(19,3);not null;default:0" json:"reversed_amount"`
+	Currency       string          `gorm:"type:text;not null;default:'EUR'" json:"currency"`
+	// Set for converted transfers only
+	ConvertedAmount   *decimal.Decimal `gorm:"type:numeric(19,3)" json:"converted_amount,omitempty"`
+	ConvertedCurrency *string          `gorm:"type:text" json:"converted_currency,omitempty"`
+	FXRate            *decimal.Decimal `gorm:"column:fx_rate;type:numeric(20,10)" json:"fx_rate,omitempty"`
+	QuoteID           *uuid.UUID       `gorm:"type:uuid" json:"quote_id,omitempty"`
+	// Fee charged to the sending account on top of Amount, with how it was computed
+	Fee          decimal.Decimal `gorm:"type:numeric(19,3);not null;default:0" json:"fee"`
+	FeeBreakdown *FeeBreakdown   `gorm:"type:jsonb;serializer:json" json:"fee_breakdown,omitempty"`
+	// Set while the transfer waits for approval
+	ApprovalExpiresAt *time.Time `json:"approval_expires_at,omitempty"`
+	// Set on listed transfers only: in or out, relative to the account they are listed for
+	Direction string `gorm:"-" json:"direction,omitempty"`
+}
+
+// TransferFilter narrows down the transfers of an account. Zero values do not filter; From is inclusive and To
+// exclusive on initiated_at. Direction is in (received by the account) or out (sent by it), and Counterparty the
+// account on the other side.
+type TransferFilter struct {
+	Direction    string
+	Status       string
+	From         *time.Time
+	To           *time.Time
+	MinAmount    *decimal.Decimal
+	MaxAmount    *decimal.Decimal
+	Counterparty *uuid.UUID
+}
+
+// TransferDetails is a transfer as seen by a user on one of its sides: the masked name of the owner of the account
+// on the other side, and the movements the transfer posted on the accounts the user can see
+type TransferDetails struct {
+	Transfer
+	CounterpartyName string      `json:"counterparty_name"`
+	Movements        []*Movement `json:"movements"`
+}
+
+// TransferOutbox is the message queuing the execution of a pending transfer, written in the same transaction as
+// the transfer. It is retried with backoff from NextAttemptAt until ProcessedAt is set; LockedUntil hides it from
+// the other workers while one processes it.
+type TransferOutbox struct {
+	ID            uint64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	TransferID    uint64     `gorm:"not null;uniqueIndex" json:"transfer_id"`
+	Attempts      int        `gorm:"not null;default:0" json:"attempts"`
+	NextAttemptAt time.Time  `gorm:"not null;default:now()" json:"next_attempt_at"`
+	LockedUntil   *time.Time `json:"locked_until"`
+	LastError     string     `gorm:"type:text;not null;default:''" json:"last_error"`
+	ProcessedAt   *time.Time `json:"processed_at"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+// TransferApproval is an entry of the decision trail of a transfer held for approval: its request by the sender,
+// its approval or rejection by a co-signer, or its expiry, which has no user
+type TransferApproval struct {
+	ID         uint64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	TransferID uint64     `gorm:"not null;index" json:"transfer_id"`
+	UserID     *uuid.UUID `gorm:"type:uuid" json:"user_id"`
+	Decision   string     `gorm:"type:text;not null;check:decision IN ('requested','approved','rejected','expired')" json:"decision"`
+	Note       string     `gorm:"type:text;not null;default:''" json:"note"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// FeeBreakdown details how the fee of an operation was computed from the rule of its operation type. Total is
+// what is charged, in Currency; it is zero for operations without a rule.
+type FeeBreakdown struct {
+	Operation        string          `json:"operation"`
+	Rule             string          `json:"rule"`
+	Amount           decimal.Decimal `json:"amount"`
+	Currency         string          `json:"currency"`
+	Tier             int             `json:"tier,omitempty"`
+	Flat             decimal.Decimal `json:"flat"`
+	Percentage       decimal.Decimal `json:"percentage"`
+	PercentageAmount decimal.Decimal `json:"percentage_amount"`
+	CappedAt         string          `json:"capped_at,omitempty"`
+	Total            decimal.Decimal `json:"total"`
+}
+
+// ReversibleAmount returns the part of the transfer that has not been reversed yet
+func (t *Transfer) ReversibleAmount() decimal.Decimal {
+	return t.Amount.Sub(t.ReversedAmount)
+}
+
+// FXRate is the exchange rate between two currencies: one unit of BaseCurrency buys Rate units of QuoteCurrency.
+// The rate of the opposite direction is derived from it when not set on its own.
+type FXRate struct {
+	BaseCurrency  string          `gorm:"type:text;primaryKey" json:"base_currency"`
+	QuoteCurrency string          `gorm:"type:text;primaryKey" json:"quote_currency"`
+	Rate          decimal.Decimal `gorm:"type:numeric(20,10);not null;check:rate > 0" json:"rate"`
+	UpdatedAt     time.Time       `json:"updated_at"`
+}
+
+// FXQuote locks the rate for converting Amount from FromCurrency into ConvertedAmount of ToCurrency until
+// ExpiresAt. A quote is used by a single transfer of the user who asked for it; UsedAt is set when it is.
+type FXQuote struct {
+	ID              uuid.UUID       `gorm:"type:uuid;primaryKey" json:"id"`
+	UserID          uuid.UUID       `gorm:"type:uuid;not null;index" json:"-"`
+	User            User            `gorm:"foreignKey:UserID" json:"-"`
+	FromCurrency    string          `gorm:"type:text;not null" json:"from_currency"`
+	ToCurrency      string          `gorm:"type:text;not null" json:"to_currency"`
+	Rate            decimal.Decimal `gorm:"type:numeric(20,10);not null;check:rate > 0" json:"rate"`
+	Amount          decimal.Decimal `gorm:"type:numeric(19,3);not null;check:amount > 0" json:"amount"`
+	ConvertedAmount decimal.Decimal `gorm:"type:numeric(19,3);not null;check:converted_amount > 0" json:"converted_amount"`
+	ExpiresAt       time.Time       `gorm:"not null" json:"expires_at"`
+	UsedAt          *time.Time      `json:"used_at"`
+	CreatedAt       time.Time       `json:"created_at"`
+}
+
+// TransferBatch is a set of transfers from one account uploaded together and executed asynchronously.
+// The total is reserved by a hold from the moment the batch is accepted. In `all_or_nothing` mode the items are
+// executed in a single database transaction; in `best_effort` mode each item is executed on its own and the
+// failures do not stop the others. CompletedCount and FailedCount track the progress of the batch.
+type TransferBatch struct {
+	ID             uint64               `gorm:"primaryKey;autoIncrement" json:"id"`
+	FromAccount    uuid.UUID            `gorm:"type:uuid;not null;index" json:"from_account"`
+	Mode           string               `gorm:"type:text;not null;check:mode IN ('all_or_nothing','best_effort')" json:"mode"`
+	Status         string               `gorm:"type:text;not null;default:'pending';check:status IN ('pending','processing','completed','partially_completed','failed')" json:"status"`
+	TotalAmount    decimal.Decimal      `gorm:"type:numeric(19,3);not null" json:"total_amount"`
+	ItemCount      int                  `gorm:"not null" json:"item_count"`
+	CompletedCount int                  `gorm:"not null;default:0" json:"completed_count"`
+	FailedCount    int                  `gorm:"not null;default:0" json:"failed_count"`
+	HoldID         *uint64              `json:"hold_id"`
+	LockedUntil    *time.Time           `json:"-"`
+	Items          []*TransferBatchItem `gorm:"foreignKey:BatchID" json:"items,omitempty"`
+	CreatedAt      time.Time            `json:"created_at"`
+	UpdatedAt      time.Time            `json:"updated_at"`
+	CompletedAt    *time.Time           `json:"completed_at"`
+}
+
+// TransferBatchItem is one transfer of a batch. Line is its 1-based position within the batch; TransferID links
+// the executed transfer and Error tells why the item failed.
+type TransferBatchItem struct {
+	ID        uint64          `gorm:"primaryKey;autoIncrement" json:"-"`
+	BatchID   uint64          `gorm:"not null;uniqueIndex:idx_transfer_batch_items_line" json:"-"`
+	Line      int             `gorm:"not null;uniqueIndex:idx_transfer_batch_items_line" json:"line"`
+	ToAccount uuid.UUID       `gorm:"type:uuid;not null" json:"to_account"`
+	Amount    decimal.Decimal `gorm:"type:numeric(19,3);not null" json:"amount"`
+	// Fee reserved with the amount, for transfers to other users
+	Fee         decimal.Decimal `gorm:"type:numeric(19,3);not null;default:0" json:"fee"`
+	Description string          `gorm:"type:text;not null;default:''" json:"description"`
+	Status      string          `gorm:"type:text;not null;default:'pending';check:status IN ('pending','completed','failed','skipped')" json:"status"`
+	TransferID  *uint64         `json:"transfer_id"`
+	Error       string          `gorm:"type:text;not null;default:''" json:"error,omitempty"`
+}
+
+// StandingOrder is a recurring transfer that is materialised as a Transfer on every occurrence.
+// Occurrences fall every Interval weeks or months from StartDate; monthly orders keep the day of the month
+// of StartDate, falling back to the last day of shorter months.
+type StandingOrder struct {
+	ID                  uint64          `gorm:"primaryKey;autoIncrement" json:"id"`
+	FromAccount         uuid.UUID       `gorm:"type:uuid;not null" json:"from_account"`
+	ToAccount           uuid.UUID       `gorm:"type:uuid;not null" json:"to_account"`
+	Amount              decimal.Decimal `gorm:"type:numeric(19,3);not null" json:"amount"`
+	Description         string          `gorm:"type:text;not null;default:''" json:"description"`
+	Frequency           string          `gorm:"type:text;not null;check:frequency IN ('weekly','monthly')" json:"frequency"`
+	Interval            int             `gorm:"column:repeat_interval;not null;default:1" json:"interval"`
+	StartDate           time.Time       `gorm:"not null" json:"start_date"`
+	EndDate             *time.Time      `json:"end_date"`
+	Status              string          `gorm:"type:text;not null;check:status IN ('active','suspended','completed','cancelled')" json:"status"`
+	NextRunAt           *time.Time      `json:"next_run_at"`
+	Occurrences         int             `gorm:"not null;default:0" json:"occurrences"`
+	ConsecutiveFailures int             `gorm:"not null;default:0" json:"consecutive_failures"`
+	LastError           string          `gorm:"type:text;not null;default:''" json:"last_error"`
+	LastRunAt           *time.Time      `json:"last_run_at"`
+	LastTransferID      *uint64         `json:"last_transfer_id"`
+	CreatedAt           time.Time       `json:"created_at"`
+	UpdatedAt           time.Time       `json:"updated_at"`
+}
+
+// Occurrence returns the execution date of the n-th occurrence, starting from 0
+func (o *StandingOrder) Occurrence(n int) time.Time {
+	if o.Frequency == "weekly" {
+		return o.StartDate.AddDate(0, 0, 7*o.Interval*n)
+	}
+
+	// Add months without letting time.AddDate normalise e.g. Jan 31 + 1 month into March
+	start := o.StartDate
+	firstOfMonth := time.Date(start.Year(), start.Month(), 1, start.Hour(), start.Minute(), start.Second(), start.Nanosecond(), start.Location())
+	target := firstOfMonth.AddDate(0, o.Interval*n, 0)
+	lastDay := target.AddDate(0, 1, -1).Day()
+	day := start.Day()
+	if day > lastDay {
+		day = lastDay
+	}
+	return target.AddDate(0, 0, day-1)
+}
+
+// SystemAccount is a bank-owned ledger account holding the other side of the postings for money
+// entering (`cash_in`) or leaving (`cash_out`) the bank, for the fees it charges (`fees`) and for its position
+// in the currencies it converts transfers between (`fx`). Every code exists once per currency.
+type SystemAccount struct {
+	Code      string          `gorm:"type:text;primaryKey" json:"code"`
+	Currency  string          `gorm:"type:text;primaryKey" json:"currency"`
+	Balance   decimal.Decimal `gorm:"type:numeric(19,3);not null;default:0" json:"balance"`
+	CreatedAt time.Time       `json:"created_at"`
+	UpdatedAt time.Time       `json:"updated_at"`
+}
+
+// JournalEntry is the double-entry record of a single business event: the postings of each currency always sum
+// to zero
+type JournalEntry struct {
+	ID          uint64    `gorm:"primaryKey;autoIncrement" json:"id"`
+	Description string    `gorm:"type:text;not null;default:''" json:"description"`
+	TransferID  *uint64   `json:"transfer_id"`
+	Postings    []Posting `gorm:"foreignKey:JournalEntryID" json:"postings"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// Posting adds Amount to (or, when negative, takes it from) either a customer account or a system account.
+// Currency is the currency of the account the posting targets.
+type Posting struct {
+	ID             uint64          `gorm:"primaryKey;autoIncrement" json:"id"`
+	JournalEntryID uint64          `gorm:"not null" json:"journal_entry_id"`
+	AccountID      *uuid.UUID      `gorm:"type:uuid" json:"account_id"`
+	SystemAccount  *string         `gorm:"type:text" json:"system_account"`
+	Amount         decimal.Decimal `gorm:"type:numeric(19,3);not null" json:"amount"`
+	Currency       string          `gorm:"type:text;not null" json:"currency"`
+	// BalanceAfter is the customer account balance once the posting is applied, set by LedgerRepository.Post
+	BalanceAfter decimal.Decimal `gorm:"-" json:"-"`
+}
+
+// IdempotencyKey stores the first response returned for a client-supplied Idempotency-Key.
+// A record with StatusCode 0 is a reservation for a request that is still in flight.
+type IdempotencyKey struct {
+	UserID       uuid.UUID `gorm:"type:uuid;primaryKey" json:"user_id"`
+	User         User      `gorm:"foreignKey:UserID" json:"-"`
+	Key          string    `gorm:"type:text;primaryKey" json:"key"`
+	RequestHash  string    `gorm:"type:text;not null" json:"request_hash"`
+	StatusCode   int       `gorm:"not null;default:0" json:"status_code"`
+	ResponseBody []byte    `gorm:"type:bytea" json:"response_body"`
+	CreatedAt    time.Time `json:"created_at"`
+	ExpiresAt    time.Time `gorm:"not null" json:"expires_at"`
+}
+
+// Completed reports whether a response has been stored for the key
+func (k *IdempotencyKey) Completed() bool {
+	return k.StatusCode != 0
+}
+
+// ReconciliationReport lists the inconsistencies found by a reconciliation run between balances, movements and
+// transfers. An empty report means the books are consistent.
+type ReconciliationReport struct {
+	GeneratedAt           time.Time               `json:"generated_at"`
+	BalanceDiscrepancies  []*BalanceDiscrepancy   `json:"balance_discrepancies"`
+	TransferDiscrepancies []*TransferDiscrepancy  `json:"transfer_discrepancies"`
+	StalePendingTransfers []*StalePendingTransfer `json:"stale_pending_transfers"`
+}
+
+// BalanceDiscrepancy is an account whose balance differs from the signed sum of its movements
+type BalanceDiscrepancy struct {
+	AccountID      uuid.UUID       `json:"account_id"`
+	Balance        decimal.Decimal `json:"balance"`
+	MovementsTotal decimal.Decimal `json:"movements_total"`
+	Difference     decimal.Decimal `json:"difference"`
+}
+
+// TransferDiscrepancy is a completed transfer without exactly one matching debit and one matching credit movement
+type TransferDiscrepancy struct {
+	TransferID      uint64 `json:"transfer_id"`
+	DebitMovements  int64  `json:"debit_movements"`
+	CreditMovements int64  `json:"credit_movements"`
+}
+
+// StalePendingTransfer is a transfer that has been pending for longer than expected.
+// Orphaned transfers never moved any funds and can safely be marked as failed.
+type StalePendingTransfer struct {
+	TransferID   uint64    `json:"transfer_id"`
+	PendingSince time.Time `json:"pending_since"`
+	Orphaned     bool      `json:"orphaned"`
+	MarkedFailed bool      `json:"marked_failed"`
+}
+
+// HasDiscrepancies reports whether the reconciliation found anything to look into
+func (r *ReconciliationReport) HasDiscrepancies() bool {
+	return len(r.BalanceDiscrepancies) > 0 || len(r.TransferDiscrepancies) > 0 || len(r.StalePendingTransfers) > 0
 }
 
 // TableName sets the table names explicitly
@@ -76,6 +529,34 @@ func (*Movement) TableName() string {
 	return "movements"
 }
 
+func (*Hold) TableName() string {
+	return "holds"
+}
+
+func (*PaymentRequest) TableName() string {
+	return "payment_requests"
+}
+
+func (*Beneficiary) TableName() string {
+	return "beneficiaries"
+}
+
+func (*AccountCosigner) TableName() string {
+	return "account_cosigners"
+}
+
+func (*TransferOutbox) TableName() string {
+	return "transfer_outbox"
+}
+
+func (*TransferApproval) TableName() string {
+	return "transfer_approvals"
+}
+
+func (*TransferLimit) TableName() string {
+	return "transfer_limits"
+}
+
 func (*OAuthToken) TableName() string {
 	return "oauth_tokens"
 }
@@ -83,3 +564,39 @@ func (*OAuthToken) TableName() string {
 func (*Transfer) TableName() string {
 	return "transfers"
 }
+
+func (*TransferBatch) TableName() string {
+	return "transfer_batches"
+}
+
+func (*TransferBatchItem) TableName() string {
+	return "transfer_batch_items"
+}
+
+func (*StandingOrder) TableName() string {
+	return "standing_orders"
+}
+
+func (*SystemAccount) TableName() string {
+	return "system_accounts"
+}
+
+func (*FXRate) TableName() string {
+	return "fx_rates"
+}
+
+func (*FXQuote) TableName() string {
+	return "fx_quotes"
+}
+
+func (*JournalEntry) TableName() string {
+	return "journal_entries"
+}
+
+func (*Posting) TableName() string {
+	return "postings"
+}
+
+func (*IdempotencyKey) TableName() string {
+	return "idempotency_keys"
+}
@@ -2,6 +2,8 @@ package repository
 
 import (
 	"context"
+	"strconv"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
@@ -12,6 +14,12 @@ import (
 	"VDM2-BankBE/internal/util"
 )
 
+// Bank codes the IBANs of new accounts are built with; migration 000009 backfills existing accounts with the same codes
+const (
+	bankABI = "03599"
+	bankCAB = "01800"
+)
+
 // GormAccountRepository implements AccountRepository using GORM
 type GormAccountRepository struct {
 	db *gorm.DB
@@ -28,7 +36,20 @@ func (r *GormAccountRepository) Create(ctx context.Context, account *model.Accou
 		account.ID = uuid.New()
 	}
 
-	err := r.db.WithContext(ctx).Create(account).Error
+	if account.IBAN == "" {
+		var accountNumber int64
+		if err := withContext(ctx, r.db).Raw("SELECT nextval('account_number_seq')").Scan(&accountNumber).Error; err != nil {
+			return errors.Wrap(err, "failed to allocate account number")
+		}
+
+		iban, err := util.NewItalianIBAN(bankABI, bankCAB, strconv.FormatInt(accountNumber, 10))
+		if err != nil {
+			return errors.Wrap(err, "failed to build iban")
+		}
+		account.IBAN = iban
+	}
+
+	err := withContext(ctx, r.db).Create(account).Error
 	if err != nil {
 		return errors.Wrap(err, "failed to create account")
 	}
@@ -40,7 +61,7 @@ func (r *GormAccountRepository) Create(ctx context.Context, account *model.Accou
 func (r *GormAccountRepository) GetByID(ctx context.Context, id uuid.UUID) (*model.Account, error) {
 	var account model.Account
 
-	err := r.db.WithContext(ctx).Where("id = ?", id).First(&account).Error
+	err := withContext(ctx, r.db).Where("id = ?", id).First(&account).Error
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, util.NewNotFoundError("account not found")
@@ -51,11 +72,29 @@ func (r *GormAccountRepository) GetByID(ctx context.Context, id uuid.UUID) (*mod
 	return &account, nil
 }
 
-// GetByUserID retrieves an account by user ID
+// GetByIBAN retrieves an account by IBAN
+func (r *GormAccountRepository) GetByIBAN(ctx context.Context, iban string) (*model.Account, error) {
+	var account model.Account
+
+	err := withContext(ctx, r.db).Where("iban = ?", iban).First(&account).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, util.NewNotFoundError("account not found")
+		}
+		return nil, errors.Wrap(err, "failed to get account by iban")
+	}
+
+	return &account, nil
+}
+
+// GetByUserID retrieves the default account of a user, falling back to the oldest open one
 func (r *GormAccountRepository) GetByUserID(ctx context.Context, userID uuid.UUID) (*model.Account, error) {
 	var account model.Account
 
-	err := r.db.WithContext(ctx).Where("user_id = ?", userID).First(&account).Error
+	err := withContext(ctx, r.db).
+		Where("user_id = ?", userID).
+		Order("is_default DESC, status = 'closed', created_at").
+		First(&account).Error
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, util.NewNotFoundError("account not found")
@@ -66,44 +105,84 @@ func (r *GormAccountRepository) GetByUserID(ctx context.Context, userID uuid.UUI
 	return &account, nil
 }
 
-// UpdateBalance updates an account's balance
-func (r *GormAccountRepository) UpdateBalance(ctx context.Context, id uuid.UUID, amount decimal.Decimal) error {
-	// Use a transaction to ensure consistency
-	tx := r.db.WithContext(ctx).Begin()
-	if tx.Error != nil {
-		return errors.Wrap(tx.Error, "failed to begin transaction")
-	}
+// ListByUserID retrieves all the accounts of a user, oldest first
+func (r *GormAccountRepository) ListByUserID(ctx context.Context, userID uuid.UUID) ([]*model.Account, error) {
+	var accounts []*model.Account
 
-	// Get the current account with locking to prevent race conditions
-	var account model.Account
-	err := tx.Set("gorm:query_option", "FOR UPDATE").Where("id = ?", id).First(&account).Error
+	err := withContext(ctx, r.db).
+		Where("user_id = ?", userID).
+		Order("created_at, id").
+		Find(&accounts).Error
 	if err != nil {
-		tx.Rollback()
-		if err == gorm.ErrRecordNotFound {
+		return nil, errors.Wrap(err, "failed to list accounts by user ID")
+	}
+
+	return accounts, nil
+}
+
+// SetDefault makes an account the default one of its user, clearing the flag on the user's other accounts
+func (r *GormAccountRepository) SetDefault(ctx context.Context, userID, id uuid.UUID) error {
+	return withContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&model.Account{}).
+			Where("user_id = ? AND id <> ? AND is_default", userID, id).
+			Update("is_default", false).Error
+		if err != nil {
+			return errors.Wrap(err, "failed to clear default account")
+		}
+
+		result := tx.Model(&model.Account{}).
+			Where("id = ? AND user_id = ? AND status <> ?", id, userID, "closed").
+			Update("is_default", true)
+		if result.Error != nil {
+			return errors.Wrap(result.Error, "failed to set default account")
+		}
+		if result.RowsAffected == 0 {
 			return util.NewNotFoundError("account not found")
 		}
-		return errors.Wrap(err, "failed to get account for balance update")
-	}
 
-	// Update the balance
-	account.Balance = account.Balance.Add(amount)
+		return nil
+	})
+}
 
-	// Check for negative balance
-	if account.Balance.LessThan(decimal.Zero) {
-		tx.Rollback()
-		return util.NewBadRequestError("insufficient funds")
+// TransitionStatus moves an account to toStatus only if it is currently in fromStatus.
+// It reports whether the transition happened, so concurrent callers can safely race for the same account.
+func (r *GormAccountRepository) TransitionStatus(ctx context.Context, id uuid.UUID, fromStatus, toStatus string) (bool, error) {
+	result := withContext(ctx, r.db).
+		Model(&model.Account{}).
+		Where("id = ? AND status = ?", id, fromStatus).
+		Update("status", toStatus)
+	if result.Error != nil {
+		return false, errors.Wrap(result.Error, "failed to transition account status")
 	}
 
-	// Save the updated balance
-	err = tx.Save(&account).Error
-	if err != nil {
-		tx.Rollback()
-		return errors.Wrap(err, "failed to update account balance")
+	return result.RowsAffected == 1, nil
+}
+
+// Close marks an account in fromStatus as closed, as long as its balance is zero and it has no active holds, and
+// stops it from being the default account. It reports whether the account was closed.
+func (r *GormAccountRepository) Close(ctx context.Context, id uuid.UUID, fromStatus string, closedAt time.Time) (bool, error) {
+	result := withContext(ctx, r.db).
+		Model(&model.Account{}).
+		Where("id = ? AND status = ? AND balance = 0 AND held_amount = 0", id, fromStatus).
+		Updates(map[string]any{"status": "closed", "is_default": false, "closed_at": closedAt})
+	if result.Error != nil {
+		return false, errors.Wrap(result.Error, "failed to close account")
 	}
 
-	// Commit the transaction
-	if err := tx.Commit().Error; err != nil {
-		return errors.Wrap(err, "failed to commit transaction")
+	return result.RowsAffected == 1, nil
+}
+
+// UpdateOverdraft sets the overdraft limit and interest rate of an account
+func (r *GormAccountRepository) UpdateOverdraft(ctx context.Context, id uuid.UUID, limit, rate decimal.Decimal) error {
+	result := withContext(ctx, r.db).
+		Model(&model.Account{}).
+		Where("id = ?", id).
+		Updates(map[string]any{"overdraft_limit": limit, "overdraft_rate": rate})
+	if result.Error != nil {
+		return errors.Wrap(result.Error, "failed to update overdraft")
+	}
+	if result.RowsAffected == 0 {
+		return util.NewNotFoundError("account not found")
 	}
 
 	return nil
@@ -111,7 +190,7 @@ func (r *GormAccountRepository) UpdateBalance(ctx context.Context, id uuid.UUID,
 
 // Delete deletes an account from the database
 func (r *GormAccountRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	err := r.db.WithContext(ctx).Delete(&model.Account{}, "id = ?", id).Error
+	err := withContext(ctx, r.db).Delete(&model.Account{}, "id = ?", id).Error
 	if err != nil {
 		return errors.Wrap(err, "failed to delete account")
 	}
@@ -4,12 +4,12 @@ import (
 	"context"
 	"errors"
 	"regexp"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/google/uuid"
-	"github.com/shopspring/decimal"
 	"gorm.io/gorm"
 
 	"VDM2-BankBE/internal/model"
@@ -30,12 +30,14 @@ func TestGormAccountRepository_Create(t *testing.T) {
 		assertFunc func(t *testing.T, account *model.Account, err error)
 	}{
 		{
-			name:    "success assigns id if nil",
+			name:    "success assigns id and iban if missing",
 			account: &model.Account{ID: uuid.Nil, UserID: uuid.MustParse("550e8400-e29b-41d4-a716-446655440911"), Currency: "EUR"},
 			setupSQL: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(`SELECT nextval\('account_number_seq'\)`).
+					WillReturnRows(sqlmock.NewRows([]string{"nextval"}).AddRow(42))
 				m.ExpectBegin()
 				m.ExpectExec(`INSERT INTO "accounts" .*`).
-					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
 					WillReturnResult(sqlmock.NewResult(0, 1))
 				m.ExpectCommit()
 			},
@@ -46,11 +48,14 @@ func TestGormAccountRepository_Create(t *testing.T) {
 				if account.ID == uuid.Nil {
 					t.Fatalf("expected ID to be set")
 				}
+				if !strings.HasSuffix(account.IBAN, "0359901800000000000042") || util.ValidateIBAN(account.IBAN) != nil {
+					t.Fatalf("unexpected iban: %s", account.IBAN)
+				}
 			},
 		},
 		{
 			name:    "db error wraps create failure",
-			account: &model.Account{ID: uuid.MustParse("550e8400-e29b-41d4-a716-446655440912"), UserID: uuid.MustParse("550e8400-e29b-41d4-a716-446655440913"), Currency: "EUR"},
+			account: &model.Account{ID: uuid.MustParse("550e8400-e29b-41d4-a716-446655440912"), UserID: uuid.MustParse("550e8400-e29b-41d4-a716-446655440913"), IBAN: "IT60X0542811101000000123456", Currency: "EUR"},
 			setupSQL: func(m sqlmock.Sqlmock) {
 				baseErr := errors.New("db err")
 				m.ExpectBegin()
@@ -217,35 +222,27 @@ func TestGormAccountRepository_GetByID_GetByUserID_Delete(t *testing.T) {
 	}
 }
 
-func TestGormAccountRepository_UpdateBalance(t *testing.T) {
+func TestGormAccountRepository_SetDefault(t *testing.T) {
 	t.Parallel()
 
 	ctx := context.Background()
 	accountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440930")
-	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	userID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440931")
 
-	type testCase struct {
+	tests := []struct {
 		name      string
-		amount    decimal.Decimal
 		setupSQL  func(m sqlmock.Sqlmock)
 		assertErr func(t *testing.T, err error)
-	}
-
-	selectForUpdateRegex := `SELECT .* FROM "accounts" WHERE id = \$1 ORDER BY .* LIMIT 1.*FOR UPDATE`
-	selectRegex := `SELECT .* FROM "accounts" WHERE id = \$1 ORDER BY .* LIMIT \$2`
-	updateAnyPlaceholderRegex := `UPDATE "accounts" SET .* WHERE "id" = \$\d+`
-
-	tests := []testCase{
+	}{
 		{
-			name:   "success BEGIN -> SELECT FOR UPDATE -> UPDATE -> COMMIT",
-			amount: decimal.NewFromInt(5),
+			name: "previous default is cleared",
 			setupSQL: func(m sqlmock.Sqlmock) {
 				m.ExpectBegin()
-				m.ExpectQuery(selectRegex).
-					WithArgs(accountID, 1).
-					WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "balance", "currency", "created_at", "updated_at"}).
-						AddRow(accountID, uuid.MustParse("550e8400-e29b-41d4-a716-446655440931"), "10.00", "EUR", now, now))
-				m.ExpectExec(updateAnyPlaceholderRegex).
+				m.ExpectExec(`UPDATE "accounts" SET "is_default"=\$1,"updated_at"=\$2 WHERE user_id = \$3 AND id <> \$4 AND is_default`).
+					WithArgs(false, sqlmock.AnyArg(), userID, accountID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				m.ExpectExec(`UPDATE "accounts" SET "is_default"=\$1,"updated_at"=\$2 WHERE id = \$3 AND user_id = \$4 AND status <> \$5`).
+					WithArgs(true, sqlmock.AnyArg(), accountID, userID, "closed").
 					WillReturnResult(sqlmock.NewResult(0, 1))
 				m.ExpectCommit()
 			},
@@ -256,112 +253,36 @@ func TestGormAccountRepository_UpdateBalance(t *testing.T) {
 			},
 		},
 		{
-			name:   "begin error returns wrapped error",
-			amount: decimal.NewFromInt(1),
-			setupSQL: func(m sqlmock.Sqlmock) {
-				m.ExpectBegin().WillReturnError(errors.New("begin failed"))
-			},
-			assertErr: func(t *testing.T, err error) {
-				if err == nil || !regexp.MustCompile(`failed to begin transaction`).MatchString(err.Error()) {
-					t.Fatalf("expected begin error, got: %v", err)
-				}
-			},
-		},
-		{
-			name:   "record not found rolls back and returns APIError 404",
-			amount: decimal.NewFromInt(1),
+			name: "account of another user is not found",
 			setupSQL: func(m sqlmock.Sqlmock) {
 				m.ExpectBegin()
-				m.ExpectQuery(selectRegex).
-					WithArgs(accountID, 1).
-					WillReturnError(gorm.ErrRecordNotFound)
-				m.ExpectRollback()
-			},
-			assertErr: func(t *testing.T, err error) {
-				var apiErr *util.APIError
-				if !errors.As(err, &apiErr) {
-					t.Fatalf("expected APIError, got: %#v", err)
-				}
-				if apiErr.Code != 404 || apiErr.Message != "account not found" {
-					t.Fatalf("unexpected APIError: %+v", apiErr)
-				}
-			},
-		},
-		{
-			name:   "insufficient funds rolls back and returns APIError 400",
-			amount: decimal.RequireFromString("-20.00"),
-			setupSQL: func(m sqlmock.Sqlmock) {
-				m.ExpectBegin()
-				m.ExpectQuery(selectRegex).
-					WithArgs(accountID, 1).
-					WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "balance", "currency", "created_at", "updated_at"}).
-						AddRow(accountID, uuid.MustParse("550e8400-e29b-41d4-a716-446655440932"), "10.00", "EUR", now, now))
-				m.ExpectRollback()
-			},
-			assertErr: func(t *testing.T, err error) {
-				var apiErr *util.APIError
-				if !errors.As(err, &apiErr) {
-					t.Fatalf("expected APIError, got: %#v", err)
-				}
-				if apiErr.Code != 400 || apiErr.Message != "insufficient funds" {
-					t.Fatalf("unexpected APIError: %+v", apiErr)
-				}
-			},
-		},
-		{
-			name:   "save error rolls back and returns wrapped error",
-			amount: decimal.NewFromInt(1),
-			setupSQL: func(m sqlmock.Sqlmock) {
-				baseErr := errors.New("update failed")
-				m.ExpectBegin()
-				m.ExpectQuery(selectRegex).
-					WithArgs(accountID, 1).
-					WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "balance", "currency", "created_at", "updated_at"}).
-						AddRow(accountID, uuid.MustParse("550e8400-e29b-41d4-a716-446655440933"), "10.00", "EUR", now, now))
-				m.ExpectExec(updateAnyPlaceholderRegex).
-					WillReturnError(baseErr)
-				m.ExpectRollback()
-			},
-			assertErr: func(t *testing.T, err error) {
-				if err == nil || !regexp.MustCompile(`failed to update account balance`).MatchString(err.Error()) {
-					t.Fatalf("expected update error, got: %v", err)
-				}
-			},
-		},
-		{
-			name:   "commit error returns wrapped error",
-			amount: decimal.NewFromInt(1),
-			setupSQL: func(m sqlmock.Sqlmock) {
-				m.ExpectBegin()
-				m.ExpectQuery(selectRegex).
-					WithArgs(accountID, 1).
-					WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "balance", "currency", "created_at", "updated_at"}).
-						AddRow(accountID, uuid.MustParse("550e8400-e29b-41d4-a716-446655440934"), "10.00", "EUR", now, now))
-				m.ExpectExec(updateAnyPlaceholderRegex).
+				m.ExpectExec(`UPDATE "accounts" SET "is_default"=\$1,"updated_at"=\$2 WHERE user_id = \$3 AND id <> \$4 AND is_default`).
+					WithArgs(false, sqlmock.AnyArg(), userID, accountID).
 					WillReturnResult(sqlmock.NewResult(0, 1))
-				m.ExpectCommit().WillReturnError(errors.New("commit failed"))
+				m.ExpectExec(`UPDATE "accounts" SET "is_default"=\$1,"updated_at"=\$2 WHERE id = \$3 AND user_id = \$4 AND status <> \$5`).
+					WithArgs(true, sqlmock.AnyArg(), accountID, userID, "closed").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				m.ExpectRollback()
 			},
 			assertErr: func(t *testing.T, err error) {
-				if err == nil || !regexp.MustCompile(`failed to commit transaction`).MatchString(err.Error()) {
-					t.Fatalf("expected commit error, got: %v", err)
+				apiErr, ok := err.(*util.APIError)
+				if !ok || apiErr.Code != 404 {
+					t.Fatalf("expected 404 APIError, got %#v", err)
 				}
 			},
 		},
 	}
 
-	_ = selectForUpdateRegex
-	_ = updateAnyPlaceholderRegex
 	for _, tc := range tests {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
-
 			dbm := testutil.NewGormSQLMock(t)
 			defer dbm.Cleanup()
 
 			tc.setupSQL(dbm.Mock)
 			repo := repository.NewGormAccountRepository(dbm.DB)
-			err := repo.UpdateBalance(ctx, accountID, tc.amount)
+			err := repo.SetDefault(ctx, userID, accountID)
 			tc.assertErr(t, err)
 
 			if err := dbm.Mock.ExpectationsWereMet(); err != nil {
@@ -370,4 +291,3 @@ func TestGormAccountRepository_UpdateBalance(t *testing.T) {
 		})
 	}
 }
-
@@ -0,0 +1,141 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"VDM2-BankBE/internal/model"
+	"VDM2-BankBE/internal/util"
+)
+
+// GormBeneficiaryRepository implements BeneficiaryRepository using GORM
+type GormBeneficiaryRepository struct {
+	db *gorm.DB
+}
+
+// NewGormBeneficiaryRepository creates a new beneficiary repository with GORM
+func NewGormBeneficiaryRepository(db *gorm.DB) BeneficiaryRepository {
+	return &GormBeneficiaryRepository{db: db}
+}
+
+// Create adds a beneficiary, failing with a conflict if the account is already in the user's address book
+func (r *GormBeneficiaryRepository) Create(ctx context.Context, beneficiary *model.Beneficiary) error {
+	result := withContext(ctx, r.db).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(beneficiary)
+	if result.Error != nil {
+		return errors.Wrap(result.Error, "failed to create beneficiary")
+	}
+
+	if result.RowsAffected == 0 {
+		return util.NewConflictError("account is already a beneficiary")
+	}
+
+	return nil
+}
+
+// GetByID retrieves a beneficiary by ID
+func (r *GormBeneficiaryRepository) GetByID(ctx context.Context, id uint64) (*model.Beneficiary, error) {
+	var beneficiary model.Beneficiary
+
+	err := withContext(ctx, r.db).Where("id = ?", id).First(&beneficiary).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, util.NewNotFoundError("beneficiary not found")
+		}
+		return nil, errors.Wrap(err, "failed to get beneficiary by ID")
+	}
+
+	return &beneficiary, nil
+}
+
+// GetByAccountID retrieves the beneficiary of a user that is the given account
+func (r *GormBeneficiaryRepository) GetByAccountID(
+	ctx context.Context,
+	userID, accountID uuid.UUID,
+) (*model.Beneficiary, error) {
+	var beneficiary model.Beneficiary
+
+	err := withContext(ctx, r.db).Where("user_id = ? AND account_id = ?", userID, accountID).First(&beneficiary).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, util.NewNotFoundError("beneficiary not found")
+		}
+		return nil, errors.Wrap(err, "failed to get beneficiary by account")
+	}
+
+	return &beneficiary, nil
+}
+
+// GetByUserID retrieves the address book of a user with pagination, sorted by nickname
+func (r *GormBeneficiaryRepository) GetByUserID(
+	ctx context.Context,
+	userID uuid.UUID,
+	params *util.PaginationParams,
+) ([]*model.Beneficiary, int, error) {
+	var beneficiaries []*model.Beneficiary
+	var count int64
+
+	// Count total records
+	err := withContext(ctx, r.db).
+		Model(&model.Beneficiary{}).
+		Where("user_id = ?", userID).
+		Count(&count).Error
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "failed to count beneficiaries")
+	}
+
+	// Get paginated records
+	err = withContext(ctx, r.db).
+		Where("user_id = ?", userID).
+		Order("nickname ASC, id ASC").
+		Offset(params.Offset()).
+		Limit(params.Limit).
+		Find(&beneficiaries).Error
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "failed to get beneficiaries by user ID")
+	}
+
+	return beneficiaries, int(count), nil
+}
+
+// UpdateNickname renames a beneficiary
+func (r *GormBeneficiaryRepository) UpdateNickname(ctx context.Context, id uint64, nickname string) error {
+	err := withContext(ctx, r.db).
+		Model(&model.Beneficiary{}).
+		Where("id = ?", id).
+		Update("nickname", nickname).Error
+	if err != nil {
+		return errors.Wrap(err, "failed to update beneficiary")
+	}
+
+	return nil
+}
+
+// MarkUsed records when a transfer was last sent to a beneficiary
+func (r *GormBeneficiaryRepository) MarkUsed(ctx context.Context, id uint64, usedAt time.Time) error {
+	err := withContext(ctx, r.db).
+		Model(&model.Beneficiary{}).
+		Where("id = ?", id).
+		Update("last_used_at", usedAt).Error
+	if err != nil {
+		return errors.Wrap(err, "failed to mark beneficiary as used")
+	}
+
+	return nil
+}
+
+// Delete removes a beneficiary
+func (r *GormBeneficiaryRepository) Delete(ctx context.Context, id uint64) error {
+	err := withContext(ctx, r.db).Delete(&model.Beneficiary{}, id).Error
+	if err != nil {
+		return errors.Wrap(err, "failed to delete beneficiary")
+	}
+
+	return nil
+}
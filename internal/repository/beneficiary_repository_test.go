@@ -0,0 +1,82 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+
+	"VDM2-BankBE/internal/model"
+	"VDM2-BankBE/internal/repository"
+	"VDM2-BankBE/internal/testutil"
+	"VDM2-BankBE/internal/util"
+)
+
+func TestGormBeneficiaryRepository_Create(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	userID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440870")
+	accountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440871")
+
+	const insert = `INSERT INTO "beneficiaries" .* ON CONFLICT DO NOTHING RETURNING "id"`
+
+	tests := []struct {
+		name      string
+		setupSQL  func(m sqlmock.Sqlmock)
+		assertErr func(t *testing.T, beneficiary *model.Beneficiary, err error)
+	}{
+		{
+			name: "beneficiary is inserted",
+			setupSQL: func(m sqlmock.Sqlmock) {
+				m.ExpectBegin()
+				m.ExpectQuery(insert).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+				m.ExpectCommit()
+			},
+			assertErr: func(t *testing.T, beneficiary *model.Beneficiary, err error) {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if beneficiary.ID != 3 {
+					t.Fatalf("expected beneficiary ID to be set, got %d", beneficiary.ID)
+				}
+			},
+		},
+		{
+			name: "account already in the address book returns 409",
+			setupSQL: func(m sqlmock.Sqlmock) {
+				m.ExpectBegin()
+				m.ExpectQuery(insert).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+				m.ExpectCommit()
+			},
+			assertErr: func(t *testing.T, beneficiary *model.Beneficiary, err error) {
+				apiErr, ok := err.(*util.APIError)
+				if !ok || apiErr.Code != 409 {
+					t.Fatalf("expected 409 APIError, got %#v", err)
+				}
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			dbm := testutil.NewGormSQLMock(t)
+			defer dbm.Cleanup()
+
+			tc.setupSQL(dbm.Mock)
+			repo := repository.NewGormBeneficiaryRepository(dbm.DB)
+			beneficiary := &model.Beneficiary{UserID: userID, Nickname: "Rent", AccountID: accountID, IBAN: "IT60X0542811101000000123456"}
+			err := repo.Create(ctx, beneficiary)
+			tc.assertErr(t, beneficiary, err)
+
+			if err := dbm.Mock.ExpectationsWereMet(); err != nil {
+				t.Fatalf("unmet sqlmock expectations: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"VDM2-BankBE/internal/model"
+	"VDM2-BankBE/internal/util"
+)
+
+// GormCosignerRepository implements CosignerRepository using GORM
+type GormCosignerRepository struct {
+	db *gorm.DB
+}
+
+// NewGormCosignerRepository creates a new co-signer repository with GORM
+func NewGormCosignerRepository(db *gorm.DB) CosignerRepository {
+	return &GormCosignerRepository{db: db}
+}
+
+// Create adds a co-signer to an account, failing with a conflict if the user already is one
+func (r *GormCosignerRepository) Create(ctx context.Context, cosigner *model.AccountCosigner) error {
+	result := withContext(ctx, r.db).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(cosigner)
+	if result.Error != nil {
+		return errors.Wrap(result.Error, "failed to create co-signer")
+	}
+
+	if result.RowsAffected == 0 {
+		return util.NewConflictError("user is already a co-signer of the account")
+	}
+
+	return nil
+}
+
+// ListByAccountID retrieves the co-signers of an account with their usernames, oldest first
+func (r *GormCosignerRepository) ListByAccountID(ctx context.Context, accountID uuid.UUID) ([]*model.AccountCosigner, error) {
+	var cosigners []*model.AccountCosigner
+
+	err := withContext(ctx, r.db).
+		Select("account_cosigners.*, users.username").
+		Joins("JOIN users ON users.id = account_cosigners.user_id").
+		Where("account_cosigners.account_id = ?", accountID).
+		Order("account_cosigners.created_at ASC").
+		Find(&cosigners).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get co-signers by account ID")
+	}
+
+	return cosigners, nil
+}
+
+// Exists reports whether a user is a co-signer of an account
+func (r *GormCosignerRepository) Exists(ctx context.Context, accountID, userID uuid.UUID) (bool, error) {
+	var count int64
+
+	err := withContext(ctx, r.db).
+		Model(&model.AccountCosigner{}).
+		Where("account_id = ? AND user_id = ?", accountID, userID).
+		Count(&count).Error
+	if err != nil {
+		return false, errors.Wrap(err, "failed to check co-signer")
+	}
+
+	return count > 0, nil
+}
+
+// Delete removes a co-signer from an account
+func (r *GormCosignerRepository) Delete(ctx context.Context, accountID, userID uuid.UUID) error {
+	result := withContext(ctx, r.db).
+		Where("account_id = ? AND user_id = ?", accountID, userID).
+		Delete(&model.AccountCosigner{})
+	if result.Error != nil {
+		return errors.Wrap(result.Error, "failed to delete co-signer")
+	}
+
+	if result.RowsAffected == 0 {
+		return util.NewNotFoundError("co-signer not found")
+	}
+
+	return nil
+}
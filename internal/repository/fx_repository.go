@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"VDM2-BankBE/internal/model"
+	"VDM2-BankBE/internal/util"
+)
+
+// GormFXRepository implements FXRepository using GORM
+type GormFXRepository struct {
+	db *gorm.DB
+}
+
+// NewGormFXRepository creates a new FX repository with GORM
+func NewGormFXRepository(db *gorm.DB) FXRepository {
+	return &GormFXRepository{db: db}
+}
+
+// ListRates retrieves all the exchange rates, ordered by currency pair
+func (r *GormFXRepository) ListRates(ctx context.Context) ([]*model.FXRate, error) {
+	var rates []*model.FXRate
+
+	err := withContext(ctx, r.db).Order("base_currency ASC, quote_currency ASC").Find(&rates).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list exchange rates")
+	}
+
+	return rates, nil
+}
+
+// GetRate retrieves the exchange rate from baseCurrency to quoteCurrency
+func (r *GormFXRepository) GetRate(ctx context.Context, baseCurrency, quoteCurrency string) (*model.FXRate, error) {
+	var rate model.FXRate
+
+	err := withContext(ctx, r.db).
+		Where("base_currency = ? AND quote_currency = ?", baseCurrency, quoteCurrency).
+		First(&rate).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, util.NewNotFoundError("exchange rate not found")
+		}
+		return nil, errors.Wrap(err, "failed to get exchange rate")
+	}
+
+	return &rate, nil
+}
+
+// UpsertRates creates or replaces exchange rates in one statement
+func (r *GormFXRepository) UpsertRates(ctx context.Context, rates []*model.FXRate) error {
+	if len(rates) == 0 {
+		return nil
+	}
+
+	err := withContext(ctx, r.db).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "base_currency"}, {Name: "quote_currency"}},
+			DoUpdates: clause.AssignmentColumns([]string{"rate", "updated_at"}),
+		}).
+		Create(&rates).Error
+	if err != nil {
+		return errors.Wrap(err, "failed to save exchange rates")
+	}
+
+	return nil
+}
+
+// CreateQuote stores a new FX quote
+func (r *GormFXRepository) CreateQuote(ctx context.Context, quote *model.FXQuote) error {
+	if err := withContext(ctx, r.db).Create(quote).Error; err != nil {
+		return errors.Wrap(err, "failed to create fx quote")
+	}
+
+	return nil
+}
+
+// GetQuote retrieves an FX quote by ID
+func (r *GormFXRepository) GetQuote(ctx context.Context, id uuid.UUID) (*model.FXQuote, error) {
+	var quote model.FXQuote
+
+	err := withContext(ctx, r.db).Where("id = ?", id).First(&quote).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, util.NewNotFoundError("fx quote not found")
+		}
+		return nil, errors.Wrap(err, "failed to get fx quote")
+	}
+
+	return &quote, nil
+}
+
+// UseQuote marks a quote as used, as long as it has neither been used nor expired at now.
+// It reports whether the quote was updated.
+func (r *GormFXRepository) UseQuote(ctx context.Context, id uuid.UUID, now time.Time) (bool, error) {
+	result := withContext(ctx, r.db).
+		Model(&model.FXQuote{}).
+		Where("id = ? AND used_at IS NULL AND expires_at > ?", id, now).
+		Update("used_at", now)
+	if result.Error != nil {
+		return false, errors.Wrap(result.Error, "failed to use fx quote")
+	}
+
+	return result.RowsAffected == 1, nil
+}
@@ -0,0 +1,202 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+
+	"VDM2-BankBE/internal/model"
+	"VDM2-BankBE/internal/util"
+)
+
+// GormHoldRepository implements HoldRepository using GORM
+type GormHoldRepository struct {
+	db *gorm.DB
+}
+
+// NewGormHoldRepository creates a new hold repository with GORM
+func NewGormHoldRepository(db *gorm.DB) HoldRepository {
+	return &GormHoldRepository{db: db}
+}
+
+// Create reserves the amount of a hold on its account and inserts the hold, in one transaction.
+// The account must be active and its available balance must cover the amount.
+func (r *GormHoldRepository) Create(ctx context.Context, hold *model.Hold) error {
+	return withContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&model.Account{}).
+			Where("id = ? AND balance - held_amount - ? >= -overdraft_limit AND status = ?", hold.AccountID, hold.Amount, "active").
+			Update("held_amount", gorm.Expr("held_amount + ?", hold.Amount))
+		if result.Error != nil {
+			return errors.Wrap(result.Error, "failed to reserve hold amount")
+		}
+
+		if result.RowsAffected == 0 {
+			// Tell a missing account and a status that forbids debits apart from missing funds
+			var current model.Account
+			if err := tx.Select("status").Where("id = ?", hold.AccountID).Take(&current).Error; err != nil {
+				if err == gorm.ErrRecordNotFound {
+					return util.NewNotFoundError("account not found")
+				}
+				return errors.Wrap(err, "failed to get account for hold")
+			}
+			if !current.CanSend() {
+				return util.NewConflictError("account is " + current.Status)
+			}
+			return util.NewBadRequestError("insufficient funds")
+		}
+
+		if err := tx.Create(hold).Error; err != nil {
+			return errors.Wrap(err, "failed to create hold")
+		}
+
+		return nil
+	})
+}
+
+// GetByID retrieves a hold by ID along with its account, whose currency the hold is in
+func (r *GormHoldRepository) GetByID(ctx context.Context, id uint64) (*model.Hold, error) {
+	var hold model.Hold
+
+	err := withContext(ctx, r.db).Preload("Account").Where("id = ?", id).First(&hold).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, util.NewNotFoundError("hold not found")
+		}
+		return nil, errors.Wrap(err, "failed to get hold by ID")
+	}
+
+	return &hold, nil
+}
+
+// GetByAccountID retrieves the holds of an account with pagination, newest first
+func (r *GormHoldRepository) GetByAccountID(
+	ctx context.Context,
+	accountID uuid.UUID,
+	params *util.PaginationParams,
+) ([]*model.Hold, int, error) {
+	var holds []*model.Hold
+	var count int64
+
+	// Count total records
+	err := withContext(ctx, r.db).
+		Model(&model.Hold{}).
+		Where("account_id = ?", accountID).
+		Count(&count).Error
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "failed to count holds")
+	}
+
+	// Get paginated records
+	err = withContext(ctx, r.db).
+		Where("account_id = ?", accountID).
+		Order("created_at DESC").
+		Offset(params.Offset()).
+		Limit(params.Limit).
+		Find(&holds).Error
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "failed to get holds by account ID")
+	}
+
+	return holds, int(count), nil
+}
+
+// Resolve moves an active hold to status (captured, released or expired), recording its CapturedAmount, and gives
+// the held amount back to the available balance of the account. It reports whether the hold was still active, so
+// that a hold is only resolved once.
+func (r *GormHoldRepository) Resolve(ctx context.Context, hold *model.Hold, status string) (bool, error) {
+	resolved := false
+	err := withContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&model.Hold{}).
+			Where("id = ? AND status = ?", hold.ID, "active").
+			Updates(map[string]any{"status": status, "captured_amount": hold.CapturedAmount})
+		if result.Error != nil {
+			return errors.Wrap(result.Error, "failed to resolve hold")
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		err := tx.Model(&model.Account{}).
+			Where("id = ?", hold.AccountID).
+			Update("held_amount", gorm.Expr("held_amount - ?", hold.Amount)).Error
+		if err != nil {
+			return errors.Wrap(err, "failed to release hold amount")
+		}
+
+		resolved = true
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return resolved, nil
+}
+
+// Reduce gives amount of an active hold back to the available balance of the account, releasing the hold once
+// nothing is left of it. It reports false, leaving the hold untouched, when the hold is no longer active or holds
+// less than amount. hold.Amount is updated to the amount still held.
+func (r *GormHoldRepository) Reduce(ctx context.Context, hold *model.Hold, amount decimal.Decimal) (bool, error) {
+	reduced := false
+	err := withContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		// The amount of a hold cannot drop to zero, the last part releases the hold instead
+		result := tx.Model(&model.Hold{}).
+			Where("id = ? AND status = ? AND amount = ?", hold.ID, "active", amount).
+			Update("status", "released")
+		if result.Error != nil {
+			return errors.Wrap(result.Error, "failed to release hold")
+		}
+		if result.RowsAffected == 0 {
+			result = tx.Model(&model.Hold{}).
+				Where("id = ? AND status = ? AND amount > ?", hold.ID, "active", amount).
+				Update("amount", gorm.Expr("amount - ?", amount))
+			if result.Error != nil {
+				return errors.Wrap(result.Error, "failed to reduce hold")
+			}
+			if result.RowsAffected == 0 {
+				return nil
+			}
+		}
+
+		err := tx.Model(&model.Account{}).
+			Where("id = ?", hold.AccountID).
+			Update("held_amount", gorm.Expr("held_amount - ?", amount)).Error
+		if err != nil {
+			return errors.Wrap(err, "failed to release hold amount")
+		}
+
+		reduced = true
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if reduced {
+		hold.Amount = hold.Amount.Sub(amount)
+		if hold.Amount.IsZero() {
+			hold.Status = "released"
+		}
+	}
+	return reduced, nil
+}
+
+// GetExpired retrieves active holds expiring not after before, oldest first
+func (r *GormHoldRepository) GetExpired(ctx context.Context, before time.Time, limit int) ([]*model.Hold, error) {
+	var holds []*model.Hold
+
+	err := withContext(ctx, r.db).
+		Where("status = ? AND expires_at <= ?", "active", before).
+		Order("expires_at ASC").
+		Limit(limit).
+		Find(&holds).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get expired holds")
+	}
+
+	return holds, nil
+}
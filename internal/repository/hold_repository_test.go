@@ -0,0 +1,184 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"VDM2-BankBE/internal/model"
+	"VDM2-BankBE/internal/repository"
+	"VDM2-BankBE/internal/testutil"
+	"VDM2-BankBE/internal/util"
+)
+
+func TestGormHoldRepository_Create(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	accountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440960")
+	amount := decimal.RequireFromString("30.00")
+
+	const reserve = `UPDATE "accounts" SET "held_amount"=held_amount \+ \$1,"updated_at"=\$2 WHERE id = \$3 AND balance - held_amount - \$4 >= -overdraft_limit AND status = \$5`
+	const selectStatus = `SELECT "status" FROM "accounts" WHERE id = \$1 LIMIT \$2`
+
+	tests := []struct {
+		name      string
+		setupSQL  func(m sqlmock.Sqlmock)
+		assertErr func(t *testing.T, hold *model.Hold, err error)
+	}{
+		{
+			name: "amount is reserved and the hold inserted",
+			setupSQL: func(m sqlmock.Sqlmock) {
+				m.ExpectBegin()
+				m.ExpectExec(reserve).
+					WithArgs(amount, sqlmock.AnyArg(), accountID, amount, "active").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				m.ExpectQuery(`INSERT INTO "holds" .* RETURNING "id"`).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+				m.ExpectCommit()
+			},
+			assertErr: func(t *testing.T, hold *model.Hold, err error) {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if hold.ID != 7 {
+					t.Fatalf("expected hold ID to be set, got %d", hold.ID)
+				}
+			},
+		},
+		{
+			name: "missing available funds roll back",
+			setupSQL: func(m sqlmock.Sqlmock) {
+				m.ExpectBegin()
+				m.ExpectExec(reserve).
+					WithArgs(amount, sqlmock.AnyArg(), accountID, amount, "active").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				m.ExpectQuery(selectStatus).
+					WithArgs(accountID, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"status"}).AddRow("active"))
+				m.ExpectRollback()
+			},
+			assertErr: func(t *testing.T, hold *model.Hold, err error) {
+				apiErr, ok := err.(*util.APIError)
+				if !ok || apiErr.Code != 400 || apiErr.Message != "insufficient funds" {
+					t.Fatalf("expected insufficient funds APIError, got %#v", err)
+				}
+			},
+		},
+		{
+			name: "frozen account is rejected",
+			setupSQL: func(m sqlmock.Sqlmock) {
+				m.ExpectBegin()
+				m.ExpectExec(reserve).
+					WithArgs(amount, sqlmock.AnyArg(), accountID, amount, "active").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				m.ExpectQuery(selectStatus).
+					WithArgs(accountID, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"status"}).AddRow("frozen"))
+				m.ExpectRollback()
+			},
+			assertErr: func(t *testing.T, hold *model.Hold, err error) {
+				apiErr, ok := err.(*util.APIError)
+				if !ok || apiErr.Code != 409 || apiErr.Message != "account is frozen" {
+					t.Fatalf("expected account is frozen APIError, got %#v", err)
+				}
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			dbm := testutil.NewGormSQLMock(t)
+			defer dbm.Cleanup()
+
+			tc.setupSQL(dbm.Mock)
+			repo := repository.NewGormHoldRepository(dbm.DB)
+			hold := &model.Hold{AccountID: accountID, Amount: amount, Status: "active"}
+			err := repo.Create(ctx, hold)
+			tc.assertErr(t, hold, err)
+
+			if err := dbm.Mock.ExpectationsWereMet(); err != nil {
+				t.Fatalf("unmet sqlmock expectations: %v", err)
+			}
+		})
+	}
+}
+
+func TestGormHoldRepository_Resolve(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	accountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440961")
+	hold := &model.Hold{
+		ID:             9,
+		AccountID:      accountID,
+		Amount:         decimal.RequireFromString("30.00"),
+		CapturedAmount: decimal.RequireFromString("12.50"),
+	}
+
+	const resolve = `UPDATE "holds" SET "captured_amount"=\$1,"status"=\$2,"updated_at"=\$3 WHERE id = \$4 AND status = \$5`
+	const release = `UPDATE "accounts" SET "held_amount"=held_amount - \$1,"updated_at"=\$2 WHERE id = \$3`
+
+	tests := []struct {
+		name      string
+		setupSQL  func(m sqlmock.Sqlmock)
+		assertErr func(t *testing.T, ok bool, err error)
+	}{
+		{
+			name: "active hold is resolved and its whole amount released",
+			setupSQL: func(m sqlmock.Sqlmock) {
+				m.ExpectBegin()
+				m.ExpectExec(resolve).
+					WithArgs(hold.CapturedAmount, "captured", sqlmock.AnyArg(), hold.ID, "active").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				m.ExpectExec(release).
+					WithArgs(hold.Amount, sqlmock.AnyArg(), accountID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				m.ExpectCommit()
+			},
+			assertErr: func(t *testing.T, ok bool, err error) {
+				if err != nil || !ok {
+					t.Fatalf("expected hold to be resolved, got ok=%v err=%v", ok, err)
+				}
+			},
+		},
+		{
+			name: "hold resolved in the meantime is left untouched",
+			setupSQL: func(m sqlmock.Sqlmock) {
+				m.ExpectBegin()
+				m.ExpectExec(resolve).
+					WithArgs(hold.CapturedAmount, "captured", sqlmock.AnyArg(), hold.ID, "active").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				m.ExpectCommit()
+			},
+			assertErr: func(t *testing.T, ok bool, err error) {
+				if err != nil || ok {
+					t.Fatalf("expected hold not to be resolved, got ok=%v err=%v", ok, err)
+				}
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			dbm := testutil.NewGormSQLMock(t)
+			defer dbm.Cleanup()
+
+			tc.setupSQL(dbm.Mock)
+			repo := repository.NewGormHoldRepository(dbm.DB)
+			ok, err := repo.Resolve(ctx, hold, "captured")
+			tc.assertErr(t, ok, err)
+
+			if err := dbm.Mock.ExpectationsWereMet(); err != nil {
+				t.Fatalf("unmet sqlmock expectations: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"VDM2-BankBE/internal/model"
+	"VDM2-BankBE/internal/util"
+)
+
+// GormIdempotencyKeyRepository implements IdempotencyKeyRepository using GORM
+type GormIdempotencyKeyRepository struct {
+	db *gorm.DB
+}
+
+// NewGormIdempotencyKeyRepository creates a new idempotency key repository with GORM
+func NewGormIdempotencyKeyRepository(db *gorm.DB) IdempotencyKeyRepository {
+	return &GormIdempotencyKeyRepository{db: db}
+}
+
+// Create reserves an idempotency key, failing with a conflict if it is already taken
+func (r *GormIdempotencyKeyRepository) Create(ctx context.Context, key *model.IdempotencyKey) error {
+	result := withContext(ctx, r.db).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(key)
+	if result.Error != nil {
+		return errors.Wrap(result.Error, "failed to create idempotency key")
+	}
+
+	if result.RowsAffected == 0 {
+		return util.NewConflictError("a request with this idempotency key is already in progress")
+	}
+
+	return nil
+}
+
+// Get retrieves an idempotency key for a user
+func (r *GormIdempotencyKeyRepository) Get(ctx context.Context, userID uuid.UUID, key string) (*model.IdempotencyKey, error) {
+	var record model.IdempotencyKey
+
+	err := withContext(ctx, r.db).Where("user_id = ? AND key = ?", userID, key).First(&record).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, util.NewNotFoundError("idempotency key not found")
+		}
+		return nil, errors.Wrap(err, "failed to get idempotency key")
+	}
+
+	return &record, nil
+}
+
+// SaveResponse stores the response produced for a reserved idempotency key
+func (r *GormIdempotencyKeyRepository) SaveResponse(
+	ctx context.Context,
+	userID uuid.UUID,
+	key string,
+	statusCode int,
+	body []byte,
+) error {
+	err := withContext(ctx, r.db).
+		Model(&model.IdempotencyKey{}).
+		Where("user_id = ? AND key = ?", userID, key).
+		Updates(map[string]interface{}{
+			"status_code":   statusCode,
+			"response_body": body,
+		}).Error
+	if err != nil {
+		return errors.Wrap(err, "failed to save idempotent response")
+	}
+
+	return nil
+}
+
+// Delete removes an idempotency key
+func (r *GormIdempotencyKeyRepository) Delete(ctx context.Context, userID uuid.UUID, key string) error {
+	err := withContext(ctx, r.db).Delete(&model.IdempotencyKey{}, "user_id = ? AND key = ?", userID, key).Error
+	if err != nil {
+		return errors.Wrap(err, "failed to delete idempotency key")
+	}
+
+	return nil
+}
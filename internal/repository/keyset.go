@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+
+	"VDM2-BankBE/internal/util"
+)
+
+// keysetPage restricts query to the page of params in a list sorted by column and then id, newest first unless
+// ascending. It reads one row more than the limit, so that trimPage can tell whether there are more rows.
+func keysetPage(query *gorm.DB, column string, ascending bool, params *util.CursorParams) *gorm.DB {
+	// A page read backward walks the list in reverse from the cursor
+	if params.Backward() {
+		ascending = !ascending
+	}
+
+	op, direction := "<", "DESC"
+	if ascending {
+		op, direction = ">", "ASC"
+	}
+
+	if params.Cursor != nil {
+		query = query.Where("("+column+", id) "+op+" (?, ?)", params.Cursor.Time, params.Cursor.ID)
+	}
+
+	return query.Order(column + " " + direction + ", id " + direction).Limit(params.Limit + 1)
+}
+
+// trimPage drops the extra row read by keysetPage and puts a page read backward back in list order, reporting
+// whether there are more rows past the page
+func trimPage[T any](rows []T, params *util.CursorParams) ([]T, bool) {
+	more := len(rows) > params.Limit
+	if more {
+		rows = rows[:params.Limit]
+	}
+
+	if params.Backward() {
+		for i, j := 0, len(rows)-1; i < j; i, j = i+1, j-1 {
+			rows[i], rows[j] = rows[j], rows[i]
+		}
+	}
+
+	return rows, more
+}
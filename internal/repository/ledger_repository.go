@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"VDM2-BankBE/internal/model"
+	"VDM2-BankBE/internal/util"
+)
+
+// GormLedgerRepository implements LedgerRepository using GORM
+type GormLedgerRepository struct {
+	db *gorm.DB
+}
+
+// NewGormLedgerRepository creates a new ledger repository with GORM
+func NewGormLedgerRepository(db *gorm.DB) LedgerRepository {
+	return &GormLedgerRepository{db: db}
+}
+
+// Post records a journal entry with its postings and applies them to the account balances in one transaction.
+// A debit beyond the available balance of a customer account fails the whole entry with an insufficient funds error.
+// Customer postings get the resulting account balance in BalanceAfter.
+func (r *GormLedgerRepository) Post(ctx context.Context, entry *model.JournalEntry) error {
+	return withContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		// Create the entry along with its postings
+		if err := tx.Create(entry).Error; err != nil {
+			return errors.Wrap(err, "failed to create journal entry")
+		}
+
+		for i := range entry.Postings {
+			posting := &entry.Postings[i]
+			if posting.AccountID != nil {
+				if err := r.applyToAccount(tx, posting); err != nil {
+					return err
+				}
+				continue
+			}
+
+			result := tx.Model(&model.SystemAccount{}).
+				Where("code = ? AND currency = ?", *posting.SystemAccount, posting.Currency).
+				Update("balance", gorm.Expr("balance + ?", posting.Amount))
+			if result.Error != nil {
+				return errors.Wrap(result.Error, "failed to update system account balance")
+			}
+			if result.RowsAffected == 0 {
+				return errors.Errorf("unknown system account %q in %s", *posting.SystemAccount, posting.Currency)
+			}
+		}
+
+		return nil
+	})
+}
+
+// applyToAccount adds a posting to a customer account balance, as long as the posting is in the currency of the
+// account, the account status allows the movement and, for debits, the available balance (active holds and
+// overdraft included) covers it, and records the new balance on the posting
+func (r *GormLedgerRepository) applyToAccount(tx *gorm.DB, posting *model.Posting) error {
+	// Debits need an active account and enough available funds, credits are also accepted by debit-blocked accounts
+	var account model.Account
+	query := tx.Model(&account).Where("id = ? AND currency = ?", *posting.AccountID, posting.Currency)
+	if posting.Amount.IsPositive() {
+		query = query.Where("status IN ?", []string{"active", "debit_blocked"})
+	} else {
+		query = query.Where("balance - held_amount + ? >= -overdraft_limit AND status IN ?", posting.Amount, []string{"active"})
+	}
+
+	result := query.
+		Clauses(clause.Returning{Columns: []clause.Column{{Name: "balance"}}}).
+		Update("balance", gorm.Expr("balance + ?", posting.Amount))
+	if result.Error != nil {
+		return errors.Wrap(result.Error, "failed to update account balance")
+	}
+	if result.RowsAffected == 1 {
+		posting.BalanceAfter = account.Balance
+		return nil
+	}
+
+	// Tell a missing account, a currency mismatch and a status that forbids the movement apart from a debit
+	// beyond the available balance
+	var current model.Account
+	if err := tx.Select("status", "currency").Where("id = ?", *posting.AccountID).Take(&current).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return util.NewNotFoundError("account not found")
+		}
+		return errors.Wrap(err, "failed to get account for balance update")
+	}
+	if current.Currency != posting.Currency {
+		return errors.Errorf("posting in %s cannot be applied to an account in %s", posting.Currency, current.Currency)
+	}
+	if (posting.Amount.IsPositive() && !current.CanReceive()) || (posting.Amount.IsNegative() && !current.CanSend()) {
+		return util.NewConflictError("account is " + current.Status)
+	}
+
+	return util.NewBadRequestError("insufficient funds")
+}
@@ -0,0 +1,185 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"VDM2-BankBE/internal/model"
+	"VDM2-BankBE/internal/repository"
+	"VDM2-BankBE/internal/testutil"
+	"VDM2-BankBE/internal/util"
+)
+
+func TestGormLedgerRepository_Post(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	accountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440950")
+	amount := decimal.RequireFromString("20.00")
+	cashOut := "cash_out"
+
+	newEntry := func() *model.JournalEntry {
+		return &model.JournalEntry{
+			Description: "withdrawal",
+			Postings: []model.Posting{
+				{AccountID: &accountID, Amount: amount.Neg(), Currency: "EUR"},
+				{SystemAccount: &cashOut, Amount: amount, Currency: "EUR"},
+			},
+		}
+	}
+
+	const updateAccount = `UPDATE "accounts" SET "balance"=balance \+ \$1,"updated_at"=\$2 WHERE \(id = \$3 AND currency = \$4\) AND \(balance - held_amount \+ \$5 >= -overdraft_limit AND status IN \(\$6\)\) RETURNING "balance"`
+	const selectStatus = `SELECT "status","currency" FROM "accounts" WHERE id = \$1 LIMIT \$2`
+
+	tests := []struct {
+		name      string
+		setupSQL  func(m sqlmock.Sqlmock)
+		assertErr func(t *testing.T, entry *model.JournalEntry, err error)
+	}{
+		{
+			name: "entry, postings and balances are written in one transaction",
+			setupSQL: func(m sqlmock.Sqlmock) {
+				m.ExpectBegin()
+				m.ExpectQuery(`INSERT INTO "journal_entries" .* RETURNING "id"`).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+				m.ExpectQuery(`INSERT INTO "postings" .*`).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5).AddRow(6))
+				m.ExpectQuery(updateAccount).
+					WithArgs(amount.Neg(), sqlmock.AnyArg(), accountID, "EUR", amount.Neg(), "active").
+					WillReturnRows(sqlmock.NewRows([]string{"balance"}).AddRow("80.00"))
+				m.ExpectExec(`UPDATE "system_accounts" SET "balance"=balance \+ \$1,"updated_at"=\$2 WHERE code = \$3 AND currency = \$4`).
+					WithArgs(amount, sqlmock.AnyArg(), cashOut, "EUR").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				m.ExpectCommit()
+			},
+			assertErr: func(t *testing.T, entry *model.JournalEntry, err error) {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if entry.ID != 3 {
+					t.Fatalf("expected entry ID to be set, got %d", entry.ID)
+				}
+				if !entry.Postings[0].BalanceAfter.Equal(decimal.RequireFromString("80.00")) {
+					t.Fatalf("unexpected balance after: %s", entry.Postings[0].BalanceAfter.String())
+				}
+			},
+		},
+		{
+			name: "balance going negative rolls the entry back",
+			setupSQL: func(m sqlmock.Sqlmock) {
+				m.ExpectBegin()
+				m.ExpectQuery(`INSERT INTO "journal_entries" .* RETURNING "id"`).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+				m.ExpectQuery(`INSERT INTO "postings" .*`).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5).AddRow(6))
+				m.ExpectQuery(updateAccount).
+					WithArgs(amount.Neg(), sqlmock.AnyArg(), accountID, "EUR", amount.Neg(), "active").
+					WillReturnRows(sqlmock.NewRows([]string{"balance"}))
+				m.ExpectQuery(selectStatus).
+					WithArgs(accountID, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"status", "currency"}).AddRow("active", "EUR"))
+				m.ExpectRollback()
+			},
+			assertErr: func(t *testing.T, entry *model.JournalEntry, err error) {
+				apiErr, ok := err.(*util.APIError)
+				if !ok || apiErr.Code != 400 || apiErr.Message != "insufficient funds" {
+					t.Fatalf("expected insufficient funds APIError, got %#v", err)
+				}
+			},
+		},
+		{
+			name: "debit from a frozen account is rejected",
+			setupSQL: func(m sqlmock.Sqlmock) {
+				m.ExpectBegin()
+				m.ExpectQuery(`INSERT INTO "journal_entries" .* RETURNING "id"`).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+				m.ExpectQuery(`INSERT INTO "postings" .*`).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5).AddRow(6))
+				m.ExpectQuery(updateAccount).
+					WithArgs(amount.Neg(), sqlmock.AnyArg(), accountID, "EUR", amount.Neg(), "active").
+					WillReturnRows(sqlmock.NewRows([]string{"balance"}))
+				m.ExpectQuery(selectStatus).
+					WithArgs(accountID, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"status", "currency"}).AddRow("frozen", "EUR"))
+				m.ExpectRollback()
+			},
+			assertErr: func(t *testing.T, entry *model.JournalEntry, err error) {
+				apiErr, ok := err.(*util.APIError)
+				if !ok || apiErr.Code != 409 || apiErr.Message != "account is frozen" {
+					t.Fatalf("expected account is frozen APIError, got %#v", err)
+				}
+			},
+		},
+		{
+			name: "posting in another currency than the account is rejected",
+			setupSQL: func(m sqlmock.Sqlmock) {
+				m.ExpectBegin()
+				m.ExpectQuery(`INSERT INTO "journal_entries" .* RETURNING "id"`).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+				m.ExpectQuery(`INSERT INTO "postings" .*`).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5).AddRow(6))
+				m.ExpectQuery(updateAccount).
+					WithArgs(amount.Neg(), sqlmock.AnyArg(), accountID, "EUR", amount.Neg(), "active").
+					WillReturnRows(sqlmock.NewRows([]string{"balance"}))
+				m.ExpectQuery(selectStatus).
+					WithArgs(accountID, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"status", "currency"}).AddRow("active", "USD"))
+				m.ExpectRollback()
+			},
+			assertErr: func(t *testing.T, entry *model.JournalEntry, err error) {
+				if err == nil {
+					t.Fatal("expected currency mismatch error")
+				}
+				if _, ok := err.(*util.APIError); ok {
+					t.Fatalf("expected internal error, got %#v", err)
+				}
+			},
+		},
+		{
+			name: "missing account returns not found",
+			setupSQL: func(m sqlmock.Sqlmock) {
+				m.ExpectBegin()
+				m.ExpectQuery(`INSERT INTO "journal_entries" .* RETURNING "id"`).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+				m.ExpectQuery(`INSERT INTO "postings" .*`).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5).AddRow(6))
+				m.ExpectQuery(updateAccount).
+					WithArgs(amount.Neg(), sqlmock.AnyArg(), accountID, "EUR", amount.Neg(), "active").
+					WillReturnRows(sqlmock.NewRows([]string{"balance"}))
+				m.ExpectQuery(selectStatus).
+					WithArgs(accountID, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"status", "currency"}))
+				m.ExpectRollback()
+			},
+			assertErr: func(t *testing.T, entry *model.JournalEntry, err error) {
+				apiErr, ok := err.(*util.APIError)
+				if !ok || apiErr.Code != 404 {
+					t.Fatalf("expected 404 APIError, got %#v", err)
+				}
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			dbm := testutil.NewGormSQLMock(t)
+			defer dbm.Cleanup()
+
+			tc.setupSQL(dbm.Mock)
+			repo := repository.NewGormLedgerRepository(dbm.DB)
+			entry := newEntry()
+			err := repo.Post(ctx, entry)
+			tc.assertErr(t, entry, err)
+
+			if err := dbm.Mock.ExpectationsWereMet(); err != nil {
+				t.Fatalf("unmet sqlmock expectations: %v", err)
+			}
+		})
+	}
+}
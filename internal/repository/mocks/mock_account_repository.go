@@ -8,6 +8,7 @@ import (
 	model "VDM2-BankBE/internal/model"
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
@@ -37,6 +38,21 @@ func (m *MockAccountRepository) EXPECT() *MockAccountRepositoryMockRecorder {
 	return m.recorder
 }
 
+// Close mocks base method.
+func (m *MockAccountRepository) Close(arg0 context.Context, arg1 uuid.UUID, arg2 string, arg3 time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockAccountRepositoryMockRecorder) Close(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockAccountRepository)(nil).Close), arg0, arg1, arg2, arg3)
+}
+
 // Create mocks base method.
 func (m *MockAccountRepository) Create(arg0 context.Context, arg1 *model.Account) error {
 	m.ctrl.T.Helper()
@@ -65,6 +81,21 @@ func (mr *MockAccountRepositoryMockRecorder) Delete(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAccountRepository)(nil).Delete), arg0, arg1)
 }
 
+// GetByIBAN mocks base method.
+func (m *MockAccountRepository) GetByIBAN(arg0 context.Context, arg1 string) (*model.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIBAN", arg0, arg1)
+	ret0, _ := ret[0].(*model.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIBAN indicates an expected call of GetByIBAN.
+func (mr *MockAccountRepositoryMockRecorder) GetByIBAN(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIBAN", reflect.TypeOf((*MockAccountRepository)(nil).GetByIBAN), arg0, arg1)
+}
+
 // GetByID mocks base method.
 func (m *MockAccountRepository) GetByID(arg0 context.Context, arg1 uuid.UUID) (*model.Account, error) {
 	m.ctrl.T.Helper()
@@ -95,16 +126,60 @@ func (mr *MockAccountRepositoryMockRecorder) GetByUserID(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserID", reflect.TypeOf((*MockAccountRepository)(nil).GetByUserID), arg0, arg1)
 }
 
-// UpdateBalance mocks base method.
-func (m *MockAccountRepository) UpdateBalance(arg0 context.Context, arg1 uuid.UUID, arg2 decimal.Decimal) error {
+// ListByUserID mocks base method.
+func (m *MockAccountRepository) ListByUserID(arg0 context.Context, arg1 uuid.UUID) ([]*model.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByUserID", arg0, arg1)
+	ret0, _ := ret[0].([]*model.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByUserID indicates an expected call of ListByUserID.
+func (mr *MockAccountRepositoryMockRecorder) ListByUserID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByUserID", reflect.TypeOf((*MockAccountRepository)(nil).ListByUserID), arg0, arg1)
+}
+
+// SetDefault mocks base method.
+func (m *MockAccountRepository) SetDefault(arg0 context.Context, arg1, arg2 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDefault", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetDefault indicates an expected call of SetDefault.
+func (mr *MockAccountRepositoryMockRecorder) SetDefault(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDefault", reflect.TypeOf((*MockAccountRepository)(nil).SetDefault), arg0, arg1, arg2)
+}
+
+// TransitionStatus mocks base method.
+func (m *MockAccountRepository) TransitionStatus(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransitionStatus", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransitionStatus indicates an expected call of TransitionStatus.
+func (mr *MockAccountRepositoryMockRecorder) TransitionStatus(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransitionStatus", reflect.TypeOf((*MockAccountRepository)(nil).TransitionStatus), arg0, arg1, arg2, arg3)
+}
+
+// UpdateOverdraft mocks base method.
+func (m *MockAccountRepository) UpdateOverdraft(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 decimal.Decimal) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateBalance", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "UpdateOverdraft", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// UpdateBalance indicates an expected call of UpdateBalance.
-func (mr *MockAccountRepositoryMockRecorder) UpdateBalance(arg0, arg1, arg2 interface{}) *gomock.Call {
+// UpdateOverdraft indicates an expected call of UpdateOverdraft.
+func (mr *MockAccountRepositoryMockRecorder) UpdateOverdraft(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBalance", reflect.TypeOf((*MockAccountRepository)(nil).UpdateBalance), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOverdraft", reflect.TypeOf((*MockAccountRepository)(nil).UpdateOverdraft), arg0, arg1, arg2, arg3)
 }
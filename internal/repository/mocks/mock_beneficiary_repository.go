@@ -0,0 +1,141 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: VDM2-BankBE/internal/repository (interfaces: BeneficiaryRepository)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	model "VDM2-BankBE/internal/model"
+	util "VDM2-BankBE/internal/util"
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockBeneficiaryRepository is a mock of BeneficiaryRepository interface.
+type MockBeneficiaryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockBeneficiaryRepositoryMockRecorder
+}
+
+// MockBeneficiaryRepositoryMockRecorder is the mock recorder for MockBeneficiaryRepository.
+type MockBeneficiaryRepositoryMockRecorder struct {
+	mock *MockBeneficiaryRepository
+}
+
+// NewMockBeneficiaryRepository creates a new mock instance.
+func NewMockBeneficiaryRepository(ctrl *gomock.Controller) *MockBeneficiaryRepository {
+	mock := &MockBeneficiaryRepository{ctrl: ctrl}
+	mock.recorder = &MockBeneficiaryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBeneficiaryRepository) EXPECT() *MockBeneficiaryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockBeneficiaryRepository) Create(arg0 context.Context, arg1 *model.Beneficiary) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockBeneficiaryRepositoryMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockBeneficiaryRepository)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockBeneficiaryRepository) Delete(arg0 context.Context, arg1 uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockBeneficiaryRepositoryMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockBeneficiaryRepository)(nil).Delete), arg0, arg1)
+}
+
+// GetByAccountID mocks base method.
+func (m *MockBeneficiaryRepository) GetByAccountID(arg0 context.Context, arg1, arg2 uuid.UUID) (*model.Beneficiary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByAccountID", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.Beneficiary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByAccountID indicates an expected call of GetByAccountID.
+func (mr *MockBeneficiaryRepositoryMockRecorder) GetByAccountID(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByAccountID", reflect.TypeOf((*MockBeneficiaryRepository)(nil).GetByAccountID), arg0, arg1, arg2)
+}
+
+// GetByID mocks base method.
+func (m *MockBeneficiaryRepository) GetByID(arg0 context.Context, arg1 uint64) (*model.Beneficiary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", arg0, arg1)
+	ret0, _ := ret[0].(*model.Beneficiary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockBeneficiaryRepositoryMockRecorder) GetByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockBeneficiaryRepository)(nil).GetByID), arg0, arg1)
+}
+
+// GetByUserID mocks base method.
+func (m *MockBeneficiaryRepository) GetByUserID(arg0 context.Context, arg1 uuid.UUID, arg2 *util.PaginationParams) ([]*model.Beneficiary, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByUserID", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*model.Beneficiary)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetByUserID indicates an expected call of GetByUserID.
+func (mr *MockBeneficiaryRepositoryMockRecorder) GetByUserID(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserID", reflect.TypeOf((*MockBeneficiaryRepository)(nil).GetByUserID), arg0, arg1, arg2)
+}
+
+// MarkUsed mocks base method.
+func (m *MockBeneficiaryRepository) MarkUsed(arg0 context.Context, arg1 uint64, arg2 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkUsed", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkUsed indicates an expected call of MarkUsed.
+func (mr *MockBeneficiaryRepositoryMockRecorder) MarkUsed(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkUsed", reflect.TypeOf((*MockBeneficiaryRepository)(nil).MarkUsed), arg0, arg1, arg2)
+}
+
+// UpdateNickname mocks base method.
+func (m *MockBeneficiaryRepository) UpdateNickname(arg0 context.Context, arg1 uint64, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNickname", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateNickname indicates an expected call of UpdateNickname.
+func (mr *MockBeneficiaryRepositoryMockRecorder) UpdateNickname(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNickname", reflect.TypeOf((*MockBeneficiaryRepository)(nil).UpdateNickname), arg0, arg1, arg2)
+}
@@ -0,0 +1,95 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: VDM2-BankBE/internal/repository (interfaces: CosignerRepository)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	model "VDM2-BankBE/internal/model"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockCosignerRepository is a mock of CosignerRepository interface.
+type MockCosignerRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCosignerRepositoryMockRecorder
+}
+
+// MockCosignerRepositoryMockRecorder is the mock recorder for MockCosignerRepository.
+type MockCosignerRepositoryMockRecorder struct {
+	mock *MockCosignerRepository
+}
+
+// NewMockCosignerRepository creates a new mock instance.
+func NewMockCosignerRepository(ctrl *gomock.Controller) *MockCosignerRepository {
+	mock := &MockCosignerRepository{ctrl: ctrl}
+	mock.recorder = &MockCosignerRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCosignerRepository) EXPECT() *MockCosignerRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCosignerRepository) Create(arg0 context.Context, arg1 *model.AccountCosigner) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCosignerRepositoryMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCosignerRepository)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockCosignerRepository) Delete(arg0 context.Context, arg1, arg2 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockCosignerRepositoryMockRecorder) Delete(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCosignerRepository)(nil).Delete), arg0, arg1, arg2)
+}
+
+// Exists mocks base method.
+func (m *MockCosignerRepository) Exists(arg0 context.Context, arg1, arg2 uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exists", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exists indicates an expected call of Exists.
+func (mr *MockCosignerRepositoryMockRecorder) Exists(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockCosignerRepository)(nil).Exists), arg0, arg1, arg2)
+}
+
+// ListByAccountID mocks base method.
+func (m *MockCosignerRepository) ListByAccountID(arg0 context.Context, arg1 uuid.UUID) ([]*model.AccountCosigner, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByAccountID", arg0, arg1)
+	ret0, _ := ret[0].([]*model.AccountCosigner)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByAccountID indicates an expected call of ListByAccountID.
+func (mr *MockCosignerRepositoryMockRecorder) ListByAccountID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByAccountID", reflect.TypeOf((*MockCosignerRepository)(nil).ListByAccountID), arg0, arg1)
+}
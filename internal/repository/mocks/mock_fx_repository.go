@@ -0,0 +1,126 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: VDM2-BankBE/internal/repository (interfaces: FXRepository)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	model "VDM2-BankBE/internal/model"
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockFXRepository is a mock of FXRepository interface.
+type MockFXRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockFXRepositoryMockRecorder
+}
+
+// MockFXRepositoryMockRecorder is the mock recorder for MockFXRepository.
+type MockFXRepositoryMockRecorder struct {
+	mock *MockFXRepository
+}
+
+// NewMockFXRepository creates a new mock instance.
+func NewMockFXRepository(ctrl *gomock.Controller) *MockFXRepository {
+	mock := &MockFXRepository{ctrl: ctrl}
+	mock.recorder = &MockFXRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFXRepository) EXPECT() *MockFXRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateQuote mocks base method.
+func (m *MockFXRepository) CreateQuote(arg0 context.Context, arg1 *model.FXQuote) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateQuote", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateQuote indicates an expected call of CreateQuote.
+func (mr *MockFXRepositoryMockRecorder) CreateQuote(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateQuote", reflect.TypeOf((*MockFXRepository)(nil).CreateQuote), arg0, arg1)
+}
+
+// GetQuote mocks base method.
+func (m *MockFXRepository) GetQuote(arg0 context.Context, arg1 uuid.UUID) (*model.FXQuote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuote", arg0, arg1)
+	ret0, _ := ret[0].(*model.FXQuote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQuote indicates an expected call of GetQuote.
+func (mr *MockFXRepositoryMockRecorder) GetQuote(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuote", reflect.TypeOf((*MockFXRepository)(nil).GetQuote), arg0, arg1)
+}
+
+// GetRate mocks base method.
+func (m *MockFXRepository) GetRate(arg0 context.Context, arg1, arg2 string) (*model.FXRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.FXRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRate indicates an expected call of GetRate.
+func (mr *MockFXRepositoryMockRecorder) GetRate(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRate", reflect.TypeOf((*MockFXRepository)(nil).GetRate), arg0, arg1, arg2)
+}
+
+// ListRates mocks base method.
+func (m *MockFXRepository) ListRates(arg0 context.Context) ([]*model.FXRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRates", arg0)
+	ret0, _ := ret[0].([]*model.FXRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRates indicates an expected call of ListRates.
+func (mr *MockFXRepositoryMockRecorder) ListRates(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRates", reflect.TypeOf((*MockFXRepository)(nil).ListRates), arg0)
+}
+
+// UpsertRates mocks base method.
+func (m *MockFXRepository) UpsertRates(arg0 context.Context, arg1 []*model.FXRate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertRates", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertRates indicates an expected call of UpsertRates.
+func (mr *MockFXRepositoryMockRecorder) UpsertRates(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertRates", reflect.TypeOf((*MockFXRepository)(nil).UpsertRates), arg0, arg1)
+}
+
+// UseQuote mocks base method.
+func (m *MockFXRepository) UseQuote(arg0 context.Context, arg1 uuid.UUID, arg2 time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseQuote", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseQuote indicates an expected call of UseQuote.
+func (mr *MockFXRepositoryMockRecorder) UseQuote(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseQuote", reflect.TypeOf((*MockFXRepository)(nil).UseQuote), arg0, arg1, arg2)
+}
@@ -0,0 +1,130 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: VDM2-BankBE/internal/repository (interfaces: HoldRepository)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	model "VDM2-BankBE/internal/model"
+	util "VDM2-BankBE/internal/util"
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	decimal "github.com/shopspring/decimal"
+)
+
+// MockHoldRepository is a mock of HoldRepository interface.
+type MockHoldRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockHoldRepositoryMockRecorder
+}
+
+// MockHoldRepositoryMockRecorder is the mock recorder for MockHoldRepository.
+type MockHoldRepositoryMockRecorder struct {
+	mock *MockHoldRepository
+}
+
+// NewMockHoldRepository creates a new mock instance.
+func NewMockHoldRepository(ctrl *gomock.Controller) *MockHoldRepository {
+	mock := &MockHoldRepository{ctrl: ctrl}
+	mock.recorder = &MockHoldRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHoldRepository) EXPECT() *MockHoldRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockHoldRepository) Create(arg0 context.Context, arg1 *model.Hold) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockHoldRepositoryMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockHoldRepository)(nil).Create), arg0, arg1)
+}
+
+// GetByAccountID mocks base method.
+func (m *MockHoldRepository) GetByAccountID(arg0 context.Context, arg1 uuid.UUID, arg2 *util.PaginationParams) ([]*model.Hold, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByAccountID", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*model.Hold)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetByAccountID indicates an expected call of GetByAccountID.
+func (mr *MockHoldRepositoryMockRecorder) GetByAccountID(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByAccountID", reflect.TypeOf((*MockHoldRepository)(nil).GetByAccountID), arg0, arg1, arg2)
+}
+
+// GetByID mocks base method.
+func (m *MockHoldRepository) GetByID(arg0 context.Context, arg1 uint64) (*model.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", arg0, arg1)
+	ret0, _ := ret[0].(*model.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockHoldRepositoryMockRecorder) GetByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockHoldRepository)(nil).GetByID), arg0, arg1)
+}
+
+// GetExpired mocks base method.
+func (m *MockHoldRepository) GetExpired(arg0 context.Context, arg1 time.Time, arg2 int) ([]*model.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExpired", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*model.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExpired indicates an expected call of GetExpired.
+func (mr *MockHoldRepositoryMockRecorder) GetExpired(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExpired", reflect.TypeOf((*MockHoldRepository)(nil).GetExpired), arg0, arg1, arg2)
+}
+
+// Reduce mocks base method.
+func (m *MockHoldRepository) Reduce(arg0 context.Context, arg1 *model.Hold, arg2 decimal.Decimal) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reduce", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reduce indicates an expected call of Reduce.
+func (mr *MockHoldRepositoryMockRecorder) Reduce(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reduce", reflect.TypeOf((*MockHoldRepository)(nil).Reduce), arg0, arg1, arg2)
+}
+
+// Resolve mocks base method.
+func (m *MockHoldRepository) Resolve(arg0 context.Context, arg1 *model.Hold, arg2 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resolve", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Resolve indicates an expected call of Resolve.
+func (mr *MockHoldRepositoryMockRecorder) Resolve(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resolve", reflect.TypeOf((*MockHoldRepository)(nil).Resolve), arg0, arg1, arg2)
+}
@@ -0,0 +1,94 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: VDM2-BankBE/internal/repository (interfaces: IdempotencyKeyRepository)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	model "VDM2-BankBE/internal/model"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockIdempotencyKeyRepository is a mock of IdempotencyKeyRepository interface.
+type MockIdempotencyKeyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIdempotencyKeyRepositoryMockRecorder
+}
+
+// MockIdempotencyKeyRepositoryMockRecorder is the mock recorder for MockIdempotencyKeyRepository.
+type MockIdempotencyKeyRepositoryMockRecorder struct {
+	mock *MockIdempotencyKeyRepository
+}
+
+// NewMockIdempotencyKeyRepository creates a new mock instance.
+func NewMockIdempotencyKeyRepository(ctrl *gomock.Controller) *MockIdempotencyKeyRepository {
+	mock := &MockIdempotencyKeyRepository{ctrl: ctrl}
+	mock.recorder = &MockIdempotencyKeyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIdempotencyKeyRepository) EXPECT() *MockIdempotencyKeyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockIdempotencyKeyRepository) Create(arg0 context.Context, arg1 *model.IdempotencyKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIdempotencyKeyRepositoryMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIdempotencyKeyRepository)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockIdempotencyKeyRepository) Delete(arg0 context.Context, arg1 uuid.UUID, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockIdempotencyKeyRepositoryMockRecorder) Delete(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIdempotencyKeyRepository)(nil).Delete), arg0, arg1, arg2)
+}
+
+// Get mocks base method.
+func (m *MockIdempotencyKeyRepository) Get(arg0 context.Context, arg1 uuid.UUID, arg2 string) (*model.IdempotencyKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.IdempotencyKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockIdempotencyKeyRepositoryMockRecorder) Get(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockIdempotencyKeyRepository)(nil).Get), arg0, arg1, arg2)
+}
+
+// SaveResponse mocks base method.
+func (m *MockIdempotencyKeyRepository) SaveResponse(arg0 context.Context, arg1 uuid.UUID, arg2 string, arg3 int, arg4 []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveResponse", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveResponse indicates an expected call of SaveResponse.
+func (mr *MockIdempotencyKeyRepositoryMockRecorder) SaveResponse(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveResponse", reflect.TypeOf((*MockIdempotencyKeyRepository)(nil).SaveResponse), arg0, arg1, arg2, arg3, arg4)
+}
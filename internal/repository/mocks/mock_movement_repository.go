@@ -52,9 +52,9 @@ func (mr *MockMovementRepositoryMockRecorder) Create(arg0, arg1 interface{}) *go
 }
 
 // GetByAccountID mocks base method.
-func (m *MockMovementRepository) GetByAccountID(arg0 context.Context, arg1 uuid.UUID, arg2 *util.PaginationParams) ([]*model.Movement, int, error) {
+func (m *MockMovementRepository) GetByAccountID(arg0 context.Context, arg1 uuid.UUID, arg2 *model.MovementFilter, arg3 *util.PaginationParams) ([]*model.Movement, int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByAccountID", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "GetByAccountID", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].([]*model.Movement)
 	ret1, _ := ret[1].(int)
 	ret2, _ := ret[2].(error)
@@ -62,9 +62,25 @@ func (m *MockMovementRepository) GetByAccountID(arg0 context.Context, arg1 uuid.
 }
 
 // GetByAccountID indicates an expected call of GetByAccountID.
-func (mr *MockMovementRepositoryMockRecorder) GetByAccountID(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockMovementRepositoryMockRecorder) GetByAccountID(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByAccountID", reflect.TypeOf((*MockMovementRepository)(nil).GetByAccountID), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByAccountID", reflect.TypeOf((*MockMovementRepository)(nil).GetByAccountID), arg0, arg1, arg2, arg3)
+}
+
+// GetByAccountIDWithCursor mocks base method.
+func (m *MockMovementRepository) GetByAccountIDWithCursor(arg0 context.Context, arg1 uuid.UUID, arg2 *model.MovementFilter, arg3 *util.CursorParams) ([]*model.Movement, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByAccountIDWithCursor", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*model.Movement)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetByAccountIDWithCursor indicates an expected call of GetByAccountIDWithCursor.
+func (mr *MockMovementRepositoryMockRecorder) GetByAccountIDWithCursor(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByAccountIDWithCursor", reflect.TypeOf((*MockMovementRepository)(nil).GetByAccountIDWithCursor), arg0, arg1, arg2, arg3)
 }
 
 // GetByID mocks base method.
@@ -81,3 +97,18 @@ func (mr *MockMovementRepositoryMockRecorder) GetByID(arg0, arg1 interface{}) *g
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockMovementRepository)(nil).GetByID), arg0, arg1)
 }
+
+// GetByTransferID mocks base method.
+func (m *MockMovementRepository) GetByTransferID(arg0 context.Context, arg1 uint64) ([]*model.Movement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByTransferID", arg0, arg1)
+	ret0, _ := ret[0].([]*model.Movement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByTransferID indicates an expected call of GetByTransferID.
+func (mr *MockMovementRepositoryMockRecorder) GetByTransferID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByTransferID", reflect.TypeOf((*MockMovementRepository)(nil).GetByTransferID), arg0, arg1)
+}
@@ -0,0 +1,144 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: VDM2-BankBE/internal/repository (interfaces: PaymentRequestRepository)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	model "VDM2-BankBE/internal/model"
+	util "VDM2-BankBE/internal/util"
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockPaymentRequestRepository is a mock of PaymentRequestRepository interface.
+type MockPaymentRequestRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentRequestRepositoryMockRecorder
+}
+
+// MockPaymentRequestRepositoryMockRecorder is the mock recorder for MockPaymentRequestRepository.
+type MockPaymentRequestRepositoryMockRecorder struct {
+	mock *MockPaymentRequestRepository
+}
+
+// NewMockPaymentRequestRepository creates a new mock instance.
+func NewMockPaymentRequestRepository(ctrl *gomock.Controller) *MockPaymentRequestRepository {
+	mock := &MockPaymentRequestRepository{ctrl: ctrl}
+	mock.recorder = &MockPaymentRequestRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentRequestRepository) EXPECT() *MockPaymentRequestRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockPaymentRequestRepository) Create(arg0 context.Context, arg1 *model.PaymentRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPaymentRequestRepositoryMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPaymentRequestRepository)(nil).Create), arg0, arg1)
+}
+
+// Expire mocks base method.
+func (m *MockPaymentRequestRepository) Expire(arg0 context.Context, arg1 time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Expire", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Expire indicates an expected call of Expire.
+func (mr *MockPaymentRequestRepositoryMockRecorder) Expire(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Expire", reflect.TypeOf((*MockPaymentRequestRepository)(nil).Expire), arg0, arg1)
+}
+
+// GetByID mocks base method.
+func (m *MockPaymentRequestRepository) GetByID(arg0 context.Context, arg1 uint64) (*model.PaymentRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", arg0, arg1)
+	ret0, _ := ret[0].(*model.PaymentRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockPaymentRequestRepositoryMockRecorder) GetByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockPaymentRequestRepository)(nil).GetByID), arg0, arg1)
+}
+
+// GetByPayerID mocks base method.
+func (m *MockPaymentRequestRepository) GetByPayerID(arg0 context.Context, arg1 uuid.UUID, arg2 string, arg3 *util.PaginationParams) ([]*model.PaymentRequest, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByPayerID", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*model.PaymentRequest)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetByPayerID indicates an expected call of GetByPayerID.
+func (mr *MockPaymentRequestRepositoryMockRecorder) GetByPayerID(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByPayerID", reflect.TypeOf((*MockPaymentRequestRepository)(nil).GetByPayerID), arg0, arg1, arg2, arg3)
+}
+
+// GetByRequesterID mocks base method.
+func (m *MockPaymentRequestRepository) GetByRequesterID(arg0 context.Context, arg1 uuid.UUID, arg2 string, arg3 *util.PaginationParams) ([]*model.PaymentRequest, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByRequesterID", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*model.PaymentRequest)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetByRequesterID indicates an expected call of GetByRequesterID.
+func (mr *MockPaymentRequestRepositoryMockRecorder) GetByRequesterID(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByRequesterID", reflect.TypeOf((*MockPaymentRequestRepository)(nil).GetByRequesterID), arg0, arg1, arg2, arg3)
+}
+
+// SetTransferID mocks base method.
+func (m *MockPaymentRequestRepository) SetTransferID(arg0 context.Context, arg1, arg2 uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTransferID", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetTransferID indicates an expected call of SetTransferID.
+func (mr *MockPaymentRequestRepositoryMockRecorder) SetTransferID(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTransferID", reflect.TypeOf((*MockPaymentRequestRepository)(nil).SetTransferID), arg0, arg1, arg2)
+}
+
+// UpdateStatus mocks base method.
+func (m *MockPaymentRequestRepository) UpdateStatus(arg0 context.Context, arg1 uint64, arg2, arg3 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockPaymentRequestRepositoryMockRecorder) UpdateStatus(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockPaymentRequestRepository)(nil).UpdateStatus), arg0, arg1, arg2, arg3)
+}
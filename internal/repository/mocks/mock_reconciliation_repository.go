@@ -0,0 +1,97 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: VDM2-BankBE/internal/repository (interfaces: ReconciliationRepository)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	model "VDM2-BankBE/internal/model"
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockReconciliationRepository is a mock of ReconciliationRepository interface.
+type MockReconciliationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReconciliationRepositoryMockRecorder
+}
+
+// MockReconciliationRepositoryMockRecorder is the mock recorder for MockReconciliationRepository.
+type MockReconciliationRepositoryMockRecorder struct {
+	mock *MockReconciliationRepository
+}
+
+// NewMockReconciliationRepository creates a new mock instance.
+func NewMockReconciliationRepository(ctrl *gomock.Controller) *MockReconciliationRepository {
+	mock := &MockReconciliationRepository{ctrl: ctrl}
+	mock.recorder = &MockReconciliationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReconciliationRepository) EXPECT() *MockReconciliationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FailOrphanedPendingTransfer mocks base method.
+func (m *MockReconciliationRepository) FailOrphanedPendingTransfer(arg0 context.Context, arg1 uint64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailOrphanedPendingTransfer", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FailOrphanedPendingTransfer indicates an expected call of FailOrphanedPendingTransfer.
+func (mr *MockReconciliationRepositoryMockRecorder) FailOrphanedPendingTransfer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailOrphanedPendingTransfer", reflect.TypeOf((*MockReconciliationRepository)(nil).FailOrphanedPendingTransfer), arg0, arg1)
+}
+
+// FindBalanceDiscrepancies mocks base method.
+func (m *MockReconciliationRepository) FindBalanceDiscrepancies(arg0 context.Context) ([]*model.BalanceDiscrepancy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBalanceDiscrepancies", arg0)
+	ret0, _ := ret[0].([]*model.BalanceDiscrepancy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBalanceDiscrepancies indicates an expected call of FindBalanceDiscrepancies.
+func (mr *MockReconciliationRepositoryMockRecorder) FindBalanceDiscrepancies(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBalanceDiscrepancies", reflect.TypeOf((*MockReconciliationRepository)(nil).FindBalanceDiscrepancies), arg0)
+}
+
+// FindStalePendingTransfers mocks base method.
+func (m *MockReconciliationRepository) FindStalePendingTransfers(arg0 context.Context, arg1 time.Time) ([]*model.StalePendingTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindStalePendingTransfers", arg0, arg1)
+	ret0, _ := ret[0].([]*model.StalePendingTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindStalePendingTransfers indicates an expected call of FindStalePendingTransfers.
+func (mr *MockReconciliationRepositoryMockRecorder) FindStalePendingTransfers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindStalePendingTransfers", reflect.TypeOf((*MockReconciliationRepository)(nil).FindStalePendingTransfers), arg0, arg1)
+}
+
+// FindTransferDiscrepancies mocks base method.
+func (m *MockReconciliationRepository) FindTransferDiscrepancies(arg0 context.Context) ([]*model.TransferDiscrepancy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTransferDiscrepancies", arg0)
+	ret0, _ := ret[0].([]*model.TransferDiscrepancy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTransferDiscrepancies indicates an expected call of FindTransferDiscrepancies.
+func (mr *MockReconciliationRepositoryMockRecorder) FindTransferDiscrepancies(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTransferDiscrepancies", reflect.TypeOf((*MockReconciliationRepository)(nil).FindTransferDiscrepancies), arg0)
+}
@@ -0,0 +1,143 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: VDM2-BankBE/internal/repository (interfaces: StandingOrderRepository)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	model "VDM2-BankBE/internal/model"
+	util "VDM2-BankBE/internal/util"
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockStandingOrderRepository is a mock of StandingOrderRepository interface.
+type MockStandingOrderRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockStandingOrderRepositoryMockRecorder
+}
+
+// MockStandingOrderRepositoryMockRecorder is the mock recorder for MockStandingOrderRepository.
+type MockStandingOrderRepositoryMockRecorder struct {
+	mock *MockStandingOrderRepository
+}
+
+// NewMockStandingOrderRepository creates a new mock instance.
+func NewMockStandingOrderRepository(ctrl *gomock.Controller) *MockStandingOrderRepository {
+	mock := &MockStandingOrderRepository{ctrl: ctrl}
+	mock.recorder = &MockStandingOrderRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStandingOrderRepository) EXPECT() *MockStandingOrderRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Claim mocks base method.
+func (m *MockStandingOrderRepository) Claim(arg0 context.Context, arg1 uint64, arg2, arg3 time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Claim", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Claim indicates an expected call of Claim.
+func (mr *MockStandingOrderRepositoryMockRecorder) Claim(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Claim", reflect.TypeOf((*MockStandingOrderRepository)(nil).Claim), arg0, arg1, arg2, arg3)
+}
+
+// Create mocks base method.
+func (m *MockStandingOrderRepository) Create(arg0 context.Context, arg1 *model.StandingOrder) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockStandingOrderRepositoryMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockStandingOrderRepository)(nil).Create), arg0, arg1)
+}
+
+// GetByAccountID mocks base method.
+func (m *MockStandingOrderRepository) GetByAccountID(arg0 context.Context, arg1 uuid.UUID, arg2 *util.PaginationParams) ([]*model.StandingOrder, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByAccountID", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*model.StandingOrder)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetByAccountID indicates an expected call of GetByAccountID.
+func (mr *MockStandingOrderRepositoryMockRecorder) GetByAccountID(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByAccountID", reflect.TypeOf((*MockStandingOrderRepository)(nil).GetByAccountID), arg0, arg1, arg2)
+}
+
+// GetByID mocks base method.
+func (m *MockStandingOrderRepository) GetByID(arg0 context.Context, arg1 uint64) (*model.StandingOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", arg0, arg1)
+	ret0, _ := ret[0].(*model.StandingOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockStandingOrderRepositoryMockRecorder) GetByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockStandingOrderRepository)(nil).GetByID), arg0, arg1)
+}
+
+// GetDue mocks base method.
+func (m *MockStandingOrderRepository) GetDue(arg0 context.Context, arg1 time.Time, arg2 int) ([]*model.StandingOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDue", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*model.StandingOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDue indicates an expected call of GetDue.
+func (mr *MockStandingOrderRepositoryMockRecorder) GetDue(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDue", reflect.TypeOf((*MockStandingOrderRepository)(nil).GetDue), arg0, arg1, arg2)
+}
+
+// RecordRun mocks base method.
+func (m *MockStandingOrderRepository) RecordRun(arg0 context.Context, arg1 *model.StandingOrder, arg2 time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordRun", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordRun indicates an expected call of RecordRun.
+func (mr *MockStandingOrderRepositoryMockRecorder) RecordRun(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordRun", reflect.TypeOf((*MockStandingOrderRepository)(nil).RecordRun), arg0, arg1, arg2)
+}
+
+// Update mocks base method.
+func (m *MockStandingOrderRepository) Update(arg0 context.Context, arg1 *model.StandingOrder) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockStandingOrderRepositoryMockRecorder) Update(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockStandingOrderRepository)(nil).Update), arg0, arg1)
+}
@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: VDM2-BankBE/internal/repository (interfaces: TransferApprovalRepository)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	model "VDM2-BankBE/internal/model"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockTransferApprovalRepository is a mock of TransferApprovalRepository interface.
+type MockTransferApprovalRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransferApprovalRepositoryMockRecorder
+}
+
+// MockTransferApprovalRepositoryMockRecorder is the mock recorder for MockTransferApprovalRepository.
+type MockTransferApprovalRepositoryMockRecorder struct {
+	mock *MockTransferApprovalRepository
+}
+
+// NewMockTransferApprovalRepository creates a new mock instance.
+func NewMockTransferApprovalRepository(ctrl *gomock.Controller) *MockTransferApprovalRepository {
+	mock := &MockTransferApprovalRepository{ctrl: ctrl}
+	mock.recorder = &MockTransferApprovalRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransferApprovalRepository) EXPECT() *MockTransferApprovalRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockTransferApprovalRepository) Create(arg0 context.Context, arg1 *model.TransferApproval) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTransferApprovalRepositoryMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTransferApprovalRepository)(nil).Create), arg0, arg1)
+}
+
+// ListByTransferID mocks base method.
+func (m *MockTransferApprovalRepository) ListByTransferID(arg0 context.Context, arg1 uint64) ([]*model.TransferApproval, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByTransferID", arg0, arg1)
+	ret0, _ := ret[0].([]*model.TransferApproval)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByTransferID indicates an expected call of ListByTransferID.
+func (mr *MockTransferApprovalRepositoryMockRecorder) ListByTransferID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByTransferID", reflect.TypeOf((*MockTransferApprovalRepository)(nil).ListByTransferID), arg0, arg1)
+}
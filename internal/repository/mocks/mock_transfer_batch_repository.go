@@ -0,0 +1,124 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: VDM2-BankBE/internal/repository (interfaces: TransferBatchRepository)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	model "VDM2-BankBE/internal/model"
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockTransferBatchRepository is a mock of TransferBatchRepository interface.
+type MockTransferBatchRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransferBatchRepositoryMockRecorder
+}
+
+// MockTransferBatchRepositoryMockRecorder is the mock recorder for MockTransferBatchRepository.
+type MockTransferBatchRepositoryMockRecorder struct {
+	mock *MockTransferBatchRepository
+}
+
+// NewMockTransferBatchRepository creates a new mock instance.
+func NewMockTransferBatchRepository(ctrl *gomock.Controller) *MockTransferBatchRepository {
+	mock := &MockTransferBatchRepository{ctrl: ctrl}
+	mock.recorder = &MockTransferBatchRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransferBatchRepository) EXPECT() *MockTransferBatchRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Claim mocks base method.
+func (m *MockTransferBatchRepository) Claim(arg0 context.Context, arg1 uint64, arg2, arg3 time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Claim", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Claim indicates an expected call of Claim.
+func (mr *MockTransferBatchRepositoryMockRecorder) Claim(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Claim", reflect.TypeOf((*MockTransferBatchRepository)(nil).Claim), arg0, arg1, arg2, arg3)
+}
+
+// Create mocks base method.
+func (m *MockTransferBatchRepository) Create(arg0 context.Context, arg1 *model.TransferBatch) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTransferBatchRepositoryMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTransferBatchRepository)(nil).Create), arg0, arg1)
+}
+
+// GetByID mocks base method.
+func (m *MockTransferBatchRepository) GetByID(arg0 context.Context, arg1 uint64) (*model.TransferBatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", arg0, arg1)
+	ret0, _ := ret[0].(*model.TransferBatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockTransferBatchRepositoryMockRecorder) GetByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockTransferBatchRepository)(nil).GetByID), arg0, arg1)
+}
+
+// GetPending mocks base method.
+func (m *MockTransferBatchRepository) GetPending(arg0 context.Context, arg1 time.Time, arg2 int) ([]*model.TransferBatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPending", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*model.TransferBatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPending indicates an expected call of GetPending.
+func (mr *MockTransferBatchRepositoryMockRecorder) GetPending(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPending", reflect.TypeOf((*MockTransferBatchRepository)(nil).GetPending), arg0, arg1, arg2)
+}
+
+// UpdateItem mocks base method.
+func (m *MockTransferBatchRepository) UpdateItem(arg0 context.Context, arg1 *model.TransferBatchItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateItem", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateItem indicates an expected call of UpdateItem.
+func (mr *MockTransferBatchRepositoryMockRecorder) UpdateItem(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateItem", reflect.TypeOf((*MockTransferBatchRepository)(nil).UpdateItem), arg0, arg1)
+}
+
+// UpdateProgress mocks base method.
+func (m *MockTransferBatchRepository) UpdateProgress(arg0 context.Context, arg1 *model.TransferBatch) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProgress", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateProgress indicates an expected call of UpdateProgress.
+func (mr *MockTransferBatchRepositoryMockRecorder) UpdateProgress(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProgress", reflect.TypeOf((*MockTransferBatchRepository)(nil).UpdateProgress), arg0, arg1)
+}
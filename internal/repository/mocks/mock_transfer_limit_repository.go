@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: VDM2-BankBE/internal/repository (interfaces: TransferLimitRepository)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	model "VDM2-BankBE/internal/model"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockTransferLimitRepository is a mock of TransferLimitRepository interface.
+type MockTransferLimitRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransferLimitRepositoryMockRecorder
+}
+
+// MockTransferLimitRepositoryMockRecorder is the mock recorder for MockTransferLimitRepository.
+type MockTransferLimitRepositoryMockRecorder struct {
+	mock *MockTransferLimitRepository
+}
+
+// NewMockTransferLimitRepository creates a new mock instance.
+func NewMockTransferLimitRepository(ctrl *gomock.Controller) *MockTransferLimitRepository {
+	mock := &MockTransferLimitRepository{ctrl: ctrl}
+	mock.recorder = &MockTransferLimitRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransferLimitRepository) EXPECT() *MockTransferLimitRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetByUserID mocks base method.
+func (m *MockTransferLimitRepository) GetByUserID(arg0 context.Context, arg1 uuid.UUID) (*model.TransferLimit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByUserID", arg0, arg1)
+	ret0, _ := ret[0].(*model.TransferLimit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByUserID indicates an expected call of GetByUserID.
+func (mr *MockTransferLimitRepositoryMockRecorder) GetByUserID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserID", reflect.TypeOf((*MockTransferLimitRepository)(nil).GetByUserID), arg0, arg1)
+}
+
+// Lock mocks base method.
+func (m *MockTransferLimitRepository) Lock(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Lock", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Lock indicates an expected call of Lock.
+func (mr *MockTransferLimitRepositoryMockRecorder) Lock(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lock", reflect.TypeOf((*MockTransferLimitRepository)(nil).Lock), arg0, arg1)
+}
+
+// Upsert mocks base method.
+func (m *MockTransferLimitRepository) Upsert(arg0 context.Context, arg1 *model.TransferLimit) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockTransferLimitRepositoryMockRecorder) Upsert(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockTransferLimitRepository)(nil).Upsert), arg0, arg1)
+}
@@ -0,0 +1,95 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: VDM2-BankBE/internal/repository (interfaces: TransferOutboxRepository)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	model "VDM2-BankBE/internal/model"
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockTransferOutboxRepository is a mock of TransferOutboxRepository interface.
+type MockTransferOutboxRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransferOutboxRepositoryMockRecorder
+}
+
+// MockTransferOutboxRepositoryMockRecorder is the mock recorder for MockTransferOutboxRepository.
+type MockTransferOutboxRepositoryMockRecorder struct {
+	mock *MockTransferOutboxRepository
+}
+
+// NewMockTransferOutboxRepository creates a new mock instance.
+func NewMockTransferOutboxRepository(ctrl *gomock.Controller) *MockTransferOutboxRepository {
+	mock := &MockTransferOutboxRepository{ctrl: ctrl}
+	mock.recorder = &MockTransferOutboxRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransferOutboxRepository) EXPECT() *MockTransferOutboxRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ClaimDue mocks base method.
+func (m *MockTransferOutboxRepository) ClaimDue(arg0 context.Context, arg1 time.Time, arg2 time.Duration, arg3 int) ([]*model.TransferOutbox, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDue", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*model.TransferOutbox)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimDue indicates an expected call of ClaimDue.
+func (mr *MockTransferOutboxRepositoryMockRecorder) ClaimDue(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDue", reflect.TypeOf((*MockTransferOutboxRepository)(nil).ClaimDue), arg0, arg1, arg2, arg3)
+}
+
+// Create mocks base method.
+func (m *MockTransferOutboxRepository) Create(arg0 context.Context, arg1 *model.TransferOutbox) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTransferOutboxRepositoryMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTransferOutboxRepository)(nil).Create), arg0, arg1)
+}
+
+// MarkProcessed mocks base method.
+func (m *MockTransferOutboxRepository) MarkProcessed(arg0 context.Context, arg1 uint64, arg2 time.Time, arg3 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkProcessed", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkProcessed indicates an expected call of MarkProcessed.
+func (mr *MockTransferOutboxRepositoryMockRecorder) MarkProcessed(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkProcessed", reflect.TypeOf((*MockTransferOutboxRepository)(nil).MarkProcessed), arg0, arg1, arg2, arg3)
+}
+
+// Retry mocks base method.
+func (m *MockTransferOutboxRepository) Retry(arg0 context.Context, arg1 uint64, arg2 time.Time, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Retry", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Retry indicates an expected call of Retry.
+func (mr *MockTransferOutboxRepositoryMockRecorder) Retry(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Retry", reflect.TypeOf((*MockTransferOutboxRepository)(nil).Retry), arg0, arg1, arg2, arg3)
+}
@@ -9,9 +9,11 @@ import (
 	util "VDM2-BankBE/internal/util"
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
+	decimal "github.com/shopspring/decimal"
 )
 
 // MockTransferRepository is a mock of TransferRepository interface.
@@ -37,6 +39,35 @@ func (m *MockTransferRepository) EXPECT() *MockTransferRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AddReversedAmount mocks base method.
+func (m *MockTransferRepository) AddReversedAmount(arg0 context.Context, arg1 uint64, arg2 decimal.Decimal) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddReversedAmount", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddReversedAmount indicates an expected call of AddReversedAmount.
+func (mr *MockTransferRepositoryMockRecorder) AddReversedAmount(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReversedAmount", reflect.TypeOf((*MockTransferRepository)(nil).AddReversedAmount), arg0, arg1, arg2)
+}
+
+// AwaitApproval mocks base method.
+func (m *MockTransferRepository) AwaitApproval(arg0 context.Context, arg1 uint64, arg2 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AwaitApproval", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AwaitApproval indicates an expected call of AwaitApproval.
+func (mr *MockTransferRepositoryMockRecorder) AwaitApproval(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AwaitApproval", reflect.TypeOf((*MockTransferRepository)(nil).AwaitApproval), arg0, arg1, arg2)
+}
+
 // Create mocks base method.
 func (m *MockTransferRepository) Create(arg0 context.Context, arg1 *model.Transfer) error {
 	m.ctrl.T.Helper()
@@ -51,10 +82,26 @@ func (mr *MockTransferRepositoryMockRecorder) Create(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTransferRepository)(nil).Create), arg0, arg1)
 }
 
+// GetAwaitingApproval mocks base method.
+func (m *MockTransferRepository) GetAwaitingApproval(arg0 context.Context, arg1 uuid.UUID, arg2 *util.PaginationParams) ([]*model.Transfer, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAwaitingApproval", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*model.Transfer)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAwaitingApproval indicates an expected call of GetAwaitingApproval.
+func (mr *MockTransferRepositoryMockRecorder) GetAwaitingApproval(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAwaitingApproval", reflect.TypeOf((*MockTransferRepository)(nil).GetAwaitingApproval), arg0, arg1, arg2)
+}
+
 // GetByAccountID mocks base method.
-func (m *MockTransferRepository) GetByAccountID(arg0 context.Context, arg1 uuid.UUID, arg2 *util.PaginationParams) ([]*model.Transfer, int, error) {
+func (m *MockTransferRepository) GetByAccountID(arg0 context.Context, arg1 uuid.UUID, arg2 *model.TransferFilter, arg3 *util.PaginationParams) ([]*model.Transfer, int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByAccountID", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "GetByAccountID", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].([]*model.Transfer)
 	ret1, _ := ret[1].(int)
 	ret2, _ := ret[2].(error)
@@ -62,9 +109,25 @@ func (m *MockTransferRepository) GetByAccountID(arg0 context.Context, arg1 uuid.
 }
 
 // GetByAccountID indicates an expected call of GetByAccountID.
-func (mr *MockTransferRepositoryMockRecorder) GetByAccountID(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockTransferRepositoryMockRecorder) GetByAccountID(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByAccountID", reflect.TypeOf((*MockTransferRepository)(nil).GetByAccountID), arg0, arg1, arg2, arg3)
+}
+
+// GetByAccountIDWithCursor mocks base method.
+func (m *MockTransferRepository) GetByAccountIDWithCursor(arg0 context.Context, arg1 uuid.UUID, arg2 *model.TransferFilter, arg3 *util.CursorParams) ([]*model.Transfer, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByAccountIDWithCursor", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*model.Transfer)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetByAccountIDWithCursor indicates an expected call of GetByAccountIDWithCursor.
+func (mr *MockTransferRepositoryMockRecorder) GetByAccountIDWithCursor(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByAccountID", reflect.TypeOf((*MockTransferRepository)(nil).GetByAccountID), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByAccountIDWithCursor", reflect.TypeOf((*MockTransferRepository)(nil).GetByAccountIDWithCursor), arg0, arg1, arg2, arg3)
 }
 
 // GetByID mocks base method.
@@ -82,6 +145,112 @@ func (mr *MockTransferRepositoryMockRecorder) GetByID(arg0, arg1 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockTransferRepository)(nil).GetByID), arg0, arg1)
 }
 
+// GetByMovementID mocks base method.
+func (m *MockTransferRepository) GetByMovementID(arg0 context.Context, arg1 uint64) (*model.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByMovementID", arg0, arg1)
+	ret0, _ := ret[0].(*model.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByMovementID indicates an expected call of GetByMovementID.
+func (mr *MockTransferRepositoryMockRecorder) GetByMovementID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByMovementID", reflect.TypeOf((*MockTransferRepository)(nil).GetByMovementID), arg0, arg1)
+}
+
+// GetDueScheduled mocks base method.
+func (m *MockTransferRepository) GetDueScheduled(arg0 context.Context, arg1 time.Time, arg2 int) ([]*model.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDueScheduled", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*model.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDueScheduled indicates an expected call of GetDueScheduled.
+func (mr *MockTransferRepositoryMockRecorder) GetDueScheduled(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDueScheduled", reflect.TypeOf((*MockTransferRepository)(nil).GetDueScheduled), arg0, arg1, arg2)
+}
+
+// GetExpiredApprovals mocks base method.
+func (m *MockTransferRepository) GetExpiredApprovals(arg0 context.Context, arg1 time.Time, arg2 int) ([]*model.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExpiredApprovals", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*model.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExpiredApprovals indicates an expected call of GetExpiredApprovals.
+func (mr *MockTransferRepositoryMockRecorder) GetExpiredApprovals(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExpiredApprovals", reflect.TypeOf((*MockTransferRepository)(nil).GetExpiredApprovals), arg0, arg1, arg2)
+}
+
+// GetScheduledByAccountID mocks base method.
+func (m *MockTransferRepository) GetScheduledByAccountID(arg0 context.Context, arg1 uuid.UUID, arg2 *util.PaginationParams) ([]*model.Transfer, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScheduledByAccountID", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*model.Transfer)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetScheduledByAccountID indicates an expected call of GetScheduledByAccountID.
+func (mr *MockTransferRepositoryMockRecorder) GetScheduledByAccountID(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScheduledByAccountID", reflect.TypeOf((*MockTransferRepository)(nil).GetScheduledByAccountID), arg0, arg1, arg2)
+}
+
+// SumOutgoing mocks base method.
+func (m *MockTransferRepository) SumOutgoing(arg0 context.Context, arg1 uuid.UUID, arg2 time.Time, arg3 uint64) (map[string]decimal.Decimal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SumOutgoing", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(map[string]decimal.Decimal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SumOutgoing indicates an expected call of SumOutgoing.
+func (mr *MockTransferRepositoryMockRecorder) SumOutgoing(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SumOutgoing", reflect.TypeOf((*MockTransferRepository)(nil).SumOutgoing), arg0, arg1, arg2, arg3)
+}
+
+// SumOutgoingTo mocks base method.
+func (m *MockTransferRepository) SumOutgoingTo(arg0 context.Context, arg1, arg2 uuid.UUID, arg3 time.Time, arg4 uint64) (map[string]decimal.Decimal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SumOutgoingTo", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(map[string]decimal.Decimal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SumOutgoingTo indicates an expected call of SumOutgoingTo.
+func (mr *MockTransferRepositoryMockRecorder) SumOutgoingTo(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SumOutgoingTo", reflect.TypeOf((*MockTransferRepository)(nil).SumOutgoingTo), arg0, arg1, arg2, arg3, arg4)
+}
+
+// TransitionStatus mocks base method.
+func (m *MockTransferRepository) TransitionStatus(arg0 context.Context, arg1 uint64, arg2, arg3 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransitionStatus", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransitionStatus indicates an expected call of TransitionStatus.
+func (mr *MockTransferRepositoryMockRecorder) TransitionStatus(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransitionStatus", reflect.TypeOf((*MockTransferRepository)(nil).TransitionStatus), arg0, arg1, arg2, arg3)
+}
+
 // UpdateStatus mocks base method.
 func (m *MockTransferRepository) UpdateStatus(arg0 context.Context, arg1 uint64, arg2 string, arg3 *string) error {
 	m.ctrl.T.Helper()
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
@@ -23,7 +24,7 @@ func NewGormMovementRepository(db *gorm.DB) MovementRepository {
 
 // Create inserts a new movement into the database
 func (r *GormMovementRepository) Create(ctx context.Context, movement *model.Movement) error {
-	err := r.db.WithContext(ctx).Create(movement).Error
+	err := withContext(ctx, r.db).Create(movement).Error
 	if err != nil {
 		return errors.Wrap(err, "failed to create movement")
 	}
@@ -35,7 +36,7 @@ func (r *GormMovementRepository) Create(ctx context.Context, movement *model.Mov
 func (r *GormMovementRepository) GetByID(ctx context.Context, id uint64) (*model.Movement, error) {
 	var movement model.Movement
 
-	err := r.db.WithContext(ctx).Where("id = ?", id).First(&movement).Error
+	err := withContext(ctx, r.db).Where("id = ?", id).First(&movement).Error
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, util.NewNotFoundError("movement not found")
@@ -46,28 +47,43 @@ func (r *GormMovementRepository) GetByID(ctx context.Context, id uint64) (*model
 	return &movement, nil
 }
 
-// GetByAccountID retrieves movements for an account with pagination
+// GetByTransferID retrieves the movements posted by a transfer through its journal entry
+func (r *GormMovementRepository) GetByTransferID(ctx context.Context, transferID uint64) ([]*model.Movement, error) {
+	var movements []*model.Movement
+
+	err := withContext(ctx, r.db).
+		Joins("JOIN journal_entries ON journal_entries.id = movements.journal_entry_id").
+		Where("journal_entries.transfer_id = ?", transferID).
+		Order("movements.id ASC").
+		Find(&movements).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get movements by transfer ID")
+	}
+
+	return movements, nil
+}
+
+// GetByAccountID retrieves the movements of an account matching filter with pagination
 func (r *GormMovementRepository) GetByAccountID(
 	ctx context.Context,
 	accountID uuid.UUID,
+	filter *model.MovementFilter,
 	params *util.PaginationParams,
 ) ([]*model.Movement, int, error) {
 	var movements []*model.Movement
 	var count int64
 
 	// Count total records
-	err := r.db.WithContext(ctx).
+	err := r.filtered(ctx, accountID, filter).
 		Model(&model.Movement{}).
-		Where("account_id = ?", accountID).
 		Count(&count).Error
 	if err != nil {
 		return nil, 0, errors.Wrap(err, "failed to count movements")
 	}
 
 	// Get paginated records
-	err = r.db.WithContext(ctx).
-		Where("account_id = ?", accountID).
-		Order("occurred_at DESC").
+	err = r.filtered(ctx, accountID, filter).
+		Order(movementOrder(filter)).
 		Offset(params.Offset()).
 		Limit(params.Limit).
 		Find(&movements).Error
@@ -77,3 +93,69 @@ func (r *GormMovementRepository) GetByAccountID(
 
 	return movements, int(count), nil
 }
+
+// GetByAccountIDWithCursor retrieves a page of the movements of an account matching filter by keyset on
+// occurred_at and ID, without counting them, and reports whether there are more past the page
+func (r *GormMovementRepository) GetByAccountIDWithCursor(
+	ctx context.Context,
+	accountID uuid.UUID,
+	filter *model.MovementFilter,
+	params *util.CursorParams,
+) ([]*model.Movement, bool, error) {
+	var movements []*model.Movement
+
+	err := keysetPage(r.filtered(ctx, accountID, filter), "occurred_at", filter.Order == "asc", params).
+		Find(&movements).Error
+	if err != nil {
+		return nil, false, errors.Wrap(err, "failed to get movements by account ID")
+	}
+
+	movements, more := trimPage(movements, params)
+	return movements, more, nil
+}
+
+// filtered starts a query on the movements of an account matching filter
+func (r *GormMovementRepository) filtered(ctx context.Context, accountID uuid.UUID, filter *model.MovementFilter) *gorm.DB {
+	query := withContext(ctx, r.db).Where("account_id = ?", accountID)
+
+	if filter.From != nil {
+		query = query.Where("occurred_at >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("occurred_at < ?", *filter.To)
+	}
+	if filter.Type != "" {
+		query = query.Where("type = ?", filter.Type)
+	}
+	if filter.MinAmount != nil {
+		query = query.Where("amount >= ?", *filter.MinAmount)
+	}
+	if filter.MaxAmount != nil {
+		query = query.Where("amount <= ?", *filter.MaxAmount)
+	}
+	// Served by the trigram index on the description
+	if filter.Search != "" {
+		query = query.Where("description ILIKE ?", "%"+escapeLike(filter.Search)+"%")
+	}
+
+	return query
+}
+
+// movementOrder returns the ORDER BY clause of filter, with the ID as tie-breaker so that pages are stable
+func movementOrder(filter *model.MovementFilter) string {
+	column := "occurred_at"
+	if filter.Sort == "amount" {
+		column = "amount"
+	}
+	direction := "DESC"
+	if filter.Order == "asc" {
+		direction = "ASC"
+	}
+
+	return column + " " + direction + ", id " + direction
+}
+
+// escapeLike escapes the wildcards of a LIKE pattern so that s is matched literally
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
@@ -173,6 +173,73 @@ func TestGormMovementRepository_GetByID(t *testing.T) {
 	}
 }
 
+func TestGormMovementRepository_GetByTransferID(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	fromID := uuid.MustParse("550e8400-e29b-41d4-a716-446655441011")
+	toID := uuid.MustParse("550e8400-e29b-41d4-a716-446655441012")
+	transferID := uint64(7)
+
+	tests := []struct {
+		name      string
+		setupSQL  func(m sqlmock.Sqlmock)
+		assertErr func(t *testing.T, got []*model.Movement, err error)
+	}{
+		{
+			name: "success",
+			setupSQL: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(`SELECT .* FROM "movements" JOIN journal_entries ON journal_entries.id = movements.journal_entry_id WHERE journal_entries.transfer_id = \$1 ORDER BY movements.id ASC`).
+					WithArgs(transferID).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "account_id", "amount", "type", "description", "occurred_at"}).
+						AddRow(uint64(1), fromID, "10.00", "debit", "rent (Transfer #7)", now).
+						AddRow(uint64(2), toID, "10.00", "credit", "rent (Transfer #7)", now))
+			},
+			assertErr: func(t *testing.T, got []*model.Movement, err error) {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if len(got) != 2 || got[0].AccountID != fromID || got[1].AccountID != toID {
+					t.Fatalf("unexpected movements: %+v", got)
+				}
+			},
+		},
+		{
+			name: "db error wraps failure",
+			setupSQL: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(`SELECT .* FROM "movements" JOIN journal_entries`).
+					WithArgs(transferID).
+					WillReturnError(errors.New("db err"))
+			},
+			assertErr: func(t *testing.T, got []*model.Movement, err error) {
+				if err == nil || !regexp.MustCompile(`failed to get movements by transfer ID`).MatchString(err.Error()) {
+					t.Fatalf("expected wrapped error, got: %v", err)
+				}
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			dbm := testutil.NewGormSQLMock(t)
+			defer dbm.Cleanup()
+
+			tc.setupSQL(dbm.Mock)
+			repo := repository.NewGormMovementRepository(dbm.DB)
+			got, err := repo.GetByTransferID(ctx, transferID)
+			tc.assertErr(t, got, err)
+
+			if err := dbm.Mock.ExpectationsWereMet(); err != nil {
+				t.Fatalf("unmet sqlmock expectations: %v", err)
+			}
+		})
+	}
+}
+
 func TestGormMovementRepository_GetByAccountID(t *testing.T) {
 	t.Parallel()
 
@@ -180,20 +247,24 @@ func TestGormMovementRepository_GetByAccountID(t *testing.T) {
 	accountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655441020")
 	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
 	params := &util.PaginationParams{Page: 1, Limit: 10}
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	minAmount := mustDecimal(t, "10.00")
 
 	tests := []struct {
 		name      string
+		filter    *model.MovementFilter
 		setupSQL  func(m sqlmock.Sqlmock)
 		assertErr func(t *testing.T, mvs []*model.Movement, count int, err error)
 	}{
 		{
-			name: "success count + select",
+			name:   "success count + select",
+			filter: &model.MovementFilter{},
 			setupSQL: func(m sqlmock.Sqlmock) {
 				m.ExpectQuery(`SELECT count\(\*\) FROM "movements" WHERE account_id = \$1`).
 					WithArgs(accountID).
 					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(int64(2)))
 
-				m.ExpectQuery(`SELECT .* FROM "movements" WHERE account_id = \$1 ORDER BY occurred_at DESC LIMIT \$2`).
+				m.ExpectQuery(`SELECT .* FROM "movements" WHERE account_id = \$1 ORDER BY occurred_at DESC, id DESC LIMIT \$2`).
 					WithArgs(accountID, params.Limit).
 					WillReturnRows(sqlmock.NewRows([]string{"id", "account_id", "amount", "type", "description", "occurred_at"}).
 						AddRow(uint64(1), accountID, "10.00", "credit", "a", now).
@@ -212,7 +283,37 @@ func TestGormMovementRepository_GetByAccountID(t *testing.T) {
 			},
 		},
 		{
-			name: "count error wraps",
+			name: "filters apply to count and select, sorted as asked",
+			filter: &model.MovementFilter{
+				From:      &from,
+				Type:      "debit",
+				MinAmount: &minAmount,
+				Search:    "50%_off",
+				Sort:      "amount",
+				Order:     "asc",
+			},
+			setupSQL: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(`SELECT count\(\*\) FROM "movements" WHERE account_id = \$1 AND occurred_at >= \$2 AND type = \$3 AND amount >= \$4 AND description ILIKE \$5`).
+					WithArgs(accountID, from, "debit", minAmount, `%50\%\_off%`).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(int64(1)))
+
+				m.ExpectQuery(`SELECT .* FROM "movements" WHERE account_id = \$1 AND occurred_at >= \$2 AND type = \$3 AND amount >= \$4 AND description ILIKE \$5 ORDER BY amount ASC, id ASC LIMIT \$6`).
+					WithArgs(accountID, from, "debit", minAmount, `%50\%\_off%`, params.Limit).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "account_id", "amount", "type", "description", "occurred_at"}).
+						AddRow(uint64(3), accountID, "20.00", "debit", "50%_off voucher", now))
+			},
+			assertErr: func(t *testing.T, mvs []*model.Movement, count int, err error) {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if count != 1 || len(mvs) != 1 {
+					t.Fatalf("unexpected result: count=%d len=%d", count, len(mvs))
+				}
+			},
+		},
+		{
+			name:   "count error wraps",
+			filter: &model.MovementFilter{},
 			setupSQL: func(m sqlmock.Sqlmock) {
 				m.ExpectQuery(`SELECT count\(\*\) FROM "movements" WHERE account_id = \$1`).
 					WithArgs(accountID).
@@ -225,13 +326,14 @@ func TestGormMovementRepository_GetByAccountID(t *testing.T) {
 			},
 		},
 		{
-			name: "select error wraps",
+			name:   "select error wraps",
+			filter: &model.MovementFilter{},
 			setupSQL: func(m sqlmock.Sqlmock) {
 				m.ExpectQuery(`SELECT count\(\*\) FROM "movements" WHERE account_id = \$1`).
 					WithArgs(accountID).
 					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(int64(2)))
 
-				m.ExpectQuery(`SELECT .* FROM "movements" WHERE account_id = \$1 ORDER BY occurred_at DESC LIMIT \$2`).
+				m.ExpectQuery(`SELECT .* FROM "movements" WHERE account_id = \$1 ORDER BY occurred_at DESC, id DESC LIMIT \$2`).
 					WithArgs(accountID, params.Limit).
 					WillReturnError(errors.New("select err"))
 			},
@@ -252,7 +354,7 @@ func TestGormMovementRepository_GetByAccountID(t *testing.T) {
 
 			tc.setupSQL(dbm.Mock)
 			repo := repository.NewGormMovementRepository(dbm.DB)
-			mvs, count, err := repo.GetByAccountID(ctx, accountID, params)
+			mvs, count, err := repo.GetByAccountID(ctx, accountID, tc.filter, params)
 			tc.assertErr(t, mvs, count, err)
 
 			if err := dbm.Mock.ExpectationsWereMet(); err != nil {
@@ -271,3 +373,78 @@ func mustDecimal(t *testing.T, s string) decimal.Decimal {
 	return d
 }
 
+func TestGormMovementRepository_GetByAccountIDWithCursor(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	accountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440050")
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	columns := []string{"id", "account_id", "amount", "type", "description", "occurred_at"}
+
+	tests := []struct {
+		name     string
+		filter   *model.MovementFilter
+		params   *util.CursorParams
+		setupSQL func(m sqlmock.Sqlmock)
+		wantIDs  []uint64
+		wantMore bool
+	}{
+		{
+			name:   "first page newest first, one extra row to detect more",
+			filter: &model.MovementFilter{},
+			params: &util.CursorParams{Limit: 1},
+			setupSQL: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(`SELECT .* FROM "movements" WHERE account_id = \$1 ORDER BY occurred_at DESC, id DESC LIMIT \$2`).
+					WithArgs(accountID, 2).
+					WillReturnRows(sqlmock.NewRows(columns).
+						AddRow(uint64(5), accountID, "10.00", "credit", "salary", now).
+						AddRow(uint64(4), accountID, "3.00", "debit", "coffee", now.Add(-time.Hour)))
+			},
+			wantIDs:  []uint64{5},
+			wantMore: true,
+		},
+		{
+			name:   "ascending page after a cursor keeps the filters",
+			filter: &model.MovementFilter{Type: "debit", Order: "asc"},
+			params: &util.CursorParams{Cursor: &util.Cursor{Time: now, ID: 5}, Limit: 2},
+			setupSQL: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(`SELECT .* FROM "movements" WHERE account_id = \$1 AND type = \$2 AND \(occurred_at, id\) > \(\$3, \$4\) ORDER BY occurred_at ASC, id ASC LIMIT \$5`).
+					WithArgs(accountID, "debit", now, uint64(5), 3).
+					WillReturnRows(sqlmock.NewRows(columns).
+						AddRow(uint64(6), accountID, "3.00", "debit", "coffee", now.Add(time.Hour)))
+			},
+			wantIDs: []uint64{6},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			dbm := testutil.NewGormSQLMock(t)
+			defer dbm.Cleanup()
+
+			tc.setupSQL(dbm.Mock)
+			repo := repository.NewGormMovementRepository(dbm.DB)
+			mvs, more, err := repo.GetByAccountIDWithCursor(ctx, accountID, tc.filter, tc.params)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if more != tc.wantMore {
+				t.Fatalf("unexpected more: got=%v want=%v", more, tc.wantMore)
+			}
+			if len(mvs) != len(tc.wantIDs) {
+				t.Fatalf("unexpected result: len=%d want=%d", len(mvs), len(tc.wantIDs))
+			}
+			for i, id := range tc.wantIDs {
+				if mvs[i].ID != id {
+					t.Fatalf("unexpected movement %d: got=%d want=%d", i, mvs[i].ID, id)
+				}
+			}
+
+			if err := dbm.Mock.ExpectationsWereMet(); err != nil {
+				t.Fatalf("unmet sqlmock expectations: %v", err)
+			}
+		})
+	}
+}
@@ -23,7 +23,7 @@ func NewGormOAuthTokenRepository(db *gorm.DB) OAuthTokenRepository {
 
 // Create inserts a new OAuth token into the database
 func (r *GormOAuthTokenRepository) Create(ctx context.Context, token *model.OAuthToken) error {
-	err := r.db.WithContext(ctx).Create(token).Error
+	err := withContext(ctx, r.db).Create(token).Error
 	if err != nil {
 		return errors.Wrap(err, "failed to create OAuth token")
 	}
@@ -35,7 +35,7 @@ func (r *GormOAuthTokenRepository) Create(ctx context.Context, token *model.OAut
 func (r *GormOAuthTokenRepository) GetByUserID(ctx context.Context, userID uuid.UUID) (*model.OAuthToken, error) {
 	var token model.OAuthToken
 
-	err := r.db.WithContext(ctx).Where("user_id = ?", userID).First(&token).Error
+	err := withContext(ctx, r.db).Where("user_id = ?", userID).First(&token).Error
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, util.NewNotFoundError("OAuth token not found")
@@ -48,7 +48,7 @@ func (r *GormOAuthTokenRepository) GetByUserID(ctx context.Context, userID uuid.
 
 // Update updates an OAuth token in the database
 func (r *GormOAuthTokenRepository) Update(ctx context.Context, token *model.OAuthToken) error {
-	err := r.db.WithContext(ctx).Save(token).Error
+	err := withContext(ctx, r.db).Save(token).Error
 	if err != nil {
 		return errors.Wrap(err, "failed to update OAuth token")
 	}
@@ -58,7 +58,7 @@ func (r *GormOAuthTokenRepository) Update(ctx context.Context, token *model.OAut
 
 // Delete deletes an OAuth token from the database
 func (r *GormOAuthTokenRepository) Delete(ctx context.Context, userID uuid.UUID) error {
-	err := r.db.WithContext(ctx).Delete(&model.OAuthToken{}, "user_id = ?", userID).Error
+	err := withContext(ctx, r.db).Delete(&model.OAuthToken{}, "user_id = ?", userID).Error
 	if err != nil {
 		return errors.Wrap(err, "failed to delete OAuth token")
 	}
@@ -0,0 +1,141 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+
+	"VDM2-BankBE/internal/model"
+	"VDM2-BankBE/internal/util"
+)
+
+// GormPaymentRequestRepository implements PaymentRequestRepository using GORM
+type GormPaymentRequestRepository struct {
+	db *gorm.DB
+}
+
+// NewGormPaymentRequestRepository creates a new payment request repository with GORM
+func NewGormPaymentRequestRepository(db *gorm.DB) PaymentRequestRepository {
+	return &GormPaymentRequestRepository{db: db}
+}
+
+// Create inserts a new payment request
+func (r *GormPaymentRequestRepository) Create(ctx context.Context, request *model.PaymentRequest) error {
+	if err := withContext(ctx, r.db).Create(request).Error; err != nil {
+		return errors.Wrap(err, "failed to create payment request")
+	}
+
+	return nil
+}
+
+// GetByID retrieves a payment request by ID
+func (r *GormPaymentRequestRepository) GetByID(ctx context.Context, id uint64) (*model.PaymentRequest, error) {
+	var request model.PaymentRequest
+
+	err := withContext(ctx, r.db).Where("id = ?", id).First(&request).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, util.NewNotFoundError("payment request not found")
+		}
+		return nil, errors.Wrap(err, "failed to get payment request by ID")
+	}
+
+	return &request, nil
+}
+
+// GetByPayerID retrieves the requests a user received with pagination, newest first, optionally filtered by status
+func (r *GormPaymentRequestRepository) GetByPayerID(
+	ctx context.Context,
+	payerID uuid.UUID,
+	status string,
+	params *util.PaginationParams,
+) ([]*model.PaymentRequest, int, error) {
+	return r.list(ctx, "payer_id", payerID, status, params)
+}
+
+// GetByRequesterID retrieves the requests a user sent with pagination, newest first, optionally filtered by status
+func (r *GormPaymentRequestRepository) GetByRequesterID(
+	ctx context.Context,
+	requesterID uuid.UUID,
+	status string,
+	params *util.PaginationParams,
+) ([]*model.PaymentRequest, int, error) {
+	return r.list(ctx, "requester_id", requesterID, status, params)
+}
+
+// list retrieves the requests whose column matches userID with pagination
+func (r *GormPaymentRequestRepository) list(
+	ctx context.Context,
+	column string,
+	userID uuid.UUID,
+	status string,
+	params *util.PaginationParams,
+) ([]*model.PaymentRequest, int, error) {
+	var requests []*model.PaymentRequest
+	var count int64
+
+	query := withContext(ctx, r.db).Model(&model.PaymentRequest{}).Where(column+" = ?", userID)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	// Count total records
+	if err := query.Count(&count).Error; err != nil {
+		return nil, 0, errors.Wrap(err, "failed to count payment requests")
+	}
+
+	// Get paginated records
+	err := query.
+		Order("created_at DESC").
+		Offset(params.Offset()).
+		Limit(params.Limit).
+		Find(&requests).Error
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "failed to get payment requests")
+	}
+
+	return requests, int(count), nil
+}
+
+// UpdateStatus moves a payment request from status from to status to. It reports whether the request was
+// still in status from, so that concurrent transitions only succeed once.
+func (r *GormPaymentRequestRepository) UpdateStatus(ctx context.Context, id uint64, from, to string) (bool, error) {
+	result := withContext(ctx, r.db).
+		Model(&model.PaymentRequest{}).
+		Where("id = ? AND status = ?", id, from).
+		Update("status", to)
+	if result.Error != nil {
+		return false, errors.Wrap(result.Error, "failed to update payment request status")
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// SetTransferID links a payment request to the transfer that paid it
+func (r *GormPaymentRequestRepository) SetTransferID(ctx context.Context, id, transferID uint64) error {
+	err := withContext(ctx, r.db).
+		Model(&model.PaymentRequest{}).
+		Where("id = ?", id).
+		Update("transfer_id", transferID).Error
+	if err != nil {
+		return errors.Wrap(err, "failed to set payment request transfer")
+	}
+
+	return nil
+}
+
+// Expire moves the open requests expiring not after before to expired, returning how many were expired
+func (r *GormPaymentRequestRepository) Expire(ctx context.Context, before time.Time) (int, error) {
+	result := withContext(ctx, r.db).
+		Model(&model.PaymentRequest{}).
+		Where("status = ? AND expires_at <= ?", "open", before).
+		Update("status", "expired")
+	if result.Error != nil {
+		return 0, errors.Wrap(result.Error, "failed to expire payment requests")
+	}
+
+	return int(result.RowsAffected), nil
+}
@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+
+	"VDM2-BankBE/internal/model"
+)
+
+// legacyTransferID extracts the transfer ID from the "(Transfer #N)" description suffix of the movements recorded
+// before the ledger existed. It must match the expression of idx_movements_legacy_transfer_id.
+const legacyTransferID = `substring(m.description FROM '\(Transfer #([0-9]+)\)$')::BIGINT`
+
+// transferMovements lists the movements written for each transfer: through its journal entry, or by their
+// description for the movements without one. Each branch is served by its own index.
+const transferMovements = `
+	SELECT je.transfer_id, m.id, m.type, m.account_id, m.amount
+	FROM journal_entries je
+	JOIN movements m ON m.journal_entry_id = je.id
+	WHERE je.transfer_id IS NOT NULL
+	UNION ALL
+	SELECT ` + legacyTransferID + `, m.id, m.type, m.account_id, m.amount
+	FROM movements m
+	WHERE m.journal_entry_id IS NULL AND ` + legacyTransferID + ` IS NOT NULL`
+
+// orphanedTransfer holds for a transfer that left no trace in the ledger or in the movements
+const orphanedTransfer = `NOT EXISTS (SELECT 1 FROM journal_entries je WHERE je.transfer_id = t.id)
+	AND NOT EXISTS (SELECT 1 FROM movements m WHERE m.journal_entry_id IS NULL AND ` + legacyTransferID + ` = t.id)`
+
+// GormReconciliationRepository implements ReconciliationRepository using GORM
+type GormReconciliationRepository struct {
+	db *gorm.DB
+}
+
+// NewGormReconciliationRepository creates a new reconciliation repository with GORM
+func NewGormReconciliationRepository(db *gorm.DB) ReconciliationRepository {
+	return &GormReconciliationRepository{db: db}
+}
+
+// FindBalanceDiscrepancies returns the accounts whose balance differs from the signed sum of their movements
+func (r *GormReconciliationRepository) FindBalanceDiscrepancies(ctx context.Context) ([]*model.BalanceDiscrepancy, error) {
+	var discrepancies []*model.BalanceDiscrepancy
+
+	err := withContext(ctx, r.db).Raw(`
+		SELECT a.id AS account_id, a.balance, s.movements_total, a.balance - s.movements_total AS difference
+		FROM accounts a
+		CROSS JOIN LATERAL (
+			SELECT COALESCE(SUM(CASE WHEN m.type = 'credit' THEN m.amount ELSE -m.amount END), 0) AS movements_total
+			FROM movements m
+			WHERE m.account_id = a.id
+		) s
+		WHERE a.balance <> s.movements_total
+		ORDER BY a.id`).
+		Scan(&discrepancies).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find balance discrepancies")
+	}
+
+	return discrepancies, nil
+}
+
+// FindTransferDiscrepancies returns the completed transfers without exactly one debit movement on the sending
+// account for the transferred amount and one credit movement on the receiving account for the received amount,
+// which differs from the transferred one for converted transfers
+func (r *GormReconciliationRepository) FindTransferDiscrepancies(ctx context.Context) ([]*model.TransferDiscrepancy, error) {
+	var discrepancies []*model.TransferDiscrepancy
+
+	err := withContext(ctx, r.db).Raw(`
+		SELECT t.id AS transfer_id,
+			COUNT(m.id) FILTER (WHERE m.type = 'debit' AND m.account_id = t.from_account AND m.amount = t.amount) AS debit_movements,
+			COUNT(m.id) FILTER (WHERE m.type = 'credit' AND m.account_id = t.to_account AND m.amount = COALESCE(t.converted_amount, t.amount)) AS credit_movements
+		FROM transfers t
+		LEFT JOIN (` + transferMovements + `
+		) m ON m.transfer_id = t.id
+		WHERE t.status = 'completed'
+		GROUP BY t.id
+		HAVING COUNT(m.id) FILTER (WHERE m.type = 'debit' AND m.account_id = t.from_account AND m.amount = t.amount) <> 1
+			OR COUNT(m.id) FILTER (WHERE m.type = 'credit' AND m.account_id = t.to_account AND m.amount = COALESCE(t.converted_amount, t.amount)) <> 1
+		ORDER BY t.id`).
+		Scan(&discrepancies).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find transfer discrepancies")
+	}
+
+	return discrepancies, nil
+}
+
+// FindStalePendingTransfers returns the transfers that have been pending since before the given time.
+// Scheduled transfers are considered pending from their execution date.
+func (r *GormReconciliationRepository) FindStalePendingTransfers(ctx context.Context, before time.Time) ([]*model.StalePendingTransfer, error) {
+	var transfers []*model.StalePendingTransfer
+
+	err := withContext(ctx, r.db).Raw(`
+		SELECT t.id AS transfer_id, COALESCE(t.execute_at, t.initiated_at) AS pending_since, (`+orphanedTransfer+`) AS orphaned
+		FROM transfers t
+		WHERE t.status = 'pending' AND COALESCE(t.execute_at, t.initiated_at) < ?
+		ORDER BY t.id`, before).
+		Scan(&transfers).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find stale pending transfers")
+	}
+
+	return transfers, nil
+}
+
+// FailOrphanedPendingTransfer marks a pending transfer as failed, as long as it never moved any funds and is not
+// queued in the outbox for another attempt. It reports whether the transfer was updated.
+func (r *GormReconciliationRepository) FailOrphanedPendingTransfer(ctx context.Context, id uint64) (bool, error) {
+	result := withContext(ctx, r.db).Exec(`
+		UPDATE transfers t SET status = 'failed', completed_at = ?
+		WHERE t.id = ? AND t.status = 'pending' AND `+orphanedTransfer+`
+			AND NOT EXISTS (SELECT 1 FROM transfer_outbox o WHERE o.transfer_id = t.id AND o.processed_at IS NULL)`,
+		time.Now(), id)
+	if result.Error != nil {
+		return false, errors.Wrap(result.Error, "failed to mark orphaned transfer as failed")
+	}
+
+	return result.RowsAffected == 1, nil
+}
@@ -0,0 +1,126 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	"VDM2-BankBE/internal/repository"
+	"VDM2-BankBE/internal/testutil"
+)
+
+func TestGormReconciliationRepository_FailOrphanedPendingTransfer(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	id := uint64(7)
+
+	tests := []struct {
+		name      string
+		setupSQL  func(m sqlmock.Sqlmock)
+		assertErr func(t *testing.T, ok bool, err error)
+	}{
+		{
+			name: "orphaned pending transfer is marked as failed",
+			setupSQL: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(`UPDATE transfers t SET status = 'failed', completed_at = \$1\s+WHERE t.id = \$2 AND t.status = 'pending' AND NOT EXISTS`).
+					WithArgs(sqlmock.AnyArg(), id).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			assertErr: func(t *testing.T, ok bool, err error) {
+				if err != nil || !ok {
+					t.Fatalf("expected transfer to be failed, got ok=%v err=%v", ok, err)
+				}
+			},
+		},
+		{
+			name: "transfer that moved funds or left pending is left untouched",
+			setupSQL: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(`UPDATE transfers t SET status = 'failed'`).
+					WithArgs(sqlmock.AnyArg(), id).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			assertErr: func(t *testing.T, ok bool, err error) {
+				if err != nil || ok {
+					t.Fatalf("expected no update, got ok=%v err=%v", ok, err)
+				}
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			dbm := testutil.NewGormSQLMock(t)
+			defer dbm.Cleanup()
+
+			tc.setupSQL(dbm.Mock)
+			repo := repository.NewGormReconciliationRepository(dbm.DB)
+			ok, err := repo.FailOrphanedPendingTransfer(ctx, id)
+			tc.assertErr(t, ok, err)
+
+			if err := dbm.Mock.ExpectationsWereMet(); err != nil {
+				t.Fatalf("unmet sqlmock expectations: %v", err)
+			}
+		})
+	}
+}
+
+func TestGormReconciliationRepository_FindTransferDiscrepancies(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		setupSQL func(m sqlmock.Sqlmock)
+		want     int
+		wantErr  bool
+	}{
+		{
+			name: "ledger and legacy movements are matched in separate branches",
+			setupSQL: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(`JOIN movements m ON m.journal_entry_id = je.id\s+WHERE je.transfer_id IS NOT NULL\s+UNION ALL\s+` +
+					`SELECT substring\(m.description FROM .+\)::BIGINT, m.id, m.type, m.account_id, m.amount\s+FROM movements m\s+` +
+					`WHERE m.journal_entry_id IS NULL AND .+\s+\) m ON m.transfer_id = t.id`).
+					WillReturnRows(sqlmock.NewRows([]string{"transfer_id", "debit_movements", "credit_movements"}).
+						AddRow(3, 1, 0).
+						AddRow(8, 2, 1))
+			},
+			want: 2,
+		},
+		{
+			name: "query error is returned",
+			setupSQL: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(`FROM transfers t`).WillReturnError(sqlmock.ErrCancelled)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			dbm := testutil.NewGormSQLMock(t)
+			defer dbm.Cleanup()
+
+			tc.setupSQL(dbm.Mock)
+			repo := repository.NewGormReconciliationRepository(dbm.DB)
+			got, err := repo.FindTransferDiscrepancies(ctx)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+			} else if err != nil || len(got) != tc.want {
+				t.Fatalf("expected %d discrepancies, got %d err=%v", tc.want, len(got), err)
+			}
+
+			if err := dbm.Mock.ExpectationsWereMet(); err != nil {
+				t.Fatalf("unmet sqlmock expectations: %v", err)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"VDM2-BankBE/internal/model"
 	"VDM2-BankBE/internal/util"
@@ -28,8 +29,13 @@ type UserRepository interface {
 type AccountRepository interface {
 	Create(ctx context.Context, account *model.Account) error
 	GetByID(ctx context.Context, id uuid.UUID) (*model.Account, error)
+	GetByIBAN(ctx context.Context, iban string) (*model.Account, error)
 	GetByUserID(ctx context.Context, userID uuid.UUID) (*model.Account, error)
-	UpdateBalance(ctx context.Context, id uuid.UUID, amount decimal.Decimal) error
+	ListByUserID(ctx context.Context, userID uuid.UUID) ([]*model.Account, error)
+	SetDefault(ctx context.Context, userID, id uuid.UUID) error
+	TransitionStatus(ctx context.Context, id uuid.UUID, fromStatus, toStatus string) (bool, error)
+	Close(ctx context.Context, id uuid.UUID, fromStatus string, closedAt time.Time) (bool, error)
+	UpdateOverdraft(ctx context.Context, id uuid.UUID, limit, rate decimal.Decimal) error
 	Delete(ctx context.Context, id uuid.UUID) error
 }
 
@@ -39,7 +45,21 @@ type AccountRepository interface {
 type MovementRepository interface {
 	Create(ctx context.Context, movement *model.Movement) error
 	GetByID(ctx context.Context, id uint64) (*model.Movement, error)
-	GetByAccountID(ctx context.Context, accountID uuid.UUID, params *util.PaginationParams) ([]*model.Movement, int, error)
+	GetByTransferID(ctx context.Context, transferID uint64) ([]*model.Movement, error)
+	GetByAccountID(ctx context.Context, accountID uuid.UUID, filter *model.MovementFilter, params *util.PaginationParams) ([]*model.Movement, int, error)
+	GetByAccountIDWithCursor(ctx context.Context, accountID uuid.UUID, filter *model.MovementFilter, params *util.CursorParams) ([]*model.Movement, bool, error)
+}
+
+// HoldRepository defines the interface for hold repository operations
+//
+//go:generate mockgen -destination=./mocks/mock_hold_repository.go -package=mocks VDM2-BankBE/internal/repository HoldRepository
+type HoldRepository interface {
+	Create(ctx context.Context, hold *model.Hold) error
+	GetByID(ctx context.Context, id uint64) (*model.Hold, error)
+	GetByAccountID(ctx context.Context, accountID uuid.UUID, params *util.PaginationParams) ([]*model.Hold, int, error)
+	Resolve(ctx context.Context, hold *model.Hold, status string) (bool, error)
+	Reduce(ctx context.Context, hold *model.Hold, amount decimal.Decimal) (bool, error)
+	GetExpired(ctx context.Context, before time.Time, limit int) ([]*model.Hold, error)
 }
 
 // OAuthTokenRepository defines the interface for OAuth token repository operations
@@ -58,32 +78,209 @@ type OAuthTokenRepository interface {
 type TransferRepository interface {
 	Create(ctx context.Context, transfer *model.Transfer) error
 	GetByID(ctx context.Context, id uint64) (*model.Transfer, error)
-	GetByAccountID(ctx context.Context, accountID uuid.UUID, params *util.PaginationParams) ([]*model.Transfer, int, error)
+	GetByMovementID(ctx context.Context, movementID uint64) (*model.Transfer, error)
+	GetByAccountID(ctx context.Context, accountID uuid.UUID, filter *model.TransferFilter, params *util.PaginationParams) ([]*model.Transfer, int, error)
+	GetByAccountIDWithCursor(ctx context.Context, accountID uuid.UUID, filter *model.TransferFilter, params *util.CursorParams) ([]*model.Transfer, bool, error)
 	UpdateStatus(ctx context.Context, id uint64, status string, completedAt *string) error
+	TransitionStatus(ctx context.Context, id uint64, fromStatus, toStatus string) (bool, error)
+	GetScheduledByAccountID(ctx context.Context, accountID uuid.UUID, params *util.PaginationParams) ([]*model.Transfer, int, error)
+	GetDueScheduled(ctx context.Context, before time.Time, limit int) ([]*model.Transfer, error)
+	AddReversedAmount(ctx context.Context, id uint64, amount decimal.Decimal) (bool, error)
+	SumOutgoing(ctx context.Context, userID uuid.UUID, since time.Time, excludeID uint64) (map[string]decimal.Decimal, error)
+	SumOutgoingTo(ctx context.Context, userID, toAccountID uuid.UUID, since time.Time, excludeID uint64) (map[string]decimal.Decimal, error)
+	AwaitApproval(ctx context.Context, id uint64, expiresAt time.Time) error
+	GetAwaitingApproval(ctx context.Context, cosignerID uuid.UUID, params *util.PaginationParams) ([]*model.Transfer, int, error)
+	GetExpiredApprovals(ctx context.Context, before time.Time, limit int) ([]*model.Transfer, error)
+}
+
+// CosignerRepository defines the interface for account co-signer repository operations
+//
+//go:generate mockgen -destination=./mocks/mock_cosigner_repository.go -package=mocks VDM2-BankBE/internal/repository CosignerRepository
+type CosignerRepository interface {
+	Create(ctx context.Context, cosigner *model.AccountCosigner) error
+	ListByAccountID(ctx context.Context, accountID uuid.UUID) ([]*model.AccountCosigner, error)
+	Exists(ctx context.Context, accountID, userID uuid.UUID) (bool, error)
+	Delete(ctx context.Context, accountID, userID uuid.UUID) error
+}
+
+// TransferApprovalRepository defines the interface for transfer approval repository operations
+//
+//go:generate mockgen -destination=./mocks/mock_transfer_approval_repository.go -package=mocks VDM2-BankBE/internal/repository TransferApprovalRepository
+type TransferApprovalRepository interface {
+	Create(ctx context.Context, approval *model.TransferApproval) error
+	ListByTransferID(ctx context.Context, transferID uint64) ([]*model.TransferApproval, error)
+}
+
+// TransferOutboxRepository defines the interface for transfer outbox repository operations
+//
+//go:generate mockgen -destination=./mocks/mock_transfer_outbox_repository.go -package=mocks VDM2-BankBE/internal/repository TransferOutboxRepository
+type TransferOutboxRepository interface {
+	Create(ctx context.Context, message *model.TransferOutbox) error
+	ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*model.TransferOutbox, error)
+	MarkProcessed(ctx context.Context, id uint64, processedAt time.Time, lastError string) (bool, error)
+	Retry(ctx context.Context, id uint64, nextAttemptAt time.Time, lastError string) error
+}
+
+// TransferBatchRepository defines the interface for transfer batch repository operations
+//
+//go:generate mockgen -destination=./mocks/mock_transfer_batch_repository.go -package=mocks VDM2-BankBE/internal/repository TransferBatchRepository
+type TransferBatchRepository interface {
+	Create(ctx context.Context, batch *model.TransferBatch) error
+	GetByID(ctx context.Context, id uint64) (*model.TransferBatch, error)
+	GetPending(ctx context.Context, now time.Time, limit int) ([]*model.TransferBatch, error)
+	Claim(ctx context.Context, id uint64, now, leaseUntil time.Time) (bool, error)
+	UpdateItem(ctx context.Context, item *model.TransferBatchItem) error
+	UpdateProgress(ctx context.Context, batch *model.TransferBatch) error
+}
+
+// FXRepository defines the interface for exchange rate and FX quote repository operations
+//
+//go:generate mockgen -destination=./mocks/mock_fx_repository.go -package=mocks VDM2-BankBE/internal/repository FXRepository
+type FXRepository interface {
+	ListRates(ctx context.Context) ([]*model.FXRate, error)
+	GetRate(ctx context.Context, baseCurrency, quoteCurrency string) (*model.FXRate, error)
+	UpsertRates(ctx context.Context, rates []*model.FXRate) error
+	CreateQuote(ctx context.Context, quote *model.FXQuote) error
+	GetQuote(ctx context.Context, id uuid.UUID) (*model.FXQuote, error)
+	UseQuote(ctx context.Context, id uuid.UUID, now time.Time) (bool, error)
+}
+
+// BeneficiaryRepository defines the interface for beneficiary repository operations
+//
+//go:generate mockgen -destination=./mocks/mock_beneficiary_repository.go -package=mocks VDM2-BankBE/internal/repository BeneficiaryRepository
+type BeneficiaryRepository interface {
+	Create(ctx context.Context, beneficiary *model.Beneficiary) error
+	GetByID(ctx context.Context, id uint64) (*model.Beneficiary, error)
+	GetByAccountID(ctx context.Context, userID, accountID uuid.UUID) (*model.Beneficiary, error)
+	GetByUserID(ctx context.Context, userID uuid.UUID, params *util.PaginationParams) ([]*model.Beneficiary, int, error)
+	UpdateNickname(ctx context.Context, id uint64, nickname string) error
+	MarkUsed(ctx context.Context, id uint64, usedAt time.Time) error
+	Delete(ctx context.Context, id uint64) error
+}
+
+// PaymentRequestRepository defines the interface for payment request repository operations
+//
+//go:generate mockgen -destination=./mocks/mock_payment_request_repository.go -package=mocks VDM2-BankBE/internal/repository PaymentRequestRepository
+type PaymentRequestRepository interface {
+	Create(ctx context.Context, request *model.PaymentRequest) error
+	GetByID(ctx context.Context, id uint64) (*model.PaymentRequest, error)
+	GetByPayerID(ctx context.Context, payerID uuid.UUID, status string, params *util.PaginationParams) ([]*model.PaymentRequest, int, error)
+	GetByRequesterID(ctx context.Context, requesterID uuid.UUID, status string, params *util.PaginationParams) ([]*model.PaymentRequest, int, error)
+	UpdateStatus(ctx context.Context, id uint64, from, to string) (bool, error)
+	SetTransferID(ctx context.Context, id, transferID uint64) error
+	Expire(ctx context.Context, before time.Time) (int, error)
+}
+
+// TransferLimitRepository defines the interface for transfer limit repository operations
+//
+//go:generate mockgen -destination=./mocks/mock_transfer_limit_repository.go -package=mocks VDM2-BankBE/internal/repository TransferLimitRepository
+type TransferLimitRepository interface {
+	GetByUserID(ctx context.Context, userID uuid.UUID) (*model.TransferLimit, error)
+	Lock(ctx context.Context, userID uuid.UUID) error
+	Upsert(ctx context.Context, limit *model.TransferLimit) error
+}
+
+// StandingOrderRepository defines the interface for standing order repository operations
+//
+//go:generate mockgen -destination=./mocks/mock_standing_order_repository.go -package=mocks VDM2-BankBE/internal/repository StandingOrderRepository
+type StandingOrderRepository interface {
+	Create(ctx context.Context, order *model.StandingOrder) error
+	GetByID(ctx context.Context, id uint64) (*model.StandingOrder, error)
+	GetByAccountID(ctx context.Context, accountID uuid.UUID, params *util.PaginationParams) ([]*model.StandingOrder, int, error)
+	Update(ctx context.Context, order *model.StandingOrder) error
+	GetDue(ctx context.Context, before time.Time, limit int) ([]*model.StandingOrder, error)
+	Claim(ctx context.Context, id uint64, nextRunAt, leaseUntil time.Time) (bool, error)
+	RecordRun(ctx context.Context, order *model.StandingOrder, leaseUntil time.Time) (bool, error)
+}
+
+// LedgerRepository defines the interface for ledger repository operations
+//
+//go:generate mockgen -destination=./mocks/mock_ledger_repository.go -package=mocks VDM2-BankBE/internal/repository LedgerRepository
+type LedgerRepository interface {
+	Post(ctx context.Context, entry *model.JournalEntry) error
+}
+
+// ReconciliationRepository defines the consistency checks run by the reconciliation job
+//
+//go:generate mockgen -destination=./mocks/mock_reconciliation_repository.go -package=mocks VDM2-BankBE/internal/repository ReconciliationRepository
+type ReconciliationRepository interface {
+	FindBalanceDiscrepancies(ctx context.Context) ([]*model.BalanceDiscrepancy, error)
+	FindTransferDiscrepancies(ctx context.Context) ([]*model.TransferDiscrepancy, error)
+	FindStalePendingTransfers(ctx context.Context, before time.Time) ([]*model.StalePendingTransfer, error)
+	FailOrphanedPendingTransfer(ctx context.Context, id uint64) (bool, error)
+}
+
+// IdempotencyKeyRepository defines the interface for idempotency key repository operations
+//
+//go:generate mockgen -destination=./mocks/mock_idempotency_key_repository.go -package=mocks VDM2-BankBE/internal/repository IdempotencyKeyRepository
+type IdempotencyKeyRepository interface {
+	Create(ctx context.Context, key *model.IdempotencyKey) error
+	Get(ctx context.Context, userID uuid.UUID, key string) (*model.IdempotencyKey, error)
+	SaveResponse(ctx context.Context, userID uuid.UUID, key string, statusCode int, body []byte) error
+	Delete(ctx context.Context, userID uuid.UUID, key string) error
 }
 
 // Repository provides access to all repositories
 type Repository struct {
-	User       UserRepository
-	Account    AccountRepository
-	Movement   MovementRepository
-	OAuthToken OAuthTokenRepository
-	Transfer   TransferRepository
+	User             UserRepository
+	Account          AccountRepository
+	Cosigner         CosignerRepository
+	Movement         MovementRepository
+	Hold             HoldRepository
+	OAuthToken       OAuthTokenRepository
+	Transfer         TransferRepository
+	TransferApproval TransferApprovalRepository
+	TransferOutbox   TransferOutboxRepository
+	TransferBatch    TransferBatchRepository
+	TransferLimit    TransferLimitRepository
+	FX               FXRepository
+	Beneficiary      BeneficiaryRepository
+	PaymentRequest   PaymentRequestRepository
+	StandingOrder    StandingOrderRepository
+	Ledger           LedgerRepository
+	Reconciliation   ReconciliationRepository
+	IdempotencyKey   IdempotencyKeyRepository
 }
 
 // NewRepository creates a new repository provider
 func NewRepository(
 	userRepo UserRepository,
 	accountRepo AccountRepository,
+	cosignerRepo CosignerRepository,
 	movementRepo MovementRepository,
+	holdRepo HoldRepository,
 	oauthTokenRepo OAuthTokenRepository,
 	transferRepo TransferRepository,
+	transferApprovalRepo TransferApprovalRepository,
+	transferOutboxRepo TransferOutboxRepository,
+	transferBatchRepo TransferBatchRepository,
+	transferLimitRepo TransferLimitRepository,
+	fxRepo FXRepository,
+	beneficiaryRepo BeneficiaryRepository,
+	paymentRequestRepo PaymentRequestRepository,
+	standingOrderRepo StandingOrderRepository,
+	ledgerRepo LedgerRepository,
+	reconciliationRepo ReconciliationRepository,
+	idempotencyKeyRepo IdempotencyKeyRepository,
 ) *Repository {
 	return &Repository{
-		User:       userRepo,
-		Account:    accountRepo,
-		Movement:   movementRepo,
-		OAuthToken: oauthTokenRepo,
-		Transfer:   transferRepo,
+		User:             userRepo,
+		Account:          accountRepo,
+		Cosigner:         cosignerRepo,
+		Movement:         movementRepo,
+		Hold:             holdRepo,
+		OAuthToken:       oauthTokenRepo,
+		Transfer:         transferRepo,
+		TransferApproval: transferApprovalRepo,
+		TransferOutbox:   transferOutboxRepo,
+		TransferBatch:    transferBatchRepo,
+		TransferLimit:    transferLimitRepo,
+		FX:               fxRepo,
+		Beneficiary:      beneficiaryRepo,
+		PaymentRequest:   paymentRequestRepo,
+		StandingOrder:    standingOrderRepo,
+		Ledger:           ledgerRepo,
+		Reconciliation:   reconciliationRepo,
+		IdempotencyKey:   idempotencyKeyRepo,
 	}
 }
@@ -0,0 +1,151 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+
+	"VDM2-BankBE/internal/model"
+	"VDM2-BankBE/internal/util"
+)
+
+// GormStandingOrderRepository implements StandingOrderRepository using GORM
+type GormStandingOrderRepository struct {
+	db *gorm.DB
+}
+
+// NewGormStandingOrderRepository creates a new standing order repository with GORM
+func NewGormStandingOrderRepository(db *gorm.DB) StandingOrderRepository {
+	return &GormStandingOrderRepository{db: db}
+}
+
+// Create inserts a new standing order into the database
+func (r *GormStandingOrderRepository) Create(ctx context.Context, order *model.StandingOrder) error {
+	err := withContext(ctx, r.db).Create(order).Error
+	if err != nil {
+		return errors.Wrap(err, "failed to create standing order")
+	}
+
+	return nil
+}
+
+// GetByID retrieves a standing order by ID
+func (r *GormStandingOrderRepository) GetByID(ctx context.Context, id uint64) (*model.StandingOrder, error) {
+	var order model.StandingOrder
+
+	err := withContext(ctx, r.db).Where("id = ?", id).First(&order).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, util.NewNotFoundError("standing order not found")
+		}
+		return nil, errors.Wrap(err, "failed to get standing order by ID")
+	}
+
+	return &order, nil
+}
+
+// GetByAccountID retrieves the standing orders of an account with pagination
+func (r *GormStandingOrderRepository) GetByAccountID(
+	ctx context.Context,
+	accountID uuid.UUID,
+	params *util.PaginationParams,
+) ([]*model.StandingOrder, int, error) {
+	var orders []*model.StandingOrder
+	var count int64
+
+	// Count total records
+	err := withContext(ctx, r.db).
+		Model(&model.StandingOrder{}).
+		Where("from_account = ?", accountID).
+		Count(&count).Error
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "failed to count standing orders")
+	}
+
+	// Get paginated records
+	err = withContext(ctx, r.db).
+		Where("from_account = ?", accountID).
+		Order("created_at DESC").
+		Offset(params.Offset()).
+		Limit(params.Limit).
+		Find(&orders).Error
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "failed to get standing orders by account ID")
+	}
+
+	return orders, int(count), nil
+}
+
+// Update saves all the fields of a standing order
+func (r *GormStandingOrderRepository) Update(ctx context.Context, order *model.StandingOrder) error {
+	err := withContext(ctx, r.db).Save(order).Error
+	if err != nil {
+		return errors.Wrap(err, "failed to update standing order")
+	}
+
+	return nil
+}
+
+// GetDue retrieves active standing orders whose next run is not after before, oldest first
+func (r *GormStandingOrderRepository) GetDue(ctx context.Context, before time.Time, limit int) ([]*model.StandingOrder, error) {
+	var orders []*model.StandingOrder
+
+	err := withContext(ctx, r.db).
+		Where("status = ? AND next_run_at <= ?", "active", before).
+		Order("next_run_at ASC").
+		Limit(limit).
+		Find(&orders).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get due standing orders")
+	}
+
+	return orders, nil
+}
+
+// Claim pushes the next run of an active standing order to leaseUntil, provided it is still at nextRunAt.
+// It reports whether the order was claimed, so that an occurrence is only executed by one scheduler.
+func (r *GormStandingOrderRepository) Claim(
+	ctx context.Context,
+	id uint64,
+	nextRunAt, leaseUntil time.Time,
+) (bool, error) {
+	result := withContext(ctx, r.db).
+		Model(&model.StandingOrder{}).
+		Where("id = ? AND status = ? AND next_run_at = ?", id, "active", nextRunAt).
+		Update("next_run_at", leaseUntil)
+	if result.Error != nil {
+		return false, errors.Wrap(result.Error, "failed to claim standing order")
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+// RecordRun saves the outcome of an occurrence claimed until leaseUntil. Only the run fields are written, and only
+// while the order is still active and claimed, so that a cancellation or edit made in the meantime is not
+// overwritten. It reports whether the order was updated.
+func (r *GormStandingOrderRepository) RecordRun(
+	ctx context.Context,
+	order *model.StandingOrder,
+	leaseUntil time.Time,
+) (bool, error) {
+	result := withContext(ctx, r.db).
+		Model(&model.StandingOrder{}).
+		Where("id = ? AND status = ? AND next_run_at = ?", order.ID, "active", leaseUntil).
+		Updates(map[string]interface{}{
+			"status":               order.Status,
+			"next_run_at":          order.NextRunAt,
+			"occurrences":          order.Occurrences,
+			"consecutive_failures": order.ConsecutiveFailures,
+			"last_error":           order.LastError,
+			"last_run_at":          order.LastRunAt,
+			"last_transfer_id":     order.LastTransferID,
+		})
+	if result.Error != nil {
+		return false, errors.Wrap(result.Error, "failed to record standing order run")
+	}
+
+	return result.RowsAffected == 1, nil
+}
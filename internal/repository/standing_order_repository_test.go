@@ -0,0 +1,129 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	"VDM2-BankBE/internal/model"
+	"VDM2-BankBE/internal/repository"
+	"VDM2-BankBE/internal/testutil"
+)
+
+func TestGormStandingOrderRepository_Claim(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	id := uint64(5)
+	nextRunAt := time.Date(2025, time.March, 1, 9, 0, 0, 0, time.UTC)
+	leaseUntil := nextRunAt.Add(6 * time.Hour)
+
+	tests := []struct {
+		name      string
+		setupSQL  func(m sqlmock.Sqlmock)
+		assertErr func(t *testing.T, ok bool, err error)
+	}{
+		{
+			name: "due occurrence is claimed",
+			setupSQL: func(m sqlmock.Sqlmock) {
+				m.ExpectBegin()
+				m.ExpectExec(`UPDATE "standing_orders" SET "next_run_at"=\$1,"updated_at"=\$2 WHERE id = \$3 AND status = \$4 AND next_run_at = \$5`).
+					WithArgs(leaseUntil, sqlmock.AnyArg(), id, "active", nextRunAt).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				m.ExpectCommit()
+			},
+			assertErr: func(t *testing.T, ok bool, err error) {
+				if err != nil || !ok {
+					t.Fatalf("expected claim, got ok=%v err=%v", ok, err)
+				}
+			},
+		},
+		{
+			name: "occurrence claimed by another scheduler is left untouched",
+			setupSQL: func(m sqlmock.Sqlmock) {
+				m.ExpectBegin()
+				m.ExpectExec(`UPDATE "standing_orders" SET "next_run_at"=\$1,"updated_at"=\$2 WHERE id = \$3 AND status = \$4 AND next_run_at = \$5`).
+					WithArgs(leaseUntil, sqlmock.AnyArg(), id, "active", nextRunAt).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				m.ExpectCommit()
+			},
+			assertErr: func(t *testing.T, ok bool, err error) {
+				if err != nil || ok {
+					t.Fatalf("expected no claim, got ok=%v err=%v", ok, err)
+				}
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			dbm := testutil.NewGormSQLMock(t)
+			defer dbm.Cleanup()
+
+			tc.setupSQL(dbm.Mock)
+			repo := repository.NewGormStandingOrderRepository(dbm.DB)
+			ok, err := repo.Claim(ctx, id, nextRunAt, leaseUntil)
+			tc.assertErr(t, ok, err)
+
+			if err := dbm.Mock.ExpectationsWereMet(); err != nil {
+				t.Fatalf("unmet sqlmock expectations: %v", err)
+			}
+		})
+	}
+}
+
+func TestGormStandingOrderRepository_RecordRun(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	leaseUntil := time.Date(2025, time.March, 1, 15, 0, 0, 0, time.UTC)
+	nextRunAt := time.Date(2025, time.April, 1, 9, 0, 0, 0, time.UTC)
+	transferID := uint64(77)
+	order := &model.StandingOrder{
+		ID:             5,
+		Status:         "active",
+		NextRunAt:      &nextRunAt,
+		Occurrences:    1,
+		LastRunAt:      &leaseUntil,
+		LastTransferID: &transferID,
+	}
+
+	tests := []struct {
+		name     string
+		affected int64
+		want     bool
+	}{
+		{name: "claimed order records the run", affected: 1, want: true},
+		{name: "order cancelled or edited meanwhile is left untouched", affected: 0, want: false},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			dbm := testutil.NewGormSQLMock(t)
+			defer dbm.Cleanup()
+
+			// Only the run fields are written, so that a concurrent edit of the order is not overwritten
+			dbm.Mock.ExpectBegin()
+			dbm.Mock.ExpectExec(`UPDATE "standing_orders" SET "consecutive_failures"=\$1,"last_error"=\$2,"last_run_at"=\$3,"last_transfer_id"=\$4,"next_run_at"=\$5,"occurrences"=\$6,"status"=\$7,"updated_at"=\$8 WHERE id = \$9 AND status = \$10 AND next_run_at = \$11`).
+				WithArgs(0, "", leaseUntil, transferID, nextRunAt, 1, "active", sqlmock.AnyArg(), order.ID, "active", leaseUntil).
+				WillReturnResult(sqlmock.NewResult(0, tc.affected))
+			dbm.Mock.ExpectCommit()
+
+			repo := repository.NewGormStandingOrderRepository(dbm.DB)
+			ok, err := repo.RecordRun(ctx, order, leaseUntil)
+			if err != nil || ok != tc.want {
+				t.Fatalf("unexpected result: ok=%v err=%v", ok, err)
+			}
+
+			if err := dbm.Mock.ExpectationsWereMet(); err != nil {
+				t.Fatalf("unmet sqlmock expectations: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+
+	"VDM2-BankBE/internal/model"
+)
+
+// GormTransferApprovalRepository implements TransferApprovalRepository using GORM
+type GormTransferApprovalRepository struct {
+	db *gorm.DB
+}
+
+// NewGormTransferApprovalRepository creates a new transfer approval repository with GORM
+func NewGormTransferApprovalRepository(db *gorm.DB) TransferApprovalRepository {
+	return &GormTransferApprovalRepository{db: db}
+}
+
+// Create records a decision on a transfer held for approval
+func (r *GormTransferApprovalRepository) Create(ctx context.Context, approval *model.TransferApproval) error {
+	err := withContext(ctx, r.db).Create(approval).Error
+	if err != nil {
+		return errors.Wrap(err, "failed to create transfer approval")
+	}
+
+	return nil
+}
+
+// ListByTransferID retrieves the decision trail of a transfer, oldest first
+func (r *GormTransferApprovalRepository) ListByTransferID(ctx context.Context, transferID uint64) ([]*model.TransferApproval, error) {
+	var approvals []*model.TransferApproval
+
+	err := withContext(ctx, r.db).
+		Where("transfer_id = ?", transferID).
+		Order("created_at ASC, id ASC").
+		Find(&approvals).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get transfer approvals by transfer ID")
+	}
+
+	return approvals, nil
+}
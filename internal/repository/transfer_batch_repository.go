@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+
+	"VDM2-BankBE/internal/model"
+	"VDM2-BankBE/internal/util"
+)
+
+// GormTransferBatchRepository implements TransferBatchRepository using GORM
+type GormTransferBatchRepository struct {
+	db *gorm.DB
+}
+
+// NewGormTransferBatchRepository creates a new transfer batch repository with GORM
+func NewGormTransferBatchRepository(db *gorm.DB) TransferBatchRepository {
+	return &GormTransferBatchRepository{db: db}
+}
+
+// Create inserts a batch together with its items
+func (r *GormTransferBatchRepository) Create(ctx context.Context, batch *model.TransferBatch) error {
+	if err := withContext(ctx, r.db).Create(batch).Error; err != nil {
+		return errors.Wrap(err, "failed to create transfer batch")
+	}
+
+	return nil
+}
+
+// GetByID retrieves a batch by ID with its items in line order
+func (r *GormTransferBatchRepository) GetByID(ctx context.Context, id uint64) (*model.TransferBatch, error) {
+	var batch model.TransferBatch
+
+	err := withContext(ctx, r.db).
+		Preload("Items", func(db *gorm.DB) *gorm.DB {
+			return db.Order("line ASC")
+		}).
+		Where("id = ?", id).
+		First(&batch).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, util.NewNotFoundError("transfer batch not found")
+		}
+		return nil, errors.Wrap(err, "failed to get transfer batch by ID")
+	}
+
+	return &batch, nil
+}
+
+// GetPending retrieves up to limit batches waiting to be processed at now, oldest first, without their items.
+// Batches still processing once their lease expired are included, so that a stopped processing is resumed.
+func (r *GormTransferBatchRepository) GetPending(ctx context.Context, now time.Time, limit int) ([]*model.TransferBatch, error) {
+	var batches []*model.TransferBatch
+
+	err := withContext(ctx, r.db).
+		Where("status = ? OR (status = ? AND locked_until <= ?)", "pending", "processing", now).
+		Order("created_at ASC").
+		Limit(limit).
+		Find(&batches).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get pending transfer batches")
+	}
+
+	return batches, nil
+}
+
+// Claim moves a batch to processing and leases it until leaseUntil, provided it is pending or its lease expired
+// at now. It reports whether the batch was claimed, so that a batch is only processed by one processor at a time.
+func (r *GormTransferBatchRepository) Claim(
+	ctx context.Context,
+	id uint64,
+	now, leaseUntil time.Time,
+) (bool, error) {
+	result := withContext(ctx, r.db).
+		Model(&model.TransferBatch{}).
+		Where("id = ? AND (status = ? OR (status = ? AND locked_until <= ?))", id, "pending", "processing", now).
+		Updates(map[string]any{"status": "processing", "locked_until": leaseUntil})
+	if result.Error != nil {
+		return false, errors.Wrap(result.Error, "failed to claim transfer batch")
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+// UpdateItem records the outcome of an item: its status, transfer and error
+func (r *GormTransferBatchRepository) UpdateItem(ctx context.Context, item *model.TransferBatchItem) error {
+	err := withContext(ctx, r.db).
+		Model(&model.TransferBatchItem{}).
+		Where("id = ?", item.ID).
+		Updates(map[string]any{
+			"status":      item.Status,
+			"transfer_id": item.TransferID,
+			"error":       item.Error,
+		}).Error
+	if err != nil {
+		return errors.Wrap(err, "failed to update transfer batch item")
+	}
+
+	return nil
+}
+
+// UpdateProgress records the status, counters and completion time of a batch
+func (r *GormTransferBatchRepository) UpdateProgress(ctx context.Context, batch *model.TransferBatch) error {
+	err := withContext(ctx, r.db).
+		Model(&model.TransferBatch{}).
+		Where("id = ?", batch.ID).
+		Updates(map[string]any{
+			"status":          batch.Status,
+			"completed_count": batch.CompletedCount,
+			"failed_count":    batch.FailedCount,
+			"completed_at":    batch.CompletedAt,
+		}).Error
+	if err != nil {
+		return errors.Wrap(err, "failed to update transfer batch progress")
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"VDM2-BankBE/internal/model"
+	"VDM2-BankBE/internal/util"
+)
+
+// GormTransferLimitRepository implements TransferLimitRepository using GORM
+type GormTransferLimitRepository struct {
+	db *gorm.DB
+}
+
+// NewGormTransferLimitRepository creates a new transfer limit repository with GORM
+func NewGormTransferLimitRepository(db *gorm.DB) TransferLimitRepository {
+	return &GormTransferLimitRepository{db: db}
+}
+
+// GetByUserID retrieves the limits a user set. Users who never changed their limits get an empty
+// TransferLimit, so that every limit falls back to its default.
+func (r *GormTransferLimitRepository) GetByUserID(ctx context.Context, userID uuid.UUID) (*model.TransferLimit, error) {
+	var limit model.TransferLimit
+
+	err := withContext(ctx, r.db).Where("user_id = ?", userID).First(&limit).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return &model.TransferLimit{UserID: userID}, nil
+		}
+		return nil, errors.Wrap(err, "failed to get transfer limits")
+	}
+
+	return &limit, nil
+}
+
+// Lock locks the row of a user until the end of the transaction in ctx, so that the limit checks of concurrent
+// transfers of the same user are serialized
+func (r *GormTransferLimitRepository) Lock(ctx context.Context, userID uuid.UUID) error {
+	var user model.User
+
+	err := withContext(ctx, r.db).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id").
+		Where("id = ?", userID).
+		Take(&user).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return util.NewNotFoundError("user not found")
+		}
+		return errors.Wrap(err, "failed to lock user")
+	}
+
+	return nil
+}
+
+// Upsert creates or replaces the limits of a user
+func (r *GormTransferLimitRepository) Upsert(ctx context.Context, limit *model.TransferLimit) error {
+	err := withContext(ctx, r.db).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"per_transaction", "daily", "monthly", "updated_at"}),
+		}).
+		Create(limit).Error
+	if err != nil {
+		return errors.Wrap(err, "failed to save transfer limits")
+	}
+
+	return nil
+}
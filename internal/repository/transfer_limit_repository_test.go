@@ -0,0 +1,63 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+
+	"VDM2-BankBE/internal/repository"
+	"VDM2-BankBE/internal/testutil"
+)
+
+func TestGormTransferLimitRepository_GetByUserID(t *testing.T) {
+	t.Parallel()
+
+	dbm := testutil.NewGormSQLMock(t)
+	defer dbm.Cleanup()
+
+	ctx := context.Background()
+	userID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440860")
+
+	// Users who never lowered their limits have no row
+	dbm.Mock.ExpectQuery(`SELECT \* FROM "transfer_limits" WHERE user_id = \$1 ORDER BY "transfer_limits"."user_id" LIMIT \$2`).
+		WithArgs(userID, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "per_transaction", "daily", "monthly", "updated_at"}))
+
+	repo := repository.NewGormTransferLimitRepository(dbm.DB)
+	limit, err := repo.GetByUserID(ctx, userID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if limit.UserID != userID || limit.PerTransaction != nil || limit.Daily != nil || limit.Monthly != nil {
+		t.Fatalf("expected empty limits, got %+v", limit)
+	}
+
+	if err := dbm.Mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet sqlmock expectations: %v", err)
+	}
+}
+
+func TestGormTransferLimitRepository_Lock(t *testing.T) {
+	t.Parallel()
+
+	dbm := testutil.NewGormSQLMock(t)
+	defer dbm.Cleanup()
+
+	ctx := context.Background()
+	userID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440861")
+
+	dbm.Mock.ExpectQuery(`SELECT "id" FROM "users" WHERE id = \$1 LIMIT \$2 FOR UPDATE`).
+		WithArgs(userID, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(userID))
+
+	repo := repository.NewGormTransferLimitRepository(dbm.DB)
+	if err := repo.Lock(ctx, userID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := dbm.Mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet sqlmock expectations: %v", err)
+	}
+}
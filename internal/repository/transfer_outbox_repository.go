@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+
+	"VDM2-BankBE/internal/model"
+)
+
+// GormTransferOutboxRepository implements TransferOutboxRepository using GORM
+type GormTransferOutboxRepository struct {
+	db *gorm.DB
+}
+
+// NewGormTransferOutboxRepository creates a new transfer outbox repository with GORM
+func NewGormTransferOutboxRepository(db *gorm.DB) TransferOutboxRepository {
+	return &GormTransferOutboxRepository{db: db}
+}
+
+// Create queues the execution of a transfer
+func (r *GormTransferOutboxRepository) Create(ctx context.Context, message *model.TransferOutbox) error {
+	err := withContext(ctx, r.db).Create(message).Error
+	if err != nil {
+		return errors.Wrap(err, "failed to create transfer outbox message")
+	}
+
+	return nil
+}
+
+// ClaimDue locks up to limit unprocessed messages due by now for lease, oldest first, counting the attempt.
+// Messages locked by another worker are skipped, so that concurrent workers claim different messages.
+func (r *GormTransferOutboxRepository) ClaimDue(
+	ctx context.Context,
+	now time.Time,
+	lease time.Duration,
+	limit int,
+) ([]*model.TransferOutbox, error) {
+	var messages []*model.TransferOutbox
+
+	err := withContext(ctx, r.db).Raw(`
+		UPDATE transfer_outbox SET attempts = attempts + 1, locked_until = ?
+		WHERE id IN (
+			SELECT id FROM transfer_outbox
+			WHERE processed_at IS NULL AND next_attempt_at <= ? AND (locked_until IS NULL OR locked_until <= ?)
+			ORDER BY next_attempt_at, id
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`, now.Add(lease), now, now, limit).
+		Scan(&messages).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to claim transfer outbox messages")
+	}
+
+	return messages, nil
+}
+
+// MarkProcessed marks a message as processed, recording why its transfer failed if it did. It reports false when
+// the message had already been processed; the row stays locked until the transaction carried by ctx ends.
+func (r *GormTransferOutboxRepository) MarkProcessed(
+	ctx context.Context,
+	id uint64,
+	processedAt time.Time,
+	lastError string,
+) (bool, error) {
+	result := withContext(ctx, r.db).
+		Model(&model.TransferOutbox{}).
+		Where("id = ? AND processed_at IS NULL", id).
+		Updates(map[string]interface{}{
+			"processed_at": processedAt,
+			"locked_until": nil,
+			"last_error":   lastError,
+		})
+	if result.Error != nil {
+		return false, errors.Wrap(result.Error, "failed to mark transfer outbox message as processed")
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+// Retry releases a message for another attempt at nextAttemptAt, recording the error of the last one
+func (r *GormTransferOutboxRepository) Retry(ctx context.Context, id uint64, nextAttemptAt time.Time, lastError string) error {
+	err := withContext(ctx, r.db).
+		Model(&model.TransferOutbox{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"next_attempt_at": nextAttemptAt,
+			"locked_until":    nil,
+			"last_error":      lastError,
+		}).Error
+	if err != nil {
+		return errors.Wrap(err, "failed to reschedule transfer outbox message")
+	}
+
+	return nil
+}
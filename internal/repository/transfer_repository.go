@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
 	"gorm.io/gorm"
 
 	"VDM2-BankBE/internal/model"
@@ -24,7 +25,7 @@ func NewGormTransferRepository(db *gorm.DB) TransferRepository {
 
 // Create inserts a new transfer into the database
 func (r *GormTransferRepository) Create(ctx context.Context, transfer *model.Transfer) error {
-	err := r.db.WithContext(ctx).Create(transfer).Error
+	err := withContext(ctx, r.db).Create(transfer).Error
 	if err != nil {
 		return errors.Wrap(err, "failed to create transfer")
 	}
@@ -36,7 +37,7 @@ func (r *GormTransferRepository) Create(ctx context.Context, transfer *model.Tra
 func (r *GormTransferRepository) GetByID(ctx context.Context, id uint64) (*model.Transfer, error) {
 	var transfer model.Transfer
 
-	err := r.db.WithContext(ctx).Where("id = ?", id).First(&transfer).Error
+	err := withContext(ctx, r.db).Where("id = ?", id).First(&transfer).Error
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, util.NewNotFoundError("transfer not found")
@@ -47,27 +48,45 @@ func (r *GormTransferRepository) GetByID(ctx context.Context, id uint64) (*model
 	return &transfer, nil
 }
 
-// GetByAccountID retrieves transfers for an account with pagination
+// GetByMovementID retrieves the transfer that posted a movement through its journal entry
+func (r *GormTransferRepository) GetByMovementID(ctx context.Context, movementID uint64) (*model.Transfer, error) {
+	var transfer model.Transfer
+
+	err := withContext(ctx, r.db).
+		Joins("JOIN journal_entries ON journal_entries.transfer_id = transfers.id").
+		Joins("JOIN movements ON movements.journal_entry_id = journal_entries.id").
+		Where("movements.id = ?", movementID).
+		First(&transfer).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, util.NewNotFoundError("transfer not found")
+		}
+		return nil, errors.Wrap(err, "failed to get transfer by movement ID")
+	}
+
+	return &transfer, nil
+}
+
+// GetByAccountID retrieves the transfers of an account matching filter with pagination
 func (r *GormTransferRepository) GetByAccountID(
 	ctx context.Context,
 	accountID uuid.UUID,
+	filter *model.TransferFilter,
 	params *util.PaginationParams,
 ) ([]*model.Transfer, int, error) {
 	var transfers []*model.Transfer
 	var count int64
 
 	// Count total records
-	err := r.db.WithContext(ctx).
+	err := r.filtered(ctx, accountID, filter).
 		Model(&model.Transfer{}).
-		Where("from_account = ? OR to_account = ?", accountID, accountID).
 		Count(&count).Error
 	if err != nil {
 		return nil, 0, errors.Wrap(err, "failed to count transfers")
 	}
 
 	// Get paginated records
-	err = r.db.WithContext(ctx).
-		Where("from_account = ? OR to_account = ?", accountID, accountID).
+	err = r.filtered(ctx, accountID, filter).
 		Order("initiated_at DESC").
 		Offset(params.Offset()).
 		Limit(params.Limit).
@@ -79,6 +98,70 @@ func (r *GormTransferRepository) GetByAccountID(
 	return transfers, int(count), nil
 }
 
+// GetByAccountIDWithCursor retrieves a page of the transfers of an account matching filter by keyset on
+// initiated_at and ID, newest first, without counting them, and reports whether there are more past the page
+func (r *GormTransferRepository) GetByAccountIDWithCursor(
+	ctx context.Context,
+	accountID uuid.UUID,
+	filter *model.TransferFilter,
+	params *util.CursorParams,
+) ([]*model.Transfer, bool, error) {
+	var transfers []*model.Transfer
+
+	err := keysetPage(r.filtered(ctx, accountID, filter), "initiated_at", false, params).Find(&transfers).Error
+	if err != nil {
+		return nil, false, errors.Wrap(err, "failed to get transfers by account ID")
+	}
+
+	transfers, more := trimPage(transfers, params)
+	return transfers, more, nil
+}
+
+// filtered starts a query on the transfers sent or received by an account matching filter
+func (r *GormTransferRepository) filtered(ctx context.Context, accountID uuid.UUID, filter *model.TransferFilter) *gorm.DB {
+	query := withContext(ctx, r.db)
+
+	switch filter.Direction {
+	case "in":
+		query = query.Where("to_account = ?", accountID)
+		if filter.Counterparty != nil {
+			query = query.Where("from_account = ?", *filter.Counterparty)
+		}
+	case "out":
+		query = query.Where("from_account = ?", accountID)
+		if filter.Counterparty != nil {
+			query = query.Where("to_account = ?", *filter.Counterparty)
+		}
+	default:
+		if filter.Counterparty != nil {
+			query = query.Where(
+				"(from_account = ? AND to_account = ?) OR (from_account = ? AND to_account = ?)",
+				accountID, *filter.Counterparty, *filter.Counterparty, accountID,
+			)
+		} else {
+			query = query.Where("from_account = ? OR to_account = ?", accountID, accountID)
+		}
+	}
+
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.From != nil {
+		query = query.Where("initiated_at >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("initiated_at < ?", *filter.To)
+	}
+	if filter.MinAmount != nil {
+		query = query.Where("amount >= ?", *filter.MinAmount)
+	}
+	if filter.MaxAmount != nil {
+		query = query.Where("amount <= ?", *filter.MaxAmount)
+	}
+
+	return query
+}
+
 // UpdateStatus updates a transfer's status
 func (r *GormTransferRepository) UpdateStatus(
 	ctx context.Context,
@@ -99,7 +182,7 @@ func (r *GormTransferRepository) UpdateStatus(
 		updates["completed_at"] = completedAt
 	}
 
-	err := r.db.WithContext(ctx).
+	err := withContext(ctx, r.db).
 		Model(&model.Transfer{}).
 		Where("id = ?", id).
 		Updates(updates).Error
@@ -109,3 +192,227 @@ func (r *GormTransferRepository) UpdateStatus(
 
 	return nil
 }
+
+// TransitionStatus moves a transfer to toStatus only if it is currently in fromStatus.
+// It reports whether the transition happened, so concurrent callers can safely race for the same transfer.
+func (r *GormTransferRepository) TransitionStatus(
+	ctx context.Context,
+	id uint64,
+	fromStatus, toStatus string,
+) (bool, error) {
+	result := withContext(ctx, r.db).
+		Model(&model.Transfer{}).
+		Where("id = ? AND status = ?", id, fromStatus).
+		Update("status", toStatus)
+	if result.Error != nil {
+		return false, errors.Wrap(result.Error, "failed to transition transfer status")
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+// GetScheduledByAccountID retrieves the scheduled transfers sent from an account with pagination
+func (r *GormTransferRepository) GetScheduledByAccountID(
+	ctx context.Context,
+	accountID uuid.UUID,
+	params *util.PaginationParams,
+) ([]*model.Transfer, int, error) {
+	var transfers []*model.Transfer
+	var count int64
+
+	// Count total records
+	err := withContext(ctx, r.db).
+		Model(&model.Transfer{}).
+		Where("from_account = ? AND status = ?", accountID, "scheduled").
+		Count(&count).Error
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "failed to count scheduled transfers")
+	}
+
+	// Get paginated records
+	err = withContext(ctx, r.db).
+		Where("from_account = ? AND status = ?", accountID, "scheduled").
+		Order("execute_at ASC").
+		Offset(params.Offset()).
+		Limit(params.Limit).
+		Find(&transfers).Error
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "failed to get scheduled transfers by account ID")
+	}
+
+	return transfers, int(count), nil
+}
+
+// GetDueScheduled retrieves scheduled transfers whose execution date is not after before, oldest first
+func (r *GormTransferRepository) GetDueScheduled(ctx context.Context, before time.Time, limit int) ([]*model.Transfer, error) {
+	var transfers []*model.Transfer
+
+	err := withContext(ctx, r.db).
+		Where("status = ? AND execute_at <= ?", "scheduled", before).
+		Order("execute_at ASC").
+		Limit(limit).
+		Find(&transfers).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get due scheduled transfers")
+	}
+
+	return transfers, nil
+}
+
+// AddReversedAmount records that amount of a completed transfer has been reversed.
+// It reports false, leaving the transfer untouched, when that would exceed the transfer amount.
+func (r *GormTransferRepository) AddReversedAmount(ctx context.Context, id uint64, amount decimal.Decimal) (bool, error) {
+	result := withContext(ctx, r.db).
+		Model(&model.Transfer{}).
+		Where("id = ? AND status = ? AND reversed_amount + ? <= amount", id, "completed", amount).
+		Update("reversed_amount", gorm.Expr("reversed_amount + ?", amount))
+	if result.Error != nil {
+		return false, errors.Wrap(result.Error, "failed to add reversed amount")
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+// SumOutgoing returns the totals by currency of the completed and pending transfers from the accounts of a user to
+// accounts of other users since the given time, leaving out reversals and the transfer excludeID. A transfer counts
+// from its completion, or from its execution date while it is pending.
+func (r *GormTransferRepository) SumOutgoing(
+	ctx context.Context,
+	userID uuid.UUID,
+	since time.Time,
+	excludeID uint64,
+) (map[string]decimal.Decimal, error) {
+	var rows []struct {
+		Currency string
+		Total    decimal.Decimal
+	}
+
+	err := withContext(ctx, r.db).Raw(`
+		SELECT t.currency, SUM(t.amount) AS total
+		FROM transfers t
+		JOIN accounts f ON f.id = t.from_account
+		JOIN accounts d ON d.id = t.to_account
+		WHERE f.user_id = ? AND d.user_id <> ?
+			AND t.status IN ('completed', 'pending')
+			AND t.reversal_of IS NULL
+			AND t.id <> ?
+			AND COALESCE(t.completed_at, t.execute_at, t.initiated_at) >= ?
+		GROUP BY t.currency`, userID, userID, excludeID, since).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sum outgoing transfers")
+	}
+
+	totals := make(map[string]decimal.Decimal, len(rows))
+	for _, row := range rows {
+		totals[row.Currency] = row.Total
+	}
+
+	return totals, nil
+}
+
+// SumOutgoingTo returns the totals by currency of the completed and pending transfers from the accounts of a user
+// to toAccountID since the given time, leaving out reversals and the transfer excludeID
+func (r *GormTransferRepository) SumOutgoingTo(
+	ctx context.Context,
+	userID, toAccountID uuid.UUID,
+	since time.Time,
+	excludeID uint64,
+) (map[string]decimal.Decimal, error) {
+	var rows []struct {
+		Currency string
+		Total    decimal.Decimal
+	}
+
+	err := withContext(ctx, r.db).Raw(`
+		SELECT t.currency, SUM(t.amount) AS total
+		FROM transfers t
+		JOIN accounts f ON f.id = t.from_account
+		WHERE f.user_id = ? AND t.to_account = ?
+			AND t.status IN ('completed', 'pending')
+			AND t.reversal_of IS NULL
+			AND t.id <> ?
+			AND COALESCE(t.completed_at, t.execute_at, t.initiated_at) >= ?
+		GROUP BY t.currency`, userID, toAccountID, excludeID, since).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sum transfers to account")
+	}
+
+	totals := make(map[string]decimal.Decimal, len(rows))
+	for _, row := range rows {
+		totals[row.Currency] = row.Total
+	}
+
+	return totals, nil
+}
+
+// AwaitApproval holds a transfer for approval until expiresAt
+func (r *GormTransferRepository) AwaitApproval(ctx context.Context, id uint64, expiresAt time.Time) error {
+	err := withContext(ctx, r.db).
+		Model(&model.Transfer{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"status":              "awaiting_approval",
+			"approval_expires_at": expiresAt,
+		}).Error
+	if err != nil {
+		return errors.Wrap(err, "failed to hold transfer for approval")
+	}
+
+	return nil
+}
+
+// GetAwaitingApproval retrieves the transfers awaiting approval sent from the accounts a user co-signs with
+// pagination, soonest to expire first
+func (r *GormTransferRepository) GetAwaitingApproval(
+	ctx context.Context,
+	cosignerID uuid.UUID,
+	params *util.PaginationParams,
+) ([]*model.Transfer, int, error) {
+	var transfers []*model.Transfer
+	var count int64
+
+	cosigned := withContext(ctx, r.db).
+		Model(&model.AccountCosigner{}).
+		Select("account_id").
+		Where("user_id = ?", cosignerID)
+
+	// Count total records
+	err := withContext(ctx, r.db).
+		Model(&model.Transfer{}).
+		Where("status = ? AND from_account IN (?)", "awaiting_approval", cosigned).
+		Count(&count).Error
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "failed to count transfers awaiting approval")
+	}
+
+	// Get paginated records
+	err = withContext(ctx, r.db).
+		Where("status = ? AND from_account IN (?)", "awaiting_approval", cosigned).
+		Order("approval_expires_at ASC, id ASC").
+		Offset(params.Offset()).
+		Limit(params.Limit).
+		Find(&transfers).Error
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "failed to get transfers awaiting approval")
+	}
+
+	return transfers, int(count), nil
+}
+
+// GetExpiredApprovals retrieves the transfers awaiting approval whose approval expired not after before, oldest first
+func (r *GormTransferRepository) GetExpiredApprovals(ctx context.Context, before time.Time, limit int) ([]*model.Transfer, error) {
+	var transfers []*model.Transfer
+
+	err := withContext(ctx, r.db).
+		Where("status = ? AND approval_expires_at <= ?", "awaiting_approval", before).
+		Order("approval_expires_at ASC").
+		Limit(limit).
+		Find(&transfers).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get transfers with expired approvals")
+	}
+
+	return transfers, nil
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"gorm.io/gorm"
 
 	"VDM2-BankBE/internal/model"
@@ -89,6 +90,73 @@ func TestGormTransferRepository_GetByID(t *testing.T) {
 	}
 }
 
+func TestGormTransferRepository_GetByMovementID(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	id := uint64(10)
+	movementID := uint64(20)
+	fromID := uuid.MustParse("550e8400-e29b-41d4-a716-446655441102")
+	toID := uuid.MustParse("550e8400-e29b-41d4-a716-446655441103")
+
+	tests := []struct {
+		name      string
+		setupSQL  func(m sqlmock.Sqlmock)
+		assertErr func(t *testing.T, tr *model.Transfer, err error)
+	}{
+		{
+			name: "success",
+			setupSQL: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(`SELECT .* FROM "transfers" JOIN journal_entries ON journal_entries.transfer_id = transfers.id JOIN movements ON movements.journal_entry_id = journal_entries.id WHERE movements.id = \$1 ORDER BY .* LIMIT \$2`).
+					WithArgs(movementID, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "from_account", "to_account", "amount", "status", "initiated_at", "completed_at"}).
+						AddRow(id, fromID, toID, "25.00", "completed", now, now))
+			},
+			assertErr: func(t *testing.T, tr *model.Transfer, err error) {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if tr == nil || tr.ID != id {
+					t.Fatalf("unexpected transfer: %+v", tr)
+				}
+			},
+		},
+		{
+			name: "movement without a transfer maps to APIError 404",
+			setupSQL: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(`SELECT .* FROM "transfers" JOIN journal_entries .* WHERE movements.id = \$1`).
+					WithArgs(movementID, 1).
+					WillReturnError(gorm.ErrRecordNotFound)
+			},
+			assertErr: func(t *testing.T, tr *model.Transfer, err error) {
+				var apiErr *util.APIError
+				if !errors.As(err, &apiErr) || apiErr.Code != 404 {
+					t.Fatalf("expected 404 APIError, got %#v", err)
+				}
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			dbm := testutil.NewGormSQLMock(t)
+			defer dbm.Cleanup()
+
+			tc.setupSQL(dbm.Mock)
+			repo := repository.NewGormTransferRepository(dbm.DB)
+			tr, err := repo.GetByMovementID(ctx, movementID)
+			tc.assertErr(t, tr, err)
+
+			if err := dbm.Mock.ExpectationsWereMet(); err != nil {
+				t.Fatalf("unmet sqlmock expectations: %v", err)
+			}
+		})
+	}
+}
+
 func TestGormTransferRepository_GetByAccountID(t *testing.T) {
 	t.Parallel()
 
@@ -97,13 +165,18 @@ func TestGormTransferRepository_GetByAccountID(t *testing.T) {
 	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
 	params := &util.PaginationParams{Page: 1, Limit: 10}
 
+	counterpartyID := uuid.MustParse("550e8400-e29b-41d4-a716-446655441121")
+	minAmount := decimal.RequireFromString("10.00")
+
 	tests := []struct {
 		name      string
+		filter    *model.TransferFilter
 		setupSQL  func(m sqlmock.Sqlmock)
 		assertErr func(t *testing.T, trs []*model.Transfer, count int, err error)
 	}{
 		{
-			name: "success count + select",
+			name:   "success count + select",
+			filter: &model.TransferFilter{},
 			setupSQL: func(m sqlmock.Sqlmock) {
 				m.ExpectQuery(`SELECT count\(\*\) FROM "transfers" WHERE from_account = \$1 OR to_account = \$2`).
 					WithArgs(accountID, accountID).
@@ -123,6 +196,63 @@ func TestGormTransferRepository_GetByAccountID(t *testing.T) {
 				}
 			},
 		},
+		{
+			name:   "outgoing transfers to a counterparty",
+			filter: &model.TransferFilter{Direction: "out", Counterparty: &counterpartyID, Status: "completed", From: &now, MinAmount: &minAmount},
+			setupSQL: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(`SELECT count\(\*\) FROM "transfers" WHERE from_account = \$1 AND to_account = \$2 AND status = \$3 AND initiated_at >= \$4 AND amount >= \$5`).
+					WithArgs(accountID, counterpartyID, "completed", now, minAmount).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(int64(0)))
+
+				m.ExpectQuery(`SELECT .* FROM "transfers" WHERE from_account = \$1 AND to_account = \$2 AND status = \$3 AND initiated_at >= \$4 AND amount >= \$5 ORDER BY initiated_at DESC LIMIT \$6`).
+					WithArgs(accountID, counterpartyID, "completed", now, minAmount, params.Limit).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "from_account", "to_account", "amount", "status", "initiated_at", "completed_at"}))
+			},
+			assertErr: func(t *testing.T, trs []*model.Transfer, count int, err error) {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if count != 0 || len(trs) != 0 {
+					t.Fatalf("unexpected result: count=%d len=%d", count, len(trs))
+				}
+			},
+		},
+		{
+			name:   "incoming transfers",
+			filter: &model.TransferFilter{Direction: "in"},
+			setupSQL: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(`SELECT count\(\*\) FROM "transfers" WHERE to_account = \$1`).
+					WithArgs(accountID).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(int64(0)))
+
+				m.ExpectQuery(`SELECT .* FROM "transfers" WHERE to_account = \$1 ORDER BY initiated_at DESC LIMIT \$2`).
+					WithArgs(accountID, params.Limit).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "from_account", "to_account", "amount", "status", "initiated_at", "completed_at"}))
+			},
+			assertErr: func(t *testing.T, trs []*model.Transfer, count int, err error) {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			},
+		},
+		{
+			name:   "both directions with a counterparty",
+			filter: &model.TransferFilter{Counterparty: &counterpartyID, Status: "failed"},
+			setupSQL: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(`SELECT count\(\*\) FROM "transfers" WHERE \(\(from_account = \$1 AND to_account = \$2\) OR \(from_account = \$3 AND to_account = \$4\)\) AND status = \$5`).
+					WithArgs(accountID, counterpartyID, counterpartyID, accountID, "failed").
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(int64(0)))
+
+				m.ExpectQuery(`SELECT .* FROM "transfers" WHERE \(\(from_account = \$1 AND to_account = \$2\) OR \(from_account = \$3 AND to_account = \$4\)\) AND status = \$5 ORDER BY initiated_at DESC LIMIT \$6`).
+					WithArgs(accountID, counterpartyID, counterpartyID, accountID, "failed", params.Limit).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "from_account", "to_account", "amount", "status", "initiated_at", "completed_at"}))
+			},
+			assertErr: func(t *testing.T, trs []*model.Transfer, count int, err error) {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -134,7 +264,7 @@ func TestGormTransferRepository_GetByAccountID(t *testing.T) {
 
 			tc.setupSQL(dbm.Mock)
 			repo := repository.NewGormTransferRepository(dbm.DB)
-			trs, count, err := repo.GetByAccountID(ctx, accountID, params)
+			trs, count, err := repo.GetByAccountID(ctx, accountID, tc.filter, params)
 			tc.assertErr(t, trs, count, err)
 
 			if err := dbm.Mock.ExpectationsWereMet(); err != nil {
@@ -144,6 +274,93 @@ func TestGormTransferRepository_GetByAccountID(t *testing.T) {
 	}
 }
 
+func TestGormTransferRepository_GetByAccountIDWithCursor(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	accountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655441130")
+	otherID := uuid.MustParse("550e8400-e29b-41d4-a716-446655441131")
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	columns := []string{"id", "from_account", "to_account", "amount", "status", "initiated_at", "completed_at"}
+
+	tests := []struct {
+		name     string
+		params   *util.CursorParams
+		setupSQL func(m sqlmock.Sqlmock)
+		wantIDs  []uint64
+		wantMore bool
+	}{
+		{
+			name:   "first page reads one extra row to detect more",
+			params: &util.CursorParams{Limit: 2},
+			setupSQL: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(`SELECT .* FROM "transfers" WHERE from_account = \$1 OR to_account = \$2 ORDER BY initiated_at DESC, id DESC LIMIT \$3`).
+					WithArgs(accountID, accountID, 3).
+					WillReturnRows(sqlmock.NewRows(columns).
+						AddRow(uint64(9), accountID, otherID, "25.00", "completed", now, nil).
+						AddRow(uint64(8), otherID, accountID, "10.00", "completed", now.Add(-time.Hour), nil).
+						AddRow(uint64(7), accountID, otherID, "5.00", "completed", now.Add(-2*time.Hour), nil))
+			},
+			wantIDs:  []uint64{9, 8},
+			wantMore: true,
+		},
+		{
+			name:   "page after a cursor",
+			params: &util.CursorParams{Cursor: &util.Cursor{Time: now, ID: 9}, Limit: 2},
+			setupSQL: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(`SELECT .* FROM "transfers" WHERE \(from_account = \$1 OR to_account = \$2\) AND \(initiated_at, id\) < \(\$3, \$4\) ORDER BY initiated_at DESC, id DESC LIMIT \$5`).
+					WithArgs(accountID, accountID, now, uint64(9), 3).
+					WillReturnRows(sqlmock.NewRows(columns).
+						AddRow(uint64(8), otherID, accountID, "10.00", "completed", now.Add(-time.Hour), nil))
+			},
+			wantIDs: []uint64{8},
+		},
+		{
+			name:   "page before a cursor is read in reverse and put back in order",
+			params: &util.CursorParams{Cursor: &util.Cursor{Time: now.Add(-2 * time.Hour), ID: 7, Backward: true}, Limit: 2},
+			setupSQL: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(`SELECT .* FROM "transfers" WHERE \(from_account = \$1 OR to_account = \$2\) AND \(initiated_at, id\) > \(\$3, \$4\) ORDER BY initiated_at ASC, id ASC LIMIT \$5`).
+					WithArgs(accountID, accountID, now.Add(-2*time.Hour), uint64(7), 3).
+					WillReturnRows(sqlmock.NewRows(columns).
+						AddRow(uint64(8), otherID, accountID, "10.00", "completed", now.Add(-time.Hour), nil).
+						AddRow(uint64(9), accountID, otherID, "25.00", "completed", now, nil))
+			},
+			wantIDs: []uint64{9, 8},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			dbm := testutil.NewGormSQLMock(t)
+			defer dbm.Cleanup()
+
+			tc.setupSQL(dbm.Mock)
+			repo := repository.NewGormTransferRepository(dbm.DB)
+			trs, more, err := repo.GetByAccountIDWithCursor(ctx, accountID, &model.TransferFilter{}, tc.params)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if more != tc.wantMore {
+				t.Fatalf("unexpected more: got=%v want=%v", more, tc.wantMore)
+			}
+			if len(trs) != len(tc.wantIDs) {
+				t.Fatalf("unexpected result: len=%d want=%d", len(trs), len(tc.wantIDs))
+			}
+			for i, id := range tc.wantIDs {
+				if trs[i].ID != id {
+					t.Fatalf("unexpected transfer %d: got=%d want=%d", i, trs[i].ID, id)
+				}
+			}
+
+			if err := dbm.Mock.ExpectationsWereMet(); err != nil {
+				t.Fatalf("unmet sqlmock expectations: %v", err)
+			}
+		})
+	}
+}
+
 func TestGormTransferRepository_UpdateStatus(t *testing.T) {
 	t.Parallel()
 
@@ -237,5 +454,187 @@ func TestGormTransferRepository_UpdateStatus(t *testing.T) {
 	}
 }
 
+func TestGormTransferRepository_TransitionStatus(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	id := uint64(42)
+
+	tests := []struct {
+		name      string
+		setupSQL  func(m sqlmock.Sqlmock)
+		assertErr func(t *testing.T, ok bool, err error)
+	}{
+		{
+			name: "transfer in expected status is transitioned",
+			setupSQL: func(m sqlmock.Sqlmock) {
+				m.ExpectBegin()
+				m.ExpectExec(`UPDATE "transfers" SET "status"=\$1 WHERE id = \$2 AND status = \$3`).
+					WithArgs("pending", id, "scheduled").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				m.ExpectCommit()
+			},
+			assertErr: func(t *testing.T, ok bool, err error) {
+				if err != nil || !ok {
+					t.Fatalf("expected transition, got ok=%v err=%v", ok, err)
+				}
+			},
+		},
+		{
+			name: "transfer in another status is left untouched",
+			setupSQL: func(m sqlmock.Sqlmock) {
+				m.ExpectBegin()
+				m.ExpectExec(`UPDATE "transfers" SET "status"=\$1 WHERE id = \$2 AND status = \$3`).
+					WithArgs("pending", id, "scheduled").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				m.ExpectCommit()
+			},
+			assertErr: func(t *testing.T, ok bool, err error) {
+				if err != nil || ok {
+					t.Fatalf("expected no transition, got ok=%v err=%v", ok, err)
+				}
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			dbm := testutil.NewGormSQLMock(t)
+			defer dbm.Cleanup()
+
+			tc.setupSQL(dbm.Mock)
+			repo := repository.NewGormTransferRepository(dbm.DB)
+			ok, err := repo.TransitionStatus(ctx, id, "scheduled", "pending")
+			tc.assertErr(t, ok, err)
+
+			if err := dbm.Mock.ExpectationsWereMet(); err != nil {
+				t.Fatalf("unmet sqlmock expectations: %v", err)
+			}
+		})
+	}
+}
+
 func ptr(s string) *string { return &s }
 
+
+func TestGormTransferRepository_AddReversedAmount(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	id := uint64(43)
+	amount := decimal.RequireFromString("15.50")
+
+	tests := []struct {
+		name      string
+		setupSQL  func(m sqlmock.Sqlmock)
+		assertErr func(t *testing.T, ok bool, err error)
+	}{
+		{
+			name: "amount within what is left is recorded",
+			setupSQL: func(m sqlmock.Sqlmock) {
+				m.ExpectBegin()
+				m.ExpectExec(`UPDATE "transfers" SET "reversed_amount"=reversed_amount \+ \$1 WHERE id = \$2 AND status = \$3 AND reversed_amount \+ \$4 <= amount`).
+					WithArgs(amount, id, "completed", amount).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				m.ExpectCommit()
+			},
+			assertErr: func(t *testing.T, ok bool, err error) {
+				if err != nil || !ok {
+					t.Fatalf("expected reversed amount update, got ok=%v err=%v", ok, err)
+				}
+			},
+		},
+		{
+			name: "amount exceeding the transfer is rejected",
+			setupSQL: func(m sqlmock.Sqlmock) {
+				m.ExpectBegin()
+				m.ExpectExec(`UPDATE "transfers" SET "reversed_amount"=reversed_amount \+ \$1 WHERE id = \$2 AND status = \$3 AND reversed_amount \+ \$4 <= amount`).
+					WithArgs(amount, id, "completed", amount).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				m.ExpectCommit()
+			},
+			assertErr: func(t *testing.T, ok bool, err error) {
+				if err != nil || ok {
+					t.Fatalf("expected no update, got ok=%v err=%v", ok, err)
+				}
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			dbm := testutil.NewGormSQLMock(t)
+			defer dbm.Cleanup()
+
+			tc.setupSQL(dbm.Mock)
+			repo := repository.NewGormTransferRepository(dbm.DB)
+			ok, err := repo.AddReversedAmount(ctx, id, amount)
+			tc.assertErr(t, ok, err)
+
+			if err := dbm.Mock.ExpectationsWereMet(); err != nil {
+				t.Fatalf("unmet sqlmock expectations: %v", err)
+			}
+		})
+	}
+}
+
+func TestGormTransferRepository_SumOutgoing(t *testing.T) {
+	t.Parallel()
+
+	dbm := testutil.NewGormSQLMock(t)
+	defer dbm.Cleanup()
+
+	ctx := context.Background()
+	userID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440850")
+	since := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	dbm.Mock.ExpectQuery(`SELECT t.currency, SUM\(t.amount\) AS total FROM transfers t .* WHERE f.user_id = \$1 AND d.user_id <> \$2 .* AND t.id <> \$3 AND COALESCE\(t.completed_at, t.execute_at, t.initiated_at\) >= \$4 GROUP BY t.currency`).
+		WithArgs(userID, userID, uint64(9), since).
+		WillReturnRows(sqlmock.NewRows([]string{"currency", "total"}).AddRow("EUR", "125.50").AddRow("USD", "40.00"))
+
+	repo := repository.NewGormTransferRepository(dbm.DB)
+	totals, err := repo.SumOutgoing(ctx, userID, since, 9)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(totals) != 2 || !totals["EUR"].Equal(decimal.RequireFromString("125.50")) || !totals["USD"].Equal(decimal.RequireFromString("40.00")) {
+		t.Fatalf("unexpected totals: %v", totals)
+	}
+
+	if err := dbm.Mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet sqlmock expectations: %v", err)
+	}
+}
+
+func TestGormTransferRepository_SumOutgoingTo(t *testing.T) {
+	t.Parallel()
+
+	dbm := testutil.NewGormSQLMock(t)
+	defer dbm.Cleanup()
+
+	ctx := context.Background()
+	userID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440851")
+	toAccountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440852")
+	since := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	dbm.Mock.ExpectQuery(`SELECT t.currency, SUM\(t.amount\) AS total FROM transfers t .* WHERE f.user_id = \$1 AND t.to_account = \$2 .* AND t.id <> \$3 AND COALESCE\(t.completed_at, t.execute_at, t.initiated_at\) >= \$4 GROUP BY t.currency`).
+		WithArgs(userID, toAccountID, uint64(0), since).
+		WillReturnRows(sqlmock.NewRows([]string{"currency", "total"}).AddRow("EUR", "300.00"))
+
+	repo := repository.NewGormTransferRepository(dbm.DB)
+	totals, err := repo.SumOutgoingTo(ctx, userID, toAccountID, since, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(totals) != 1 || !totals["EUR"].Equal(decimal.RequireFromString("300.00")) {
+		t.Fatalf("unexpected totals: %v", totals)
+	}
+
+	if err := dbm.Mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet sqlmock expectations: %v", err)
+	}
+}
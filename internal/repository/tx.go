@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+type txContextKey struct{}
+
+// WithTx returns a copy of ctx carrying tx, so that repositories called with it run their queries within tx
+func WithTx(ctx context.Context, tx *gorm.DB) context.Context {
+	return context.WithValue(ctx, txContextKey{}, tx)
+}
+
+// withContext returns the transaction carried by ctx, or db when there is none, bound to ctx
+func withContext(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := TxFromContext(ctx); ok {
+		return tx.WithContext(ctx)
+	}
+	return db.WithContext(ctx)
+}
+
+// TxFromContext returns the transaction carried by ctx, if any
+func TxFromContext(ctx context.Context) (*gorm.DB, bool) {
+	tx, ok := ctx.Value(txContextKey{}).(*gorm.DB)
+	return tx, ok && tx != nil
+}
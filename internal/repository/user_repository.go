@@ -27,7 +27,7 @@ func (r *GormUserRepository) Create(ctx context.Context, user *model.User) error
 		user.ID = uuid.New()
 	}
 
-	err := r.db.WithContext(ctx).Create(user).Error
+	err := withContext(ctx, r.db).Create(user).Error
 	if err != nil {
 		return errors.Wrap(err, "failed to create user")
 	}
@@ -39,7 +39,7 @@ func (r *GormUserRepository) Create(ctx context.Context, user *model.User) error
 func (r *GormUserRepository) GetByID(ctx context.Context, id uuid.UUID) (*model.User, error) {
 	var user model.User
 
-	err := r.db.WithContext(ctx).Where("id = ?", id).First(&user).Error
+	err := withContext(ctx, r.db).Where("id = ?", id).First(&user).Error
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, util.NewNotFoundError("user not found")
@@ -54,7 +54,7 @@ func (r *GormUserRepository) GetByID(ctx context.Context, id uuid.UUID) (*model.
 func (r *GormUserRepository) GetByEmail(ctx context.Context, email string) (*model.User, error) {
 	var user model.User
 
-	err := r.db.WithContext(ctx).Where("email = ?", email).First(&user).Error
+	err := withContext(ctx, r.db).Where("email = ?", email).First(&user).Error
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, util.NewNotFoundError("user not found")
@@ -69,7 +69,7 @@ func (r *GormUserRepository) GetByEmail(ctx context.Context, email string) (*mod
 func (r *GormUserRepository) GetByUsername(ctx context.Context, username string) (*model.User, error) {
 	var user model.User
 
-	err := r.db.WithContext(ctx).Where("username = ?", username).First(&user).Error
+	err := withContext(ctx, r.db).Where("username = ?", username).First(&user).Error
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, util.NewNotFoundError("user not found")
@@ -82,7 +82,7 @@ func (r *GormUserRepository) GetByUsername(ctx context.Context, username string)
 
 // Update updates a user in the database
 func (r *GormUserRepository) Update(ctx context.Context, user *model.User) error {
-	err := r.db.WithContext(ctx).Save(user).Error
+	err := withContext(ctx, r.db).Save(user).Error
 	if err != nil {
 		return errors.Wrap(err, "failed to update user")
 	}
@@ -92,7 +92,7 @@ func (r *GormUserRepository) Update(ctx context.Context, user *model.User) error
 
 // Delete deletes a user from the database
 func (r *GormUserRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	err := r.db.WithContext(ctx).Delete(&model.User{}, "id = ?", id).Error
+	err := withContext(ctx, r.db).Delete(&model.User{}, "id = ?", id).Error
 	if err != nil {
 		return errors.Wrap(err, "failed to delete user")
 	}
@@ -18,14 +18,23 @@ import (
 
 // Router handles HTTP routing with Gin
 type Router struct {
-	engine              *gin.Engine
-	authHandler         *handler.AuthHandler
-	accountHandler      *handler.AccountHandler
-	movementHandler     *handler.MovementHandler
-	transferHandler     *handler.TransferHandler
-	authMiddleware      *middleware.AuthMiddleware
-	rateLimitMiddleware *middleware.RateLimitMiddleware
-	logger              *zap.Logger
+	engine                *gin.Engine
+	authHandler           *handler.AuthHandler
+	accountHandler        *handler.AccountHandler
+	movementHandler       *handler.MovementHandler
+	holdHandler           *handler.HoldHandler
+	transferHandler       *handler.TransferHandler
+	transferBatchHandler  *handler.TransferBatchHandler
+	transferLimitHandler  *handler.TransferLimitHandler
+	beneficiaryHandler    *handler.BeneficiaryHandler
+	paymentRequestHandler *handler.PaymentRequestHandler
+	standingOrderHandler  *handler.StandingOrderHandler
+	fxHandler             *handler.FXHandler
+	feeHandler            *handler.FeeHandler
+	authMiddleware        *middleware.AuthMiddleware
+	rateLimitMiddleware   *middleware.RateLimitMiddleware
+	idempotencyMiddleware *middleware.IdempotencyMiddleware
+	logger                *zap.Logger
 }
 
 // NewRouter creates a new router
@@ -33,20 +42,38 @@ func NewRouter(
 	authHandler *handler.AuthHandler,
 	accountHandler *handler.AccountHandler,
 	movementHandler *handler.MovementHandler,
+	holdHandler *handler.HoldHandler,
 	transferHandler *handler.TransferHandler,
+	transferBatchHandler *handler.TransferBatchHandler,
+	transferLimitHandler *handler.TransferLimitHandler,
+	beneficiaryHandler *handler.BeneficiaryHandler,
+	paymentRequestHandler *handler.PaymentRequestHandler,
+	standingOrderHandler *handler.StandingOrderHandler,
+	fxHandler *handler.FXHandler,
+	feeHandler *handler.FeeHandler,
 	authMiddleware *middleware.AuthMiddleware,
 	rateLimitMiddleware *middleware.RateLimitMiddleware,
+	idempotencyMiddleware *middleware.IdempotencyMiddleware,
 	logger *zap.Logger,
 ) *Router {
 	return &Router{
-		engine:              gin.New(),
-		authHandler:         authHandler,
-		accountHandler:      accountHandler,
-		movementHandler:     movementHandler,
-		transferHandler:     transferHandler,
-		authMiddleware:      authMiddleware,
-		rateLimitMiddleware: rateLimitMiddleware,
-		logger:              logger,
+		engine:                gin.New(),
+		authHandler:           authHandler,
+		accountHandler:        accountHandler,
+		movementHandler:       movementHandler,
+		holdHandler:           holdHandler,
+		transferHandler:       transferHandler,
+		transferBatchHandler:  transferBatchHandler,
+		transferLimitHandler:  transferLimitHandler,
+		beneficiaryHandler:    beneficiaryHandler,
+		paymentRequestHandler: paymentRequestHandler,
+		standingOrderHandler:  standingOrderHandler,
+		fxHandler:             fxHandler,
+		feeHandler:            feeHandler,
+		authMiddleware:        authMiddleware,
+		rateLimitMiddleware:   rateLimitMiddleware,
+		idempotencyMiddleware: idempotencyMiddleware,
+		logger:                logger,
 	}
 }
 
@@ -59,7 +86,7 @@ func (r *Router) Setup() http.Handler {
 			return true // TODO: Restrict this in production!
 		},
 		AllowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
-		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization"},
+		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization", middleware.IdempotencyKeyHeader},
 		ExposeHeaders:    []string{"Content-Length"},
 		AllowCredentials: true,
 		MaxAge:           12 * time.Hour,
@@ -70,12 +97,15 @@ func (r *Router) Setup() http.Handler {
 	r.engine.Use(middleware.NewLoggingMiddleware(r.logger).LogRequest())
 	r.engine.Use(ginzap.RecoveryWithZap(r.logger, true))
 
+	// Store responses of requests carrying an Idempotency-Key (see IdempotencyMiddleware.CheckFunc)
+	r.engine.Use(r.idempotencyMiddleware.Capture())
+
 	// Register Swagger documentation (wildcard route)
 	// NOTE: Swagger is intentionally kept outside the generated server because OpenAPI cannot represent `/swagger/*any`.
 	api.RegisterSwaggerRoutes(r.engine)
 
 	// Build the generated-server adapter that delegates to existing handlers.
-	server := api.NewServer(r.authHandler, r.accountHandler, r.movementHandler, r.transferHandler)
+	server := api.NewServer(r.authHandler, r.accountHandler, r.movementHandler, r.holdHandler, r.transferHandler, r.transferBatchHandler, r.transferLimitHandler, r.beneficiaryHandler, r.paymentRequestHandler, r.standingOrderHandler, r.fxHandler, r.feeHandler)
 
 	// Register OpenAPI-generated routes with per-operation middlewares.
 	// These middlewares run AFTER the generated wrapper sets operation security markers.
@@ -85,6 +115,8 @@ func (r *Router) Setup() http.Handler {
 			r.authMiddleware.AuthenticateIfRequiredFunc(),
 			// Apply per-user rate limiting when a user is present.
 			r.rateLimitMiddleware.LimitByUserFunc(),
+			// Replay stored responses for retried mutating requests.
+			r.idempotencyMiddleware.CheckFunc(),
 		},
 		// Preserve the existing error envelope format for parameter binding errors.
 		ErrorHandler: func(c *gin.Context, err error, statusCode int) {
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -10,37 +11,73 @@ import (
 
 	"VDM2-BankBE/internal/model"
 	"VDM2-BankBE/internal/repository"
+	"VDM2-BankBE/internal/util"
 )
 
 // DefaultAccountService implements AccountService
 type DefaultAccountService struct {
-	accountRepo repository.AccountRepository
-	redisClient CacheClient
+	accountRepo     repository.AccountRepository
+	cosignerRepo    repository.CosignerRepository
+	userRepo        repository.UserRepository
+	transferService TransferService
+	redisClient     CacheClient
 }
 
 // NewAccountService creates a new account service
 func NewAccountService(
 	accountRepo repository.AccountRepository,
+	cosignerRepo repository.CosignerRepository,
+	userRepo repository.UserRepository,
+	transferService TransferService,
 	redisClient CacheClient,
 ) AccountService {
 	return &DefaultAccountService{
-		accountRepo: accountRepo,
-		redisClient: redisClient,
+		accountRepo:     accountRepo,
+		cosignerRepo:    cosignerRepo,
+		userRepo:        userRepo,
+		transferService: transferService,
+		redisClient:     redisClient,
 	}
 }
 
-// Create creates a new account for a user
-func (s *DefaultAccountService) Create(ctx context.Context, userID uuid.UUID) (*model.Account, error) {
+// Create opens a new account of the given type and currency for a user; the user's first account becomes the
+// default one
+func (s *DefaultAccountService) Create(ctx context.Context, userID uuid.UUID, accountType, name, currency string) (*model.Account, error) {
+	// Validate account type
+	if accountType == "" {
+		accountType = "checking"
+	}
+	if accountType != "checking" && accountType != "savings" && accountType != "pocket" && accountType != "business" {
+		return nil, util.NewBadRequestError("account type must be 'checking', 'savings', 'pocket' or 'business'")
+	}
+
+	// Validate currency
+	currency = util.NormalizeCurrency(currency)
+	if currency == "" {
+		currency = util.DefaultCurrency
+	}
+	if err := util.ValidateCurrency(currency); err != nil {
+		return nil, util.NewBadRequestError(err.Error())
+	}
+
+	existing, err := s.accountRepo.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list accounts")
+	}
+
 	account := &model.Account{
 		ID:        uuid.New(),
 		UserID:    userID,
+		Type:      accountType,
+		Name:      name,
+		IsDefault: len(existing) == 0,
 		Balance:   decimal.NewFromInt(0),
-		Currency:  "EUR",
+		Currency:  currency,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 	}
 
-	err := s.accountRepo.Create(ctx, account)
+	err = s.accountRepo.Create(ctx, account)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create account")
 	}
@@ -58,7 +95,7 @@ func (s *DefaultAccountService) GetByID(ctx context.Context, id uuid.UUID) (*mod
 	return account, nil
 }
 
-// GetByUserID retrieves an account by user ID
+// GetByUserID retrieves the default account of a user
 func (s *DefaultAccountService) GetByUserID(ctx context.Context, userID uuid.UUID) (*model.Account, error) {
 	account, err := s.accountRepo.GetByUserID(ctx, userID)
 	if err != nil {
@@ -68,19 +105,233 @@ func (s *DefaultAccountService) GetByUserID(ctx context.Context, userID uuid.UUI
 	return account, nil
 }
 
-// GetBalance retrieves an account's balance, using Redis cache when available
-func (s *DefaultAccountService) GetBalance(ctx context.Context, accountID uuid.UUID) (decimal.Decimal, error) {
-	// Try to get balance from cache first
-	balance, err := s.redisClient.GetBalanceCache(ctx, accountID)
-	if err == nil {
-		// Cache hit
-		return balance, nil
+// ListByUserID retrieves all the accounts of a user
+func (s *DefaultAccountService) ListByUserID(ctx context.Context, userID uuid.UUID) ([]*model.Account, error) {
+	accounts, err := s.accountRepo.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list accounts")
+	}
+
+	return accounts, nil
+}
+
+// GetRecipient resolves a transfer alias, given either as a username or as an email, to the default account of
+// the user it belongs to
+func (s *DefaultAccountService) GetRecipient(ctx context.Context, username, email string) (*model.Recipient, error) {
+	username = strings.TrimSpace(username)
+	email = strings.TrimSpace(email)
+	if (username == "") == (email == "") {
+		return nil, util.NewBadRequestError("exactly one of username and email is required")
+	}
+
+	// Get the user behind the alias
+	var user *model.User
+	var err error
+	if username != "" {
+		user, err = s.userRepo.GetByUsername(ctx, username)
+	} else {
+		user, err = s.userRepo.GetByEmail(ctx, email)
+	}
+	if err != nil {
+		if _, ok := err.(*util.APIError); ok {
+			return nil, util.NewNotFoundError("recipient not found")
+		}
+		return nil, errors.Wrap(err, "failed to get recipient user")
+	}
+
+	// Transfers go to the default account of the user
+	account, err := s.accountRepo.GetByUserID(ctx, user.ID)
+	if err != nil {
+		if _, ok := err.(*util.APIError); ok {
+			return nil, util.NewNotFoundError("recipient not found")
+		}
+		return nil, errors.Wrap(err, "failed to get recipient account")
+	}
+
+	return &model.Recipient{
+		AccountID: account.ID,
+		Name:      util.MaskName(user.FirstName, user.LastName),
+	}, nil
+}
+
+// GetByIBAN validates an IBAN and retrieves the account it identifies
+func (s *DefaultAccountService) GetByIBAN(ctx context.Context, iban string) (*model.Account, error) {
+	iban = util.NormalizeIBAN(iban)
+	if err := util.ValidateIBAN(iban); err != nil {
+		return nil, util.NewBadRequestError(err.Error())
+	}
+
+	account, err := s.accountRepo.GetByIBAN(ctx, iban)
+	if err != nil {
+		if _, ok := err.(*util.APIError); ok {
+			return nil, err
+		}
+		return nil, errors.Wrap(err, "failed to get account by iban")
+	}
+
+	return account, nil
+}
+
+// GetForUser retrieves an account of a user; accounts of other users are reported as not found
+func (s *DefaultAccountService) GetForUser(ctx context.Context, userID, id uuid.UUID) (*model.Account, error) {
+	account, err := s.accountRepo.GetByID(ctx, id)
+	if err != nil {
+		if _, ok := err.(*util.APIError); ok {
+			return nil, err
+		}
+		return nil, errors.Wrap(err, "failed to get account")
+	}
+	if account.UserID != userID {
+		return nil, util.NewNotFoundError("account not found")
+	}
+
+	return account, nil
+}
+
+// SetDefault makes one of the user's accounts the default one
+func (s *DefaultAccountService) SetDefault(ctx context.Context, userID, id uuid.UUID) (*model.Account, error) {
+	account, err := s.GetForUser(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if account.Status == "closed" {
+		return nil, util.NewConflictError("closed accounts cannot be the default account")
+	}
+
+	if err := s.accountRepo.SetDefault(ctx, userID, id); err != nil {
+		if _, ok := err.(*util.APIError); ok {
+			return nil, err
+		}
+		return nil, errors.Wrap(err, "failed to set default account")
+	}
+
+	return s.GetForUser(ctx, userID, id)
+}
+
+// UpdateStatus moves an account between the active, frozen and debit_blocked statuses; closed accounts stay closed
+func (s *DefaultAccountService) UpdateStatus(ctx context.Context, id uuid.UUID, status string) (*model.Account, error) {
+	if status != "active" && status != "frozen" && status != "debit_blocked" {
+		return nil, util.NewBadRequestError("status must be 'active', 'frozen' or 'debit_blocked'")
+	}
+
+	account, err := s.accountRepo.GetByID(ctx, id)
+	if err != nil {
+		if _, ok := err.(*util.APIError); ok {
+			return nil, err
+		}
+		return nil, errors.Wrap(err, "failed to get account")
+	}
+
+	if account.Status == "closed" {
+		return nil, util.NewConflictError("closed accounts cannot be reopened")
+	}
+	if account.Status == status {
+		return account, nil
+	}
+
+	// The account may have been closed or changed by someone else in the meantime
+	updated, err := s.accountRepo.TransitionStatus(ctx, id, account.Status, status)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to update account status")
+	}
+	if !updated {
+		return nil, util.NewConflictError("account status changed, try again")
+	}
+
+	account.Status = status
+	return account, nil
+}
+
+// Close closes an account of a user, which is kept read-only for statements. A non-zero balance is swept to
+// sweepTo first, limited and charged like a transfer when sweepTo belongs to another user; without it only empty
+// accounts can be closed.
+func (s *DefaultAccountService) Close(ctx context.Context, userID, id uuid.UUID, sweepTo *uuid.UUID) (*model.Account, error) {
+	account, err := s.GetForUser(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	switch account.Status {
+	case "closed":
+		return nil, util.NewConflictError("account is already closed")
+	case "frozen":
+		return nil, util.NewConflictError("frozen accounts cannot be closed")
+	}
+
+	// An overdrawn balance has to be paid back first, and holds captured or released
+	if account.Balance.IsNegative() {
+		return nil, util.NewConflictError("overdrawn accounts cannot be closed")
+	}
+	if account.HeldAmount.IsPositive() {
+		return nil, util.NewConflictError("accounts with active holds cannot be closed")
+	}
+
+	// Sweep the remaining balance to the nominated account
+	if !account.Balance.IsZero() {
+		if sweepTo == nil {
+			return nil, util.NewConflictError("account balance must be zero or swept to another account")
+		}
+
+		_, err := s.transferService.Sweep(ctx, id, *sweepTo, account.Balance, "Closure of account "+account.IBAN)
+		if err != nil {
+			if _, ok := err.(*util.APIError); ok {
+				return nil, err
+			}
+			return nil, errors.Wrap(err, "failed to sweep account balance")
+		}
+	}
+
+	// Funds received after the sweep keep the account open
+	closed, err := s.accountRepo.Close(ctx, id, account.Status, time.Now())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to close account")
+	}
+	if !closed {
+		return nil, util.NewConflictError("account changed while closing it, try again")
+	}
+
+	return s.GetForUser(ctx, userID, id)
+}
+
+// SetOverdraft sets how far below zero an account can go and the yearly interest rate, in percent, charged on
+// the overdrawn balance. Lowering the limit below an existing overdraft only blocks further debits.
+func (s *DefaultAccountService) SetOverdraft(ctx context.Context, id uuid.UUID, limit, rate decimal.Decimal) (*model.Account, error) {
+	if limit.IsNegative() {
+		return nil, util.NewBadRequestError("overdraft limit must not be negative")
+	}
+	if rate.IsNegative() || rate.GreaterThan(decimal.NewFromInt(100)) {
+		return nil, util.NewBadRequestError("overdraft rate must be between 0 and 100")
+	}
+
+	account, err := s.accountRepo.GetByID(ctx, id)
+	if err != nil {
+		if _, ok := err.(*util.APIError); ok {
+			return nil, err
+		}
+		return nil, errors.Wrap(err, "failed to get account")
+	}
+	if account.Status == "closed" {
+		return nil, util.NewConflictError("account is closed")
+	}
+
+	if err := s.accountRepo.UpdateOverdraft(ctx, id, limit, rate); err != nil {
+		if _, ok := err.(*util.APIError); ok {
+			return nil, err
+		}
+		return nil, errors.Wrap(err, "failed to set overdraft")
 	}
 
-	// Cache miss, get from DB
+	account.OverdraftLimit = limit
+	account.OverdraftRate = rate
+	return account, nil
+}
+
+// GetBalance retrieves the ledger and available balances of an account. They are read together from the account,
+// as holds change the available balance without going through the ledger, and the cached ledger balance is refreshed.
+func (s *DefaultAccountService) GetBalance(ctx context.Context, accountID uuid.UUID) (*model.Balance, error) {
 	account, err := s.accountRepo.GetByID(ctx, accountID)
 	if err != nil {
-		return decimal.Zero, errors.Wrap(err, "failed to get account balance")
+		return nil, errors.Wrap(err, "failed to get account balance")
 	}
 
 	// Update cache
@@ -90,5 +341,84 @@ func (s *DefaultAccountService) GetBalance(ctx context.Context, accountID uuid.U
 		_ = err
 	}
 
-	return account.Balance, nil
+	return &model.Balance{
+		AccountID:      account.ID,
+		Ledger:         account.Balance,
+		Held:           account.HeldAmount,
+		OverdraftLimit: account.OverdraftLimit,
+		Available:      account.AvailableBalance(),
+		Currency:       account.Currency,
+	}, nil
+}
+
+// AddCosigner makes the user with the given username a co-signer of a business account of userID, able to approve
+// the transfers sent from it
+func (s *DefaultAccountService) AddCosigner(ctx context.Context, userID, accountID uuid.UUID, username string) (*model.AccountCosigner, error) {
+	account, err := s.GetForUser(ctx, userID, accountID)
+	if err != nil {
+		return nil, err
+	}
+	if account.Type != "business" {
+		return nil, util.NewBadRequestError("only business accounts have co-signers")
+	}
+	if account.Status == "closed" {
+		return nil, util.NewConflictError("account is closed")
+	}
+
+	user, err := s.userRepo.GetByUsername(ctx, strings.TrimSpace(username))
+	if err != nil {
+		if _, ok := err.(*util.APIError); ok {
+			return nil, util.NewNotFoundError("user not found")
+		}
+		return nil, errors.Wrap(err, "failed to get co-signer user")
+	}
+	if user.ID == userID {
+		return nil, util.NewBadRequestError("the owner of an account cannot co-sign it")
+	}
+
+	cosigner := &model.AccountCosigner{
+		AccountID: accountID,
+		UserID:    user.ID,
+		Username:  user.Username,
+		CreatedAt: time.Now(),
+	}
+	if err := s.cosignerRepo.Create(ctx, cosigner); err != nil {
+		if _, ok := err.(*util.APIError); ok {
+			return nil, err
+		}
+		return nil, errors.Wrap(err, "failed to add co-signer")
+	}
+
+	return cosigner, nil
+}
+
+// ListCosigners retrieves the co-signers of an account of userID
+func (s *DefaultAccountService) ListCosigners(ctx context.Context, userID, accountID uuid.UUID) ([]*model.AccountCosigner, error) {
+	if _, err := s.GetForUser(ctx, userID, accountID); err != nil {
+		return nil, err
+	}
+
+	cosigners, err := s.cosignerRepo.ListByAccountID(ctx, accountID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list co-signers")
+	}
+
+	return cosigners, nil
+}
+
+// RemoveCosigner removes a co-signer from an account of userID. Transfers already awaiting approval can still be
+// approved by the remaining co-signers.
+func (s *DefaultAccountService) RemoveCosigner(ctx context.Context, userID, accountID, cosignerID uuid.UUID) error {
+	if _, err := s.GetForUser(ctx, userID, accountID); err != nil {
+		return err
+	}
+
+	if err := s.cosignerRepo.Delete(ctx, accountID, cosignerID); err != nil {
+		if _, ok := err.(*util.APIError); ok {
+			return err
+		}
+		return errors.Wrap(err, "failed to remove co-signer")
+	}
+
+	return nil
 }
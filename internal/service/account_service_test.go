@@ -13,6 +13,7 @@ import (
 	repmocks "VDM2-BankBE/internal/repository/mocks"
 	"VDM2-BankBE/internal/service"
 	servicemocks "VDM2-BankBE/internal/service/mocks"
+	"VDM2-BankBE/internal/util"
 )
 
 func TestAccountService_GetBalance(t *testing.T) {
@@ -24,43 +25,53 @@ func TestAccountService_GetBalance(t *testing.T) {
 	tests := []struct {
 		name       string
 		buildMocks func(ctrl *gomock.Controller) (*repmocks.MockAccountRepository, *servicemocks.MockCacheClient)
-		assert     func(t *testing.T, got decimal.Decimal, err error)
+		assert     func(t *testing.T, got *model.Balance, err error)
 	}{
 		{
-			name: "cache hit returns cached value",
+			name: "loads from repo and refreshes cache",
 			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockAccountRepository, *servicemocks.MockCacheClient) {
 				accountRepo := repmocks.NewMockAccountRepository(ctrl)
 				cache := servicemocks.NewMockCacheClient(ctrl)
-				cache.EXPECT().GetBalanceCache(gomock.Any(), accountID).Return(decimal.NewFromInt(123), nil)
+
+				accountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(&model.Account{ID: accountID, Balance: expectedBalance}, nil)
+				cache.EXPECT().SetBalanceCache(gomock.Any(), accountID, expectedBalance).Return(nil)
+
 				return accountRepo, cache
 			},
-			assert: func(t *testing.T, got decimal.Decimal, err error) {
+			assert: func(t *testing.T, got *model.Balance, err error) {
 				if err != nil {
 					t.Fatalf("unexpected error: %v", err)
 				}
-				if !got.Equal(decimal.NewFromInt(123)) {
-					t.Fatalf("unexpected balance: got=%s want=%s", got.String(), decimal.NewFromInt(123).String())
+				if !got.Ledger.Equal(expectedBalance) || !got.Available.Equal(expectedBalance) {
+					t.Fatalf("unexpected balance: %+v", got)
 				}
 			},
 		},
 		{
-			name: "cache miss loads from repo and updates cache",
+			name: "holds reduce the available balance only",
 			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockAccountRepository, *servicemocks.MockCacheClient) {
 				accountRepo := repmocks.NewMockAccountRepository(ctrl)
 				cache := servicemocks.NewMockCacheClient(ctrl)
 
-				cache.EXPECT().GetBalanceCache(gomock.Any(), accountID).Return(decimal.Zero, errors.New("cache miss"))
-				accountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(&model.Account{ID: accountID, Balance: expectedBalance}, nil)
-				cache.EXPECT().SetBalanceCache(gomock.Any(), accountID, expectedBalance).Return(nil)
+				accountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(&model.Account{
+					ID:             accountID,
+					Balance:        expectedBalance,
+					HeldAmount:     decimal.RequireFromString("4.00"),
+					OverdraftLimit: decimal.RequireFromString("100.00"),
+				}, nil)
+				cache.EXPECT().SetBalanceCache(gomock.Any(), accountID, expectedBalance).Return(errors.New("cache down"))
 
 				return accountRepo, cache
 			},
-			assert: func(t *testing.T, got decimal.Decimal, err error) {
+			assert: func(t *testing.T, got *model.Balance, err error) {
 				if err != nil {
 					t.Fatalf("unexpected error: %v", err)
 				}
-				if !got.Equal(expectedBalance) {
-					t.Fatalf("unexpected balance: got=%s want=%s", got.String(), expectedBalance.String())
+				if !got.Ledger.Equal(expectedBalance) || !got.Held.Equal(decimal.RequireFromString("4.00")) {
+					t.Fatalf("unexpected balance: %+v", got)
+				}
+				if !got.Available.Equal(decimal.RequireFromString("106.50")) {
+					t.Fatalf("unexpected available balance: %s", got.Available.String())
 				}
 			},
 		},
@@ -74,7 +85,7 @@ func TestAccountService_GetBalance(t *testing.T) {
 			defer ctrl.Finish()
 
 			accountRepo, cache := tc.buildMocks(ctrl)
-			svc := service.NewAccountService(accountRepo, cache)
+			svc := service.NewAccountService(accountRepo, repmocks.NewMockCosignerRepository(ctrl), repmocks.NewMockUserRepository(ctrl), servicemocks.NewMockTransferService(ctrl), cache)
 
 			got, err := svc.GetBalance(context.Background(), accountID)
 			tc.assert(t, got, err)
@@ -82,3 +93,637 @@ func TestAccountService_GetBalance(t *testing.T) {
 	}
 }
 
+func TestAccountService_Create(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440110")
+
+	tests := []struct {
+		name        string
+		accountType string
+		currency    string
+		buildMocks  func(ctrl *gomock.Controller) *repmocks.MockAccountRepository
+		assert      func(t *testing.T, account *model.Account, err error)
+	}{
+		{
+			name:        "first account becomes the default one",
+			accountType: "",
+			buildMocks: func(ctrl *gomock.Controller) *repmocks.MockAccountRepository {
+				accountRepo := repmocks.NewMockAccountRepository(ctrl)
+				accountRepo.EXPECT().ListByUserID(gomock.Any(), userID).Return(nil, nil)
+				accountRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+				return accountRepo
+			},
+			assert: func(t *testing.T, account *model.Account, err error) {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if !account.IsDefault || account.Type != "checking" || account.Currency != "EUR" {
+					t.Fatalf("unexpected account: %+v", account)
+				}
+			},
+		},
+		{
+			name:        "additional account is not the default one",
+			accountType: "savings",
+			buildMocks: func(ctrl *gomock.Controller) *repmocks.MockAccountRepository {
+				accountRepo := repmocks.NewMockAccountRepository(ctrl)
+				accountRepo.EXPECT().ListByUserID(gomock.Any(), userID).Return([]*model.Account{{UserID: userID, IsDefault: true}}, nil)
+				accountRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+				return accountRepo
+			},
+			assert: func(t *testing.T, account *model.Account, err error) {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if account.IsDefault || account.Type != "savings" {
+					t.Fatalf("unexpected account: %+v", account)
+				}
+			},
+		},
+		{
+			name:        "account in another currency",
+			accountType: "savings",
+			currency:    "usd",
+			buildMocks: func(ctrl *gomock.Controller) *repmocks.MockAccountRepository {
+				accountRepo := repmocks.NewMockAccountRepository(ctrl)
+				accountRepo.EXPECT().ListByUserID(gomock.Any(), userID).Return([]*model.Account{{UserID: userID, IsDefault: true}}, nil)
+				accountRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+				return accountRepo
+			},
+			assert: func(t *testing.T, account *model.Account, err error) {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if account.Currency != "USD" {
+					t.Fatalf("unexpected currency: %s", account.Currency)
+				}
+			},
+		},
+		{
+			name:        "unsupported currency returns 400",
+			accountType: "savings",
+			currency:    "XYZ",
+			buildMocks:  repmocks.NewMockAccountRepository,
+			assert: func(t *testing.T, account *model.Account, err error) {
+				apiErr, ok := err.(*util.APIError)
+				if !ok || apiErr.Code != 400 {
+					t.Fatalf("expected 400 APIError, got %#v", err)
+				}
+			},
+		},
+		{
+			name:        "unknown type returns 400",
+			accountType: "brokerage",
+			buildMocks:  repmocks.NewMockAccountRepository,
+			assert: func(t *testing.T, account *model.Account, err error) {
+				apiErr, ok := err.(*util.APIError)
+				if !ok || apiErr.Code != 400 {
+					t.Fatalf("expected 400 APIError, got %#v", err)
+				}
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			svc := service.NewAccountService(tc.buildMocks(ctrl), repmocks.NewMockCosignerRepository(ctrl), repmocks.NewMockUserRepository(ctrl), servicemocks.NewMockTransferService(ctrl), servicemocks.NewMockCacheClient(ctrl))
+
+			account, err := svc.Create(context.Background(), userID, tc.accountType, "Holidays", tc.currency)
+			tc.assert(t, account, err)
+		})
+	}
+}
+
+func TestAccountService_GetForUser(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440120")
+	accountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440121")
+
+	tests := []struct {
+		name       string
+		owner      uuid.UUID
+		wantStatus int
+	}{
+		{name: "own account is returned", owner: userID},
+		{name: "account of another user is not found", owner: uuid.MustParse("550e8400-e29b-41d4-a716-446655440122"), wantStatus: 404},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			accountRepo := repmocks.NewMockAccountRepository(ctrl)
+			accountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(&model.Account{ID: accountID, UserID: tc.owner}, nil)
+			svc := service.NewAccountService(accountRepo, repmocks.NewMockCosignerRepository(ctrl), repmocks.NewMockUserRepository(ctrl), servicemocks.NewMockTransferService(ctrl), servicemocks.NewMockCacheClient(ctrl))
+
+			account, err := svc.GetForUser(context.Background(), userID, accountID)
+			if tc.wantStatus == 0 {
+				if err != nil || account.ID != accountID {
+					t.Fatalf("unexpected result: account=%+v err=%v", account, err)
+				}
+				return
+			}
+			apiErr, ok := err.(*util.APIError)
+			if !ok || apiErr.Code != tc.wantStatus {
+				t.Fatalf("expected %d APIError, got %#v", tc.wantStatus, err)
+			}
+		})
+	}
+}
+
+func TestAccountService_GetRecipient(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440125")
+	accountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440126")
+	user := &model.User{ID: userID, Username: "mrossi", Email: "mario@example.com", FirstName: "Mario", LastName: "Rossi"}
+
+	tests := []struct {
+		name       string
+		username   string
+		email      string
+		buildMocks func(ctrl *gomock.Controller) (*repmocks.MockAccountRepository, *repmocks.MockUserRepository)
+		wantStatus int
+	}{
+		{
+			name:     "username resolves to the default account",
+			username: "mrossi",
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockAccountRepository, *repmocks.MockUserRepository) {
+				accountRepo := repmocks.NewMockAccountRepository(ctrl)
+				userRepo := repmocks.NewMockUserRepository(ctrl)
+				userRepo.EXPECT().GetByUsername(gomock.Any(), "mrossi").Return(user, nil)
+				accountRepo.EXPECT().GetByUserID(gomock.Any(), userID).Return(&model.Account{ID: accountID, UserID: userID}, nil)
+				return accountRepo, userRepo
+			},
+		},
+		{
+			name:  "email resolves to the default account",
+			email: " mario@example.com ",
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockAccountRepository, *repmocks.MockUserRepository) {
+				accountRepo := repmocks.NewMockAccountRepository(ctrl)
+				userRepo := repmocks.NewMockUserRepository(ctrl)
+				userRepo.EXPECT().GetByEmail(gomock.Any(), "mario@example.com").Return(user, nil)
+				accountRepo.EXPECT().GetByUserID(gomock.Any(), userID).Return(&model.Account{ID: accountID, UserID: userID}, nil)
+				return accountRepo, userRepo
+			},
+		},
+		{
+			name:     "unknown user returns 404",
+			username: "nobody",
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockAccountRepository, *repmocks.MockUserRepository) {
+				userRepo := repmocks.NewMockUserRepository(ctrl)
+				userRepo.EXPECT().GetByUsername(gomock.Any(), "nobody").Return(nil, util.NewNotFoundError("user not found"))
+				return repmocks.NewMockAccountRepository(ctrl), userRepo
+			},
+			wantStatus: 404,
+		},
+		{
+			name:     "both username and email return 400",
+			username: "mrossi",
+			email:    "mario@example.com",
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockAccountRepository, *repmocks.MockUserRepository) {
+				return repmocks.NewMockAccountRepository(ctrl), repmocks.NewMockUserRepository(ctrl)
+			},
+			wantStatus: 400,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			accountRepo, userRepo := tc.buildMocks(ctrl)
+			svc := service.NewAccountService(accountRepo, repmocks.NewMockCosignerRepository(ctrl), userRepo, servicemocks.NewMockTransferService(ctrl), servicemocks.NewMockCacheClient(ctrl))
+
+			recipient, err := svc.GetRecipient(context.Background(), tc.username, tc.email)
+			if tc.wantStatus == 0 {
+				if err != nil || recipient.AccountID != accountID || recipient.Name != "Ma*** R***" {
+					t.Fatalf("unexpected result: recipient=%+v err=%v", recipient, err)
+				}
+				return
+			}
+			apiErr, ok := err.(*util.APIError)
+			if !ok || apiErr.Code != tc.wantStatus {
+				t.Fatalf("expected %d APIError, got %#v", tc.wantStatus, err)
+			}
+		})
+	}
+}
+
+func TestAccountService_GetByIBAN(t *testing.T) {
+	t.Parallel()
+
+	accountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440130")
+
+	tests := []struct {
+		name       string
+		iban       string
+		buildMocks func(ctrl *gomock.Controller) *repmocks.MockAccountRepository
+		wantStatus int
+	}{
+		{
+			name: "iban is normalized before the lookup",
+			iban: "it60 x054 2811 1010 0000 0123 456",
+			buildMocks: func(ctrl *gomock.Controller) *repmocks.MockAccountRepository {
+				accountRepo := repmocks.NewMockAccountRepository(ctrl)
+				accountRepo.EXPECT().GetByIBAN(gomock.Any(), "IT60X0542811101000000123456").Return(&model.Account{ID: accountID}, nil)
+				return accountRepo
+			},
+		},
+		{
+			name:       "invalid iban returns 400",
+			iban:       "IT61X0542811101000000123456",
+			buildMocks: repmocks.NewMockAccountRepository,
+			wantStatus: 400,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			svc := service.NewAccountService(tc.buildMocks(ctrl), repmocks.NewMockCosignerRepository(ctrl), repmocks.NewMockUserRepository(ctrl), servicemocks.NewMockTransferService(ctrl), servicemocks.NewMockCacheClient(ctrl))
+
+			account, err := svc.GetByIBAN(context.Background(), tc.iban)
+			if tc.wantStatus == 0 {
+				if err != nil || account.ID != accountID {
+					t.Fatalf("unexpected result: account=%+v err=%v", account, err)
+				}
+				return
+			}
+			apiErr, ok := err.(*util.APIError)
+			if !ok || apiErr.Code != tc.wantStatus {
+				t.Fatalf("expected %d APIError, got %#v", tc.wantStatus, err)
+			}
+		})
+	}
+}
+
+func TestAccountService_Close(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440140")
+	accountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440141")
+	sweepTo := uuid.MustParse("550e8400-e29b-41d4-a716-446655440142")
+	balance := decimal.RequireFromString("12.50")
+
+	tests := []struct {
+		name       string
+		sweepTo    *uuid.UUID
+		buildMocks func(ctrl *gomock.Controller) (*repmocks.MockAccountRepository, *servicemocks.MockTransferService)
+		wantStatus int
+	}{
+		{
+			name: "empty account is closed",
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockAccountRepository, *servicemocks.MockTransferService) {
+				accountRepo := repmocks.NewMockAccountRepository(ctrl)
+				gomock.InOrder(
+					accountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(&model.Account{ID: accountID, UserID: userID, Status: "active"}, nil),
+					accountRepo.EXPECT().Close(gomock.Any(), accountID, "active", gomock.Any()).Return(true, nil),
+					accountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(&model.Account{ID: accountID, UserID: userID, Status: "closed"}, nil),
+				)
+				return accountRepo, servicemocks.NewMockTransferService(ctrl)
+			},
+		},
+		{
+			name:    "remaining balance is swept before closing",
+			sweepTo: &sweepTo,
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockAccountRepository, *servicemocks.MockTransferService) {
+				accountRepo := repmocks.NewMockAccountRepository(ctrl)
+				transferSvc := servicemocks.NewMockTransferService(ctrl)
+				gomock.InOrder(
+					accountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(&model.Account{ID: accountID, UserID: userID, Status: "active", Balance: balance}, nil),
+					transferSvc.EXPECT().Sweep(gomock.Any(), accountID, sweepTo, balance, gomock.Any()).Return(&model.Transfer{ID: 1, Status: "completed"}, nil),
+					accountRepo.EXPECT().Close(gomock.Any(), accountID, "active", gomock.Any()).Return(true, nil),
+					accountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(&model.Account{ID: accountID, UserID: userID, Status: "closed"}, nil),
+				)
+				return accountRepo, transferSvc
+			},
+		},
+		{
+			name:    "sweep from a business account needing approval keeps the account open",
+			sweepTo: &sweepTo,
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockAccountRepository, *servicemocks.MockTransferService) {
+				accountRepo := repmocks.NewMockAccountRepository(ctrl)
+				transferSvc := servicemocks.NewMockTransferService(ctrl)
+				// The account is not closed while its balance has not left it
+				accountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(&model.Account{ID: accountID, UserID: userID, Type: "business", Status: "active", Balance: balance}, nil)
+				transferSvc.EXPECT().Sweep(gomock.Any(), accountID, sweepTo, balance, gomock.Any()).
+					Return(nil, util.NewUnprocessableEntityError("transfers needing approval cannot be executed immediately"))
+				return accountRepo, transferSvc
+			},
+			wantStatus: 422,
+		},
+		{
+			name:    "sweep to another user over a transfer limit keeps the account open",
+			sweepTo: &sweepTo,
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockAccountRepository, *servicemocks.MockTransferService) {
+				accountRepo := repmocks.NewMockAccountRepository(ctrl)
+				transferSvc := servicemocks.NewMockTransferService(ctrl)
+				accountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(&model.Account{ID: accountID, UserID: userID, Status: "active", Balance: balance}, nil)
+				transferSvc.EXPECT().Sweep(gomock.Any(), accountID, sweepTo, balance, gomock.Any()).Return(nil, util.NewTransferLimitError("daily"))
+				return accountRepo, transferSvc
+			},
+			wantStatus: 422,
+		},
+		{
+			name: "remaining balance without a sweep account returns 409",
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockAccountRepository, *servicemocks.MockTransferService) {
+				accountRepo := repmocks.NewMockAccountRepository(ctrl)
+				accountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(&model.Account{ID: accountID, UserID: userID, Status: "active", Balance: balance}, nil)
+				return accountRepo, servicemocks.NewMockTransferService(ctrl)
+			},
+			wantStatus: 409,
+		},
+		{
+			name:    "frozen account returns 409",
+			sweepTo: &sweepTo,
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockAccountRepository, *servicemocks.MockTransferService) {
+				accountRepo := repmocks.NewMockAccountRepository(ctrl)
+				accountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(&model.Account{ID: accountID, UserID: userID, Status: "frozen", Balance: balance}, nil)
+				return accountRepo, servicemocks.NewMockTransferService(ctrl)
+			},
+			wantStatus: 409,
+		},
+		{
+			name: "funds received while closing return 409",
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockAccountRepository, *servicemocks.MockTransferService) {
+				accountRepo := repmocks.NewMockAccountRepository(ctrl)
+				accountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(&model.Account{ID: accountID, UserID: userID, Status: "active"}, nil)
+				accountRepo.EXPECT().Close(gomock.Any(), accountID, "active", gomock.Any()).Return(false, nil)
+				return accountRepo, servicemocks.NewMockTransferService(ctrl)
+			},
+			wantStatus: 409,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			accountRepo, transferSvc := tc.buildMocks(ctrl)
+			svc := service.NewAccountService(accountRepo, repmocks.NewMockCosignerRepository(ctrl), repmocks.NewMockUserRepository(ctrl), transferSvc, servicemocks.NewMockCacheClient(ctrl))
+
+			account, err := svc.Close(context.Background(), userID, accountID, tc.sweepTo)
+			if tc.wantStatus == 0 {
+				if err != nil || account.Status != "closed" {
+					t.Fatalf("unexpected result: account=%+v err=%v", account, err)
+				}
+				return
+			}
+			apiErr, ok := err.(*util.APIError)
+			if !ok || apiErr.Code != tc.wantStatus {
+				t.Fatalf("expected %d APIError, got %#v", tc.wantStatus, err)
+			}
+		})
+	}
+}
+
+func TestAccountService_UpdateStatus(t *testing.T) {
+	t.Parallel()
+
+	accountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440150")
+
+	tests := []struct {
+		name       string
+		status     string
+		buildMocks func(ctrl *gomock.Controller) *repmocks.MockAccountRepository
+		wantStatus int
+	}{
+		{
+			name:   "active account is frozen",
+			status: "frozen",
+			buildMocks: func(ctrl *gomock.Controller) *repmocks.MockAccountRepository {
+				accountRepo := repmocks.NewMockAccountRepository(ctrl)
+				accountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(&model.Account{ID: accountID, Status: "active"}, nil)
+				accountRepo.EXPECT().TransitionStatus(gomock.Any(), accountID, "active", "frozen").Return(true, nil)
+				return accountRepo
+			},
+		},
+		{
+			name:   "closed account cannot be reopened",
+			status: "active",
+			buildMocks: func(ctrl *gomock.Controller) *repmocks.MockAccountRepository {
+				accountRepo := repmocks.NewMockAccountRepository(ctrl)
+				accountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(&model.Account{ID: accountID, Status: "closed"}, nil)
+				return accountRepo
+			},
+			wantStatus: 409,
+		},
+		{
+			name:       "closing through a status change returns 400",
+			status:     "closed",
+			buildMocks: repmocks.NewMockAccountRepository,
+			wantStatus: 400,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			svc := service.NewAccountService(tc.buildMocks(ctrl), repmocks.NewMockCosignerRepository(ctrl), repmocks.NewMockUserRepository(ctrl), servicemocks.NewMockTransferService(ctrl), servicemocks.NewMockCacheClient(ctrl))
+
+			account, err := svc.UpdateStatus(context.Background(), accountID, tc.status)
+			if tc.wantStatus == 0 {
+				if err != nil || account.Status != tc.status {
+					t.Fatalf("unexpected result: account=%+v err=%v", account, err)
+				}
+				return
+			}
+			apiErr, ok := err.(*util.APIError)
+			if !ok || apiErr.Code != tc.wantStatus {
+				t.Fatalf("expected %d APIError, got %#v", tc.wantStatus, err)
+			}
+		})
+	}
+}
+
+func TestAccountService_SetOverdraft(t *testing.T) {
+	t.Parallel()
+
+	accountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440160")
+
+	tests := []struct {
+		name       string
+		limit      string
+		rate       string
+		buildMocks func(ctrl *gomock.Controller) *repmocks.MockAccountRepository
+		wantStatus int
+	}{
+		{
+			name:  "overdraft is set",
+			limit: "500.00",
+			rate:  "9.50",
+			buildMocks: func(ctrl *gomock.Controller) *repmocks.MockAccountRepository {
+				accountRepo := repmocks.NewMockAccountRepository(ctrl)
+				accountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(&model.Account{ID: accountID, Status: "active"}, nil)
+				accountRepo.EXPECT().UpdateOverdraft(gomock.Any(), accountID, gomock.Any(), gomock.Any()).Return(nil)
+				return accountRepo
+			},
+		},
+		{
+			name:  "closed account returns 409",
+			limit: "500.00",
+			rate:  "0",
+			buildMocks: func(ctrl *gomock.Controller) *repmocks.MockAccountRepository {
+				accountRepo := repmocks.NewMockAccountRepository(ctrl)
+				accountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(&model.Account{ID: accountID, Status: "closed"}, nil)
+				return accountRepo
+			},
+			wantStatus: 409,
+		},
+		{
+			name:       "negative limit returns 400",
+			limit:      "-1",
+			rate:       "0",
+			buildMocks: repmocks.NewMockAccountRepository,
+			wantStatus: 400,
+		},
+		{
+			name:       "rate above 100 returns 400",
+			limit:      "500.00",
+			rate:       "101",
+			buildMocks: repmocks.NewMockAccountRepository,
+			wantStatus: 400,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			svc := service.NewAccountService(tc.buildMocks(ctrl), repmocks.NewMockCosignerRepository(ctrl), repmocks.NewMockUserRepository(ctrl), servicemocks.NewMockTransferService(ctrl), servicemocks.NewMockCacheClient(ctrl))
+
+			account, err := svc.SetOverdraft(context.Background(), accountID, decimal.RequireFromString(tc.limit), decimal.RequireFromString(tc.rate))
+			if tc.wantStatus == 0 {
+				if err != nil || !account.OverdraftLimit.Equal(decimal.RequireFromString(tc.limit)) {
+					t.Fatalf("unexpected result: account=%+v err=%v", account, err)
+				}
+				return
+			}
+			apiErr, ok := err.(*util.APIError)
+			if !ok || apiErr.Code != tc.wantStatus {
+				t.Fatalf("expected %d APIError, got %#v", tc.wantStatus, err)
+			}
+		})
+	}
+}
+
+func TestAccountService_AddCosigner(t *testing.T) {
+	t.Parallel()
+
+	ownerID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440160")
+	cosignerID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440161")
+	accountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440162")
+	business := &model.Account{ID: accountID, UserID: ownerID, Type: "business", Status: "active"}
+
+	tests := []struct {
+		name       string
+		username   string
+		buildMocks func(ctrl *gomock.Controller) (*repmocks.MockAccountRepository, *repmocks.MockCosignerRepository, *repmocks.MockUserRepository)
+		wantStatus int
+	}{
+		{
+			name:     "another user becomes a co-signer",
+			username: " lbianchi ",
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockAccountRepository, *repmocks.MockCosignerRepository, *repmocks.MockUserRepository) {
+				accountRepo := repmocks.NewMockAccountRepository(ctrl)
+				cosignerRepo := repmocks.NewMockCosignerRepository(ctrl)
+				userRepo := repmocks.NewMockUserRepository(ctrl)
+				accountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(business, nil)
+				userRepo.EXPECT().GetByUsername(gomock.Any(), "lbianchi").Return(&model.User{ID: cosignerID, Username: "lbianchi"}, nil)
+				cosignerRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, c *model.AccountCosigner) error {
+					if c.AccountID != accountID || c.UserID != cosignerID {
+						t.Fatalf("unexpected co-signer: %+v", c)
+					}
+					return nil
+				})
+				return accountRepo, cosignerRepo, userRepo
+			},
+		},
+		{
+			name:     "personal accounts return 400",
+			username: "lbianchi",
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockAccountRepository, *repmocks.MockCosignerRepository, *repmocks.MockUserRepository) {
+				accountRepo := repmocks.NewMockAccountRepository(ctrl)
+				accountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(&model.Account{ID: accountID, UserID: ownerID, Type: "checking", Status: "active"}, nil)
+				return accountRepo, repmocks.NewMockCosignerRepository(ctrl), repmocks.NewMockUserRepository(ctrl)
+			},
+			wantStatus: 400,
+		},
+		{
+			name:     "the owner cannot co-sign the account",
+			username: "owner",
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockAccountRepository, *repmocks.MockCosignerRepository, *repmocks.MockUserRepository) {
+				accountRepo := repmocks.NewMockAccountRepository(ctrl)
+				userRepo := repmocks.NewMockUserRepository(ctrl)
+				accountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(business, nil)
+				userRepo.EXPECT().GetByUsername(gomock.Any(), "owner").Return(&model.User{ID: ownerID, Username: "owner"}, nil)
+				return accountRepo, repmocks.NewMockCosignerRepository(ctrl), userRepo
+			},
+			wantStatus: 400,
+		},
+		{
+			name:     "unknown user returns 404",
+			username: "nobody",
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockAccountRepository, *repmocks.MockCosignerRepository, *repmocks.MockUserRepository) {
+				accountRepo := repmocks.NewMockAccountRepository(ctrl)
+				userRepo := repmocks.NewMockUserRepository(ctrl)
+				accountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(business, nil)
+				userRepo.EXPECT().GetByUsername(gomock.Any(), "nobody").Return(nil, util.NewNotFoundError("user not found"))
+				return accountRepo, repmocks.NewMockCosignerRepository(ctrl), userRepo
+			},
+			wantStatus: 404,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			accountRepo, cosignerRepo, userRepo := tc.buildMocks(ctrl)
+			svc := service.NewAccountService(accountRepo, cosignerRepo, userRepo, servicemocks.NewMockTransferService(ctrl), servicemocks.NewMockCacheClient(ctrl))
+
+			cosigner, err := svc.AddCosigner(context.Background(), ownerID, accountID, tc.username)
+			if tc.wantStatus == 0 {
+				if err != nil || cosigner.UserID != cosignerID || cosigner.Username != "lbianchi" {
+					t.Fatalf("unexpected result: cosigner=%+v err=%v", cosigner, err)
+				}
+				return
+			}
+			apiErr, ok := err.(*util.APIError)
+			if !ok || apiErr.Code != tc.wantStatus {
+				t.Fatalf("expected %d APIError, got %#v", tc.wantStatus, err)
+			}
+		})
+	}
+}
@@ -64,11 +64,19 @@ func NewAuthService(
 	}
 }
 
-// SignUp registers a new user
+// SignUp registers a new user along with a first account in currency, EUR if empty
 func (s *DefaultAuthService) SignUp(
 	ctx context.Context,
-	email, username, firstName, lastName, fiscalCode, password string,
+	email, username, firstName, lastName, fiscalCode, password, currency string,
 ) (*model.User, error) {
+	currency = util.NormalizeCurrency(currency)
+	if currency == "" {
+		currency = util.DefaultCurrency
+	}
+	if err := util.ValidateCurrency(currency); err != nil {
+		return nil, util.NewBadRequestError(err.Error())
+	}
+
 	// Check if email is already taken
 	_, err := s.userRepo.GetByEmail(ctx, email)
 	if err == nil {
@@ -100,6 +108,7 @@ func (s *DefaultAuthService) SignUp(
 		LastName:     lastName,
 		FiscalCode:   fiscalCode,
 		PasswordHash: string(hashedPassword),
+		Role:         "user",
 		CreatedAt:    time.Now(),
 		UpdatedAt:    time.Now(),
 	}
@@ -112,8 +121,10 @@ func (s *DefaultAuthService) SignUp(
 	// Create an account for the user
 	account := &model.Account{
 		UserID:    user.ID,
+		Type:      "checking",
+		IsDefault: true,
 		Balance:   decimal.Zero,
-		Currency:  "EUR",
+		Currency:  currency,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 	}
@@ -218,7 +229,7 @@ func (s *DefaultAuthService) GoogleCallback(ctx context.Context, code, state str
 			password := hex.EncodeToString(passwordBytes)
 
 			// Create the user
-			user, err = s.SignUp(ctx, userInfo.Email, username, firstName, lastName, userInfo.ID, password)
+			user, err = s.SignUp(ctx, userInfo.Email, username, firstName, lastName, userInfo.ID, password, "")
 			if err != nil {
 				return "", errors.Wrap(err, "failed to create user from Google account")
 			}
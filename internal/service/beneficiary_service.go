@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"VDM2-BankBE/internal/model"
+	"VDM2-BankBE/internal/repository"
+	"VDM2-BankBE/internal/util"
+)
+
+// DefaultBeneficiaryService implements BeneficiaryService
+type DefaultBeneficiaryService struct {
+	beneficiaryRepo repository.BeneficiaryRepository
+	accountRepo     repository.AccountRepository
+}
+
+// NewBeneficiaryService creates a new beneficiary service
+func NewBeneficiaryService(
+	beneficiaryRepo repository.BeneficiaryRepository,
+	accountRepo repository.AccountRepository,
+) BeneficiaryService {
+	return &DefaultBeneficiaryService{
+		beneficiaryRepo: beneficiaryRepo,
+		accountRepo:     accountRepo,
+	}
+}
+
+// Create adds an account to the address book of a user. The account is given either by AccountID or by IBAN;
+// the other one is filled in from the account.
+func (s *DefaultBeneficiaryService) Create(ctx context.Context, beneficiary *model.Beneficiary) (*model.Beneficiary, error) {
+	beneficiary.Nickname = strings.TrimSpace(beneficiary.Nickname)
+	if beneficiary.Nickname == "" {
+		return nil, util.NewBadRequestError("nickname is required")
+	}
+
+	// Resolve the account
+	var account *model.Account
+	var err error
+	if beneficiary.IBAN != "" {
+		iban := util.NormalizeIBAN(beneficiary.IBAN)
+		if err := util.ValidateIBAN(iban); err != nil {
+			return nil, util.NewBadRequestError(err.Error())
+		}
+		account, err = s.accountRepo.GetByIBAN(ctx, iban)
+	} else {
+		account, err = s.accountRepo.GetByID(ctx, beneficiary.AccountID)
+	}
+	if err != nil {
+		if _, ok := err.(*util.APIError); ok {
+			return nil, err
+		}
+		return nil, errors.Wrap(err, "failed to get beneficiary account")
+	}
+
+	if account.Status == "closed" {
+		return nil, util.NewConflictError("account is closed")
+	}
+
+	beneficiary.AccountID = account.ID
+	beneficiary.IBAN = account.IBAN
+	beneficiary.LastUsedAt = nil
+
+	if err := s.beneficiaryRepo.Create(ctx, beneficiary); err != nil {
+		if _, ok := err.(*util.APIError); ok {
+			return nil, err
+		}
+		return nil, errors.Wrap(err, "failed to create beneficiary")
+	}
+
+	return beneficiary, nil
+}
+
+// GetByID retrieves a beneficiary of userID
+func (s *DefaultBeneficiaryService) GetByID(ctx context.Context, userID uuid.UUID, id uint64) (*model.Beneficiary, error) {
+	beneficiary, err := s.beneficiaryRepo.GetByID(ctx, id)
+	if err != nil {
+		if _, ok := err.(*util.APIError); ok {
+			return nil, err
+		}
+		return nil, errors.Wrap(err, "failed to get beneficiary")
+	}
+
+	// Beneficiaries of other users are reported as missing
+	if beneficiary.UserID != userID {
+		return nil, util.NewNotFoundError("beneficiary not found")
+	}
+
+	return beneficiary, nil
+}
+
+// GetByUserID retrieves the address book of a user with pagination
+func (s *DefaultBeneficiaryService) GetByUserID(ctx context.Context, userID uuid.UUID, page, limit int) (*util.PaginatedResponse, error) {
+	// Create pagination params
+	params, err := util.NewPaginationParams(strconv.Itoa(page), strconv.Itoa(limit))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid pagination parameters")
+	}
+
+	// Get beneficiaries
+	beneficiaries, count, err := s.beneficiaryRepo.GetByUserID(ctx, userID, params)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get beneficiaries")
+	}
+
+	// Create paginated response
+	response := util.NewPaginatedResponse(beneficiaries, params, count)
+	return response, nil
+}
+
+// Rename changes the nickname of a beneficiary of userID
+func (s *DefaultBeneficiaryService) Rename(
+	ctx context.Context,
+	userID uuid.UUID,
+	id uint64,
+	nickname string,
+) (*model.Beneficiary, error) {
+	nickname = strings.TrimSpace(nickname)
+	if nickname == "" {
+		return nil, util.NewBadRequestError("nickname is required")
+	}
+
+	beneficiary, err := s.GetByID(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.beneficiaryRepo.UpdateNickname(ctx, id, nickname); err != nil {
+		return nil, errors.Wrap(err, "failed to rename beneficiary")
+	}
+
+	beneficiary.Nickname = nickname
+	return beneficiary, nil
+}
+
+// Delete removes a beneficiary of userID. Transfers already sent to it are not affected.
+func (s *DefaultBeneficiaryService) Delete(ctx context.Context, userID uuid.UUID, id uint64) error {
+	if _, err := s.GetByID(ctx, userID, id); err != nil {
+		return err
+	}
+
+	if err := s.beneficiaryRepo.Delete(ctx, id); err != nil {
+		return errors.Wrap(err, "failed to delete beneficiary")
+	}
+
+	return nil
+}
+
+// MarkUsed records that a transfer was sent to a beneficiary
+func (s *DefaultBeneficiaryService) MarkUsed(ctx context.Context, id uint64) error {
+	if err := s.beneficiaryRepo.MarkUsed(ctx, id, time.Now()); err != nil {
+		return errors.Wrap(err, "failed to mark beneficiary as used")
+	}
+
+	return nil
+}
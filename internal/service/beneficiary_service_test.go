@@ -0,0 +1,146 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+
+	"VDM2-BankBE/internal/model"
+	repmocks "VDM2-BankBE/internal/repository/mocks"
+	"VDM2-BankBE/internal/service"
+	"VDM2-BankBE/internal/util"
+)
+
+func TestBeneficiaryService_Create(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440800")
+	accountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440801")
+	iban := "IT60X0542811101000000123456"
+
+	tests := []struct {
+		name        string
+		beneficiary *model.Beneficiary
+		buildMocks  func(ctrl *gomock.Controller) (*repmocks.MockBeneficiaryRepository, *repmocks.MockAccountRepository)
+		wantCode    int
+	}{
+		{
+			name:        "blank nickname returns 400",
+			beneficiary: &model.Beneficiary{UserID: userID, Nickname: "  ", IBAN: iban},
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockBeneficiaryRepository, *repmocks.MockAccountRepository) {
+				return repmocks.NewMockBeneficiaryRepository(ctrl), repmocks.NewMockAccountRepository(ctrl)
+			},
+			wantCode: 400,
+		},
+		{
+			name:        "invalid iban returns 400",
+			beneficiary: &model.Beneficiary{UserID: userID, Nickname: "Rent", IBAN: "IT61X0542811101000000123456"},
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockBeneficiaryRepository, *repmocks.MockAccountRepository) {
+				return repmocks.NewMockBeneficiaryRepository(ctrl), repmocks.NewMockAccountRepository(ctrl)
+			},
+			wantCode: 400,
+		},
+		{
+			name:        "closed account returns 409",
+			beneficiary: &model.Beneficiary{UserID: userID, Nickname: "Rent", AccountID: accountID},
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockBeneficiaryRepository, *repmocks.MockAccountRepository) {
+				accountRepo := repmocks.NewMockAccountRepository(ctrl)
+				accountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(&model.Account{ID: accountID, Status: "closed"}, nil)
+				return repmocks.NewMockBeneficiaryRepository(ctrl), accountRepo
+			},
+			wantCode: 409,
+		},
+		{
+			name:        "success by iban fills in the account",
+			beneficiary: &model.Beneficiary{UserID: userID, Nickname: " Rent ", IBAN: "it60 x054 2811 1010 0000 0123 456"},
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockBeneficiaryRepository, *repmocks.MockAccountRepository) {
+				beneficiaryRepo := repmocks.NewMockBeneficiaryRepository(ctrl)
+				accountRepo := repmocks.NewMockAccountRepository(ctrl)
+
+				accountRepo.EXPECT().GetByIBAN(gomock.Any(), iban).Return(&model.Account{ID: accountID, IBAN: iban, Status: "active"}, nil)
+				beneficiaryRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, beneficiary *model.Beneficiary) error {
+					if beneficiary.AccountID != accountID || beneficiary.IBAN != iban || beneficiary.Nickname != "Rent" {
+						t.Fatalf("unexpected beneficiary: %+v", beneficiary)
+					}
+					return nil
+				})
+
+				return beneficiaryRepo, accountRepo
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			beneficiaryRepo, accountRepo := tc.buildMocks(ctrl)
+			svc := service.NewBeneficiaryService(beneficiaryRepo, accountRepo)
+
+			got, err := svc.Create(context.Background(), tc.beneficiary)
+			if tc.wantCode != 0 {
+				apiErr, ok := err.(*util.APIError)
+				if !ok || apiErr.Code != tc.wantCode {
+					t.Fatalf("expected %d APIError, got %#v", tc.wantCode, err)
+				}
+				return
+			}
+			if err != nil || got == nil {
+				t.Fatalf("unexpected result: beneficiary=%+v err=%v", got, err)
+			}
+		})
+	}
+}
+
+func TestBeneficiaryService_GetByID(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440810")
+	otherUserID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440811")
+
+	tests := []struct {
+		name        string
+		beneficiary *model.Beneficiary
+		wantCode    int
+	}{
+		{
+			name:        "beneficiary of another user returns 404",
+			beneficiary: &model.Beneficiary{ID: 1, UserID: otherUserID},
+			wantCode:    404,
+		},
+		{
+			name:        "beneficiary of the user is returned",
+			beneficiary: &model.Beneficiary{ID: 1, UserID: userID},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			beneficiaryRepo := repmocks.NewMockBeneficiaryRepository(ctrl)
+			beneficiaryRepo.EXPECT().GetByID(gomock.Any(), uint64(1)).Return(tc.beneficiary, nil)
+			svc := service.NewBeneficiaryService(beneficiaryRepo, repmocks.NewMockAccountRepository(ctrl))
+
+			got, err := svc.GetByID(context.Background(), userID, 1)
+			if tc.wantCode != 0 {
+				apiErr, ok := err.(*util.APIError)
+				if !ok || apiErr.Code != tc.wantCode {
+					t.Fatalf("expected %d APIError, got %#v", tc.wantCode, err)
+				}
+				return
+			}
+			if err != nil || got == nil {
+				t.Fatalf("unexpected result: beneficiary=%+v err=%v", got, err)
+			}
+		})
+	}
+}
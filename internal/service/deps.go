@@ -3,12 +3,14 @@ package service
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 	"golang.org/x/oauth2"
 	"gorm.io/gorm"
 
+	"VDM2-BankBE/internal/model"
 	"VDM2-BankBE/pkg/oauth"
 )
 
@@ -23,6 +25,10 @@ type CacheClient interface {
 	// OAuth state store
 	SetOAuthState(ctx context.Context, state string, redirectURL string) error
 	GetOAuthState(ctx context.Context, state string) (string, error)
+
+	// Idempotency store (completed responses only)
+	SetIdempotencyRecord(ctx context.Context, record *model.IdempotencyKey, ttl time.Duration) error
+	GetIdempotencyRecord(ctx context.Context, userID uuid.UUID, key string) (*model.IdempotencyKey, error)
 }
 
 // GoogleOAuthClient represents the Google OAuth boundary used by the auth service.
@@ -34,7 +40,7 @@ type GoogleOAuthClient interface {
 	GetUserInfo(ctx context.Context, token *oauth2.Token) (*oauth.GoogleUserInfo, error)
 }
 
-// TxDB represents the DB transaction boundary used by the transfer service.
+// TxDB represents the DB transaction boundary used by the transfer and movement services.
 // Implemented by `*gorm.DB`.
 //go:generate mockgen -destination=./mocks/mock_tx_db.go -package=mocks VDM2-BankBE/internal/service TxDB
 type TxDB interface {
@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+
+	"VDM2-BankBE/internal/config"
+	"VDM2-BankBE/internal/model"
+	"VDM2-BankBE/internal/repository"
+	"VDM2-BankBE/internal/util"
+)
+
+// Operation types fees are configured for
+const (
+	FeeOperationInstantTransfer       = "instant_transfer"
+	FeeOperationScheduledTransfer     = "scheduled_transfer"
+	FeeOperationCrossCurrencyTransfer = "cross_currency_transfer"
+	FeeOperationWithdrawal            = "withdrawal"
+)
+
+// DefaultFeeService implements FeeService
+type DefaultFeeService struct {
+	fxRepo repository.FXRepository
+	config *config.FeeConfig
+}
+
+// NewFeeService creates a new fee service
+func NewFeeService(fxRepo repository.FXRepository, config *config.FeeConfig) FeeService {
+	return &DefaultFeeService{
+		fxRepo: fxRepo,
+		config: config,
+	}
+}
+
+// Calculate computes the fee of an operation of amount in currency from the rule of its type: a flat amount,
+// a percentage of the amount, or the flat amount plus percentage of the first tier covering the amount. The fee
+// is then bounded by the min and max of the rule and rounded to the minor unit of the currency. The amounts of the
+// rule are converted from the currency of the fees at the current exchange rate.
+func (s *DefaultFeeService) Calculate(
+	ctx context.Context,
+	operation string,
+	amount decimal.Decimal,
+	currency string,
+) (*model.FeeBreakdown, error) {
+	rule, ok := s.config.Rule(operation)
+	if !ok {
+		return nil, util.NewBadRequestError("unknown operation " + operation)
+	}
+
+	breakdown := &model.FeeBreakdown{
+		Operation: operation,
+		Rule:      "none",
+		Amount:    amount,
+		Currency:  currency,
+	}
+	if rule == nil {
+		return breakdown, nil
+	}
+
+	rate := decimal.NewFromInt(1)
+	if currency != s.config.Currency {
+		var err error
+		rate, err = exchangeRate(ctx, s.fxRepo, s.config.Currency, currency)
+		if err != nil {
+			return nil, err
+		}
+	}
+	convert := func(amount decimal.Decimal) decimal.Decimal {
+		return util.RoundAmount(amount.Mul(rate), currency)
+	}
+
+	breakdown.Rule = rule.Type
+	switch rule.Type {
+	case "flat":
+		breakdown.Flat = convert(rule.Amount)
+	case "percentage":
+		breakdown.Percentage = rule.Percentage
+	case "tiered":
+		for i, tier := range rule.Tiers {
+			if !tier.UpTo.IsPositive() || amount.LessThanOrEqual(convert(tier.UpTo)) || i == len(rule.Tiers)-1 {
+				breakdown.Tier = i + 1
+				breakdown.Flat = convert(tier.Amount)
+				breakdown.Percentage = tier.Percentage
+				break
+			}
+		}
+	}
+	breakdown.PercentageAmount = util.RoundAmount(amount.Mul(breakdown.Percentage).Div(decimal.NewFromInt(100)), currency)
+
+	total := breakdown.Flat.Add(breakdown.PercentageAmount)
+	if minFee := convert(rule.Min); minFee.IsPositive() && total.LessThan(minFee) {
+		total = minFee
+		breakdown.CappedAt = "min"
+	}
+	if maxFee := convert(rule.Max); maxFee.IsPositive() && total.GreaterThan(maxFee) {
+		total = maxFee
+		breakdown.CappedAt = "max"
+	}
+	breakdown.Total = util.RoundAmount(total, currency)
+
+	return breakdown, nil
+}
+
+// chargeFee debits a fee from an account to the fee account of its currency within the transaction carried by
+// ctx, and records it as a debit movement of its own
+func chargeFee(
+	ctx context.Context,
+	ledgerService LedgerService,
+	movementRepo repository.MovementRepository,
+	accountID uuid.UUID,
+	fee decimal.Decimal,
+	currency, description string,
+) (*model.Movement, error) {
+	entry := feeEntry(accountID, fee, currency, description)
+	if err := ledgerService.Post(ctx, entry); err != nil {
+		if _, ok := err.(*util.APIError); ok {
+			return nil, err
+		}
+		return nil, errors.Wrap(err, "failed to charge fee")
+	}
+
+	movement := &model.Movement{
+		AccountID:      accountID,
+		Amount:         fee,
+		Type:           "debit",
+		Description:    description,
+		OccurredAt:     time.Now(),
+		BalanceAfter:   entry.Postings[0].BalanceAfter,
+		JournalEntryID: &entry.ID,
+	}
+	if err := movementRepo.Create(ctx, movement); err != nil {
+		return nil, errors.Wrap(err, "failed to create fee movement")
+	}
+
+	return movement, nil
+}
@@ -0,0 +1,108 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/shopspring/decimal"
+
+	"VDM2-BankBE/internal/config"
+	"VDM2-BankBE/internal/model"
+	repmocks "VDM2-BankBE/internal/repository/mocks"
+	"VDM2-BankBE/internal/service"
+	"VDM2-BankBE/internal/util"
+)
+
+// noFees charges nothing for any operation
+var noFees = service.NewFeeService(nil, &config.FeeConfig{Currency: "EUR"})
+
+func TestFeeService_Calculate(t *testing.T) {
+	t.Parallel()
+
+	fees := &config.FeeConfig{
+		Currency:        "EUR",
+		InstantTransfer: &config.FeeRule{Type: "flat", Amount: decimal.RequireFromString("0.50")},
+		CrossCurrencyTransfer: &config.FeeRule{
+			Type:       "percentage",
+			Percentage: decimal.RequireFromString("0.5"),
+			Min:        decimal.RequireFromString("1.00"),
+			Max:        decimal.RequireFromString("25.00"),
+		},
+		Withdrawal: &config.FeeRule{
+			Type: "tiered",
+			Tiers: []config.FeeTier{
+				{UpTo: decimal.RequireFromString("250.00"), Amount: decimal.RequireFromString("1.00")},
+				{Amount: decimal.RequireFromString("1.00"), Percentage: decimal.RequireFromString("0.2")},
+			},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		operation string
+		amount    string
+		currency  string
+		// rate is the exchange rate from the currency of the fees to currency, if any
+		rate         string
+		wantCode     int
+		wantRule     string
+		wantTier     int
+		wantCappedAt string
+		wantTotal    string
+	}{
+		{name: "flat", operation: service.FeeOperationInstantTransfer, amount: "100.00", currency: "EUR", wantRule: "flat", wantTotal: "0.50"},
+		{name: "percentage", operation: service.FeeOperationCrossCurrencyTransfer, amount: "1000.00", currency: "EUR", wantRule: "percentage", wantTotal: "5.00"},
+		{name: "percentage raised to the minimum", operation: service.FeeOperationCrossCurrencyTransfer, amount: "20.00", currency: "EUR", wantRule: "percentage", wantCappedAt: "min", wantTotal: "1.00"},
+		{name: "percentage lowered to the maximum", operation: service.FeeOperationCrossCurrencyTransfer, amount: "10000.00", currency: "EUR", wantRule: "percentage", wantCappedAt: "max", wantTotal: "25.00"},
+		{name: "percentage rounded to the minor unit", operation: service.FeeOperationCrossCurrencyTransfer, amount: "333.33", currency: "EUR", wantRule: "percentage", wantTotal: "1.67"},
+		{name: "first tier", operation: service.FeeOperationWithdrawal, amount: "250.00", currency: "EUR", wantRule: "tiered", wantTier: 1, wantTotal: "1.00"},
+		{name: "open-ended last tier", operation: service.FeeOperationWithdrawal, amount: "1000.00", currency: "EUR", wantRule: "tiered", wantTier: 2, wantTotal: "3.00"},
+		{name: "operation without a rule is free", operation: service.FeeOperationScheduledTransfer, amount: "100.00", currency: "EUR", wantRule: "none", wantTotal: "0"},
+		{name: "unknown operation returns 400", operation: "deposit", amount: "100.00", currency: "EUR", wantCode: 400},
+		{name: "flat amount converted to the currency of the operation", operation: service.FeeOperationInstantTransfer, amount: "100.00", currency: "USD", rate: "1.10", wantRule: "flat", wantTotal: "0.55"},
+		{name: "minimum converted to the currency of the operation", operation: service.FeeOperationCrossCurrencyTransfer, amount: "20.00", currency: "USD", rate: "1.10", wantRule: "percentage", wantCappedAt: "min", wantTotal: "1.10"},
+		{name: "tier bound converted to the currency of the operation", operation: service.FeeOperationWithdrawal, amount: "270.00", currency: "USD", rate: "1.10", wantRule: "tiered", wantTier: 1, wantTotal: "1.10"},
+		{name: "converted amounts are rounded to the minor unit of the currency", operation: service.FeeOperationInstantTransfer, amount: "10000", currency: "JPY", rate: "161.37", wantRule: "flat", wantTotal: "81"},
+		{name: "currency without an exchange rate returns 422", operation: service.FeeOperationInstantTransfer, amount: "100.00", currency: "CHF", wantCode: 422},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			fxRepo := repmocks.NewMockFXRepository(ctrl)
+			if tc.currency != fees.Currency && tc.wantCode != 400 {
+				if tc.rate != "" {
+					fxRepo.EXPECT().GetRate(gomock.Any(), fees.Currency, tc.currency).
+						Return(&model.FXRate{BaseCurrency: fees.Currency, QuoteCurrency: tc.currency, Rate: decimal.RequireFromString(tc.rate)}, nil)
+				} else {
+					fxRepo.EXPECT().GetRate(gomock.Any(), fees.Currency, tc.currency).Return(nil, util.NewNotFoundError("exchange rate not found"))
+					fxRepo.EXPECT().GetRate(gomock.Any(), tc.currency, fees.Currency).Return(nil, util.NewNotFoundError("exchange rate not found"))
+				}
+			}
+			svc := service.NewFeeService(fxRepo, fees)
+
+			breakdown, err := svc.Calculate(context.Background(), tc.operation, decimal.RequireFromString(tc.amount), tc.currency)
+			if tc.wantCode != 0 {
+				apiErr, ok := err.(*util.APIError)
+				if !ok || apiErr.Code != tc.wantCode {
+					t.Fatalf("expected %d APIError, got %#v", tc.wantCode, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if breakdown.Rule != tc.wantRule || breakdown.Tier != tc.wantTier || breakdown.CappedAt != tc.wantCappedAt {
+				t.Fatalf("unexpected breakdown: %+v", breakdown)
+			}
+			if !breakdown.Total.Equal(decimal.RequireFromString(tc.wantTotal)) {
+				t.Fatalf("unexpected total: got=%s want=%s", breakdown.Total, tc.wantTotal)
+			}
+		})
+	}
+}
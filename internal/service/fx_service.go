@@ -0,0 +1,165 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+
+	"VDM2-BankBE/internal/config"
+	"VDM2-BankBE/internal/model"
+	"VDM2-BankBE/internal/repository"
+	"VDM2-BankBE/internal/util"
+)
+
+// fxRatePrecision is the number of decimal places rates derived from the opposite pair are rounded to, the scale
+// of the rates table
+const fxRatePrecision = 10
+
+// DefaultFXService implements FXService
+type DefaultFXService struct {
+	fxRepo repository.FXRepository
+	config *config.FXConfig
+}
+
+// NewFXService creates a new FX service
+func NewFXService(fxRepo repository.FXRepository, config *config.FXConfig) FXService {
+	return &DefaultFXService{
+		fxRepo: fxRepo,
+		config: config,
+	}
+}
+
+// ListRates returns all the exchange rates
+func (s *DefaultFXService) ListRates(ctx context.Context) ([]*model.FXRate, error) {
+	return s.fxRepo.ListRates(ctx)
+}
+
+// SetRates creates or replaces exchange rates and returns all the rates in force
+func (s *DefaultFXService) SetRates(ctx context.Context, updates []FXRateUpdate) ([]*model.FXRate, error) {
+	if len(updates) == 0 {
+		return nil, util.NewBadRequestError("at least one rate is required")
+	}
+
+	now := time.Now()
+	rates := make([]*model.FXRate, 0, len(updates))
+	seen := make(map[string]bool, len(updates))
+	for _, update := range updates {
+		base := util.NormalizeCurrency(update.BaseCurrency)
+		quote := util.NormalizeCurrency(update.QuoteCurrency)
+		if err := util.ValidateCurrency(base); err != nil {
+			return nil, util.NewBadRequestError(err.Error())
+		}
+		if err := util.ValidateCurrency(quote); err != nil {
+			return nil, util.NewBadRequestError(err.Error())
+		}
+		if base == quote {
+			return nil, util.NewBadRequestError("base and quote currencies must differ")
+		}
+		if !update.Rate.IsPositive() {
+			return nil, util.NewBadRequestError("rate must be greater than zero")
+		}
+		if seen[base+quote] {
+			return nil, util.NewBadRequestError("rate " + base + "/" + quote + " is listed more than once")
+		}
+		seen[base+quote] = true
+
+		rates = append(rates, &model.FXRate{
+			BaseCurrency:  base,
+			QuoteCurrency: quote,
+			Rate:          update.Rate,
+			UpdatedAt:     now,
+		})
+	}
+
+	if err := s.fxRepo.UpsertRates(ctx, rates); err != nil {
+		return nil, err
+	}
+
+	return s.fxRepo.ListRates(ctx)
+}
+
+// Quote locks the rate at which amount can be converted from one currency to another for the configured TTL.
+// A pair with no rate of its own is converted at the inverse of the opposite pair.
+func (s *DefaultFXService) Quote(
+	ctx context.Context,
+	userID uuid.UUID,
+	fromCurrency, toCurrency string,
+	amount decimal.Decimal,
+) (*model.FXQuote, error) {
+	fromCurrency = util.NormalizeCurrency(fromCurrency)
+	toCurrency = util.NormalizeCurrency(toCurrency)
+	if err := util.ValidateCurrency(fromCurrency); err != nil {
+		return nil, util.NewBadRequestError(err.Error())
+	}
+	if err := util.ValidateCurrency(toCurrency); err != nil {
+		return nil, util.NewBadRequestError(err.Error())
+	}
+	if fromCurrency == toCurrency {
+		return nil, util.NewBadRequestError("from and to currencies must differ")
+	}
+	if amount.LessThanOrEqual(decimal.Zero) {
+		return nil, util.NewBadRequestError("amount must be greater than zero")
+	}
+	if err := util.ValidateAmountPrecision(amount, fromCurrency); err != nil {
+		return nil, util.NewBadRequestError(err.Error())
+	}
+
+	rate, err := exchangeRate(ctx, s.fxRepo, fromCurrency, toCurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	converted := util.RoundAmount(amount.Mul(rate), toCurrency)
+	if !converted.IsPositive() {
+		return nil, util.NewUnprocessableEntityError("amount is too small to convert to " + toCurrency)
+	}
+
+	now := time.Now()
+	quote := &model.FXQuote{
+		ID:              uuid.New(),
+		UserID:          userID,
+		FromCurrency:    fromCurrency,
+		ToCurrency:      toCurrency,
+		Rate:            rate,
+		Amount:          amount,
+		ConvertedAmount: converted,
+		ExpiresAt:       now.Add(s.config.QuoteTTL),
+		CreatedAt:       now,
+	}
+	if err := s.fxRepo.CreateQuote(ctx, quote); err != nil {
+		return nil, err
+	}
+
+	return quote, nil
+}
+
+// exchangeRate returns the rate from one currency to another, falling back to the inverse of the opposite pair
+func exchangeRate(ctx context.Context, fxRepo repository.FXRepository, fromCurrency, toCurrency string) (decimal.Decimal, error) {
+	rate, err := fxRepo.GetRate(ctx, fromCurrency, toCurrency)
+	if err == nil {
+		return rate.Rate, nil
+	}
+	if !isNotFound(err) {
+		return decimal.Zero, errors.Wrap(err, "failed to get exchange rate")
+	}
+
+	inverse, err := fxRepo.GetRate(ctx, toCurrency, fromCurrency)
+	if err != nil {
+		if isNotFound(err) {
+			return decimal.Zero, util.NewUnprocessableEntityError("no exchange rate from " + fromCurrency + " to " + toCurrency)
+		}
+		return decimal.Zero, errors.Wrap(err, "failed to get exchange rate")
+	}
+
+	return decimal.NewFromInt(1).DivRound(inverse.Rate, fxRatePrecision), nil
+}
+
+// isNotFound tells whether err is a not found API error
+func isNotFound(err error) bool {
+	apiErr, ok := err.(*util.APIError)
+	return ok && apiErr.Code == http.StatusNotFound
+}
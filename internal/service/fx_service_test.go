@@ -0,0 +1,221 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"VDM2-BankBE/internal/config"
+	"VDM2-BankBE/internal/model"
+	repmocks "VDM2-BankBE/internal/repository/mocks"
+	"VDM2-BankBE/internal/service"
+	"VDM2-BankBE/internal/util"
+)
+
+var fxConfig = &config.FXConfig{QuoteTTL: 30 * time.Second}
+
+func TestFXService_Quote(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440900")
+
+	tests := []struct {
+		name          string
+		from          string
+		to            string
+		amount        string
+		buildMocks    func(ctrl *gomock.Controller) *repmocks.MockFXRepository
+		wantCode      int
+		wantRate      string
+		wantConverted string
+	}{
+		{
+			name:   "direct rate",
+			from:   "eur",
+			to:     "USD",
+			amount: "100.00",
+			buildMocks: func(ctrl *gomock.Controller) *repmocks.MockFXRepository {
+				fxRepo := repmocks.NewMockFXRepository(ctrl)
+				fxRepo.EXPECT().GetRate(gomock.Any(), "EUR", "USD").
+					Return(&model.FXRate{BaseCurrency: "EUR", QuoteCurrency: "USD", Rate: decimal.RequireFromString("1.0850")}, nil)
+				fxRepo.EXPECT().CreateQuote(gomock.Any(), gomock.Any()).Return(nil)
+				return fxRepo
+			},
+			wantRate:      "1.085",
+			wantConverted: "108.50",
+		},
+		{
+			name:   "converted amount is rounded to the minor unit of the target currency",
+			from:   "EUR",
+			to:     "JPY",
+			amount: "10.85",
+			buildMocks: func(ctrl *gomock.Controller) *repmocks.MockFXRepository {
+				fxRepo := repmocks.NewMockFXRepository(ctrl)
+				fxRepo.EXPECT().GetRate(gomock.Any(), "EUR", "JPY").
+					Return(&model.FXRate{BaseCurrency: "EUR", QuoteCurrency: "JPY", Rate: decimal.RequireFromString("162.50")}, nil)
+				fxRepo.EXPECT().CreateQuote(gomock.Any(), gomock.Any()).Return(nil)
+				return fxRepo
+			},
+			wantRate:      "162.5",
+			wantConverted: "1763",
+		},
+		{
+			name:   "inverse of the opposite pair",
+			from:   "USD",
+			to:     "EUR",
+			amount: "100.00",
+			buildMocks: func(ctrl *gomock.Controller) *repmocks.MockFXRepository {
+				fxRepo := repmocks.NewMockFXRepository(ctrl)
+				fxRepo.EXPECT().GetRate(gomock.Any(), "USD", "EUR").Return(nil, util.NewNotFoundError("exchange rate not found"))
+				fxRepo.EXPECT().GetRate(gomock.Any(), "EUR", "USD").
+					Return(&model.FXRate{BaseCurrency: "EUR", QuoteCurrency: "USD", Rate: decimal.RequireFromString("1.25")}, nil)
+				fxRepo.EXPECT().CreateQuote(gomock.Any(), gomock.Any()).Return(nil)
+				return fxRepo
+			},
+			wantRate:      "0.8",
+			wantConverted: "80.00",
+		},
+		{
+			name:   "no rate either way returns 422",
+			from:   "USD",
+			to:     "GBP",
+			amount: "100.00",
+			buildMocks: func(ctrl *gomock.Controller) *repmocks.MockFXRepository {
+				fxRepo := repmocks.NewMockFXRepository(ctrl)
+				fxRepo.EXPECT().GetRate(gomock.Any(), "USD", "GBP").Return(nil, util.NewNotFoundError("exchange rate not found"))
+				fxRepo.EXPECT().GetRate(gomock.Any(), "GBP", "USD").Return(nil, util.NewNotFoundError("exchange rate not found"))
+				return fxRepo
+			},
+			wantCode: 422,
+		},
+		{
+			name:       "same currency returns 400",
+			from:       "EUR",
+			to:         "EUR",
+			amount:     "100.00",
+			buildMocks: repmocks.NewMockFXRepository,
+			wantCode:   400,
+		},
+		{
+			name:       "amount finer than the minor unit returns 400",
+			from:       "JPY",
+			to:         "EUR",
+			amount:     "100.5",
+			buildMocks: repmocks.NewMockFXRepository,
+			wantCode:   400,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			svc := service.NewFXService(tc.buildMocks(ctrl), fxConfig)
+
+			quote, err := svc.Quote(context.Background(), userID, tc.from, tc.to, decimal.RequireFromString(tc.amount))
+			if tc.wantCode != 0 {
+				apiErr, ok := err.(*util.APIError)
+				if !ok || apiErr.Code != tc.wantCode {
+					t.Fatalf("expected %d APIError, got %#v", tc.wantCode, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !quote.Rate.Equal(decimal.RequireFromString(tc.wantRate)) {
+				t.Fatalf("unexpected rate: got=%s want=%s", quote.Rate, tc.wantRate)
+			}
+			if !quote.ConvertedAmount.Equal(decimal.RequireFromString(tc.wantConverted)) {
+				t.Fatalf("unexpected converted amount: got=%s want=%s", quote.ConvertedAmount, tc.wantConverted)
+			}
+			if quote.UserID != userID || !quote.ExpiresAt.After(quote.CreatedAt) {
+				t.Fatalf("unexpected quote: %+v", quote)
+			}
+		})
+	}
+}
+
+func TestFXService_SetRates(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		updates    []service.FXRateUpdate
+		buildMocks func(ctrl *gomock.Controller) *repmocks.MockFXRepository
+		wantCode   int
+	}{
+		{
+			name: "rates are normalized and saved",
+			updates: []service.FXRateUpdate{
+				{BaseCurrency: "eur", QuoteCurrency: "usd", Rate: decimal.RequireFromString("1.09")},
+			},
+			buildMocks: func(ctrl *gomock.Controller) *repmocks.MockFXRepository {
+				fxRepo := repmocks.NewMockFXRepository(ctrl)
+				fxRepo.EXPECT().UpsertRates(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, rates []*model.FXRate) error {
+					if len(rates) != 1 || rates[0].BaseCurrency != "EUR" || rates[0].QuoteCurrency != "USD" {
+						t.Errorf("unexpected rates: %+v", rates)
+					}
+					return nil
+				})
+				fxRepo.EXPECT().ListRates(gomock.Any()).Return(nil, nil)
+				return fxRepo
+			},
+		},
+		{
+			name: "non-positive rate returns 400",
+			updates: []service.FXRateUpdate{
+				{BaseCurrency: "EUR", QuoteCurrency: "USD", Rate: decimal.Zero},
+			},
+			buildMocks: repmocks.NewMockFXRepository,
+			wantCode:   400,
+		},
+		{
+			name: "unsupported currency returns 400",
+			updates: []service.FXRateUpdate{
+				{BaseCurrency: "EUR", QuoteCurrency: "XYZ", Rate: decimal.NewFromInt(2)},
+			},
+			buildMocks: repmocks.NewMockFXRepository,
+			wantCode:   400,
+		},
+		{
+			name: "pair listed twice returns 400",
+			updates: []service.FXRateUpdate{
+				{BaseCurrency: "EUR", QuoteCurrency: "USD", Rate: decimal.NewFromInt(1)},
+				{BaseCurrency: "eur", QuoteCurrency: "USD", Rate: decimal.NewFromInt(2)},
+			},
+			buildMocks: repmocks.NewMockFXRepository,
+			wantCode:   400,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			svc := service.NewFXService(tc.buildMocks(ctrl), fxConfig)
+
+			_, err := svc.SetRates(context.Background(), tc.updates)
+			if tc.wantCode != 0 {
+				apiErr, ok := err.(*util.APIError)
+				if !ok || apiErr.Code != tc.wantCode {
+					t.Fatalf("expected %d APIError, got %#v", tc.wantCode, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
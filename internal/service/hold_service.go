@@ -0,0 +1,282 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+
+	"VDM2-BankBE/internal/config"
+	"VDM2-BankBE/internal/model"
+	"VDM2-BankBE/internal/repository"
+	"VDM2-BankBE/internal/util"
+)
+
+// DefaultHoldService implements HoldService
+type DefaultHoldService struct {
+	holdRepo      repository.HoldRepository
+	accountRepo   repository.AccountRepository
+	movementRepo  repository.MovementRepository
+	ledgerService LedgerService
+	redisClient   CacheClient
+	db            TxDB // For transactions
+	config        *config.HoldConfig
+}
+
+// NewHoldService creates a new hold service
+func NewHoldService(
+	holdRepo repository.HoldRepository,
+	accountRepo repository.AccountRepository,
+	movementRepo repository.MovementRepository,
+	ledgerService LedgerService,
+	redisClient CacheClient,
+	db TxDB,
+	config *config.HoldConfig,
+) HoldService {
+	return &DefaultHoldService{
+		holdRepo:      holdRepo,
+		accountRepo:   accountRepo,
+		movementRepo:  movementRepo,
+		ledgerService: ledgerService,
+		redisClient:   redisClient,
+		db:            db,
+		config:        config,
+	}
+}
+
+// Place reserves amount out of the available balance of an account until expiresAt, or for the configured
+// default lifetime when expiresAt is nil
+func (s *DefaultHoldService) Place(
+	ctx context.Context,
+	accountID uuid.UUID,
+	amount decimal.Decimal,
+	description string,
+	expiresAt *time.Time,
+) (*model.Hold, error) {
+	// Validate amount
+	if amount.LessThanOrEqual(decimal.Zero) {
+		return nil, util.NewBadRequestError("amount must be greater than zero")
+	}
+
+	// Validate expiry
+	now := time.Now()
+	expiry := now.Add(s.config.DefaultTTL)
+	if expiresAt != nil {
+		if !expiresAt.After(now) {
+			return nil, util.NewBadRequestError("expires_at must be in the future")
+		}
+		if expiresAt.After(now.Add(s.config.MaxTTL)) {
+			return nil, util.NewBadRequestError("expires_at must be within " + s.config.MaxTTL.String())
+		}
+		expiry = *expiresAt
+	}
+
+	// Check that the account can be debited and has enough available funds
+	account, err := s.accountRepo.GetByID(ctx, accountID)
+	if err != nil {
+		if _, ok := err.(*util.APIError); ok {
+			return nil, err
+		}
+		return nil, errors.Wrap(err, "failed to get account")
+	}
+	if !account.CanSend() {
+		return nil, util.NewConflictError("account is " + account.Status)
+	}
+	if err := util.ValidateAmountPrecision(amount, account.Currency); err != nil {
+		return nil, util.NewBadRequestError(err.Error())
+	}
+	if account.AvailableBalance().LessThan(amount) {
+		return nil, util.NewBadRequestError("insufficient funds")
+	}
+
+	hold := &model.Hold{
+		AccountID:   accountID,
+		Amount:      amount,
+		Description: description,
+		Status:      "active",
+		ExpiresAt:   expiry,
+	}
+
+	// The repository checks the available balance again while reserving the amount
+	if err := s.holdRepo.Create(ctx, hold); err != nil {
+		if _, ok := err.(*util.APIError); ok {
+			return nil, err
+		}
+		return nil, errors.Wrap(err, "failed to place hold")
+	}
+
+	return hold, nil
+}
+
+// GetByID retrieves a hold of accountID
+func (s *DefaultHoldService) GetByID(ctx context.Context, accountID uuid.UUID, id uint64) (*model.Hold, error) {
+	hold, err := s.holdRepo.GetByID(ctx, id)
+	if err != nil {
+		if _, ok := err.(*util.APIError); ok {
+			return nil, err
+		}
+		return nil, errors.Wrap(err, "failed to get hold")
+	}
+
+	// Holds of other accounts are reported as missing
+	if hold.AccountID != accountID {
+		return nil, util.NewNotFoundError("hold not found")
+	}
+
+	return hold, nil
+}
+
+// GetByAccountID retrieves the holds of an account with pagination
+func (s *DefaultHoldService) GetByAccountID(ctx context.Context, accountID uuid.UUID, page, limit int) (*util.PaginatedResponse, error) {
+	// Create pagination params
+	params, err := util.NewPaginationParams(strconv.Itoa(page), strconv.Itoa(limit))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid pagination parameters")
+	}
+
+	// Get holds
+	holds, count, err := s.holdRepo.GetByAccountID(ctx, accountID, params)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get holds")
+	}
+
+	// Create paginated response
+	response := util.NewPaginatedResponse(holds, params, count)
+	return response, nil
+}
+
+// Capture turns an active hold into a debit movement of amount, or of the whole held amount when amount is zero.
+// The part of the hold that is not captured goes back to the available balance.
+func (s *DefaultHoldService) Capture(
+	ctx context.Context,
+	accountID uuid.UUID,
+	id uint64,
+	amount decimal.Decimal,
+	description string,
+) (*model.Hold, error) {
+	hold, err := s.GetByID(ctx, accountID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if hold.Status != "active" {
+		return nil, util.NewConflictError("hold is " + hold.Status)
+	}
+	if !hold.ExpiresAt.After(time.Now()) {
+		return nil, util.NewConflictError("hold has expired")
+	}
+
+	// Validate amount
+	if amount.IsZero() {
+		amount = hold.Amount
+	}
+	if amount.IsNegative() || amount.GreaterThan(hold.Amount) {
+		return nil, util.NewBadRequestError("amount must be greater than zero and not exceed the held amount")
+	}
+	if description == "" {
+		description = hold.Description
+	}
+
+	hold.CapturedAmount = amount
+	movement := &model.Movement{
+		AccountID:   hold.AccountID,
+		Amount:      amount,
+		Type:        "debit",
+		Description: description,
+		OccurredAt:  time.Now(),
+		HoldID:      &hold.ID,
+	}
+
+	// Resolve the hold and post the debit in one transaction; repositories join it through the context
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		txCtx := repository.WithTx(ctx, tx)
+
+		// Give the reserved funds back first, so that the debit is checked against them
+		resolved, err := s.holdRepo.Resolve(txCtx, hold, "captured")
+		if err != nil {
+			return errors.Wrap(err, "failed to resolve hold")
+		}
+		if !resolved {
+			return util.NewConflictError("hold is no longer active")
+		}
+
+		// Post to the ledger, which updates the balance in DB
+		entry := movementEntry(hold.AccountID, amount, hold.Account.Currency, "debit", description)
+		if err := s.ledgerService.Post(txCtx, entry); err != nil {
+			if _, ok := err.(*util.APIError); ok {
+				return err
+			}
+			return errors.Wrap(err, "failed to update account balance")
+		}
+		movement.JournalEntryID = &entry.ID
+		movement.BalanceAfter = entry.Postings[0].BalanceAfter
+
+		// Create movement in DB
+		if err := s.movementRepo.Create(txCtx, movement); err != nil {
+			return errors.Wrap(err, "failed to create movement")
+		}
+
+		return nil
+	})
+	if err != nil {
+		if _, ok := err.(*util.APIError); ok {
+			return nil, err
+		}
+		return nil, errors.Wrap(err, "capture failed")
+	}
+
+	// Update balance cache
+	_ = s.redisClient.SetBalanceCache(ctx, hold.AccountID, movement.BalanceAfter)
+
+	hold.Status = "captured"
+	return hold, nil
+}
+
+// Release cancels an active hold, giving the held amount back to the available balance
+func (s *DefaultHoldService) Release(ctx context.Context, accountID uuid.UUID, id uint64) (*model.Hold, error) {
+	hold, err := s.GetByID(ctx, accountID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if hold.Status != "active" {
+		return nil, util.NewConflictError("hold is " + hold.Status)
+	}
+
+	resolved, err := s.holdRepo.Resolve(ctx, hold, "released")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to release hold")
+	}
+	if !resolved {
+		return nil, util.NewConflictError("hold is no longer active")
+	}
+
+	hold.Status = "released"
+	return hold, nil
+}
+
+// ExpireDue releases up to limit active holds that expired by now, returning how many were expired
+func (s *DefaultHoldService) ExpireDue(ctx context.Context, now time.Time, limit int) (int, error) {
+	holds, err := s.holdRepo.GetExpired(ctx, now, limit)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get expired holds")
+	}
+
+	expired := 0
+	for _, hold := range holds {
+		// Holds captured or released in the meantime are skipped
+		resolved, err := s.holdRepo.Resolve(ctx, hold, "expired")
+		if err != nil {
+			return expired, errors.Wrapf(err, "failed to expire hold %d", hold.ID)
+		}
+		if resolved {
+			expired++
+		}
+	}
+
+	return expired, nil
+}
@@ -0,0 +1,274 @@
+package service_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+
+	"VDM2-BankBE/internal/config"
+	"VDM2-BankBE/internal/model"
+	repmocks "VDM2-BankBE/internal/repository/mocks"
+	"VDM2-BankBE/internal/service"
+	servicemocks "VDM2-BankBE/internal/service/mocks"
+	"VDM2-BankBE/internal/util"
+)
+
+var holdConfig = &config.HoldConfig{DefaultTTL: 7 * 24 * time.Hour, MaxTTL: 30 * 24 * time.Hour}
+
+func TestHoldService_Place(t *testing.T) {
+	t.Parallel()
+
+	accountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440700")
+	amount := decimal.RequireFromString("25.00")
+	tooLate := time.Now().Add(60 * 24 * time.Hour)
+
+	tests := []struct {
+		name       string
+		expiresAt  *time.Time
+		buildMocks func(ctrl *gomock.Controller) (*repmocks.MockHoldRepository, *repmocks.MockAccountRepository)
+		wantCode   int
+	}{
+		{
+			name:      "expiry beyond the maximum lifetime returns 400",
+			expiresAt: &tooLate,
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockHoldRepository, *repmocks.MockAccountRepository) {
+				return repmocks.NewMockHoldRepository(ctrl), repmocks.NewMockAccountRepository(ctrl)
+			},
+			wantCode: 400,
+		},
+		{
+			name: "existing holds count against the available balance",
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockHoldRepository, *repmocks.MockAccountRepository) {
+				accountRepo := repmocks.NewMockAccountRepository(ctrl)
+				accountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(&model.Account{
+					ID:         accountID,
+					Status:     "active",
+					Balance:    decimal.RequireFromString("50.00"),
+					HeldAmount: decimal.RequireFromString("30.00"),
+				}, nil)
+				return repmocks.NewMockHoldRepository(ctrl), accountRepo
+			},
+			wantCode: 400,
+		},
+		{
+			name: "debit-blocked account returns 409",
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockHoldRepository, *repmocks.MockAccountRepository) {
+				accountRepo := repmocks.NewMockAccountRepository(ctrl)
+				accountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(&model.Account{ID: accountID, Status: "debit_blocked", Balance: decimal.RequireFromString("50.00")}, nil)
+				return repmocks.NewMockHoldRepository(ctrl), accountRepo
+			},
+			wantCode: 409,
+		},
+		{
+			name: "success expires after the default lifetime",
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockHoldRepository, *repmocks.MockAccountRepository) {
+				holdRepo := repmocks.NewMockHoldRepository(ctrl)
+				accountRepo := repmocks.NewMockAccountRepository(ctrl)
+
+				accountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(&model.Account{ID: accountID, Status: "active", Balance: decimal.RequireFromString("50.00")}, nil)
+				holdRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, hold *model.Hold) error {
+					if hold.Status != "active" || !hold.Amount.Equal(amount) {
+						t.Fatalf("unexpected hold: %+v", hold)
+					}
+					if expiry := time.Now().Add(holdConfig.DefaultTTL); hold.ExpiresAt.After(expiry) || hold.ExpiresAt.Before(expiry.Add(-time.Minute)) {
+						t.Fatalf("unexpected expiry: %s", hold.ExpiresAt)
+					}
+					return nil
+				})
+
+				return holdRepo, accountRepo
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			holdRepo, accountRepo := tc.buildMocks(ctrl)
+			svc := service.NewHoldService(holdRepo, accountRepo, repmocks.NewMockMovementRepository(ctrl), servicemocks.NewMockLedgerService(ctrl), servicemocks.NewMockCacheClient(ctrl), servicemocks.NewMockTxDB(ctrl), holdConfig)
+
+			got, err := svc.Place(context.Background(), accountID, amount, "card payment", tc.expiresAt)
+			if tc.wantCode != 0 {
+				apiErr, ok := err.(*util.APIError)
+				if !ok || apiErr.Code != tc.wantCode {
+					t.Fatalf("expected %d APIError, got %#v", tc.wantCode, err)
+				}
+				return
+			}
+			if err != nil || got == nil {
+				t.Fatalf("unexpected result: hold=%+v err=%v", got, err)
+			}
+		})
+	}
+}
+
+func TestHoldService_Capture(t *testing.T) {
+	t.Parallel()
+
+	accountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440710")
+	otherAccountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440711")
+	held := decimal.RequireFromString("30.00")
+	captured := decimal.RequireFromString("12.50")
+
+	newHold := func(status string) *model.Hold {
+		return &model.Hold{ID: 4, AccountID: accountID, Amount: held, Description: "card payment", Status: status, ExpiresAt: time.Now().Add(time.Hour)}
+	}
+
+	tests := []struct {
+		name       string
+		amount     decimal.Decimal
+		buildMocks func(ctrl *gomock.Controller) (*repmocks.MockHoldRepository, *repmocks.MockMovementRepository, *servicemocks.MockLedgerService, *servicemocks.MockCacheClient, *servicemocks.MockTxDB)
+		wantCode   int
+	}{
+		{
+			name:   "hold of another account returns 404",
+			amount: captured,
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockHoldRepository, *repmocks.MockMovementRepository, *servicemocks.MockLedgerService, *servicemocks.MockCacheClient, *servicemocks.MockTxDB) {
+				holdRepo := repmocks.NewMockHoldRepository(ctrl)
+				hold := newHold("active")
+				hold.AccountID = otherAccountID
+				holdRepo.EXPECT().GetByID(gomock.Any(), uint64(4)).Return(hold, nil)
+				return holdRepo, repmocks.NewMockMovementRepository(ctrl), servicemocks.NewMockLedgerService(ctrl), servicemocks.NewMockCacheClient(ctrl), servicemocks.NewMockTxDB(ctrl)
+			},
+			wantCode: 404,
+		},
+		{
+			name:   "released hold returns 409",
+			amount: captured,
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockHoldRepository, *repmocks.MockMovementRepository, *servicemocks.MockLedgerService, *servicemocks.MockCacheClient, *servicemocks.MockTxDB) {
+				holdRepo := repmocks.NewMockHoldRepository(ctrl)
+				holdRepo.EXPECT().GetByID(gomock.Any(), uint64(4)).Return(newHold("released"), nil)
+				return holdRepo, repmocks.NewMockMovementRepository(ctrl), servicemocks.NewMockLedgerService(ctrl), servicemocks.NewMockCacheClient(ctrl), servicemocks.NewMockTxDB(ctrl)
+			},
+			wantCode: 409,
+		},
+		{
+			name:   "amount above the held amount returns 400",
+			amount: decimal.RequireFromString("30.01"),
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockHoldRepository, *repmocks.MockMovementRepository, *servicemocks.MockLedgerService, *servicemocks.MockCacheClient, *servicemocks.MockTxDB) {
+				holdRepo := repmocks.NewMockHoldRepository(ctrl)
+				holdRepo.EXPECT().GetByID(gomock.Any(), uint64(4)).Return(newHold("active"), nil)
+				return holdRepo, repmocks.NewMockMovementRepository(ctrl), servicemocks.NewMockLedgerService(ctrl), servicemocks.NewMockCacheClient(ctrl), servicemocks.NewMockTxDB(ctrl)
+			},
+			wantCode: 400,
+		},
+		{
+			name:   "hold resolved concurrently returns 409",
+			amount: captured,
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockHoldRepository, *repmocks.MockMovementRepository, *servicemocks.MockLedgerService, *servicemocks.MockCacheClient, *servicemocks.MockTxDB) {
+				holdRepo := repmocks.NewMockHoldRepository(ctrl)
+				txdb := servicemocks.NewMockTxDB(ctrl)
+
+				holdRepo.EXPECT().GetByID(gomock.Any(), uint64(4)).Return(newHold("active"), nil)
+				txdb.EXPECT().
+					Transaction(gomock.Any()).
+					DoAndReturn(func(fc func(tx *gorm.DB) error, opts ...*sql.TxOptions) error {
+						return fc(&gorm.DB{})
+					})
+				holdRepo.EXPECT().Resolve(gomock.Any(), gomock.Any(), "captured").Return(false, nil)
+
+				return holdRepo, repmocks.NewMockMovementRepository(ctrl), servicemocks.NewMockLedgerService(ctrl), servicemocks.NewMockCacheClient(ctrl), txdb
+			},
+			wantCode: 409,
+		},
+		{
+			name:   "partial capture debits the captured amount only",
+			amount: captured,
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockHoldRepository, *repmocks.MockMovementRepository, *servicemocks.MockLedgerService, *servicemocks.MockCacheClient, *servicemocks.MockTxDB) {
+				holdRepo := repmocks.NewMockHoldRepository(ctrl)
+				movementRepo := repmocks.NewMockMovementRepository(ctrl)
+				ledgerSvc := servicemocks.NewMockLedgerService(ctrl)
+				cache := servicemocks.NewMockCacheClient(ctrl)
+				txdb := servicemocks.NewMockTxDB(ctrl)
+
+				holdRepo.EXPECT().GetByID(gomock.Any(), uint64(4)).Return(newHold("active"), nil)
+				txdb.EXPECT().
+					Transaction(gomock.Any()).
+					DoAndReturn(func(fc func(tx *gorm.DB) error, opts ...*sql.TxOptions) error {
+						return fc(&gorm.DB{})
+					})
+				gomock.InOrder(
+					holdRepo.EXPECT().Resolve(gomock.Any(), gomock.Any(), "captured").DoAndReturn(func(_ context.Context, hold *model.Hold, _ string) (bool, error) {
+						if !hold.CapturedAmount.Equal(captured) || !hold.Amount.Equal(held) {
+							t.Fatalf("unexpected hold: %+v", hold)
+						}
+						return true, nil
+					}),
+					ledgerSvc.EXPECT().Post(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, entry *model.JournalEntry) error {
+						if *entry.Postings[0].AccountID != accountID || !entry.Postings[0].Amount.Equal(captured.Neg()) {
+							t.Fatalf("unexpected debit posting: %+v", entry.Postings[0])
+						}
+						entry.Postings[0].BalanceAfter = decimal.RequireFromString("87.50")
+						return nil
+					}),
+					movementRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, movement *model.Movement) error {
+						if movement.Type != "debit" || !movement.Amount.Equal(captured) || movement.HoldID == nil || *movement.HoldID != 4 {
+							t.Fatalf("unexpected movement: %+v", movement)
+						}
+						return nil
+					}),
+				)
+				cache.EXPECT().SetBalanceCache(gomock.Any(), accountID, decimal.RequireFromString("87.50")).Return(nil)
+
+				return holdRepo, movementRepo, ledgerSvc, cache, txdb
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			holdRepo, movementRepo, ledgerSvc, cache, txdb := tc.buildMocks(ctrl)
+			svc := service.NewHoldService(holdRepo, repmocks.NewMockAccountRepository(ctrl), movementRepo, ledgerSvc, cache, txdb, holdConfig)
+
+			got, err := svc.Capture(context.Background(), accountID, 4, tc.amount, "")
+			if tc.wantCode != 0 {
+				apiErr, ok := err.(*util.APIError)
+				if !ok || apiErr.Code != tc.wantCode {
+					t.Fatalf("expected %d APIError, got %#v", tc.wantCode, err)
+				}
+				return
+			}
+			if err != nil || got.Status != "captured" {
+				t.Fatalf("unexpected result: hold=%+v err=%v", got, err)
+			}
+		})
+	}
+}
+
+func TestHoldService_ExpireDue(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Now()
+	holdRepo := repmocks.NewMockHoldRepository(ctrl)
+	holdRepo.EXPECT().GetExpired(gomock.Any(), now, 10).Return([]*model.Hold{{ID: 1}, {ID: 2}}, nil)
+	gomock.InOrder(
+		holdRepo.EXPECT().Resolve(gomock.Any(), &model.Hold{ID: 1}, "expired").Return(true, nil),
+		// Captured after it was listed
+		holdRepo.EXPECT().Resolve(gomock.Any(), &model.Hold{ID: 2}, "expired").Return(false, nil),
+	)
+
+	svc := service.NewHoldService(holdRepo, repmocks.NewMockAccountRepository(ctrl), repmocks.NewMockMovementRepository(ctrl), servicemocks.NewMockLedgerService(ctrl), servicemocks.NewMockCacheClient(ctrl), servicemocks.NewMockTxDB(ctrl), holdConfig)
+
+	expired, err := svc.ExpireDue(context.Background(), now, 10)
+	if err != nil || expired != 1 {
+		t.Fatalf("unexpected result: expired=%d err=%v", expired, err)
+	}
+}
@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"VDM2-BankBE/internal/model"
+	"VDM2-BankBE/internal/repository"
+	"VDM2-BankBE/internal/util"
+)
+
+// DefaultIdempotencyService implements IdempotencyService.
+// Postgres is the durable store; Redis caches completed responses for fast replays.
+type DefaultIdempotencyService struct {
+	idempotencyRepo repository.IdempotencyKeyRepository
+	redisClient     CacheClient
+	ttl             time.Duration
+}
+
+// NewIdempotencyService creates a new idempotency service
+func NewIdempotencyService(
+	idempotencyRepo repository.IdempotencyKeyRepository,
+	redisClient CacheClient,
+	ttl time.Duration,
+) IdempotencyService {
+	return &DefaultIdempotencyService{
+		idempotencyRepo: idempotencyRepo,
+		redisClient:     redisClient,
+		ttl:             ttl,
+	}
+}
+
+// Begin looks up a key before the request is executed.
+// It returns the stored record when the response must be replayed, or nil once the key
+// has been reserved for the current request.
+func (s *DefaultIdempotencyService) Begin(
+	ctx context.Context,
+	userID uuid.UUID,
+	key string,
+	requestHash string,
+) (*model.IdempotencyKey, error) {
+	// Try the cache first, it only ever holds completed responses
+	if record, err := s.redisClient.GetIdempotencyRecord(ctx, userID, key); err == nil {
+		if record.RequestHash != requestHash {
+			return nil, util.NewUnprocessableEntityError("idempotency key reused with a different request")
+		}
+		return record, nil
+	}
+
+	record, err := s.idempotencyRepo.Get(ctx, userID, key)
+	if err == nil {
+		if record.ExpiresAt.After(time.Now()) {
+			if record.RequestHash != requestHash {
+				return nil, util.NewUnprocessableEntityError("idempotency key reused with a different request")
+			}
+			if !record.Completed() {
+				return nil, util.NewConflictError("a request with this idempotency key is already in progress")
+			}
+
+			// Warm the cache for subsequent retries
+			_ = s.redisClient.SetIdempotencyRecord(ctx, record, time.Until(record.ExpiresAt))
+			return record, nil
+		}
+
+		// Expired keys can be reused
+		if err := s.idempotencyRepo.Delete(ctx, userID, key); err != nil {
+			return nil, errors.Wrap(err, "failed to delete expired idempotency key")
+		}
+	} else if _, ok := err.(*util.APIError); !ok {
+		return nil, errors.Wrap(err, "failed to get idempotency key")
+	}
+
+	// Reserve the key for this request
+	now := time.Now()
+	reservation := &model.IdempotencyKey{
+		UserID:      userID,
+		Key:         key,
+		RequestHash: requestHash,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(s.ttl),
+	}
+	if err := s.idempotencyRepo.Create(ctx, reservation); err != nil {
+		if _, ok := err.(*util.APIError); ok {
+			return nil, err
+		}
+		return nil, errors.Wrap(err, "failed to reserve idempotency key")
+	}
+
+	return nil, nil
+}
+
+// Complete stores the response produced for a reserved key
+func (s *DefaultIdempotencyService) Complete(
+	ctx context.Context,
+	userID uuid.UUID,
+	key string,
+	statusCode int,
+	body []byte,
+) error {
+	if err := s.idempotencyRepo.SaveResponse(ctx, userID, key, statusCode, body); err != nil {
+		return errors.Wrap(err, "failed to store idempotent response")
+	}
+
+	record, err := s.idempotencyRepo.Get(ctx, userID, key)
+	if err != nil {
+		return errors.Wrap(err, "failed to get idempotency key")
+	}
+
+	// Cache failures only cost a DB round-trip on replay
+	_ = s.redisClient.SetIdempotencyRecord(ctx, record, time.Until(record.ExpiresAt))
+
+	return nil
+}
+
+// Release drops a reservation so that the client can retry the request with the same key
+func (s *DefaultIdempotencyService) Release(ctx context.Context, userID uuid.UUID, key string) error {
+	if err := s.idempotencyRepo.Delete(ctx, userID, key); err != nil {
+		return errors.Wrap(err, "failed to release idempotency key")
+	}
+
+	return nil
+}
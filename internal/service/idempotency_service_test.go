@@ -0,0 +1,238 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+
+	"VDM2-BankBE/internal/model"
+	repmocks "VDM2-BankBE/internal/repository/mocks"
+	"VDM2-BankBE/internal/service"
+	servicemocks "VDM2-BankBE/internal/service/mocks"
+	"VDM2-BankBE/internal/util"
+)
+
+func TestIdempotencyService_Begin(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440300")
+	key := "3f1c2b7e-retry"
+	hash := "hash-1"
+
+	completed := &model.IdempotencyKey{
+		UserID:       userID,
+		Key:          key,
+		RequestHash:  hash,
+		StatusCode:   http.StatusCreated,
+		ResponseBody: []byte(`{"id":1}`),
+		ExpiresAt:    time.Now().Add(time.Hour),
+	}
+
+	tests := []struct {
+		name       string
+		hash       string
+		buildMocks func(ctrl *gomock.Controller) (*repmocks.MockIdempotencyKeyRepository, *servicemocks.MockCacheClient)
+		assert     func(t *testing.T, got *model.IdempotencyKey, err error)
+	}{
+		{
+			name: "cache hit replays stored response",
+			hash: hash,
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockIdempotencyKeyRepository, *servicemocks.MockCacheClient) {
+				repo := repmocks.NewMockIdempotencyKeyRepository(ctrl)
+				cache := servicemocks.NewMockCacheClient(ctrl)
+				cache.EXPECT().GetIdempotencyRecord(gomock.Any(), userID, key).Return(completed, nil)
+				return repo, cache
+			},
+			assert: func(t *testing.T, got *model.IdempotencyKey, err error) {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if got == nil || got.StatusCode != http.StatusCreated {
+					t.Fatalf("expected stored response to be replayed, got=%+v", got)
+				}
+			},
+		},
+		{
+			name: "cache hit with different request is rejected",
+			hash: "hash-2",
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockIdempotencyKeyRepository, *servicemocks.MockCacheClient) {
+				repo := repmocks.NewMockIdempotencyKeyRepository(ctrl)
+				cache := servicemocks.NewMockCacheClient(ctrl)
+				cache.EXPECT().GetIdempotencyRecord(gomock.Any(), userID, key).Return(completed, nil)
+				return repo, cache
+			},
+			assert: func(t *testing.T, got *model.IdempotencyKey, err error) {
+				assertAPIErrorCode(t, err, http.StatusUnprocessableEntity)
+			},
+		},
+		{
+			name: "cache miss replays completed record from repo and warms cache",
+			hash: hash,
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockIdempotencyKeyRepository, *servicemocks.MockCacheClient) {
+				repo := repmocks.NewMockIdempotencyKeyRepository(ctrl)
+				cache := servicemocks.NewMockCacheClient(ctrl)
+				cache.EXPECT().GetIdempotencyRecord(gomock.Any(), userID, key).Return(nil, errors.New("cache miss"))
+				repo.EXPECT().Get(gomock.Any(), userID, key).Return(completed, nil)
+				cache.EXPECT().SetIdempotencyRecord(gomock.Any(), completed, gomock.Any()).Return(nil)
+				return repo, cache
+			},
+			assert: func(t *testing.T, got *model.IdempotencyKey, err error) {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if got != completed {
+					t.Fatalf("expected repo record to be replayed, got=%+v", got)
+				}
+			},
+		},
+		{
+			name: "in-flight request returns conflict",
+			hash: hash,
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockIdempotencyKeyRepository, *servicemocks.MockCacheClient) {
+				repo := repmocks.NewMockIdempotencyKeyRepository(ctrl)
+				cache := servicemocks.NewMockCacheClient(ctrl)
+				cache.EXPECT().GetIdempotencyRecord(gomock.Any(), userID, key).Return(nil, errors.New("cache miss"))
+				repo.EXPECT().Get(gomock.Any(), userID, key).Return(&model.IdempotencyKey{
+					UserID:      userID,
+					Key:         key,
+					RequestHash: hash,
+					ExpiresAt:   time.Now().Add(time.Hour),
+				}, nil)
+				return repo, cache
+			},
+			assert: func(t *testing.T, got *model.IdempotencyKey, err error) {
+				assertAPIErrorCode(t, err, http.StatusConflict)
+			},
+		},
+		{
+			name: "unknown key is reserved",
+			hash: hash,
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockIdempotencyKeyRepository, *servicemocks.MockCacheClient) {
+				repo := repmocks.NewMockIdempotencyKeyRepository(ctrl)
+				cache := servicemocks.NewMockCacheClient(ctrl)
+				cache.EXPECT().GetIdempotencyRecord(gomock.Any(), userID, key).Return(nil, errors.New("cache miss"))
+				repo.EXPECT().Get(gomock.Any(), userID, key).Return(nil, util.NewNotFoundError("idempotency key not found"))
+				repo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, k *model.IdempotencyKey) error {
+					if k.UserID != userID || k.Key != key || k.RequestHash != hash || k.Completed() {
+						t.Fatalf("unexpected reservation: %+v", k)
+					}
+					return nil
+				})
+				return repo, cache
+			},
+			assert: func(t *testing.T, got *model.IdempotencyKey, err error) {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if got != nil {
+					t.Fatalf("expected no record to replay, got=%+v", got)
+				}
+			},
+		},
+		{
+			name: "expired key is deleted and reserved again",
+			hash: "hash-2",
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockIdempotencyKeyRepository, *servicemocks.MockCacheClient) {
+				repo := repmocks.NewMockIdempotencyKeyRepository(ctrl)
+				cache := servicemocks.NewMockCacheClient(ctrl)
+				cache.EXPECT().GetIdempotencyRecord(gomock.Any(), userID, key).Return(nil, errors.New("cache miss"))
+				repo.EXPECT().Get(gomock.Any(), userID, key).Return(&model.IdempotencyKey{
+					UserID:      userID,
+					Key:         key,
+					RequestHash: hash,
+					StatusCode:  http.StatusCreated,
+					ExpiresAt:   time.Now().Add(-time.Minute),
+				}, nil)
+				repo.EXPECT().Delete(gomock.Any(), userID, key).Return(nil)
+				repo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+				return repo, cache
+			},
+			assert: func(t *testing.T, got *model.IdempotencyKey, err error) {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if got != nil {
+					t.Fatalf("expected no record to replay, got=%+v", got)
+				}
+			},
+		},
+		{
+			name: "concurrent reservation returns conflict",
+			hash: hash,
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockIdempotencyKeyRepository, *servicemocks.MockCacheClient) {
+				repo := repmocks.NewMockIdempotencyKeyRepository(ctrl)
+				cache := servicemocks.NewMockCacheClient(ctrl)
+				cache.EXPECT().GetIdempotencyRecord(gomock.Any(), userID, key).Return(nil, errors.New("cache miss"))
+				repo.EXPECT().Get(gomock.Any(), userID, key).Return(nil, util.NewNotFoundError("idempotency key not found"))
+				repo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(util.NewConflictError("a request with this idempotency key is already in progress"))
+				return repo, cache
+			},
+			assert: func(t *testing.T, got *model.IdempotencyKey, err error) {
+				assertAPIErrorCode(t, err, http.StatusConflict)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo, cache := tc.buildMocks(ctrl)
+			svc := service.NewIdempotencyService(repo, cache, 24*time.Hour)
+
+			got, err := svc.Begin(context.Background(), userID, key, tc.hash)
+			tc.assert(t, got, err)
+		})
+	}
+}
+
+func TestIdempotencyService_Complete(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440301")
+	key := "complete-key"
+	body := []byte(`{"id":1}`)
+	record := &model.IdempotencyKey{
+		UserID:       userID,
+		Key:          key,
+		StatusCode:   http.StatusCreated,
+		ResponseBody: body,
+		ExpiresAt:    time.Now().Add(time.Hour),
+	}
+
+	repo := repmocks.NewMockIdempotencyKeyRepository(ctrl)
+	cache := servicemocks.NewMockCacheClient(ctrl)
+	gomock.InOrder(
+		repo.EXPECT().SaveResponse(gomock.Any(), userID, key, http.StatusCreated, body).Return(nil),
+		repo.EXPECT().Get(gomock.Any(), userID, key).Return(record, nil),
+		cache.EXPECT().SetIdempotencyRecord(gomock.Any(), record, gomock.Any()).Return(nil),
+	)
+
+	svc := service.NewIdempotencyService(repo, cache, 24*time.Hour)
+	if err := svc.Complete(context.Background(), userID, key, http.StatusCreated, body); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func assertAPIErrorCode(t *testing.T, err error, code int) {
+	t.Helper()
+
+	apiErr, ok := err.(*util.APIError)
+	if !ok {
+		t.Fatalf("expected *util.APIError, got %T (%v)", err, err)
+	}
+	if apiErr.Code != code {
+		t.Fatalf("unexpected error code: got=%d want=%d", apiErr.Code, code)
+	}
+}
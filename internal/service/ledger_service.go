@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+
+	"VDM2-BankBE/internal/model"
+	"VDM2-BankBE/internal/repository"
+	"VDM2-BankBE/internal/util"
+)
+
+// DefaultLedgerService implements LedgerService
+type DefaultLedgerService struct {
+	ledgerRepo repository.LedgerRepository
+}
+
+// NewLedgerService creates a new ledger service
+func NewLedgerService(ledgerRepo repository.LedgerRepository) LedgerService {
+	return &DefaultLedgerService{
+		ledgerRepo: ledgerRepo,
+	}
+}
+
+// Post validates that a journal entry is balanced in every currency and records it, updating the balances of the
+// accounts it touches
+func (s *DefaultLedgerService) Post(ctx context.Context, entry *model.JournalEntry) error {
+	if len(entry.Postings) < 2 {
+		return errors.New("journal entry needs at least two postings")
+	}
+
+	totals := make(map[string]decimal.Decimal)
+	for _, posting := range entry.Postings {
+		if (posting.AccountID == nil) == (posting.SystemAccount == nil) {
+			return errors.New("posting must target either a customer account or a system account")
+		}
+		if posting.Amount.IsZero() {
+			return errors.New("posting amount must not be zero")
+		}
+		if posting.Currency == "" {
+			return errors.New("posting currency must be set")
+		}
+		totals[posting.Currency] = totals[posting.Currency].Add(posting.Amount)
+	}
+	for currency, total := range totals {
+		if !total.IsZero() {
+			return errors.Errorf("unbalanced journal entry: %s postings sum to %s", currency, total.String())
+		}
+	}
+
+	if err := s.ledgerRepo.Post(ctx, entry); err != nil {
+		if _, ok := err.(*util.APIError); ok {
+			return err
+		}
+		return errors.Wrap(err, "failed to post journal entry")
+	}
+
+	return nil
+}
+
+// transferEntry builds the journal entry moving the funds of a transfer between two customer accounts.
+// Converted transfers go through the fx system accounts: the sending account pays into the one of its currency
+// and the receiving account is paid from the one of its own. The first two postings are always the ones of the
+// sending and the receiving account.
+func transferEntry(transfer *model.Transfer, description string) *model.JournalEntry {
+	if transfer.ConvertedAmount == nil {
+		return &model.JournalEntry{
+			Description: description,
+			TransferID:  &transfer.ID,
+			Postings: []model.Posting{
+				accountPosting(transfer.FromAccount, transfer.Amount.Neg(), transfer.Currency),
+				accountPosting(transfer.ToAccount, transfer.Amount, transfer.Currency),
+			},
+		}
+	}
+
+	return &model.JournalEntry{
+		Description: description,
+		TransferID:  &transfer.ID,
+		Postings: []model.Posting{
+			accountPosting(transfer.FromAccount, transfer.Amount.Neg(), transfer.Currency),
+			accountPosting(transfer.ToAccount, *transfer.ConvertedAmount, *transfer.ConvertedCurrency),
+			systemPosting("fx", transfer.Amount, transfer.Currency),
+			systemPosting("fx", transfer.ConvertedAmount.Neg(), *transfer.ConvertedCurrency),
+		},
+	}
+}
+
+// movementEntry builds the journal entry for money deposited into (credit) or withdrawn from (debit) an account
+// of the given currency
+func movementEntry(accountID uuid.UUID, amount decimal.Decimal, currency, movementType, description string) *model.JournalEntry {
+	if movementType == "debit" {
+		return &model.JournalEntry{
+			Description: description,
+			Postings: []model.Posting{
+				accountPosting(accountID, amount.Neg(), currency),
+				systemPosting("cash_out", amount, currency),
+			},
+		}
+	}
+
+	return &model.JournalEntry{
+		Description: description,
+		Postings: []model.Posting{
+			accountPosting(accountID, amount, currency),
+			systemPosting("cash_in", amount.Neg(), currency),
+		},
+	}
+}
+
+// feeEntry builds the journal entry for a fee charged to an account, credited to the fee account of its currency
+func feeEntry(accountID uuid.UUID, fee decimal.Decimal, currency, description string) *model.JournalEntry {
+	return &model.JournalEntry{
+		Description: description,
+		Postings: []model.Posting{
+			accountPosting(accountID, fee.Neg(), currency),
+			systemPosting("fees", fee, currency),
+		},
+	}
+}
+
+// accountPosting builds a posting to a customer account
+func accountPosting(accountID uuid.UUID, amount decimal.Decimal, currency string) model.Posting {
+	return model.Posting{AccountID: &accountID, Amount: amount, Currency: currency}
+}
+
+// systemPosting builds a posting to the system account of a currency
+func systemPosting(code string, amount decimal.Decimal, currency string) model.Posting {
+	return model.Posting{SystemAccount: &code, Amount: amount, Currency: currency}
+}
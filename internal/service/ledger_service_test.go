@@ -0,0 +1,138 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"VDM2-BankBE/internal/model"
+	repmocks "VDM2-BankBE/internal/repository/mocks"
+	"VDM2-BankBE/internal/service"
+	"VDM2-BankBE/internal/util"
+)
+
+func TestLedgerService_Post(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fromAccount := uuid.MustParse("550e8400-e29b-41d4-a716-446655440960")
+	toAccount := uuid.MustParse("550e8400-e29b-41d4-a716-446655440961")
+	amount := decimal.RequireFromString("10.00")
+	fx := "fx"
+
+	tests := []struct {
+		name       string
+		postings   []model.Posting
+		buildMocks func(ctrl *gomock.Controller) *repmocks.MockLedgerRepository
+		assertErr  func(t *testing.T, err error)
+	}{
+		{
+			name: "balanced entry is posted",
+			postings: []model.Posting{
+				{AccountID: &fromAccount, Amount: amount.Neg(), Currency: "EUR"},
+				{AccountID: &toAccount, Amount: amount, Currency: "EUR"},
+			},
+			buildMocks: func(ctrl *gomock.Controller) *repmocks.MockLedgerRepository {
+				ledgerRepo := repmocks.NewMockLedgerRepository(ctrl)
+				ledgerRepo.EXPECT().Post(gomock.Any(), gomock.Any()).Return(nil)
+				return ledgerRepo
+			},
+			assertErr: func(t *testing.T, err error) {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			},
+		},
+		{
+			name: "unbalanced entry is rejected",
+			postings: []model.Posting{
+				{AccountID: &fromAccount, Amount: amount.Neg(), Currency: "EUR"},
+				{AccountID: &toAccount, Amount: amount.Add(decimal.NewFromInt(1)), Currency: "EUR"},
+			},
+			buildMocks: repmocks.NewMockLedgerRepository,
+			assertErr: func(t *testing.T, err error) {
+				if err == nil {
+					t.Fatalf("expected error for unbalanced entry")
+				}
+			},
+		},
+		{
+			name: "converted entry balanced in each currency is posted",
+			postings: []model.Posting{
+				{AccountID: &fromAccount, Amount: amount.Neg(), Currency: "EUR"},
+				{AccountID: &toAccount, Amount: decimal.RequireFromString("10.80"), Currency: "USD"},
+				{SystemAccount: &fx, Amount: amount, Currency: "EUR"},
+				{SystemAccount: &fx, Amount: decimal.RequireFromString("-10.80"), Currency: "USD"},
+			},
+			buildMocks: func(ctrl *gomock.Controller) *repmocks.MockLedgerRepository {
+				ledgerRepo := repmocks.NewMockLedgerRepository(ctrl)
+				ledgerRepo.EXPECT().Post(gomock.Any(), gomock.Any()).Return(nil)
+				return ledgerRepo
+			},
+			assertErr: func(t *testing.T, err error) {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			},
+		},
+		{
+			name: "entry balanced across currencies only is rejected",
+			postings: []model.Posting{
+				{AccountID: &fromAccount, Amount: amount.Neg(), Currency: "EUR"},
+				{AccountID: &toAccount, Amount: amount, Currency: "USD"},
+			},
+			buildMocks: repmocks.NewMockLedgerRepository,
+			assertErr: func(t *testing.T, err error) {
+				if err == nil {
+					t.Fatalf("expected error for entry unbalanced in each currency")
+				}
+			},
+		},
+		{
+			name: "single posting is rejected",
+			postings: []model.Posting{
+				{AccountID: &fromAccount, Amount: amount, Currency: "EUR"},
+			},
+			buildMocks: repmocks.NewMockLedgerRepository,
+			assertErr: func(t *testing.T, err error) {
+				if err == nil {
+					t.Fatalf("expected error for single posting")
+				}
+			},
+		},
+		{
+			name: "insufficient funds is passed through",
+			postings: []model.Posting{
+				{AccountID: &fromAccount, Amount: amount.Neg(), Currency: "EUR"},
+				{AccountID: &toAccount, Amount: amount, Currency: "EUR"},
+			},
+			buildMocks: func(ctrl *gomock.Controller) *repmocks.MockLedgerRepository {
+				ledgerRepo := repmocks.NewMockLedgerRepository(ctrl)
+				ledgerRepo.EXPECT().Post(gomock.Any(), gomock.Any()).Return(util.NewBadRequestError("insufficient funds"))
+				return ledgerRepo
+			},
+			assertErr: func(t *testing.T, err error) {
+				apiErr, ok := err.(*util.APIError)
+				if !ok || apiErr.Code != 400 {
+					t.Fatalf("expected 400 APIError, got %#v", err)
+				}
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			svc := service.NewLedgerService(tc.buildMocks(ctrl))
+			err := svc.Post(ctx, &model.JournalEntry{Description: "test", Postings: tc.postings})
+			tc.assertErr(t, err)
+		})
+	}
+}
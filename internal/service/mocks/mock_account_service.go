@@ -37,26 +37,56 @@ func (m *MockAccountService) EXPECT() *MockAccountServiceMockRecorder {
 	return m.recorder
 }
 
+// AddCosigner mocks base method.
+func (m *MockAccountService) AddCosigner(arg0 context.Context, arg1, arg2 uuid.UUID, arg3 string) (*model.AccountCosigner, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCosigner", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*model.AccountCosigner)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddCosigner indicates an expected call of AddCosigner.
+func (mr *MockAccountServiceMockRecorder) AddCosigner(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCosigner", reflect.TypeOf((*MockAccountService)(nil).AddCosigner), arg0, arg1, arg2, arg3)
+}
+
+// Close mocks base method.
+func (m *MockAccountService) Close(arg0 context.Context, arg1, arg2 uuid.UUID, arg3 *uuid.UUID) (*model.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*model.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockAccountServiceMockRecorder) Close(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockAccountService)(nil).Close), arg0, arg1, arg2, arg3)
+}
+
 // Create mocks base method.
-func (m *MockAccountService) Create(arg0 context.Context, arg1 uuid.UUID) (*model.Account, error) {
+func (m *MockAccountService) Create(arg0 context.Context, arg1 uuid.UUID, arg2, arg3, arg4 string) (*model.Account, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret := m.ctrl.Call(m, "Create", arg0, arg1, arg2, arg3, arg4)
 	ret0, _ := ret[0].(*model.Account)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockAccountServiceMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockAccountServiceMockRecorder) Create(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAccountService)(nil).Create), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAccountService)(nil).Create), arg0, arg1, arg2, arg3, arg4)
 }
 
 // GetBalance mocks base method.
-func (m *MockAccountService) GetBalance(arg0 context.Context, arg1 uuid.UUID) (decimal.Decimal, error) {
+func (m *MockAccountService) GetBalance(arg0 context.Context, arg1 uuid.UUID) (*model.Balance, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetBalance", arg0, arg1)
-	ret0, _ := ret[0].(decimal.Decimal)
+	ret0, _ := ret[0].(*model.Balance)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -67,6 +97,21 @@ func (mr *MockAccountServiceMockRecorder) GetBalance(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalance", reflect.TypeOf((*MockAccountService)(nil).GetBalance), arg0, arg1)
 }
 
+// GetByIBAN mocks base method.
+func (m *MockAccountService) GetByIBAN(arg0 context.Context, arg1 string) (*model.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIBAN", arg0, arg1)
+	ret0, _ := ret[0].(*model.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIBAN indicates an expected call of GetByIBAN.
+func (mr *MockAccountServiceMockRecorder) GetByIBAN(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIBAN", reflect.TypeOf((*MockAccountService)(nil).GetByIBAN), arg0, arg1)
+}
+
 // GetByID mocks base method.
 func (m *MockAccountService) GetByID(arg0 context.Context, arg1 uuid.UUID) (*model.Account, error) {
 	m.ctrl.T.Helper()
@@ -96,3 +141,122 @@ func (mr *MockAccountServiceMockRecorder) GetByUserID(arg0, arg1 interface{}) *g
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserID", reflect.TypeOf((*MockAccountService)(nil).GetByUserID), arg0, arg1)
 }
+
+// GetForUser mocks base method.
+func (m *MockAccountService) GetForUser(arg0 context.Context, arg1, arg2 uuid.UUID) (*model.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForUser", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForUser indicates an expected call of GetForUser.
+func (mr *MockAccountServiceMockRecorder) GetForUser(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForUser", reflect.TypeOf((*MockAccountService)(nil).GetForUser), arg0, arg1, arg2)
+}
+
+// GetRecipient mocks base method.
+func (m *MockAccountService) GetRecipient(arg0 context.Context, arg1, arg2 string) (*model.Recipient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecipient", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.Recipient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecipient indicates an expected call of GetRecipient.
+func (mr *MockAccountServiceMockRecorder) GetRecipient(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecipient", reflect.TypeOf((*MockAccountService)(nil).GetRecipient), arg0, arg1, arg2)
+}
+
+// ListByUserID mocks base method.
+func (m *MockAccountService) ListByUserID(arg0 context.Context, arg1 uuid.UUID) ([]*model.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByUserID", arg0, arg1)
+	ret0, _ := ret[0].([]*model.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByUserID indicates an expected call of ListByUserID.
+func (mr *MockAccountServiceMockRecorder) ListByUserID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByUserID", reflect.TypeOf((*MockAccountService)(nil).ListByUserID), arg0, arg1)
+}
+
+// ListCosigners mocks base method.
+func (m *MockAccountService) ListCosigners(arg0 context.Context, arg1, arg2 uuid.UUID) ([]*model.AccountCosigner, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCosigners", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*model.AccountCosigner)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCosigners indicates an expected call of ListCosigners.
+func (mr *MockAccountServiceMockRecorder) ListCosigners(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCosigners", reflect.TypeOf((*MockAccountService)(nil).ListCosigners), arg0, arg1, arg2)
+}
+
+// RemoveCosigner mocks base method.
+func (m *MockAccountService) RemoveCosigner(arg0 context.Context, arg1, arg2, arg3 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveCosigner", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveCosigner indicates an expected call of RemoveCosigner.
+func (mr *MockAccountServiceMockRecorder) RemoveCosigner(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveCosigner", reflect.TypeOf((*MockAccountService)(nil).RemoveCosigner), arg0, arg1, arg2, arg3)
+}
+
+// SetDefault mocks base method.
+func (m *MockAccountService) SetDefault(arg0 context.Context, arg1, arg2 uuid.UUID) (*model.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDefault", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetDefault indicates an expected call of SetDefault.
+func (mr *MockAccountServiceMockRecorder) SetDefault(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDefault", reflect.TypeOf((*MockAccountService)(nil).SetDefault), arg0, arg1, arg2)
+}
+
+// SetOverdraft mocks base method.
+func (m *MockAccountService) SetOverdraft(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 decimal.Decimal) (*model.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetOverdraft", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*model.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetOverdraft indicates an expected call of SetOverdraft.
+func (mr *MockAccountServiceMockRecorder) SetOverdraft(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOverdraft", reflect.TypeOf((*MockAccountService)(nil).SetOverdraft), arg0, arg1, arg2, arg3)
+}
+
+// UpdateStatus mocks base method.
+func (m *MockAccountService) UpdateStatus(arg0 context.Context, arg1 uuid.UUID, arg2 string) (*model.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockAccountServiceMockRecorder) UpdateStatus(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockAccountService)(nil).UpdateStatus), arg0, arg1, arg2)
+}
@@ -82,18 +82,18 @@ func (mr *MockAuthServiceMockRecorder) Login(arg0, arg1, arg2 interface{}) *gomo
 }
 
 // SignUp mocks base method.
-func (m *MockAuthService) SignUp(arg0 context.Context, arg1, arg2, arg3, arg4, arg5, arg6 string) (*model.User, error) {
+func (m *MockAuthService) SignUp(arg0 context.Context, arg1, arg2, arg3, arg4, arg5, arg6, arg7 string) (*model.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SignUp", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	ret := m.ctrl.Call(m, "SignUp", arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7)
 	ret0, _ := ret[0].(*model.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SignUp indicates an expected call of SignUp.
-func (mr *MockAuthServiceMockRecorder) SignUp(arg0, arg1, arg2, arg3, arg4, arg5, arg6 interface{}) *gomock.Call {
+func (mr *MockAuthServiceMockRecorder) SignUp(arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignUp", reflect.TypeOf((*MockAuthService)(nil).SignUp), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignUp", reflect.TypeOf((*MockAuthService)(nil).SignUp), arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7)
 }
 
 // VerifyToken mocks base method.
@@ -0,0 +1,126 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: VDM2-BankBE/internal/service (interfaces: BeneficiaryService)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	model "VDM2-BankBE/internal/model"
+	util "VDM2-BankBE/internal/util"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockBeneficiaryService is a mock of BeneficiaryService interface.
+type MockBeneficiaryService struct {
+	ctrl     *gomock.Controller
+	recorder *MockBeneficiaryServiceMockRecorder
+}
+
+// MockBeneficiaryServiceMockRecorder is the mock recorder for MockBeneficiaryService.
+type MockBeneficiaryServiceMockRecorder struct {
+	mock *MockBeneficiaryService
+}
+
+// NewMockBeneficiaryService creates a new mock instance.
+func NewMockBeneficiaryService(ctrl *gomock.Controller) *MockBeneficiaryService {
+	mock := &MockBeneficiaryService{ctrl: ctrl}
+	mock.recorder = &MockBeneficiaryServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBeneficiaryService) EXPECT() *MockBeneficiaryServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockBeneficiaryService) Create(arg0 context.Context, arg1 *model.Beneficiary) (*model.Beneficiary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*model.Beneficiary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockBeneficiaryServiceMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockBeneficiaryService)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockBeneficiaryService) Delete(arg0 context.Context, arg1 uuid.UUID, arg2 uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockBeneficiaryServiceMockRecorder) Delete(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockBeneficiaryService)(nil).Delete), arg0, arg1, arg2)
+}
+
+// GetByID mocks base method.
+func (m *MockBeneficiaryService) GetByID(arg0 context.Context, arg1 uuid.UUID, arg2 uint64) (*model.Beneficiary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.Beneficiary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockBeneficiaryServiceMockRecorder) GetByID(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockBeneficiaryService)(nil).GetByID), arg0, arg1, arg2)
+}
+
+// GetByUserID mocks base method.
+func (m *MockBeneficiaryService) GetByUserID(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 int) (*util.PaginatedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByUserID", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*util.PaginatedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByUserID indicates an expected call of GetByUserID.
+func (mr *MockBeneficiaryServiceMockRecorder) GetByUserID(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserID", reflect.TypeOf((*MockBeneficiaryService)(nil).GetByUserID), arg0, arg1, arg2, arg3)
+}
+
+// MarkUsed mocks base method.
+func (m *MockBeneficiaryService) MarkUsed(arg0 context.Context, arg1 uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkUsed", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkUsed indicates an expected call of MarkUsed.
+func (mr *MockBeneficiaryServiceMockRecorder) MarkUsed(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkUsed", reflect.TypeOf((*MockBeneficiaryService)(nil).MarkUsed), arg0, arg1)
+}
+
+// Rename mocks base method.
+func (m *MockBeneficiaryService) Rename(arg0 context.Context, arg1 uuid.UUID, arg2 uint64, arg3 string) (*model.Beneficiary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rename", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*model.Beneficiary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Rename indicates an expected call of Rename.
+func (mr *MockBeneficiaryServiceMockRecorder) Rename(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rename", reflect.TypeOf((*MockBeneficiaryService)(nil).Rename), arg0, arg1, arg2, arg3)
+}
@@ -5,8 +5,10 @@
 package mocks
 
 import (
+	model "VDM2-BankBE/internal/model"
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
@@ -51,6 +53,21 @@ func (mr *MockCacheClientMockRecorder) GetBalanceCache(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalanceCache", reflect.TypeOf((*MockCacheClient)(nil).GetBalanceCache), arg0, arg1)
 }
 
+// GetIdempotencyRecord mocks base method.
+func (m *MockCacheClient) GetIdempotencyRecord(arg0 context.Context, arg1 uuid.UUID, arg2 string) (*model.IdempotencyKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIdempotencyRecord", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.IdempotencyKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIdempotencyRecord indicates an expected call of GetIdempotencyRecord.
+func (mr *MockCacheClientMockRecorder) GetIdempotencyRecord(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIdempotencyRecord", reflect.TypeOf((*MockCacheClient)(nil).GetIdempotencyRecord), arg0, arg1, arg2)
+}
+
 // GetOAuthState mocks base method.
 func (m *MockCacheClient) GetOAuthState(arg0 context.Context, arg1 string) (string, error) {
 	m.ctrl.T.Helper()
@@ -80,6 +97,20 @@ func (mr *MockCacheClientMockRecorder) SetBalanceCache(arg0, arg1, arg2 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBalanceCache", reflect.TypeOf((*MockCacheClient)(nil).SetBalanceCache), arg0, arg1, arg2)
 }
 
+// SetIdempotencyRecord mocks base method.
+func (m *MockCacheClient) SetIdempotencyRecord(arg0 context.Context, arg1 *model.IdempotencyKey, arg2 time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetIdempotencyRecord", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetIdempotencyRecord indicates an expected call of SetIdempotencyRecord.
+func (mr *MockCacheClientMockRecorder) SetIdempotencyRecord(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetIdempotencyRecord", reflect.TypeOf((*MockCacheClient)(nil).SetIdempotencyRecord), arg0, arg1, arg2)
+}
+
 // SetOAuthState mocks base method.
 func (m *MockCacheClient) SetOAuthState(arg0 context.Context, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: VDM2-BankBE/internal/service (interfaces: FeeService)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	model "VDM2-BankBE/internal/model"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	decimal "github.com/shopspring/decimal"
+)
+
+// MockFeeService is a mock of FeeService interface.
+type MockFeeService struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeeServiceMockRecorder
+}
+
+// MockFeeServiceMockRecorder is the mock recorder for MockFeeService.
+type MockFeeServiceMockRecorder struct {
+	mock *MockFeeService
+}
+
+// NewMockFeeService creates a new mock instance.
+func NewMockFeeService(ctrl *gomock.Controller) *MockFeeService {
+	mock := &MockFeeService{ctrl: ctrl}
+	mock.recorder = &MockFeeServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFeeService) EXPECT() *MockFeeServiceMockRecorder {
+	return m.recorder
+}
+
+// Calculate mocks base method.
+func (m *MockFeeService) Calculate(arg0 context.Context, arg1 string, arg2 decimal.Decimal, arg3 string) (*model.FeeBreakdown, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Calculate", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*model.FeeBreakdown)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Calculate indicates an expected call of Calculate.
+func (mr *MockFeeServiceMockRecorder) Calculate(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Calculate", reflect.TypeOf((*MockFeeService)(nil).Calculate), arg0, arg1, arg2, arg3)
+}
@@ -0,0 +1,84 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: VDM2-BankBE/internal/service (interfaces: FXService)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	model "VDM2-BankBE/internal/model"
+	service "VDM2-BankBE/internal/service"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	decimal "github.com/shopspring/decimal"
+)
+
+// MockFXService is a mock of FXService interface.
+type MockFXService struct {
+	ctrl     *gomock.Controller
+	recorder *MockFXServiceMockRecorder
+}
+
+// MockFXServiceMockRecorder is the mock recorder for MockFXService.
+type MockFXServiceMockRecorder struct {
+	mock *MockFXService
+}
+
+// NewMockFXService creates a new mock instance.
+func NewMockFXService(ctrl *gomock.Controller) *MockFXService {
+	mock := &MockFXService{ctrl: ctrl}
+	mock.recorder = &MockFXServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFXService) EXPECT() *MockFXServiceMockRecorder {
+	return m.recorder
+}
+
+// ListRates mocks base method.
+func (m *MockFXService) ListRates(arg0 context.Context) ([]*model.FXRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRates", arg0)
+	ret0, _ := ret[0].([]*model.FXRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRates indicates an expected call of ListRates.
+func (mr *MockFXServiceMockRecorder) ListRates(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRates", reflect.TypeOf((*MockFXService)(nil).ListRates), arg0)
+}
+
+// Quote mocks base method.
+func (m *MockFXService) Quote(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 string, arg4 decimal.Decimal) (*model.FXQuote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Quote", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*model.FXQuote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Quote indicates an expected call of Quote.
+func (mr *MockFXServiceMockRecorder) Quote(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Quote", reflect.TypeOf((*MockFXService)(nil).Quote), arg0, arg1, arg2, arg3, arg4)
+}
+
+// SetRates mocks base method.
+func (m *MockFXService) SetRates(arg0 context.Context, arg1 []service.FXRateUpdate) ([]*model.FXRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRates", arg0, arg1)
+	ret0, _ := ret[0].([]*model.FXRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetRates indicates an expected call of SetRates.
+func (mr *MockFXServiceMockRecorder) SetRates(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRates", reflect.TypeOf((*MockFXService)(nil).SetRates), arg0, arg1)
+}
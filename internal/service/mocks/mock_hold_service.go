@@ -0,0 +1,130 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: VDM2-BankBE/internal/service (interfaces: HoldService)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	model "VDM2-BankBE/internal/model"
+	util "VDM2-BankBE/internal/util"
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	decimal "github.com/shopspring/decimal"
+)
+
+// MockHoldService is a mock of HoldService interface.
+type MockHoldService struct {
+	ctrl     *gomock.Controller
+	recorder *MockHoldServiceMockRecorder
+}
+
+// MockHoldServiceMockRecorder is the mock recorder for MockHoldService.
+type MockHoldServiceMockRecorder struct {
+	mock *MockHoldService
+}
+
+// NewMockHoldService creates a new mock instance.
+func NewMockHoldService(ctrl *gomock.Controller) *MockHoldService {
+	mock := &MockHoldService{ctrl: ctrl}
+	mock.recorder = &MockHoldServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHoldService) EXPECT() *MockHoldServiceMockRecorder {
+	return m.recorder
+}
+
+// Capture mocks base method.
+func (m *MockHoldService) Capture(arg0 context.Context, arg1 uuid.UUID, arg2 uint64, arg3 decimal.Decimal, arg4 string) (*model.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Capture", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*model.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Capture indicates an expected call of Capture.
+func (mr *MockHoldServiceMockRecorder) Capture(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Capture", reflect.TypeOf((*MockHoldService)(nil).Capture), arg0, arg1, arg2, arg3, arg4)
+}
+
+// ExpireDue mocks base method.
+func (m *MockHoldService) ExpireDue(arg0 context.Context, arg1 time.Time, arg2 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireDue", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireDue indicates an expected call of ExpireDue.
+func (mr *MockHoldServiceMockRecorder) ExpireDue(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireDue", reflect.TypeOf((*MockHoldService)(nil).ExpireDue), arg0, arg1, arg2)
+}
+
+// GetByAccountID mocks base method.
+func (m *MockHoldService) GetByAccountID(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 int) (*util.PaginatedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByAccountID", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*util.PaginatedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByAccountID indicates an expected call of GetByAccountID.
+func (mr *MockHoldServiceMockRecorder) GetByAccountID(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByAccountID", reflect.TypeOf((*MockHoldService)(nil).GetByAccountID), arg0, arg1, arg2, arg3)
+}
+
+// GetByID mocks base method.
+func (m *MockHoldService) GetByID(arg0 context.Context, arg1 uuid.UUID, arg2 uint64) (*model.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockHoldServiceMockRecorder) GetByID(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockHoldService)(nil).GetByID), arg0, arg1, arg2)
+}
+
+// Place mocks base method.
+func (m *MockHoldService) Place(arg0 context.Context, arg1 uuid.UUID, arg2 decimal.Decimal, arg3 string, arg4 *time.Time) (*model.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Place", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*model.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Place indicates an expected call of Place.
+func (mr *MockHoldServiceMockRecorder) Place(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Place", reflect.TypeOf((*MockHoldService)(nil).Place), arg0, arg1, arg2, arg3, arg4)
+}
+
+// Release mocks base method.
+func (m *MockHoldService) Release(arg0 context.Context, arg1 uuid.UUID, arg2 uint64) (*model.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockHoldServiceMockRecorder) Release(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockHoldService)(nil).Release), arg0, arg1, arg2)
+}
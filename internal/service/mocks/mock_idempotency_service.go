@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: VDM2-BankBE/internal/service (interfaces: IdempotencyService)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	model "VDM2-BankBE/internal/model"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockIdempotencyService is a mock of IdempotencyService interface.
+type MockIdempotencyService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIdempotencyServiceMockRecorder
+}
+
+// MockIdempotencyServiceMockRecorder is the mock recorder for MockIdempotencyService.
+type MockIdempotencyServiceMockRecorder struct {
+	mock *MockIdempotencyService
+}
+
+// NewMockIdempotencyService creates a new mock instance.
+func NewMockIdempotencyService(ctrl *gomock.Controller) *MockIdempotencyService {
+	mock := &MockIdempotencyService{ctrl: ctrl}
+	mock.recorder = &MockIdempotencyServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIdempotencyService) EXPECT() *MockIdempotencyServiceMockRecorder {
+	return m.recorder
+}
+
+// Begin mocks base method.
+func (m *MockIdempotencyService) Begin(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 string) (*model.IdempotencyKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Begin", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*model.IdempotencyKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Begin indicates an expected call of Begin.
+func (mr *MockIdempotencyServiceMockRecorder) Begin(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Begin", reflect.TypeOf((*MockIdempotencyService)(nil).Begin), arg0, arg1, arg2, arg3)
+}
+
+// Complete mocks base method.
+func (m *MockIdempotencyService) Complete(arg0 context.Context, arg1 uuid.UUID, arg2 string, arg3 int, arg4 []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Complete", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Complete indicates an expected call of Complete.
+func (mr *MockIdempotencyServiceMockRecorder) Complete(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complete", reflect.TypeOf((*MockIdempotencyService)(nil).Complete), arg0, arg1, arg2, arg3, arg4)
+}
+
+// Release mocks base method.
+func (m *MockIdempotencyService) Release(arg0 context.Context, arg1 uuid.UUID, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockIdempotencyServiceMockRecorder) Release(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockIdempotencyService)(nil).Release), arg0, arg1, arg2)
+}
@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: VDM2-BankBE/internal/service (interfaces: LedgerService)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	model "VDM2-BankBE/internal/model"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockLedgerService is a mock of LedgerService interface.
+type MockLedgerService struct {
+	ctrl     *gomock.Controller
+	recorder *MockLedgerServiceMockRecorder
+}
+
+// MockLedgerServiceMockRecorder is the mock recorder for MockLedgerService.
+type MockLedgerServiceMockRecorder struct {
+	mock *MockLedgerService
+}
+
+// NewMockLedgerService creates a new mock instance.
+func NewMockLedgerService(ctrl *gomock.Controller) *MockLedgerService {
+	mock := &MockLedgerService{ctrl: ctrl}
+	mock.recorder = &MockLedgerServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLedgerService) EXPECT() *MockLedgerServiceMockRecorder {
+	return m.recorder
+}
+
+// Post mocks base method.
+func (m *MockLedgerService) Post(arg0 context.Context, arg1 *model.JournalEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Post", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Post indicates an expected call of Post.
+func (mr *MockLedgerServiceMockRecorder) Post(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Post", reflect.TypeOf((*MockLedgerService)(nil).Post), arg0, arg1)
+}
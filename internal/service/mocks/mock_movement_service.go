@@ -54,18 +54,33 @@ func (mr *MockMovementServiceMockRecorder) Create(arg0, arg1, arg2, arg3, arg4 i
 }
 
 // GetByAccountID mocks base method.
-func (m *MockMovementService) GetByAccountID(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 int) (*util.PaginatedResponse, error) {
+func (m *MockMovementService) GetByAccountID(arg0 context.Context, arg1 uuid.UUID, arg2 *model.MovementFilter, arg3, arg4 int) (*util.PaginatedResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByAccountID", arg0, arg1, arg2, arg3)
+	ret := m.ctrl.Call(m, "GetByAccountID", arg0, arg1, arg2, arg3, arg4)
 	ret0, _ := ret[0].(*util.PaginatedResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetByAccountID indicates an expected call of GetByAccountID.
-func (mr *MockMovementServiceMockRecorder) GetByAccountID(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+func (mr *MockMovementServiceMockRecorder) GetByAccountID(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByAccountID", reflect.TypeOf((*MockMovementService)(nil).GetByAccountID), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByAccountID", reflect.TypeOf((*MockMovementService)(nil).GetByAccountID), arg0, arg1, arg2, arg3, arg4)
+}
+
+// GetByAccountIDWithCursor mocks base method.
+func (m *MockMovementService) GetByAccountIDWithCursor(arg0 context.Context, arg1 uuid.UUID, arg2 *model.MovementFilter, arg3 string, arg4 int) (*util.CursorResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByAccountIDWithCursor", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*util.CursorResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByAccountIDWithCursor indicates an expected call of GetByAccountIDWithCursor.
+func (mr *MockMovementServiceMockRecorder) GetByAccountIDWithCursor(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByAccountIDWithCursor", reflect.TypeOf((*MockMovementService)(nil).GetByAccountIDWithCursor), arg0, arg1, arg2, arg3, arg4)
 }
 
 // GetByID mocks base method.
@@ -82,3 +97,18 @@ func (mr *MockMovementServiceMockRecorder) GetByID(arg0, arg1 interface{}) *gomo
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockMovementService)(nil).GetByID), arg0, arg1)
 }
+
+// GetForUser mocks base method.
+func (m *MockMovementService) GetForUser(arg0 context.Context, arg1 uuid.UUID, arg2 uint64) (*model.MovementDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForUser", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.MovementDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForUser indicates an expected call of GetForUser.
+func (mr *MockMovementServiceMockRecorder) GetForUser(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForUser", reflect.TypeOf((*MockMovementService)(nil).GetForUser), arg0, arg1, arg2)
+}
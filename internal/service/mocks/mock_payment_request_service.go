@@ -0,0 +1,159 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: VDM2-BankBE/internal/service (interfaces: PaymentRequestService)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	model "VDM2-BankBE/internal/model"
+	util "VDM2-BankBE/internal/util"
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockPaymentRequestService is a mock of PaymentRequestService interface.
+type MockPaymentRequestService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentRequestServiceMockRecorder
+}
+
+// MockPaymentRequestServiceMockRecorder is the mock recorder for MockPaymentRequestService.
+type MockPaymentRequestServiceMockRecorder struct {
+	mock *MockPaymentRequestService
+}
+
+// NewMockPaymentRequestService creates a new mock instance.
+func NewMockPaymentRequestService(ctrl *gomock.Controller) *MockPaymentRequestService {
+	mock := &MockPaymentRequestService{ctrl: ctrl}
+	mock.recorder = &MockPaymentRequestServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentRequestService) EXPECT() *MockPaymentRequestServiceMockRecorder {
+	return m.recorder
+}
+
+// Cancel mocks base method.
+func (m *MockPaymentRequestService) Cancel(arg0 context.Context, arg1 uuid.UUID, arg2 uint64) (*model.PaymentRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.PaymentRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockPaymentRequestServiceMockRecorder) Cancel(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockPaymentRequestService)(nil).Cancel), arg0, arg1, arg2)
+}
+
+// Create mocks base method.
+func (m *MockPaymentRequestService) Create(arg0 context.Context, arg1 *model.PaymentRequest, arg2, arg3 string, arg4 *time.Time) (*model.PaymentRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*model.PaymentRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPaymentRequestServiceMockRecorder) Create(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPaymentRequestService)(nil).Create), arg0, arg1, arg2, arg3, arg4)
+}
+
+// Decline mocks base method.
+func (m *MockPaymentRequestService) Decline(arg0 context.Context, arg1 uuid.UUID, arg2 uint64) (*model.PaymentRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decline", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.PaymentRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Decline indicates an expected call of Decline.
+func (mr *MockPaymentRequestServiceMockRecorder) Decline(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decline", reflect.TypeOf((*MockPaymentRequestService)(nil).Decline), arg0, arg1, arg2)
+}
+
+// ExpireDue mocks base method.
+func (m *MockPaymentRequestService) ExpireDue(arg0 context.Context, arg1 time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireDue", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireDue indicates an expected call of ExpireDue.
+func (mr *MockPaymentRequestServiceMockRecorder) ExpireDue(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireDue", reflect.TypeOf((*MockPaymentRequestService)(nil).ExpireDue), arg0, arg1)
+}
+
+// GetByID mocks base method.
+func (m *MockPaymentRequestService) GetByID(arg0 context.Context, arg1 uuid.UUID, arg2 uint64) (*model.PaymentRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.PaymentRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockPaymentRequestServiceMockRecorder) GetByID(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockPaymentRequestService)(nil).GetByID), arg0, arg1, arg2)
+}
+
+// GetIncoming mocks base method.
+func (m *MockPaymentRequestService) GetIncoming(arg0 context.Context, arg1 uuid.UUID, arg2 string, arg3, arg4 int) (*util.PaginatedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIncoming", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*util.PaginatedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIncoming indicates an expected call of GetIncoming.
+func (mr *MockPaymentRequestServiceMockRecorder) GetIncoming(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIncoming", reflect.TypeOf((*MockPaymentRequestService)(nil).GetIncoming), arg0, arg1, arg2, arg3, arg4)
+}
+
+// GetOutgoing mocks base method.
+func (m *MockPaymentRequestService) GetOutgoing(arg0 context.Context, arg1 uuid.UUID, arg2 string, arg3, arg4 int) (*util.PaginatedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOutgoing", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*util.PaginatedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOutgoing indicates an expected call of GetOutgoing.
+func (mr *MockPaymentRequestServiceMockRecorder) GetOutgoing(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOutgoing", reflect.TypeOf((*MockPaymentRequestService)(nil).GetOutgoing), arg0, arg1, arg2, arg3, arg4)
+}
+
+// Pay mocks base method.
+func (m *MockPaymentRequestService) Pay(arg0 context.Context, arg1 uuid.UUID, arg2 uint64, arg3 uuid.UUID) (*model.PaymentRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pay", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*model.PaymentRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Pay indicates an expected call of Pay.
+func (mr *MockPaymentRequestServiceMockRecorder) Pay(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pay", reflect.TypeOf((*MockPaymentRequestService)(nil).Pay), arg0, arg1, arg2, arg3)
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: VDM2-BankBE/internal/service (interfaces: ReconciliationService)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	model "VDM2-BankBE/internal/model"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockReconciliationService is a mock of ReconciliationService interface.
+type MockReconciliationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockReconciliationServiceMockRecorder
+}
+
+// MockReconciliationServiceMockRecorder is the mock recorder for MockReconciliationService.
+type MockReconciliationServiceMockRecorder struct {
+	mock *MockReconciliationService
+}
+
+// NewMockReconciliationService creates a new mock instance.
+func NewMockReconciliationService(ctrl *gomock.Controller) *MockReconciliationService {
+	mock := &MockReconciliationService{ctrl: ctrl}
+	mock.recorder = &MockReconciliationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReconciliationService) EXPECT() *MockReconciliationServiceMockRecorder {
+	return m.recorder
+}
+
+// Reconcile mocks base method.
+func (m *MockReconciliationService) Reconcile(arg0 context.Context) (*model.ReconciliationReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reconcile", arg0)
+	ret0, _ := ret[0].(*model.ReconciliationReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reconcile indicates an expected call of Reconcile.
+func (mr *MockReconciliationServiceMockRecorder) Reconcile(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reconcile", reflect.TypeOf((*MockReconciliationService)(nil).Reconcile), arg0)
+}
@@ -0,0 +1,145 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: VDM2-BankBE/internal/service (interfaces: StandingOrderService)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	model "VDM2-BankBE/internal/model"
+	service "VDM2-BankBE/internal/service"
+	util "VDM2-BankBE/internal/util"
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockStandingOrderService is a mock of StandingOrderService interface.
+type MockStandingOrderService struct {
+	ctrl     *gomock.Controller
+	recorder *MockStandingOrderServiceMockRecorder
+}
+
+// MockStandingOrderServiceMockRecorder is the mock recorder for MockStandingOrderService.
+type MockStandingOrderServiceMockRecorder struct {
+	mock *MockStandingOrderService
+}
+
+// NewMockStandingOrderService creates a new mock instance.
+func NewMockStandingOrderService(ctrl *gomock.Controller) *MockStandingOrderService {
+	mock := &MockStandingOrderService{ctrl: ctrl}
+	mock.recorder = &MockStandingOrderServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStandingOrderService) EXPECT() *MockStandingOrderServiceMockRecorder {
+	return m.recorder
+}
+
+// Cancel mocks base method.
+func (m *MockStandingOrderService) Cancel(arg0 context.Context, arg1 uuid.UUID, arg2 uint64) (*model.StandingOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.StandingOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockStandingOrderServiceMockRecorder) Cancel(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockStandingOrderService)(nil).Cancel), arg0, arg1, arg2)
+}
+
+// Create mocks base method.
+func (m *MockStandingOrderService) Create(arg0 context.Context, arg1 *model.StandingOrder) (*model.StandingOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*model.StandingOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockStandingOrderServiceMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockStandingOrderService)(nil).Create), arg0, arg1)
+}
+
+// Execute mocks base method.
+func (m *MockStandingOrderService) Execute(arg0 context.Context, arg1 *model.StandingOrder) (*model.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1)
+	ret0, _ := ret[0].(*model.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockStandingOrderServiceMockRecorder) Execute(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockStandingOrderService)(nil).Execute), arg0, arg1)
+}
+
+// GetByAccountID mocks base method.
+func (m *MockStandingOrderService) GetByAccountID(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 int) (*util.PaginatedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByAccountID", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*util.PaginatedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByAccountID indicates an expected call of GetByAccountID.
+func (mr *MockStandingOrderServiceMockRecorder) GetByAccountID(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByAccountID", reflect.TypeOf((*MockStandingOrderService)(nil).GetByAccountID), arg0, arg1, arg2, arg3)
+}
+
+// GetByID mocks base method.
+func (m *MockStandingOrderService) GetByID(arg0 context.Context, arg1 uuid.UUID, arg2 uint64) (*model.StandingOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.StandingOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockStandingOrderServiceMockRecorder) GetByID(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockStandingOrderService)(nil).GetByID), arg0, arg1, arg2)
+}
+
+// GetDue mocks base method.
+func (m *MockStandingOrderService) GetDue(arg0 context.Context, arg1 time.Time, arg2 int) ([]*model.StandingOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDue", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*model.StandingOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDue indicates an expected call of GetDue.
+func (mr *MockStandingOrderServiceMockRecorder) GetDue(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDue", reflect.TypeOf((*MockStandingOrderService)(nil).GetDue), arg0, arg1, arg2)
+}
+
+// Update mocks base method.
+func (m *MockStandingOrderService) Update(arg0 context.Context, arg1 uuid.UUID, arg2 uint64, arg3 service.StandingOrderUpdate) (*model.StandingOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*model.StandingOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockStandingOrderServiceMockRecorder) Update(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockStandingOrderService)(nil).Update), arg0, arg1, arg2, arg3)
+}
@@ -0,0 +1,98 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: VDM2-BankBE/internal/service (interfaces: TransferBatchService)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	model "VDM2-BankBE/internal/model"
+	service "VDM2-BankBE/internal/service"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockTransferBatchService is a mock of TransferBatchService interface.
+type MockTransferBatchService struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransferBatchServiceMockRecorder
+}
+
+// MockTransferBatchServiceMockRecorder is the mock recorder for MockTransferBatchService.
+type MockTransferBatchServiceMockRecorder struct {
+	mock *MockTransferBatchService
+}
+
+// NewMockTransferBatchService creates a new mock instance.
+func NewMockTransferBatchService(ctrl *gomock.Controller) *MockTransferBatchService {
+	mock := &MockTransferBatchService{ctrl: ctrl}
+	mock.recorder = &MockTransferBatchServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransferBatchService) EXPECT() *MockTransferBatchServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockTransferBatchService) Create(arg0 context.Context, arg1 uuid.UUID, arg2 string, arg3 []service.TransferBatchLine) (*model.TransferBatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*model.TransferBatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTransferBatchServiceMockRecorder) Create(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTransferBatchService)(nil).Create), arg0, arg1, arg2, arg3)
+}
+
+// GetByID mocks base method.
+func (m *MockTransferBatchService) GetByID(arg0 context.Context, arg1 uuid.UUID, arg2 uint64) (*model.TransferBatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.TransferBatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockTransferBatchServiceMockRecorder) GetByID(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockTransferBatchService)(nil).GetByID), arg0, arg1, arg2)
+}
+
+// GetPending mocks base method.
+func (m *MockTransferBatchService) GetPending(arg0 context.Context, arg1 int) ([]*model.TransferBatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPending", arg0, arg1)
+	ret0, _ := ret[0].([]*model.TransferBatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPending indicates an expected call of GetPending.
+func (mr *MockTransferBatchServiceMockRecorder) GetPending(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPending", reflect.TypeOf((*MockTransferBatchService)(nil).GetPending), arg0, arg1)
+}
+
+// Process mocks base method.
+func (m *MockTransferBatchService) Process(arg0 context.Context, arg1 uint64) (*model.TransferBatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Process", arg0, arg1)
+	ret0, _ := ret[0].(*model.TransferBatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Process indicates an expected call of Process.
+func (mr *MockTransferBatchServiceMockRecorder) Process(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Process", reflect.TypeOf((*MockTransferBatchService)(nil).Process), arg0, arg1)
+}
@@ -0,0 +1,83 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: VDM2-BankBE/internal/service (interfaces: TransferLimitService)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	model "VDM2-BankBE/internal/model"
+	service "VDM2-BankBE/internal/service"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	decimal "github.com/shopspring/decimal"
+)
+
+// MockTransferLimitService is a mock of TransferLimitService interface.
+type MockTransferLimitService struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransferLimitServiceMockRecorder
+}
+
+// MockTransferLimitServiceMockRecorder is the mock recorder for MockTransferLimitService.
+type MockTransferLimitServiceMockRecorder struct {
+	mock *MockTransferLimitService
+}
+
+// NewMockTransferLimitService creates a new mock instance.
+func NewMockTransferLimitService(ctrl *gomock.Controller) *MockTransferLimitService {
+	mock := &MockTransferLimitService{ctrl: ctrl}
+	mock.recorder = &MockTransferLimitServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransferLimitService) EXPECT() *MockTransferLimitServiceMockRecorder {
+	return m.recorder
+}
+
+// Check mocks base method.
+func (m *MockTransferLimitService) Check(arg0 context.Context, arg1, arg2 uuid.UUID, arg3 decimal.Decimal, arg4 string, arg5 uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Check", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Check indicates an expected call of Check.
+func (mr *MockTransferLimitServiceMockRecorder) Check(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Check", reflect.TypeOf((*MockTransferLimitService)(nil).Check), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// GetAllowance mocks base method.
+func (m *MockTransferLimitService) GetAllowance(arg0 context.Context, arg1 uuid.UUID, arg2 string) (*model.TransferAllowance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllowance", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.TransferAllowance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllowance indicates an expected call of GetAllowance.
+func (mr *MockTransferLimitServiceMockRecorder) GetAllowance(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllowance", reflect.TypeOf((*MockTransferLimitService)(nil).GetAllowance), arg0, arg1, arg2)
+}
+
+// Update mocks base method.
+func (m *MockTransferLimitService) Update(arg0 context.Context, arg1 uuid.UUID, arg2 service.TransferLimitUpdate) (*model.TransferAllowance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.TransferAllowance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockTransferLimitServiceMockRecorder) Update(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTransferLimitService)(nil).Update), arg0, arg1, arg2)
+}
@@ -9,6 +9,7 @@ import (
 	util "VDM2-BankBE/internal/util"
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
@@ -38,19 +39,139 @@ func (m *MockTransferService) EXPECT() *MockTransferServiceMockRecorder {
 	return m.recorder
 }
 
+// Approve mocks base method.
+func (m *MockTransferService) Approve(arg0 context.Context, arg1 uuid.UUID, arg2 uint64, arg3 string) (*model.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Approve", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*model.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Approve indicates an expected call of Approve.
+func (mr *MockTransferServiceMockRecorder) Approve(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Approve", reflect.TypeOf((*MockTransferService)(nil).Approve), arg0, arg1, arg2, arg3)
+}
+
+// Cancel mocks base method.
+func (m *MockTransferService) Cancel(arg0 context.Context, arg1 uuid.UUID, arg2 uint64) (*model.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockTransferServiceMockRecorder) Cancel(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockTransferService)(nil).Cancel), arg0, arg1, arg2)
+}
+
+// ClaimQueued mocks base method.
+func (m *MockTransferService) ClaimQueued(arg0 context.Context, arg1 time.Time, arg2 int) ([]*model.TransferOutbox, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimQueued", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*model.TransferOutbox)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimQueued indicates an expected call of ClaimQueued.
+func (mr *MockTransferServiceMockRecorder) ClaimQueued(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimQueued", reflect.TypeOf((*MockTransferService)(nil).ClaimQueued), arg0, arg1, arg2)
+}
+
+// ExecuteScheduled mocks base method.
+func (m *MockTransferService) ExecuteScheduled(arg0 context.Context, arg1 uint64) (*model.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteScheduled", arg0, arg1)
+	ret0, _ := ret[0].(*model.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecuteScheduled indicates an expected call of ExecuteScheduled.
+func (mr *MockTransferServiceMockRecorder) ExecuteScheduled(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteScheduled", reflect.TypeOf((*MockTransferService)(nil).ExecuteScheduled), arg0, arg1)
+}
+
+// ExpireApprovals mocks base method.
+func (m *MockTransferService) ExpireApprovals(arg0 context.Context, arg1 time.Time, arg2 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireApprovals", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireApprovals indicates an expected call of ExpireApprovals.
+func (mr *MockTransferServiceMockRecorder) ExpireApprovals(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireApprovals", reflect.TypeOf((*MockTransferService)(nil).ExpireApprovals), arg0, arg1, arg2)
+}
+
+// GetApprovals mocks base method.
+func (m *MockTransferService) GetApprovals(arg0 context.Context, arg1 uuid.UUID, arg2 uint64) ([]*model.TransferApproval, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApprovals", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*model.TransferApproval)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApprovals indicates an expected call of GetApprovals.
+func (mr *MockTransferServiceMockRecorder) GetApprovals(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApprovals", reflect.TypeOf((*MockTransferService)(nil).GetApprovals), arg0, arg1, arg2)
+}
+
+// GetAwaitingApproval mocks base method.
+func (m *MockTransferService) GetAwaitingApproval(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 int) (*util.PaginatedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAwaitingApproval", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*util.PaginatedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAwaitingApproval indicates an expected call of GetAwaitingApproval.
+func (mr *MockTransferServiceMockRecorder) GetAwaitingApproval(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAwaitingApproval", reflect.TypeOf((*MockTransferService)(nil).GetAwaitingApproval), arg0, arg1, arg2, arg3)
+}
+
 // GetByAccountID mocks base method.
-func (m *MockTransferService) GetByAccountID(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 int) (*util.PaginatedResponse, error) {
+func (m *MockTransferService) GetByAccountID(arg0 context.Context, arg1 uuid.UUID, arg2 *model.TransferFilter, arg3, arg4 int) (*util.PaginatedResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByAccountID", arg0, arg1, arg2, arg3)
+	ret := m.ctrl.Call(m, "GetByAccountID", arg0, arg1, arg2, arg3, arg4)
 	ret0, _ := ret[0].(*util.PaginatedResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetByAccountID indicates an expected call of GetByAccountID.
-func (mr *MockTransferServiceMockRecorder) GetByAccountID(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+func (mr *MockTransferServiceMockRecorder) GetByAccountID(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByAccountID", reflect.TypeOf((*MockTransferService)(nil).GetByAccountID), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByAccountID", reflect.TypeOf((*MockTransferService)(nil).GetByAccountID), arg0, arg1, arg2, arg3, arg4)
+}
+
+// GetByAccountIDWithCursor mocks base method.
+func (m *MockTransferService) GetByAccountIDWithCursor(arg0 context.Context, arg1 uuid.UUID, arg2 *model.TransferFilter, arg3 string, arg4 int) (*util.CursorResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByAccountIDWithCursor", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*util.CursorResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByAccountIDWithCursor indicates an expected call of GetByAccountIDWithCursor.
+func (mr *MockTransferServiceMockRecorder) GetByAccountIDWithCursor(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByAccountIDWithCursor", reflect.TypeOf((*MockTransferService)(nil).GetByAccountIDWithCursor), arg0, arg1, arg2, arg3, arg4)
 }
 
 // GetByID mocks base method.
@@ -68,6 +189,141 @@ func (mr *MockTransferServiceMockRecorder) GetByID(arg0, arg1 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockTransferService)(nil).GetByID), arg0, arg1)
 }
 
+// GetDueScheduled mocks base method.
+func (m *MockTransferService) GetDueScheduled(arg0 context.Context, arg1 time.Time, arg2 int) ([]*model.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDueScheduled", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*model.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDueScheduled indicates an expected call of GetDueScheduled.
+func (mr *MockTransferServiceMockRecorder) GetDueScheduled(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDueScheduled", reflect.TypeOf((*MockTransferService)(nil).GetDueScheduled), arg0, arg1, arg2)
+}
+
+// GetForUser mocks base method.
+func (m *MockTransferService) GetForUser(arg0 context.Context, arg1 uuid.UUID, arg2 uint64) (*model.TransferDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForUser", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.TransferDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForUser indicates an expected call of GetForUser.
+func (mr *MockTransferServiceMockRecorder) GetForUser(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForUser", reflect.TypeOf((*MockTransferService)(nil).GetForUser), arg0, arg1, arg2)
+}
+
+// GetScheduledByAccountID mocks base method.
+func (m *MockTransferService) GetScheduledByAccountID(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 int) (*util.PaginatedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScheduledByAccountID", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*util.PaginatedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScheduledByAccountID indicates an expected call of GetScheduledByAccountID.
+func (mr *MockTransferServiceMockRecorder) GetScheduledByAccountID(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScheduledByAccountID", reflect.TypeOf((*MockTransferService)(nil).GetScheduledByAccountID), arg0, arg1, arg2, arg3)
+}
+
+// ProcessQueued mocks base method.
+func (m *MockTransferService) ProcessQueued(arg0 context.Context, arg1 *model.TransferOutbox) (*model.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProcessQueued", arg0, arg1)
+	ret0, _ := ret[0].(*model.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProcessQueued indicates an expected call of ProcessQueued.
+func (mr *MockTransferServiceMockRecorder) ProcessQueued(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessQueued", reflect.TypeOf((*MockTransferService)(nil).ProcessQueued), arg0, arg1)
+}
+
+// Reject mocks base method.
+func (m *MockTransferService) Reject(arg0 context.Context, arg1 uuid.UUID, arg2 uint64, arg3 string) (*model.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reject", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*model.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reject indicates an expected call of Reject.
+func (mr *MockTransferServiceMockRecorder) Reject(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reject", reflect.TypeOf((*MockTransferService)(nil).Reject), arg0, arg1, arg2, arg3)
+}
+
+// Reverse mocks base method.
+func (m *MockTransferService) Reverse(arg0 context.Context, arg1 *uuid.UUID, arg2 uint64, arg3 decimal.Decimal, arg4 string) (*model.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reverse", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*model.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reverse indicates an expected call of Reverse.
+func (mr *MockTransferServiceMockRecorder) Reverse(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reverse", reflect.TypeOf((*MockTransferService)(nil).Reverse), arg0, arg1, arg2, arg3, arg4)
+}
+
+// Schedule mocks base method.
+func (m *MockTransferService) Schedule(arg0 context.Context, arg1, arg2 uuid.UUID, arg3 decimal.Decimal, arg4 string, arg5 time.Time) (*model.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Schedule", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(*model.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Schedule indicates an expected call of Schedule.
+func (mr *MockTransferServiceMockRecorder) Schedule(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Schedule", reflect.TypeOf((*MockTransferService)(nil).Schedule), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// Submit mocks base method.
+func (m *MockTransferService) Submit(arg0 context.Context, arg1, arg2 uuid.UUID, arg3 decimal.Decimal, arg4 string) (*model.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Submit", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*model.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Submit indicates an expected call of Submit.
+func (mr *MockTransferServiceMockRecorder) Submit(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Submit", reflect.TypeOf((*MockTransferService)(nil).Submit), arg0, arg1, arg2, arg3, arg4)
+}
+
+// Sweep mocks base method.
+func (m *MockTransferService) Sweep(arg0 context.Context, arg1, arg2 uuid.UUID, arg3 decimal.Decimal, arg4 string) (*model.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sweep", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*model.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Sweep indicates an expected call of Sweep.
+func (mr *MockTransferServiceMockRecorder) Sweep(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sweep", reflect.TypeOf((*MockTransferService)(nil).Sweep), arg0, arg1, arg2, arg3, arg4)
+}
+
 // Transfer mocks base method.
 func (m *MockTransferService) Transfer(arg0 context.Context, arg1, arg2 uuid.UUID, arg3 decimal.Decimal, arg4 string) (*model.Transfer, error) {
 	m.ctrl.T.Helper()
@@ -82,3 +338,48 @@ func (mr *MockTransferServiceMockRecorder) Transfer(arg0, arg1, arg2, arg3, arg4
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transfer", reflect.TypeOf((*MockTransferService)(nil).Transfer), arg0, arg1, arg2, arg3, arg4)
 }
+
+// TransferAll mocks base method.
+func (m *MockTransferService) TransferAll(arg0 context.Context, arg1 []*model.Transfer) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferAll", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransferAll indicates an expected call of TransferAll.
+func (mr *MockTransferServiceMockRecorder) TransferAll(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferAll", reflect.TypeOf((*MockTransferService)(nil).TransferAll), arg0, arg1)
+}
+
+// TransferConverted mocks base method.
+func (m *MockTransferService) TransferConverted(arg0 context.Context, arg1, arg2 uuid.UUID, arg3 decimal.Decimal, arg4 string, arg5 uuid.UUID) (*model.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferConverted", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(*model.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransferConverted indicates an expected call of TransferConverted.
+func (mr *MockTransferServiceMockRecorder) TransferConverted(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferConverted", reflect.TypeOf((*MockTransferService)(nil).TransferConverted), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// TransferStandingOrder mocks base method.
+func (m *MockTransferService) TransferStandingOrder(arg0 context.Context, arg1, arg2 uuid.UUID, arg3 decimal.Decimal, arg4 string) (*model.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferStandingOrder", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*model.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransferStandingOrder indicates an expected call of TransferStandingOrder.
+func (mr *MockTransferServiceMockRecorder) TransferStandingOrder(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferStandingOrder", reflect.TypeOf((*MockTransferService)(nil).TransferStandingOrder), arg0, arg1, arg2, arg3, arg4)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
 
 	"VDM2-BankBE/internal/model"
 	"VDM2-BankBE/internal/repository"
@@ -16,25 +17,41 @@ import (
 
 // DefaultMovementService implements MovementService
 type DefaultMovementService struct {
-	movementRepo repository.MovementRepository
-	accountRepo  repository.AccountRepository
-	redisClient  CacheClient
+	movementRepo  repository.MovementRepository
+	accountRepo   repository.AccountRepository
+	transferRepo  repository.TransferRepository
+	userRepo      repository.UserRepository
+	ledgerService LedgerService
+	feeService    FeeService
+	redisClient   CacheClient
+	db            TxDB // For transactions
 }
 
 // NewMovementService creates a new movement service
 func NewMovementService(
 	movementRepo repository.MovementRepository,
 	accountRepo repository.AccountRepository,
+	transferRepo repository.TransferRepository,
+	userRepo repository.UserRepository,
+	ledgerService LedgerService,
+	feeService FeeService,
 	redisClient CacheClient,
+	db TxDB,
 ) MovementService {
 	return &DefaultMovementService{
-		movementRepo: movementRepo,
-		accountRepo:  accountRepo,
-		redisClient:  redisClient,
+		movementRepo:  movementRepo,
+		accountRepo:   accountRepo,
+		transferRepo:  transferRepo,
+		userRepo:      userRepo,
+		ledgerService: ledgerService,
+		feeService:    feeService,
+		redisClient:   redisClient,
+		db:            db,
 	}
 }
 
-// Create creates a new movement and updates the account balance
+// Create creates a new movement and updates the account balance. Debits are charged the withdrawal fee as a
+// movement of their own.
 func (s *DefaultMovementService) Create(
 	ctx context.Context,
 	accountID uuid.UUID,
@@ -47,11 +64,37 @@ func (s *DefaultMovementService) Create(
 		return nil, util.NewBadRequestError("movement type must be 'credit' or 'debit'")
 	}
 
-	// Get the account to verify it exists
+	// Validate amount
+	if amount.LessThanOrEqual(decimal.Zero) {
+		return nil, util.NewBadRequestError("amount must be greater than zero")
+	}
+
+	// Get the account to verify it exists and that its status allows the movement
 	account, err := s.accountRepo.GetByID(ctx, accountID)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get account")
 	}
+	if (movementType == "debit" && !account.CanSend()) || (movementType == "credit" && !account.CanReceive()) {
+		return nil, util.NewConflictError("account is " + account.Status)
+	}
+	if err := util.ValidateAmountPrecision(amount, account.Currency); err != nil {
+		return nil, util.NewBadRequestError(err.Error())
+	}
+
+	// Debits pay the withdrawal fee
+	fee := decimal.Zero
+	if movementType == "debit" {
+		breakdown, err := s.feeService.Calculate(ctx, FeeOperationWithdrawal, amount, account.Currency)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to calculate fee")
+		}
+		fee = breakdown.Total
+	}
+
+	// Check if the account has sufficient funds for a debit and its fee, overdraft included
+	if movementType == "debit" && account.AvailableBalance().LessThan(amount.Add(fee)) {
+		return nil, util.NewBadRequestError("insufficient funds")
+	}
 
 	// Create the movement
 	movement := &model.Movement{
@@ -62,27 +105,48 @@ func (s *DefaultMovementService) Create(
 		OccurredAt:  time.Now(),
 	}
 
-	// Update the account balance
-	balanceChange := amount
-	if movementType == "debit" {
-		balanceChange = amount.Neg()
-	}
+	// Post to the ledger and record the movement in one transaction; repositories join it through the context
+	var balance decimal.Decimal
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		txCtx := repository.WithTx(ctx, tx)
 
-	// Update balance in DB
-	err = s.accountRepo.UpdateBalance(ctx, accountID, balanceChange)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to update account balance")
-	}
+		// Post to the ledger, which updates the balance in DB
+		entry := movementEntry(accountID, amount, account.Currency, movementType, description)
+		if err := s.ledgerService.Post(txCtx, entry); err != nil {
+			if _, ok := err.(*util.APIError); ok {
+				return err
+			}
+			return errors.Wrap(err, "failed to update account balance")
+		}
+		movement.JournalEntryID = &entry.ID
+		movement.BalanceAfter = entry.Postings[0].BalanceAfter
+		balance = movement.BalanceAfter
+
+		// Create movement in DB
+		if err := s.movementRepo.Create(txCtx, movement); err != nil {
+			return errors.Wrap(err, "failed to create movement")
+		}
+
+		// Charge the fee after the movement it is for
+		if fee.IsPositive() {
+			feeMovement, err := chargeFee(txCtx, s.ledgerService, s.movementRepo, accountID, fee, account.Currency, "Withdrawal fee")
+			if err != nil {
+				return err
+			}
+			balance = feeMovement.BalanceAfter
+		}
 
-	// Create movement in DB
-	err = s.movementRepo.Create(ctx, movement)
+		return nil
+	})
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to create movement")
+		if _, ok := err.(*util.APIError); ok {
+			return nil, err
+		}
+		return nil, errors.Wrap(err, "movement failed")
 	}
 
 	// Update balance cache
-	newBalance := account.Balance.Add(balanceChange)
-	_ = s.redisClient.SetBalanceCache(ctx, accountID, newBalance)
+	_ = s.redisClient.SetBalanceCache(ctx, accountID, balance)
 
 	return movement, nil
 }
@@ -97,8 +161,63 @@ func (s *DefaultMovementService) GetByID(ctx context.Context, id uint64) (*model
 	return movement, nil
 }
 
-// GetByAccountID retrieves movements for an account with pagination
-func (s *DefaultMovementService) GetByAccountID(ctx context.Context, accountID uuid.UUID, page, limit int) (*util.PaginatedResponse, error) {
+// GetForUser retrieves the details of a movement on an account of userID: the transfer that posted it, if any, and
+// the masked name of the owner of the account on the other side. Movements of other users are reported as missing.
+func (s *DefaultMovementService) GetForUser(ctx context.Context, userID uuid.UUID, id uint64) (*model.MovementDetails, error) {
+	movement, err := s.movementRepo.GetByID(ctx, id)
+	if err != nil {
+		if _, ok := err.(*util.APIError); ok {
+			return nil, err
+		}
+		return nil, errors.Wrap(err, "failed to get movement")
+	}
+
+	account, err := s.accountRepo.GetByID(ctx, movement.AccountID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get account")
+	}
+	if account.UserID != userID {
+		return nil, util.NewNotFoundError("movement not found")
+	}
+
+	// Deposits, withdrawals and fees are not posted by a transfer
+	details := &model.MovementDetails{Movement: *movement}
+	transfer, err := s.transferRepo.GetByMovementID(ctx, id)
+	if err != nil {
+		if _, ok := err.(*util.APIError); ok {
+			return details, nil
+		}
+		return nil, errors.Wrap(err, "failed to get movement transfer")
+	}
+	details.Transfer = transfer
+
+	counterpartyAccountID := transfer.FromAccount
+	if movement.AccountID == transfer.FromAccount {
+		counterpartyAccountID = transfer.ToAccount
+	}
+	counterpartyAccount, err := s.accountRepo.GetByID(ctx, counterpartyAccountID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get counterparty account")
+	}
+	details.CounterpartyName, err = maskedUserName(ctx, s.userRepo, counterpartyAccount.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return details, nil
+}
+
+// GetByAccountID retrieves the movements of an account matching filter with pagination
+func (s *DefaultMovementService) GetByAccountID(
+	ctx context.Context,
+	accountID uuid.UUID,
+	filter *model.MovementFilter,
+	page, limit int,
+) (*util.PaginatedResponse, error) {
+	if err := validateMovementFilter(filter); err != nil {
+		return nil, err
+	}
+
 	// Check if account exists
 	_, err := s.accountRepo.GetByID(ctx, accountID)
 	if err != nil {
@@ -112,7 +231,7 @@ func (s *DefaultMovementService) GetByAccountID(ctx context.Context, accountID u
 	}
 
 	// Get movements
-	movements, count, err := s.movementRepo.GetByAccountID(ctx, accountID, params)
+	movements, count, err := s.movementRepo.GetByAccountID(ctx, accountID, filter, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get movements")
 	}
@@ -121,3 +240,76 @@ func (s *DefaultMovementService) GetByAccountID(ctx context.Context, accountID u
 	response := util.NewPaginatedResponse(movements, params, count)
 	return response, nil
 }
+
+// GetByAccountIDWithCursor retrieves the movements of an account matching filter by keyset pagination, from the
+// position of cursor (empty for the first page), without counting them
+func (s *DefaultMovementService) GetByAccountIDWithCursor(
+	ctx context.Context,
+	accountID uuid.UUID,
+	filter *model.MovementFilter,
+	cursor string,
+	limit int,
+) (*util.CursorResponse, error) {
+	if err := validateMovementFilter(filter); err != nil {
+		return nil, err
+	}
+	// The cursor holds the date of a movement, not its amount
+	if filter.Sort == "amount" {
+		return nil, util.NewBadRequestError("cursor pagination only supports sort 'occurred_at'")
+	}
+
+	params, err := util.NewCursorParams(cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if account exists
+	_, err = s.accountRepo.GetByID(ctx, accountID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get account")
+	}
+
+	// Get movements
+	movements, more, err := s.movementRepo.GetByAccountIDWithCursor(ctx, accountID, filter, params)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get movements")
+	}
+
+	var first, last *util.Cursor
+	if len(movements) > 0 {
+		first = &util.Cursor{Time: movements[0].OccurredAt, ID: movements[0].ID}
+		last = &util.Cursor{Time: movements[len(movements)-1].OccurredAt, ID: movements[len(movements)-1].ID}
+	}
+
+	return util.NewCursorResponse(movements, params, first, last, more), nil
+}
+
+// maxMovementSearchLength bounds the free-text search of movements
+const maxMovementSearchLength = 100
+
+// validateMovementFilter checks that the bounds of a movement filter are consistent and its options known
+func validateMovementFilter(filter *model.MovementFilter) error {
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		return util.NewBadRequestError("from must be before to")
+	}
+	if filter.Type != "" && filter.Type != "credit" && filter.Type != "debit" {
+		return util.NewBadRequestError("type must be 'credit' or 'debit'")
+	}
+	if (filter.MinAmount != nil && filter.MinAmount.IsNegative()) || (filter.MaxAmount != nil && filter.MaxAmount.IsNegative()) {
+		return util.NewBadRequestError("amount bounds must not be negative")
+	}
+	if filter.MinAmount != nil && filter.MaxAmount != nil && filter.MinAmount.GreaterThan(*filter.MaxAmount) {
+		return util.NewBadRequestError("min_amount must not be greater than max_amount")
+	}
+	if len([]rune(filter.Search)) > maxMovementSearchLength {
+		return util.NewBadRequestError("search must be at most " + strconv.Itoa(maxMovementSearchLength) + " characters")
+	}
+	if filter.Sort != "" && filter.Sort != "occurred_at" && filter.Sort != "amount" {
+		return util.NewBadRequestError("sort must be 'occurred_at' or 'amount'")
+	}
+	if filter.Order != "" && filter.Order != "asc" && filter.Order != "desc" {
+		return util.NewBadRequestError("order must be 'asc' or 'desc'")
+	}
+
+	return nil
+}
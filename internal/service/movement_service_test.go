@@ -2,12 +2,17 @@ package service_test
 
 import (
 	"context"
+	"database/sql"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
 
+	"VDM2-BankBE/internal/config"
 	"VDM2-BankBE/internal/model"
 	repmocks "VDM2-BankBE/internal/repository/mocks"
 	"VDM2-BankBE/internal/service"
@@ -25,14 +30,14 @@ func TestMovementService_Create(t *testing.T) {
 	tests := []struct {
 		name       string
 		mType      string
-		buildMocks func(ctrl *gomock.Controller) (*repmocks.MockMovementRepository, *repmocks.MockAccountRepository, *servicemocks.MockCacheClient)
+		buildMocks func(ctrl *gomock.Controller) (*repmocks.MockMovementRepository, *repmocks.MockAccountRepository, *servicemocks.MockLedgerService, *servicemocks.MockCacheClient, *servicemocks.MockTxDB)
 		assert     func(t *testing.T, movement *model.Movement, err error)
 	}{
 		{
 			name:  "invalid type returns 400",
 			mType: "invalid",
-			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockMovementRepository, *repmocks.MockAccountRepository, *servicemocks.MockCacheClient) {
-				return repmocks.NewMockMovementRepository(ctrl), repmocks.NewMockAccountRepository(ctrl), servicemocks.NewMockCacheClient(ctrl)
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockMovementRepository, *repmocks.MockAccountRepository, *servicemocks.MockLedgerService, *servicemocks.MockCacheClient, *servicemocks.MockTxDB) {
+				return repmocks.NewMockMovementRepository(ctrl), repmocks.NewMockAccountRepository(ctrl), servicemocks.NewMockLedgerService(ctrl), servicemocks.NewMockCacheClient(ctrl), servicemocks.NewMockTxDB(ctrl)
 			},
 			assert: func(t *testing.T, movement *model.Movement, err error) {
 				if movement != nil {
@@ -47,13 +52,30 @@ func TestMovementService_Create(t *testing.T) {
 		{
 			name:  "credit updates balance and writes movement",
 			mType: "credit",
-			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockMovementRepository, *repmocks.MockAccountRepository, *servicemocks.MockCacheClient) {
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockMovementRepository, *repmocks.MockAccountRepository, *servicemocks.MockLedgerService, *servicemocks.MockCacheClient, *servicemocks.MockTxDB) {
 				movementRepo := repmocks.NewMockMovementRepository(ctrl)
 				accountRepo := repmocks.NewMockAccountRepository(ctrl)
+				ledgerSvc := servicemocks.NewMockLedgerService(ctrl)
 				cache := servicemocks.NewMockCacheClient(ctrl)
+				txdb := servicemocks.NewMockTxDB(ctrl)
 
-				accountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(&model.Account{ID: accountID, Balance: startBalance}, nil)
-				accountRepo.EXPECT().UpdateBalance(gomock.Any(), accountID, amount).Return(nil)
+				accountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(&model.Account{ID: accountID, Status: "active", Balance: startBalance}, nil)
+				txdb.EXPECT().
+					Transaction(gomock.Any()).
+					DoAndReturn(func(fc func(tx *gorm.DB) error, opts ...*sql.TxOptions) error {
+						return fc(&gorm.DB{})
+					})
+				ledgerSvc.EXPECT().Post(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, entry *model.JournalEntry) error {
+					if *entry.Postings[0].AccountID != accountID || !entry.Postings[0].Amount.Equal(amount) {
+						t.Fatalf("unexpected account posting: %+v", entry.Postings[0])
+					}
+					if *entry.Postings[1].SystemAccount != "cash_in" || !entry.Postings[1].Amount.Equal(amount.Neg()) {
+						t.Fatalf("unexpected system posting: %+v", entry.Postings[1])
+					}
+					entry.ID = 5
+					entry.Postings[0].BalanceAfter = startBalance.Add(amount)
+					return nil
+				})
 				movementRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, m *model.Movement) error {
 					if m.AccountID != accountID {
 						t.Fatalf("unexpected movement account id: %s", m.AccountID.String())
@@ -67,11 +89,17 @@ func TestMovementService_Create(t *testing.T) {
 					if m.Description != "desc" {
 						t.Fatalf("unexpected movement description: %q", m.Description)
 					}
+					if m.JournalEntryID == nil || *m.JournalEntryID != 5 {
+						t.Fatalf("movement not linked to its journal entry: %v", m.JournalEntryID)
+					}
+					if !m.BalanceAfter.Equal(startBalance.Add(amount)) {
+						t.Fatalf("unexpected balance after: %s", m.BalanceAfter.String())
+					}
 					return nil
 				})
 				cache.EXPECT().SetBalanceCache(gomock.Any(), accountID, startBalance.Add(amount)).Return(nil)
 
-				return movementRepo, accountRepo, cache
+				return movementRepo, accountRepo, ledgerSvc, cache, txdb
 			},
 			assert: func(t *testing.T, movement *model.Movement, err error) {
 				if err != nil {
@@ -85,13 +113,29 @@ func TestMovementService_Create(t *testing.T) {
 		{
 			name:  "debit updates balance and writes movement",
 			mType: "debit",
-			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockMovementRepository, *repmocks.MockAccountRepository, *servicemocks.MockCacheClient) {
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockMovementRepository, *repmocks.MockAccountRepository, *servicemocks.MockLedgerService, *servicemocks.MockCacheClient, *servicemocks.MockTxDB) {
 				movementRepo := repmocks.NewMockMovementRepository(ctrl)
 				accountRepo := repmocks.NewMockAccountRepository(ctrl)
+				ledgerSvc := servicemocks.NewMockLedgerService(ctrl)
 				cache := servicemocks.NewMockCacheClient(ctrl)
+				txdb := servicemocks.NewMockTxDB(ctrl)
 
-				accountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(&model.Account{ID: accountID, Balance: startBalance}, nil)
-				accountRepo.EXPECT().UpdateBalance(gomock.Any(), accountID, amount.Neg()).Return(nil)
+				accountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(&model.Account{ID: accountID, Status: "active", Balance: startBalance}, nil)
+				txdb.EXPECT().
+					Transaction(gomock.Any()).
+					DoAndReturn(func(fc func(tx *gorm.DB) error, opts ...*sql.TxOptions) error {
+						return fc(&gorm.DB{})
+					})
+				ledgerSvc.EXPECT().Post(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, entry *model.JournalEntry) error {
+					if *entry.Postings[0].AccountID != accountID || !entry.Postings[0].Amount.Equal(amount.Neg()) {
+						t.Fatalf("unexpected account posting: %+v", entry.Postings[0])
+					}
+					if *entry.Postings[1].SystemAccount != "cash_out" || !entry.Postings[1].Amount.Equal(amount) {
+						t.Fatalf("unexpected system posting: %+v", entry.Postings[1])
+					}
+					entry.Postings[0].BalanceAfter = startBalance.Sub(amount)
+					return nil
+				})
 				movementRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, m *model.Movement) error {
 					if m.Type != "debit" {
 						t.Fatalf("unexpected movement type: %q", m.Type)
@@ -100,7 +144,7 @@ func TestMovementService_Create(t *testing.T) {
 				})
 				cache.EXPECT().SetBalanceCache(gomock.Any(), accountID, startBalance.Sub(amount)).Return(nil)
 
-				return movementRepo, accountRepo, cache
+				return movementRepo, accountRepo, ledgerSvc, cache, txdb
 			},
 			assert: func(t *testing.T, movement *model.Movement, err error) {
 				if err != nil {
@@ -111,6 +155,52 @@ func TestMovementService_Create(t *testing.T) {
 				}
 			},
 		},
+		{
+			name:  "debit from a debit-blocked account returns 409",
+			mType: "debit",
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockMovementRepository, *repmocks.MockAccountRepository, *servicemocks.MockLedgerService, *servicemocks.MockCacheClient, *servicemocks.MockTxDB) {
+				accountRepo := repmocks.NewMockAccountRepository(ctrl)
+				accountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(&model.Account{ID: accountID, Status: "debit_blocked", Balance: startBalance}, nil)
+
+				return repmocks.NewMockMovementRepository(ctrl), accountRepo, servicemocks.NewMockLedgerService(ctrl), servicemocks.NewMockCacheClient(ctrl), servicemocks.NewMockTxDB(ctrl)
+			},
+			assert: func(t *testing.T, movement *model.Movement, err error) {
+				apiErr, ok := err.(*util.APIError)
+				if !ok || apiErr.Code != 409 || apiErr.Message != "account is debit_blocked" {
+					t.Fatalf("expected 409 APIError, got %#v", err)
+				}
+			},
+		},
+		{
+			name:  "rejected posting writes no movement",
+			mType: "debit",
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockMovementRepository, *repmocks.MockAccountRepository, *servicemocks.MockLedgerService, *servicemocks.MockCacheClient, *servicemocks.MockTxDB) {
+				movementRepo := repmocks.NewMockMovementRepository(ctrl)
+				accountRepo := repmocks.NewMockAccountRepository(ctrl)
+				ledgerSvc := servicemocks.NewMockLedgerService(ctrl)
+				cache := servicemocks.NewMockCacheClient(ctrl)
+				txdb := servicemocks.NewMockTxDB(ctrl)
+
+				accountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(&model.Account{ID: accountID, Status: "active", Balance: startBalance}, nil)
+				txdb.EXPECT().
+					Transaction(gomock.Any()).
+					DoAndReturn(func(fc func(tx *gorm.DB) error, opts ...*sql.TxOptions) error {
+						return fc(&gorm.DB{})
+					})
+				ledgerSvc.EXPECT().Post(gomock.Any(), gomock.Any()).Return(util.NewBadRequestError("insufficient funds"))
+
+				return movementRepo, accountRepo, ledgerSvc, cache, txdb
+			},
+			assert: func(t *testing.T, movement *model.Movement, err error) {
+				if movement != nil {
+					t.Fatalf("expected nil movement, got %+v", movement)
+				}
+				apiErr, ok := err.(*util.APIError)
+				if !ok || apiErr.Code != 400 || apiErr.Message != "insufficient funds" {
+					t.Fatalf("unexpected error: %#v", err)
+				}
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -120,8 +210,8 @@ func TestMovementService_Create(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			movementRepo, accountRepo, cache := tc.buildMocks(ctrl)
-			svc := service.NewMovementService(movementRepo, accountRepo, cache)
+			movementRepo, accountRepo, ledgerSvc, cache, txdb := tc.buildMocks(ctrl)
+			svc := service.NewMovementService(movementRepo, accountRepo, repmocks.NewMockTransferRepository(ctrl), repmocks.NewMockUserRepository(ctrl), ledgerSvc, noFees, cache, txdb)
 
 			m, err := svc.Create(context.Background(), accountID, amount, tc.mType, "desc")
 			tc.assert(t, m, err)
@@ -129,3 +219,321 @@ func TestMovementService_Create(t *testing.T) {
 	}
 }
 
+func TestMovementService_CreateChargesWithdrawalFee(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	accountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440210")
+	amount := decimal.RequireFromString("10.00")
+	fees := service.NewFeeService(repmocks.NewMockFXRepository(ctrl), &config.FeeConfig{
+		Currency:   "EUR",
+		Withdrawal: &config.FeeRule{Type: "flat", Amount: decimal.RequireFromString("1.00")},
+	})
+
+	movementRepo := repmocks.NewMockMovementRepository(ctrl)
+	accountRepo := repmocks.NewMockAccountRepository(ctrl)
+	ledgerSvc := servicemocks.NewMockLedgerService(ctrl)
+	cache := servicemocks.NewMockCacheClient(ctrl)
+	txdb := servicemocks.NewMockTxDB(ctrl)
+
+	accountRepo.EXPECT().GetByID(gomock.Any(), accountID).
+		Return(&model.Account{ID: accountID, Status: "active", Currency: "EUR", Balance: decimal.RequireFromString("100.00")}, nil)
+	txdb.EXPECT().
+		Transaction(gomock.Any()).
+		DoAndReturn(func(fc func(tx *gorm.DB) error, opts ...*sql.TxOptions) error {
+			return fc(&gorm.DB{})
+		})
+	gomock.InOrder(
+		ledgerSvc.EXPECT().Post(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, entry *model.JournalEntry) error {
+			entry.Postings[0].BalanceAfter = decimal.RequireFromString("90.00")
+			return nil
+		}),
+		ledgerSvc.EXPECT().Post(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, entry *model.JournalEntry) error {
+			if !entry.Postings[0].Amount.Equal(decimal.RequireFromString("-1.00")) || *entry.Postings[1].SystemAccount != "fees" {
+				t.Fatalf("unexpected fee postings: %+v", entry.Postings)
+			}
+			entry.Postings[0].BalanceAfter = decimal.RequireFromString("89.00")
+			return nil
+		}),
+	)
+	gomock.InOrder(
+		movementRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil),
+		movementRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, movement *model.Movement) error {
+			if movement.Type != "debit" || movement.Description != "Withdrawal fee" {
+				t.Fatalf("unexpected fee movement: %+v", movement)
+			}
+			return nil
+		}),
+	)
+	cache.EXPECT().SetBalanceCache(gomock.Any(), accountID, decimal.RequireFromString("89.00")).Return(nil)
+
+	svc := service.NewMovementService(movementRepo, accountRepo, repmocks.NewMockTransferRepository(ctrl), repmocks.NewMockUserRepository(ctrl), ledgerSvc, fees, cache, txdb)
+
+	movement, err := svc.Create(context.Background(), accountID, amount, "debit", "ATM")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !movement.Amount.Equal(amount) {
+		t.Fatalf("unexpected movement: %+v", movement)
+	}
+}
+
+func TestMovementService_GetForUser(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440230")
+	otherID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440231")
+	accountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440232")
+	otherAccountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440233")
+	id := uint64(40)
+
+	tests := []struct {
+		name       string
+		buildMocks func(ctrl *gomock.Controller) (
+			*repmocks.MockMovementRepository,
+			*repmocks.MockAccountRepository,
+			*repmocks.MockTransferRepository,
+			*repmocks.MockUserRepository,
+		)
+		assert func(t *testing.T, got *model.MovementDetails, err error)
+	}{
+		{
+			name: "transfer credit names the sender",
+			buildMocks: func(ctrl *gomock.Controller) (
+				*repmocks.MockMovementRepository,
+				*repmocks.MockAccountRepository,
+				*repmocks.MockTransferRepository,
+				*repmocks.MockUserRepository,
+			) {
+				movementRepo := repmocks.NewMockMovementRepository(ctrl)
+				accountRepo := repmocks.NewMockAccountRepository(ctrl)
+				transferRepo := repmocks.NewMockTransferRepository(ctrl)
+				userRepo := repmocks.NewMockUserRepository(ctrl)
+
+				movementRepo.EXPECT().GetByID(gomock.Any(), id).Return(&model.Movement{ID: id, AccountID: accountID, Type: "credit"}, nil)
+				accountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(&model.Account{ID: accountID, UserID: userID}, nil)
+				transferRepo.EXPECT().GetByMovementID(gomock.Any(), id).
+					Return(&model.Transfer{ID: 9, FromAccount: otherAccountID, ToAccount: accountID}, nil)
+				accountRepo.EXPECT().GetByID(gomock.Any(), otherAccountID).Return(&model.Account{ID: otherAccountID, UserID: otherID}, nil)
+				userRepo.EXPECT().GetByID(gomock.Any(), otherID).Return(&model.User{ID: otherID, FirstName: "Mario", LastName: "Rossi"}, nil)
+
+				return movementRepo, accountRepo, transferRepo, userRepo
+			},
+			assert: func(t *testing.T, got *model.MovementDetails, err error) {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if got.ID != id || got.Transfer == nil || got.Transfer.ID != 9 || got.CounterpartyName != "Ma*** R***" {
+					t.Fatalf("unexpected movement: %+v", got)
+				}
+			},
+		},
+		{
+			name: "deposit has no transfer",
+			buildMocks: func(ctrl *gomock.Controller) (
+				*repmocks.MockMovementRepository,
+				*repmocks.MockAccountRepository,
+				*repmocks.MockTransferRepository,
+				*repmocks.MockUserRepository,
+			) {
+				movementRepo := repmocks.NewMockMovementRepository(ctrl)
+				accountRepo := repmocks.NewMockAccountRepository(ctrl)
+				transferRepo := repmocks.NewMockTransferRepository(ctrl)
+
+				movementRepo.EXPECT().GetByID(gomock.Any(), id).Return(&model.Movement{ID: id, AccountID: accountID, Type: "credit"}, nil)
+				accountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(&model.Account{ID: accountID, UserID: userID}, nil)
+				transferRepo.EXPECT().GetByMovementID(gomock.Any(), id).Return(nil, util.NewNotFoundError("transfer not found"))
+
+				return movementRepo, accountRepo, transferRepo, repmocks.NewMockUserRepository(ctrl)
+			},
+			assert: func(t *testing.T, got *model.MovementDetails, err error) {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if got.ID != id || got.Transfer != nil || got.CounterpartyName != "" {
+					t.Fatalf("unexpected movement: %+v", got)
+				}
+			},
+		},
+		{
+			name: "movement of another user returns 404",
+			buildMocks: func(ctrl *gomock.Controller) (
+				*repmocks.MockMovementRepository,
+				*repmocks.MockAccountRepository,
+				*repmocks.MockTransferRepository,
+				*repmocks.MockUserRepository,
+			) {
+				movementRepo := repmocks.NewMockMovementRepository(ctrl)
+				accountRepo := repmocks.NewMockAccountRepository(ctrl)
+
+				movementRepo.EXPECT().GetByID(gomock.Any(), id).Return(&model.Movement{ID: id, AccountID: otherAccountID}, nil)
+				accountRepo.EXPECT().GetByID(gomock.Any(), otherAccountID).Return(&model.Account{ID: otherAccountID, UserID: otherID}, nil)
+
+				return movementRepo, accountRepo, repmocks.NewMockTransferRepository(ctrl), repmocks.NewMockUserRepository(ctrl)
+			},
+			assert: func(t *testing.T, got *model.MovementDetails, err error) {
+				apiErr, ok := err.(*util.APIError)
+				if !ok || apiErr.Code != 404 || apiErr.Message != "movement not found" {
+					t.Fatalf("expected 404 APIError, got %#v", err)
+				}
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			movementRepo, accountRepo, transferRepo, userRepo := tc.buildMocks(ctrl)
+			svc := service.NewMovementService(movementRepo, accountRepo, transferRepo, userRepo, servicemocks.NewMockLedgerService(ctrl), noFees, servicemocks.NewMockCacheClient(ctrl), servicemocks.NewMockTxDB(ctrl))
+
+			got, err := svc.GetForUser(context.Background(), userID, id)
+			tc.assert(t, got, err)
+		})
+	}
+}
+
+func TestMovementService_GetByAccountID(t *testing.T) {
+	t.Parallel()
+
+	accountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440240")
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	low := decimal.NewFromInt(10)
+	high := decimal.NewFromInt(50)
+	negative := decimal.NewFromInt(-1)
+
+	tests := []struct {
+		name    string
+		filter  *model.MovementFilter
+		wantErr string
+	}{
+		{name: "from not before to", filter: &model.MovementFilter{From: &from, To: &to}, wantErr: "from must be before to"},
+		{name: "unknown type", filter: &model.MovementFilter{Type: "refund"}, wantErr: "type must be 'credit' or 'debit'"},
+		{name: "negative bound", filter: &model.MovementFilter{MinAmount: &negative}, wantErr: "amount bounds must not be negative"},
+		{name: "min above max", filter: &model.MovementFilter{MinAmount: &high, MaxAmount: &low}, wantErr: "min_amount must not be greater than max_amount"},
+		{name: "search too long", filter: &model.MovementFilter{Search: strings.Repeat("é", 101)}, wantErr: "search must be at most 100 characters"},
+		{name: "unknown sort", filter: &model.MovementFilter{Sort: "description"}, wantErr: "sort must be 'occurred_at' or 'amount'"},
+		{name: "unknown order", filter: &model.MovementFilter{Order: "up"}, wantErr: "order must be 'asc' or 'desc'"},
+		{name: "valid filter reaches the repository", filter: &model.MovementFilter{From: &to, To: &from, Type: "debit", MinAmount: &low, MaxAmount: &high, Search: "rent", Sort: "amount", Order: "asc"}},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			movementRepo := repmocks.NewMockMovementRepository(ctrl)
+			accountRepo := repmocks.NewMockAccountRepository(ctrl)
+			if tc.wantErr == "" {
+				accountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(&model.Account{ID: accountID}, nil)
+				movementRepo.EXPECT().GetByAccountID(gomock.Any(), accountID, tc.filter, gomock.Any()).Return([]*model.Movement{}, 0, nil)
+			}
+
+			svc := service.NewMovementService(movementRepo, accountRepo, repmocks.NewMockTransferRepository(ctrl), repmocks.NewMockUserRepository(ctrl), servicemocks.NewMockLedgerService(ctrl), noFees, servicemocks.NewMockCacheClient(ctrl), servicemocks.NewMockTxDB(ctrl))
+
+			_, err := svc.GetByAccountID(context.Background(), accountID, tc.filter, 1, 10)
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			apiErr, ok := err.(*util.APIError)
+			if !ok || apiErr.Code != 400 || apiErr.Message != tc.wantErr {
+				t.Fatalf("expected 400 %q, got %#v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestMovementService_GetByAccountIDWithCursor(t *testing.T) {
+	t.Parallel()
+
+	accountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440241")
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		filter     *model.MovementFilter
+		cursor     string
+		buildMocks func(ctrl *gomock.Controller) (*repmocks.MockMovementRepository, *repmocks.MockAccountRepository)
+		assert     func(t *testing.T, got *util.CursorResponse, err error)
+	}{
+		{
+			name:   "first page links to the next one only",
+			filter: &model.MovementFilter{Type: "credit"},
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockMovementRepository, *repmocks.MockAccountRepository) {
+				movementRepo := repmocks.NewMockMovementRepository(ctrl)
+				accountRepo := repmocks.NewMockAccountRepository(ctrl)
+				accountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(&model.Account{ID: accountID}, nil)
+				movementRepo.EXPECT().GetByAccountIDWithCursor(gomock.Any(), accountID, &model.MovementFilter{Type: "credit"}, &util.CursorParams{Limit: 10}).
+					Return([]*model.Movement{{ID: 3, OccurredAt: now}}, true, nil)
+				return movementRepo, accountRepo
+			},
+			assert: func(t *testing.T, got *util.CursorResponse, err error) {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if want := (util.Cursor{Time: now, ID: 3}).Encode(); got.Pagination.NextCursor != want {
+					t.Fatalf("unexpected next cursor: got=%q want=%q", got.Pagination.NextCursor, want)
+				}
+				if got.Pagination.PrevCursor != "" {
+					t.Fatalf("unexpected prev cursor on the first page: %q", got.Pagination.PrevCursor)
+				}
+			},
+		},
+		{
+			name:   "sorting by amount is not supported",
+			filter: &model.MovementFilter{Sort: "amount"},
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockMovementRepository, *repmocks.MockAccountRepository) {
+				return repmocks.NewMockMovementRepository(ctrl), repmocks.NewMockAccountRepository(ctrl)
+			},
+			assert: func(t *testing.T, got *util.CursorResponse, err error) {
+				apiErr, ok := err.(*util.APIError)
+				if !ok || apiErr.Code != 400 || apiErr.Message != "cursor pagination only supports sort 'occurred_at'" {
+					t.Fatalf("expected 400 APIError, got %#v", err)
+				}
+			},
+		},
+		{
+			name:   "invalid cursor",
+			filter: &model.MovementFilter{},
+			cursor: "garbage!",
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockMovementRepository, *repmocks.MockAccountRepository) {
+				return repmocks.NewMockMovementRepository(ctrl), repmocks.NewMockAccountRepository(ctrl)
+			},
+			assert: func(t *testing.T, got *util.CursorResponse, err error) {
+				apiErr, ok := err.(*util.APIError)
+				if !ok || apiErr.Code != 400 || apiErr.Message != "invalid cursor" {
+					t.Fatalf("expected 400 APIError, got %#v", err)
+				}
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			movementRepo, accountRepo := tc.buildMocks(ctrl)
+			svc := service.NewMovementService(movementRepo, accountRepo, repmocks.NewMockTransferRepository(ctrl), repmocks.NewMockUserRepository(ctrl), servicemocks.NewMockLedgerService(ctrl), noFees, servicemocks.NewMockCacheClient(ctrl), servicemocks.NewMockTxDB(ctrl))
+
+			got, err := svc.GetByAccountIDWithCursor(context.Background(), accountID, tc.filter, tc.cursor, 10)
+			tc.assert(t, got, err)
+		})
+	}
+}
@@ -0,0 +1,306 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+
+	"VDM2-BankBE/internal/config"
+	"VDM2-BankBE/internal/model"
+	"VDM2-BankBE/internal/repository"
+	"VDM2-BankBE/internal/util"
+)
+
+// DefaultPaymentRequestService implements PaymentRequestService
+type DefaultPaymentRequestService struct {
+	paymentRequestRepo repository.PaymentRequestRepository
+	userRepo           repository.UserRepository
+	transferService    TransferService
+	db                 TxDB // For transactions
+	config             *config.PaymentRequestConfig
+}
+
+// NewPaymentRequestService creates a new payment request service
+func NewPaymentRequestService(
+	paymentRequestRepo repository.PaymentRequestRepository,
+	userRepo repository.UserRepository,
+	transferService TransferService,
+	db TxDB,
+	config *config.PaymentRequestConfig,
+) PaymentRequestService {
+	return &DefaultPaymentRequestService{
+		paymentRequestRepo: paymentRequestRepo,
+		userRepo:           userRepo,
+		transferService:    transferService,
+		db:                 db,
+		config:             config,
+	}
+}
+
+// Create sends a request for money from RequesterID to the user given either by payerUsername or by payerEmail.
+// The request stays open until expiresAt, or for the configured default lifetime when expiresAt is nil.
+func (s *DefaultPaymentRequestService) Create(
+	ctx context.Context,
+	request *model.PaymentRequest,
+	payerUsername, payerEmail string,
+	expiresAt *time.Time,
+) (*model.PaymentRequest, error) {
+	// Validate amount
+	if request.Amount.LessThanOrEqual(decimal.Zero) {
+		return nil, util.NewBadRequestError("amount must be greater than zero")
+	}
+
+	// Validate expiry
+	now := time.Now()
+	expiry := now.Add(s.config.DefaultTTL)
+	if expiresAt != nil {
+		if !expiresAt.After(now) {
+			return nil, util.NewBadRequestError("expires_at must be in the future")
+		}
+		if expiresAt.After(now.Add(s.config.MaxTTL)) {
+			return nil, util.NewBadRequestError("expires_at must be within " + s.config.MaxTTL.String())
+		}
+		expiry = *expiresAt
+	}
+
+	// Get the payer
+	payerUsername = strings.TrimSpace(payerUsername)
+	payerEmail = strings.TrimSpace(payerEmail)
+	if (payerUsername == "") == (payerEmail == "") {
+		return nil, util.NewBadRequestError("exactly one of username and email is required")
+	}
+
+	var payer *model.User
+	var err error
+	if payerUsername != "" {
+		payer, err = s.userRepo.GetByUsername(ctx, payerUsername)
+	} else {
+		payer, err = s.userRepo.GetByEmail(ctx, payerEmail)
+	}
+	if err != nil {
+		if _, ok := err.(*util.APIError); ok {
+			return nil, util.NewNotFoundError("payer not found")
+		}
+		return nil, errors.Wrap(err, "failed to get payer")
+	}
+	if payer.ID == request.RequesterID {
+		return nil, util.NewBadRequestError("cannot request money from yourself")
+	}
+
+	request.PayerID = payer.ID
+	request.Message = strings.TrimSpace(request.Message)
+	request.Status = "open"
+	request.ExpiresAt = expiry
+	request.TransferID = nil
+
+	if err := s.paymentRequestRepo.Create(ctx, request); err != nil {
+		return nil, errors.Wrap(err, "failed to create payment request")
+	}
+
+	return request, nil
+}
+
+// GetByID retrieves a payment request sent or received by userID
+func (s *DefaultPaymentRequestService) GetByID(ctx context.Context, userID uuid.UUID, id uint64) (*model.PaymentRequest, error) {
+	request, err := s.paymentRequestRepo.GetByID(ctx, id)
+	if err != nil {
+		if _, ok := err.(*util.APIError); ok {
+			return nil, err
+		}
+		return nil, errors.Wrap(err, "failed to get payment request")
+	}
+
+	// Requests between other users are reported as missing
+	if request.RequesterID != userID && request.PayerID != userID {
+		return nil, util.NewNotFoundError("payment request not found")
+	}
+
+	return request, nil
+}
+
+// GetIncoming retrieves the requests received by userID with pagination, optionally filtered by status
+func (s *DefaultPaymentRequestService) GetIncoming(
+	ctx context.Context,
+	userID uuid.UUID,
+	status string,
+	page, limit int,
+) (*util.PaginatedResponse, error) {
+	return s.list(ctx, s.paymentRequestRepo.GetByPayerID, userID, status, page, limit)
+}
+
+// GetOutgoing retrieves the requests sent by userID with pagination, optionally filtered by status
+func (s *DefaultPaymentRequestService) GetOutgoing(
+	ctx context.Context,
+	userID uuid.UUID,
+	status string,
+	page, limit int,
+) (*util.PaginatedResponse, error) {
+	return s.list(ctx, s.paymentRequestRepo.GetByRequesterID, userID, status, page, limit)
+}
+
+// list validates the status filter and pagination and retrieves a page of requests with get
+func (s *DefaultPaymentRequestService) list(
+	ctx context.Context,
+	get func(context.Context, uuid.UUID, string, *util.PaginationParams) ([]*model.PaymentRequest, int, error),
+	userID uuid.UUID,
+	status string,
+	page, limit int,
+) (*util.PaginatedResponse, error) {
+	switch status {
+	case "", "open", "paid", "declined", "expired", "cancelled":
+	default:
+		return nil, util.NewBadRequestError("status must be one of open, paid, declined, expired, cancelled")
+	}
+
+	// Create pagination params
+	params, err := util.NewPaginationParams(strconv.Itoa(page), strconv.Itoa(limit))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid pagination parameters")
+	}
+
+	// Get requests
+	requests, count, err := get(ctx, userID, status, params)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get payment requests")
+	}
+
+	// Create paginated response
+	response := util.NewPaginatedResponse(requests, params, count)
+	return response, nil
+}
+
+// Pay executes an open request received by userID as a transfer from fromAccountID, an account of userID, to the
+// account of the requester, and links the transfer to the request. The request is claimed, paid and linked in one
+// transaction, so that it stays open when the transfer fails.
+func (s *DefaultPaymentRequestService) Pay(
+	ctx context.Context,
+	userID uuid.UUID,
+	id uint64,
+	fromAccountID uuid.UUID,
+) (*model.PaymentRequest, error) {
+	request, err := s.getReceived(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.Status != "open" {
+		return nil, util.NewConflictError("payment request is " + request.Status)
+	}
+	if !request.ExpiresAt.After(time.Now()) {
+		return nil, util.NewConflictError("payment request has expired")
+	}
+
+	description := request.Message
+	if description == "" {
+		description = "Payment request #" + strconv.FormatUint(request.ID, 10)
+	}
+
+	// The transfer joins the transaction through the context
+	var transfer *model.Transfer
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		txCtx := repository.WithTx(ctx, tx)
+
+		// Claim the request first, so that it is paid once even when paid, declined or cancelled concurrently
+		claimed, err := s.paymentRequestRepo.UpdateStatus(txCtx, request.ID, "open", "paid")
+		if err != nil {
+			return errors.Wrap(err, "failed to claim payment request")
+		}
+		if !claimed {
+			return util.NewConflictError("payment request is no longer open")
+		}
+
+		transfer, err = s.transferService.Transfer(txCtx, fromAccountID, request.ToAccount, request.Amount, description)
+		if err != nil {
+			return err
+		}
+
+		if err := s.paymentRequestRepo.SetTransferID(txCtx, request.ID, transfer.ID); err != nil {
+			return errors.Wrap(err, "failed to link payment request to transfer")
+		}
+
+		return nil
+	})
+	if err != nil {
+		if _, ok := err.(*util.APIError); ok {
+			return nil, err
+		}
+		return nil, errors.Wrap(err, "failed to pay payment request")
+	}
+
+	request.Status = "paid"
+	request.TransferID = &transfer.ID
+	return request, nil
+}
+
+// Decline refuses an open request received by userID
+func (s *DefaultPaymentRequestService) Decline(ctx context.Context, userID uuid.UUID, id uint64) (*model.PaymentRequest, error) {
+	request, err := s.getReceived(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.close(ctx, request, "declined")
+}
+
+// Cancel withdraws an open request sent by userID
+func (s *DefaultPaymentRequestService) Cancel(ctx context.Context, userID uuid.UUID, id uint64) (*model.PaymentRequest, error) {
+	request, err := s.GetByID(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the requester can cancel a request
+	if request.RequesterID != userID {
+		return nil, util.NewForbiddenError("only the requester can cancel a payment request")
+	}
+
+	return s.close(ctx, request, "cancelled")
+}
+
+// ExpireDue expires the open requests that expired by now, returning how many were expired
+func (s *DefaultPaymentRequestService) ExpireDue(ctx context.Context, now time.Time) (int, error) {
+	expired, err := s.paymentRequestRepo.Expire(ctx, now)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to expire payment requests")
+	}
+
+	return expired, nil
+}
+
+// getReceived retrieves a request received by userID; only the payer can pay or decline a request
+func (s *DefaultPaymentRequestService) getReceived(ctx context.Context, userID uuid.UUID, id uint64) (*model.PaymentRequest, error) {
+	request, err := s.GetByID(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.PayerID != userID {
+		return nil, util.NewForbiddenError("only the payer can pay or decline a payment request")
+	}
+
+	return request, nil
+}
+
+// close moves an open request to status
+func (s *DefaultPaymentRequestService) close(ctx context.Context, request *model.PaymentRequest, status string) (*model.PaymentRequest, error) {
+	if request.Status != "open" {
+		return nil, util.NewConflictError("payment request is " + request.Status)
+	}
+
+	closed, err := s.paymentRequestRepo.UpdateStatus(ctx, request.ID, "open", status)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to update payment request")
+	}
+	if !closed {
+		return nil, util.NewConflictError("payment request is no longer open")
+	}
+
+	request.Status = status
+	return request, nil
+}
@@ -0,0 +1,304 @@
+package service_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+
+	"VDM2-BankBE/internal/config"
+	"VDM2-BankBE/internal/model"
+	repmocks "VDM2-BankBE/internal/repository/mocks"
+	"VDM2-BankBE/internal/service"
+	servicemocks "VDM2-BankBE/internal/service/mocks"
+	"VDM2-BankBE/internal/util"
+)
+
+var paymentRequestConfig = &config.PaymentRequestConfig{DefaultTTL: 7 * 24 * time.Hour, MaxTTL: 30 * 24 * time.Hour}
+
+func TestPaymentRequestService_Create(t *testing.T) {
+	t.Parallel()
+
+	requesterID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440a00")
+	payerID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440a01")
+	toAccount := uuid.MustParse("550e8400-e29b-41d4-a716-446655440a02")
+
+	tests := []struct {
+		name       string
+		username   string
+		buildMocks func(ctrl *gomock.Controller) (*repmocks.MockPaymentRequestRepository, *repmocks.MockUserRepository)
+		wantCode   int
+	}{
+		{
+			name:     "unknown payer returns 404",
+			username: "nobody",
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockPaymentRequestRepository, *repmocks.MockUserRepository) {
+				userRepo := repmocks.NewMockUserRepository(ctrl)
+				userRepo.EXPECT().GetByUsername(gomock.Any(), "nobody").Return(nil, util.NewNotFoundError("user not found"))
+				return repmocks.NewMockPaymentRequestRepository(ctrl), userRepo
+			},
+			wantCode: 404,
+		},
+		{
+			name:     "request to oneself returns 400",
+			username: "me",
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockPaymentRequestRepository, *repmocks.MockUserRepository) {
+				userRepo := repmocks.NewMockUserRepository(ctrl)
+				userRepo.EXPECT().GetByUsername(gomock.Any(), "me").Return(&model.User{ID: requesterID}, nil)
+				return repmocks.NewMockPaymentRequestRepository(ctrl), userRepo
+			},
+			wantCode: 400,
+		},
+		{
+			name:     "success opens the request for the default lifetime",
+			username: "mrossi",
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockPaymentRequestRepository, *repmocks.MockUserRepository) {
+				paymentRequestRepo := repmocks.NewMockPaymentRequestRepository(ctrl)
+				userRepo := repmocks.NewMockUserRepository(ctrl)
+
+				userRepo.EXPECT().GetByUsername(gomock.Any(), "mrossi").Return(&model.User{ID: payerID}, nil)
+				paymentRequestRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, request *model.PaymentRequest) error {
+					if request.PayerID != payerID || request.Status != "open" || request.Message != "dinner" {
+						t.Fatalf("unexpected payment request: %+v", request)
+					}
+					if expiry := time.Now().Add(paymentRequestConfig.DefaultTTL); request.ExpiresAt.After(expiry) || request.ExpiresAt.Before(expiry.Add(-time.Minute)) {
+						t.Fatalf("unexpected expiry: %s", request.ExpiresAt)
+					}
+					return nil
+				})
+
+				return paymentRequestRepo, userRepo
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			paymentRequestRepo, userRepo := tc.buildMocks(ctrl)
+			svc := service.NewPaymentRequestService(paymentRequestRepo, userRepo, servicemocks.NewMockTransferService(ctrl), servicemocks.NewMockTxDB(ctrl), paymentRequestConfig)
+
+			request := &model.PaymentRequest{RequesterID: requesterID, ToAccount: toAccount, Amount: decimal.RequireFromString("30.00"), Message: " dinner "}
+			got, err := svc.Create(context.Background(), request, tc.username, "", nil)
+			if tc.wantCode != 0 {
+				apiErr, ok := err.(*util.APIError)
+				if !ok || apiErr.Code != tc.wantCode {
+					t.Fatalf("expected %d APIError, got %#v", tc.wantCode, err)
+				}
+				return
+			}
+			if err != nil || got == nil {
+				t.Fatalf("unexpected result: request=%+v err=%v", got, err)
+			}
+		})
+	}
+}
+
+func TestPaymentRequestService_Pay(t *testing.T) {
+	t.Parallel()
+
+	requesterID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440a10")
+	payerID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440a11")
+	toAccount := uuid.MustParse("550e8400-e29b-41d4-a716-446655440a12")
+	fromAccount := uuid.MustParse("550e8400-e29b-41d4-a716-446655440a13")
+	amount := decimal.RequireFromString("30.00")
+
+	openRequest := func() *model.PaymentRequest {
+		return &model.PaymentRequest{
+			ID:          7,
+			RequesterID: requesterID,
+			PayerID:     payerID,
+			ToAccount:   toAccount,
+			Amount:      amount,
+			Message:     "dinner",
+			Status:      "open",
+			ExpiresAt:   time.Now().Add(time.Hour),
+		}
+	}
+
+	tests := []struct {
+		name       string
+		userID     uuid.UUID
+		buildMocks func(ctrl *gomock.Controller) (*repmocks.MockPaymentRequestRepository, *servicemocks.MockTransferService)
+		wantCode   int
+	}{
+		{
+			name:   "requester cannot pay returns 403",
+			userID: requesterID,
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockPaymentRequestRepository, *servicemocks.MockTransferService) {
+				paymentRequestRepo := repmocks.NewMockPaymentRequestRepository(ctrl)
+				paymentRequestRepo.EXPECT().GetByID(gomock.Any(), uint64(7)).Return(openRequest(), nil)
+				return paymentRequestRepo, servicemocks.NewMockTransferService(ctrl)
+			},
+			wantCode: 403,
+		},
+		{
+			name:   "expired request returns 409",
+			userID: payerID,
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockPaymentRequestRepository, *servicemocks.MockTransferService) {
+				request := openRequest()
+				request.ExpiresAt = time.Now().Add(-time.Minute)
+
+				paymentRequestRepo := repmocks.NewMockPaymentRequestRepository(ctrl)
+				paymentRequestRepo.EXPECT().GetByID(gomock.Any(), uint64(7)).Return(request, nil)
+				return paymentRequestRepo, servicemocks.NewMockTransferService(ctrl)
+			},
+			wantCode: 409,
+		},
+		{
+			name:   "request paid concurrently returns 409",
+			userID: payerID,
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockPaymentRequestRepository, *servicemocks.MockTransferService) {
+				paymentRequestRepo := repmocks.NewMockPaymentRequestRepository(ctrl)
+				paymentRequestRepo.EXPECT().GetByID(gomock.Any(), uint64(7)).Return(openRequest(), nil)
+				paymentRequestRepo.EXPECT().UpdateStatus(gomock.Any(), uint64(7), "open", "paid").Return(false, nil)
+				return paymentRequestRepo, servicemocks.NewMockTransferService(ctrl)
+			},
+			wantCode: 409,
+		},
+		{
+			name:   "failed transfer leaves the request open",
+			userID: payerID,
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockPaymentRequestRepository, *servicemocks.MockTransferService) {
+				paymentRequestRepo := repmocks.NewMockPaymentRequestRepository(ctrl)
+				transferSvc := servicemocks.NewMockTransferService(ctrl)
+
+				paymentRequestRepo.EXPECT().GetByID(gomock.Any(), uint64(7)).Return(openRequest(), nil)
+				gomock.InOrder(
+					paymentRequestRepo.EXPECT().UpdateStatus(gomock.Any(), uint64(7), "open", "paid").Return(true, nil),
+					transferSvc.EXPECT().Transfer(gomock.Any(), fromAccount, toAccount, amount, "dinner").Return(nil, util.NewBadRequestError("insufficient funds")),
+				)
+
+				return paymentRequestRepo, transferSvc
+			},
+			wantCode: 400,
+		},
+		{
+			name:   "transfer from a business account needing approval leaves the request open",
+			userID: payerID,
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockPaymentRequestRepository, *servicemocks.MockTransferService) {
+				paymentRequestRepo := repmocks.NewMockPaymentRequestRepository(ctrl)
+				transferSvc := servicemocks.NewMockTransferService(ctrl)
+
+				// The request must not be paid by a transfer that is only held for approval
+				paymentRequestRepo.EXPECT().GetByID(gomock.Any(), uint64(7)).Return(openRequest(), nil)
+				gomock.InOrder(
+					paymentRequestRepo.EXPECT().UpdateStatus(gomock.Any(), uint64(7), "open", "paid").Return(true, nil),
+					transferSvc.EXPECT().Transfer(gomock.Any(), fromAccount, toAccount, amount, "dinner").
+						Return(nil, util.NewUnprocessableEntityError("transfers needing approval cannot be executed immediately")),
+				)
+
+				return paymentRequestRepo, transferSvc
+			},
+			wantCode: 422,
+		},
+		{
+			name:   "success links the transfer",
+			userID: payerID,
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockPaymentRequestRepository, *servicemocks.MockTransferService) {
+				paymentRequestRepo := repmocks.NewMockPaymentRequestRepository(ctrl)
+				transferSvc := servicemocks.NewMockTransferService(ctrl)
+
+				paymentRequestRepo.EXPECT().GetByID(gomock.Any(), uint64(7)).Return(openRequest(), nil)
+				gomock.InOrder(
+					paymentRequestRepo.EXPECT().UpdateStatus(gomock.Any(), uint64(7), "open", "paid").Return(true, nil),
+					transferSvc.EXPECT().Transfer(gomock.Any(), fromAccount, toAccount, amount, "dinner").Return(&model.Transfer{ID: 42}, nil),
+					paymentRequestRepo.EXPECT().SetTransferID(gomock.Any(), uint64(7), uint64(42)).Return(nil),
+				)
+
+				return paymentRequestRepo, transferSvc
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			paymentRequestRepo, transferSvc := tc.buildMocks(ctrl)
+			// The claim is rolled back with the transfer when it fails
+			txdb := servicemocks.NewMockTxDB(ctrl)
+			txdb.EXPECT().
+				Transaction(gomock.Any()).
+				DoAndReturn(func(fc func(tx *gorm.DB) error, opts ...*sql.TxOptions) error {
+					return fc(&gorm.DB{})
+				}).
+				AnyTimes()
+			svc := service.NewPaymentRequestService(paymentRequestRepo, repmocks.NewMockUserRepository(ctrl), transferSvc, txdb, paymentRequestConfig)
+
+			got, err := svc.Pay(context.Background(), tc.userID, 7, fromAccount)
+			if tc.wantCode != 0 {
+				apiErr, ok := err.(*util.APIError)
+				if !ok || apiErr.Code != tc.wantCode {
+					t.Fatalf("expected %d APIError, got %#v", tc.wantCode, err)
+				}
+				return
+			}
+			if err != nil || got.Status != "paid" || got.TransferID == nil || *got.TransferID != 42 {
+				t.Fatalf("unexpected result: request=%+v err=%v", got, err)
+			}
+		})
+	}
+}
+
+func TestPaymentRequestService_Cancel(t *testing.T) {
+	t.Parallel()
+
+	requesterID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440a20")
+	payerID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440a21")
+
+	tests := []struct {
+		name     string
+		userID   uuid.UUID
+		status   string
+		updated  bool
+		wantCode int
+	}{
+		{name: "requester cancels an open request", userID: requesterID, status: "open", updated: true},
+		{name: "payer cannot cancel returns 403", userID: payerID, status: "open", wantCode: 403},
+		{name: "paid request returns 409", userID: requesterID, status: "paid", wantCode: 409},
+		{name: "request closed concurrently returns 409", userID: requesterID, status: "open", updated: false, wantCode: 409},
+		{name: "other user gets 404", userID: uuid.MustParse("550e8400-e29b-41d4-a716-446655440a22"), status: "open", wantCode: 404},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			paymentRequestRepo := repmocks.NewMockPaymentRequestRepository(ctrl)
+			paymentRequestRepo.EXPECT().GetByID(gomock.Any(), uint64(3)).
+				Return(&model.PaymentRequest{ID: 3, RequesterID: requesterID, PayerID: payerID, Status: tc.status}, nil)
+			if tc.userID == requesterID && tc.status == "open" {
+				paymentRequestRepo.EXPECT().UpdateStatus(gomock.Any(), uint64(3), "open", "cancelled").Return(tc.updated, nil)
+			}
+			svc := service.NewPaymentRequestService(paymentRequestRepo, repmocks.NewMockUserRepository(ctrl), servicemocks.NewMockTransferService(ctrl), servicemocks.NewMockTxDB(ctrl), paymentRequestConfig)
+
+			got, err := svc.Cancel(context.Background(), tc.userID, 3)
+			if tc.wantCode != 0 {
+				apiErr, ok := err.(*util.APIError)
+				if !ok || apiErr.Code != tc.wantCode {
+					t.Fatalf("expected %d APIError, got %#v", tc.wantCode, err)
+				}
+				return
+			}
+			if err != nil || got.Status != "cancelled" {
+				t.Fatalf("unexpected result: request=%+v err=%v", got, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"VDM2-BankBE/internal/config"
+	"VDM2-BankBE/internal/model"
+	"VDM2-BankBE/internal/repository"
+)
+
+// DefaultReconciliationService implements ReconciliationService
+type DefaultReconciliationService struct {
+	reconciliationRepo repository.ReconciliationRepository
+	config             *config.ReconciliationConfig
+}
+
+// NewReconciliationService creates a new reconciliation service
+func NewReconciliationService(
+	reconciliationRepo repository.ReconciliationRepository,
+	config *config.ReconciliationConfig,
+) ReconciliationService {
+	return &DefaultReconciliationService{
+		reconciliationRepo: reconciliationRepo,
+		config:             config,
+	}
+}
+
+// Reconcile checks that account balances match their movements, that every completed transfer has its debit
+// and credit movements, and that no transfer is stuck in pending. Orphaned pending transfers are marked as
+// failed when configured to.
+func (s *DefaultReconciliationService) Reconcile(ctx context.Context) (*model.ReconciliationReport, error) {
+	now := time.Now()
+	report := &model.ReconciliationReport{GeneratedAt: now}
+
+	balances, err := s.reconciliationRepo.FindBalanceDiscrepancies(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to reconcile balances")
+	}
+	report.BalanceDiscrepancies = balances
+
+	transfers, err := s.reconciliationRepo.FindTransferDiscrepancies(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to reconcile transfers")
+	}
+	report.TransferDiscrepancies = transfers
+
+	stale, err := s.reconciliationRepo.FindStalePendingTransfers(ctx, now.Add(-s.config.StalePendingAfter))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find stale pending transfers")
+	}
+	report.StalePendingTransfers = stale
+
+	if s.config.FailOrphanedPending {
+		for _, transfer := range stale {
+			if !transfer.Orphaned {
+				continue
+			}
+
+			// The transfer may have completed since it was found, in which case it is left alone
+			failed, err := s.reconciliationRepo.FailOrphanedPendingTransfer(ctx, transfer.TransferID)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to mark orphaned transfer as failed")
+			}
+			transfer.MarkedFailed = failed
+		}
+	}
+
+	return report, nil
+}
@@ -0,0 +1,127 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"VDM2-BankBE/internal/config"
+	"VDM2-BankBE/internal/model"
+	repmocks "VDM2-BankBE/internal/repository/mocks"
+	"VDM2-BankBE/internal/service"
+)
+
+func TestReconciliationService_Reconcile(t *testing.T) {
+	t.Parallel()
+
+	accountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440970")
+	pendingSince := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		failOrphaned bool
+		buildMocks   func(ctrl *gomock.Controller) *repmocks.MockReconciliationRepository
+		assert       func(t *testing.T, report *model.ReconciliationReport, err error)
+	}{
+		{
+			name: "consistent books produce an empty report",
+			buildMocks: func(ctrl *gomock.Controller) *repmocks.MockReconciliationRepository {
+				repo := repmocks.NewMockReconciliationRepository(ctrl)
+				repo.EXPECT().FindBalanceDiscrepancies(gomock.Any()).Return(nil, nil)
+				repo.EXPECT().FindTransferDiscrepancies(gomock.Any()).Return(nil, nil)
+				repo.EXPECT().FindStalePendingTransfers(gomock.Any(), gomock.Any()).Return(nil, nil)
+				return repo
+			},
+			assert: func(t *testing.T, report *model.ReconciliationReport, err error) {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if report.HasDiscrepancies() {
+					t.Fatalf("expected no discrepancies, got %+v", report)
+				}
+			},
+		},
+		{
+			name: "discrepancies are reported without touching transfers",
+			buildMocks: func(ctrl *gomock.Controller) *repmocks.MockReconciliationRepository {
+				repo := repmocks.NewMockReconciliationRepository(ctrl)
+				repo.EXPECT().FindBalanceDiscrepancies(gomock.Any()).Return([]*model.BalanceDiscrepancy{{
+					AccountID:      accountID,
+					Balance:        decimal.RequireFromString("100.00"),
+					MovementsTotal: decimal.RequireFromString("90.00"),
+					Difference:     decimal.RequireFromString("10.00"),
+				}}, nil)
+				repo.EXPECT().FindTransferDiscrepancies(gomock.Any()).Return([]*model.TransferDiscrepancy{{TransferID: 4, DebitMovements: 1}}, nil)
+				repo.EXPECT().FindStalePendingTransfers(gomock.Any(), gomock.Any()).Return([]*model.StalePendingTransfer{{TransferID: 7, PendingSince: pendingSince, Orphaned: true}}, nil)
+				return repo
+			},
+			assert: func(t *testing.T, report *model.ReconciliationReport, err error) {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if len(report.BalanceDiscrepancies) != 1 || len(report.TransferDiscrepancies) != 1 || len(report.StalePendingTransfers) != 1 {
+					t.Fatalf("unexpected report: %+v", report)
+				}
+				if report.StalePendingTransfers[0].MarkedFailed {
+					t.Fatalf("transfer marked as failed while disabled")
+				}
+			},
+		},
+		{
+			name:         "only orphaned stale transfers are marked as failed",
+			failOrphaned: true,
+			buildMocks: func(ctrl *gomock.Controller) *repmocks.MockReconciliationRepository {
+				repo := repmocks.NewMockReconciliationRepository(ctrl)
+				repo.EXPECT().FindBalanceDiscrepancies(gomock.Any()).Return(nil, nil)
+				repo.EXPECT().FindTransferDiscrepancies(gomock.Any()).Return(nil, nil)
+				repo.EXPECT().FindStalePendingTransfers(gomock.Any(), gomock.Any()).Return([]*model.StalePendingTransfer{
+					{TransferID: 7, PendingSince: pendingSince, Orphaned: true},
+					{TransferID: 8, PendingSince: pendingSince, Orphaned: false},
+				}, nil)
+				repo.EXPECT().FailOrphanedPendingTransfer(gomock.Any(), uint64(7)).Return(true, nil)
+				return repo
+			},
+			assert: func(t *testing.T, report *model.ReconciliationReport, err error) {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if !report.StalePendingTransfers[0].MarkedFailed || report.StalePendingTransfers[1].MarkedFailed {
+					t.Fatalf("unexpected stale transfers: %+v %+v", report.StalePendingTransfers[0], report.StalePendingTransfers[1])
+				}
+			},
+		},
+		{
+			name: "query error aborts the run",
+			buildMocks: func(ctrl *gomock.Controller) *repmocks.MockReconciliationRepository {
+				repo := repmocks.NewMockReconciliationRepository(ctrl)
+				repo.EXPECT().FindBalanceDiscrepancies(gomock.Any()).Return(nil, errors.New("db down"))
+				return repo
+			},
+			assert: func(t *testing.T, report *model.ReconciliationReport, err error) {
+				if err == nil || report != nil {
+					t.Fatalf("expected error, got report=%+v err=%v", report, err)
+				}
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			cfg := &config.ReconciliationConfig{StalePendingAfter: time.Hour, FailOrphanedPending: tc.failOrphaned}
+			svc := service.NewReconciliationService(tc.buildMocks(ctrl), cfg)
+
+			report, err := svc.Reconcile(context.Background())
+			tc.assert(t, report, err)
+		})
+	}
+}
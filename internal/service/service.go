@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
@@ -13,7 +14,7 @@ import (
 // AuthService defines methods for authentication
 //go:generate mockgen -destination=./mocks/mock_auth_service.go -package=mocks VDM2-BankBE/internal/service AuthService
 type AuthService interface {
-	SignUp(ctx context.Context, email, username, firstName, lastName, fiscalCode, password string) (*model.User, error)
+	SignUp(ctx context.Context, email, username, firstName, lastName, fiscalCode, password, currency string) (*model.User, error)
 	Login(ctx context.Context, email, password string) (string, error)
 	GoogleAuth(ctx context.Context) (string, string, error)
 	GoogleCallback(ctx context.Context, code, state string) (string, error)
@@ -23,10 +24,29 @@ type AuthService interface {
 // AccountService defines methods for account operations
 //go:generate mockgen -destination=./mocks/mock_account_service.go -package=mocks VDM2-BankBE/internal/service AccountService
 type AccountService interface {
-	Create(ctx context.Context, userID uuid.UUID) (*model.Account, error)
+	Create(ctx context.Context, userID uuid.UUID, accountType, name, currency string) (*model.Account, error)
 	GetByID(ctx context.Context, id uuid.UUID) (*model.Account, error)
+	GetByIBAN(ctx context.Context, iban string) (*model.Account, error)
 	GetByUserID(ctx context.Context, userID uuid.UUID) (*model.Account, error)
-	GetBalance(ctx context.Context, accountID uuid.UUID) (decimal.Decimal, error)
+	GetRecipient(ctx context.Context, username, email string) (*model.Recipient, error)
+	ListByUserID(ctx context.Context, userID uuid.UUID) ([]*model.Account, error)
+	GetForUser(ctx context.Context, userID, id uuid.UUID) (*model.Account, error)
+	SetDefault(ctx context.Context, userID, id uuid.UUID) (*model.Account, error)
+	UpdateStatus(ctx context.Context, id uuid.UUID, status string) (*model.Account, error)
+	Close(ctx context.Context, userID, id uuid.UUID, sweepTo *uuid.UUID) (*model.Account, error)
+	SetOverdraft(ctx context.Context, id uuid.UUID, limit, rate decimal.Decimal) (*model.Account, error)
+	GetBalance(ctx context.Context, accountID uuid.UUID) (*model.Balance, error)
+
+	// Co-signers
+	AddCosigner(ctx context.Context, userID, accountID uuid.UUID, username string) (*model.AccountCosigner, error)
+	ListCosigners(ctx context.Context, userID, accountID uuid.UUID) ([]*model.AccountCosigner, error)
+	RemoveCosigner(ctx context.Context, userID, accountID, cosignerID uuid.UUID) error
+}
+
+// LedgerService defines methods for posting to the double-entry ledger
+//go:generate mockgen -destination=./mocks/mock_ledger_service.go -package=mocks VDM2-BankBE/internal/service LedgerService
+type LedgerService interface {
+	Post(ctx context.Context, entry *model.JournalEntry) error
 }
 
 // MovementService defines methods for movement operations
@@ -34,36 +54,227 @@ type AccountService interface {
 type MovementService interface {
 	Create(ctx context.Context, accountID uuid.UUID, amount decimal.Decimal, movementType, description string) (*model.Movement, error)
 	GetByID(ctx context.Context, id uint64) (*model.Movement, error)
+	GetForUser(ctx context.Context, userID uuid.UUID, id uint64) (*model.MovementDetails, error)
+	GetByAccountID(ctx context.Context, accountID uuid.UUID, filter *model.MovementFilter, page, limit int) (*util.PaginatedResponse, error)
+	GetByAccountIDWithCursor(ctx context.Context, accountID uuid.UUID, filter *model.MovementFilter, cursor string, limit int) (*util.CursorResponse, error)
+}
+
+// HoldService defines methods for authorization holds
+//go:generate mockgen -destination=./mocks/mock_hold_service.go -package=mocks VDM2-BankBE/internal/service HoldService
+type HoldService interface {
+	Place(ctx context.Context, accountID uuid.UUID, amount decimal.Decimal, description string, expiresAt *time.Time) (*model.Hold, error)
+	GetByID(ctx context.Context, accountID uuid.UUID, id uint64) (*model.Hold, error)
 	GetByAccountID(ctx context.Context, accountID uuid.UUID, page, limit int) (*util.PaginatedResponse, error)
+	Capture(ctx context.Context, accountID uuid.UUID, id uint64, amount decimal.Decimal, description string) (*model.Hold, error)
+	Release(ctx context.Context, accountID uuid.UUID, id uint64) (*model.Hold, error)
+	ExpireDue(ctx context.Context, now time.Time, limit int) (int, error)
 }
 
 // TransferService defines methods for transfer operations
 //go:generate mockgen -destination=./mocks/mock_transfer_service.go -package=mocks VDM2-BankBE/internal/service TransferService
 type TransferService interface {
 	Transfer(ctx context.Context, fromAccountID, toAccountID uuid.UUID, amount decimal.Decimal, description string) (*model.Transfer, error)
+	TransferStandingOrder(ctx context.Context, fromAccountID, toAccountID uuid.UUID, amount decimal.Decimal, description string) (*model.Transfer, error)
+	Sweep(ctx context.Context, fromAccountID, toAccountID uuid.UUID, amount decimal.Decimal, description string) (*model.Transfer, error)
+	TransferConverted(ctx context.Context, fromAccountID, toAccountID uuid.UUID, amount decimal.Decimal, description string, quoteID uuid.UUID) (*model.Transfer, error)
 	GetByID(ctx context.Context, id uint64) (*model.Transfer, error)
+	GetForUser(ctx context.Context, userID uuid.UUID, id uint64) (*model.TransferDetails, error)
+	GetByAccountID(ctx context.Context, accountID uuid.UUID, filter *model.TransferFilter, page, limit int) (*util.PaginatedResponse, error)
+	GetByAccountIDWithCursor(ctx context.Context, accountID uuid.UUID, filter *model.TransferFilter, cursor string, limit int) (*util.CursorResponse, error)
+
+	// Asynchronous transfers
+	Submit(ctx context.Context, fromAccountID, toAccountID uuid.UUID, amount decimal.Decimal, description string) (*model.Transfer, error)
+	ClaimQueued(ctx context.Context, now time.Time, limit int) ([]*model.TransferOutbox, error)
+	ProcessQueued(ctx context.Context, message *model.TransferOutbox) (*model.Transfer, error)
+
+	// Batches
+	TransferAll(ctx context.Context, transfers []*model.Transfer) (int, error)
+
+	// Scheduled transfers
+	Schedule(ctx context.Context, fromAccountID, toAccountID uuid.UUID, amount decimal.Decimal, description string, executeAt time.Time) (*model.Transfer, error)
+	GetScheduledByAccountID(ctx context.Context, accountID uuid.UUID, page, limit int) (*util.PaginatedResponse, error)
+	Cancel(ctx context.Context, accountID uuid.UUID, id uint64) (*model.Transfer, error)
+	GetDueScheduled(ctx context.Context, now time.Time, limit int) ([]*model.Transfer, error)
+	ExecuteScheduled(ctx context.Context, id uint64) (*model.Transfer, error)
+
+	// Approvals
+	Approve(ctx context.Context, userID uuid.UUID, id uint64, note string) (*model.Transfer, error)
+	Reject(ctx context.Context, userID uuid.UUID, id uint64, note string) (*model.Transfer, error)
+	GetAwaitingApproval(ctx context.Context, userID uuid.UUID, page, limit int) (*util.PaginatedResponse, error)
+	GetApprovals(ctx context.Context, userID uuid.UUID, id uint64) ([]*model.TransferApproval, error)
+	ExpireApprovals(ctx context.Context, now time.Time, limit int) (int, error)
+
+	// Reversals
+	Reverse(ctx context.Context, accountID *uuid.UUID, id uint64, amount decimal.Decimal, description string) (*model.Transfer, error)
+}
+
+// FeeService defines methods for computing the fees charged by operation type
+//go:generate mockgen -destination=./mocks/mock_fee_service.go -package=mocks VDM2-BankBE/internal/service FeeService
+type FeeService interface {
+	Calculate(ctx context.Context, operation string, amount decimal.Decimal, currency string) (*model.FeeBreakdown, error)
+}
+
+// FXService defines methods for exchange rates and the quotes cross-currency transfers are made at
+//go:generate mockgen -destination=./mocks/mock_fx_service.go -package=mocks VDM2-BankBE/internal/service FXService
+type FXService interface {
+	ListRates(ctx context.Context) ([]*model.FXRate, error)
+	SetRates(ctx context.Context, updates []FXRateUpdate) ([]*model.FXRate, error)
+	Quote(ctx context.Context, userID uuid.UUID, fromCurrency, toCurrency string, amount decimal.Decimal) (*model.FXQuote, error)
+}
+
+// TransferBatchService defines methods for bulk transfers executed asynchronously
+//go:generate mockgen -destination=./mocks/mock_transfer_batch_service.go -package=mocks VDM2-BankBE/internal/service TransferBatchService
+type TransferBatchService interface {
+	Create(ctx context.Context, fromAccountID uuid.UUID, mode string, lines []TransferBatchLine) (*model.TransferBatch, error)
+	GetByID(ctx context.Context, accountID uuid.UUID, id uint64) (*model.TransferBatch, error)
+	GetPending(ctx context.Context, limit int) ([]*model.TransferBatch, error)
+	Process(ctx context.Context, id uint64) (*model.TransferBatch, error)
+}
+
+// TransferBatchLine holds a transfer of an uploaded batch as it was received, before it is validated.
+// Exactly one of ToAccount and ToIBAN must be set.
+type TransferBatchLine struct {
+	ToAccount   string
+	ToIBAN      string
+	Amount      string
+	Description string
+}
+
+// BeneficiaryService defines methods for the address book of the accounts users send transfers to
+//go:generate mockgen -destination=./mocks/mock_beneficiary_service.go -package=mocks VDM2-BankBE/internal/service BeneficiaryService
+type BeneficiaryService interface {
+	Create(ctx context.Context, beneficiary *model.Beneficiary) (*model.Beneficiary, error)
+	GetByID(ctx context.Context, userID uuid.UUID, id uint64) (*model.Beneficiary, error)
+	GetByUserID(ctx context.Context, userID uuid.UUID, page, limit int) (*util.PaginatedResponse, error)
+	Rename(ctx context.Context, userID uuid.UUID, id uint64, nickname string) (*model.Beneficiary, error)
+	Delete(ctx context.Context, userID uuid.UUID, id uint64) error
+	MarkUsed(ctx context.Context, id uint64) error
+}
+
+// PaymentRequestService defines methods for requests for money between users
+//go:generate mockgen -destination=./mocks/mock_payment_request_service.go -package=mocks VDM2-BankBE/internal/service PaymentRequestService
+type PaymentRequestService interface {
+	Create(ctx context.Context, request *model.PaymentRequest, payerUsername, payerEmail string, expiresAt *time.Time) (*model.PaymentRequest, error)
+	GetByID(ctx context.Context, userID uuid.UUID, id uint64) (*model.PaymentRequest, error)
+	GetIncoming(ctx context.Context, userID uuid.UUID, status string, page, limit int) (*util.PaginatedResponse, error)
+	GetOutgoing(ctx context.Context, userID uuid.UUID, status string, page, limit int) (*util.PaginatedResponse, error)
+	Pay(ctx context.Context, userID uuid.UUID, id uint64, fromAccountID uuid.UUID) (*model.PaymentRequest, error)
+	Decline(ctx context.Context, userID uuid.UUID, id uint64) (*model.PaymentRequest, error)
+	Cancel(ctx context.Context, userID uuid.UUID, id uint64) (*model.PaymentRequest, error)
+	ExpireDue(ctx context.Context, now time.Time) (int, error)
+}
+
+// TransferLimitService defines methods for checking and lowering the transfer limits of users
+//go:generate mockgen -destination=./mocks/mock_transfer_limit_service.go -package=mocks VDM2-BankBE/internal/service TransferLimitService
+type TransferLimitService interface {
+	GetAllowance(ctx context.Context, userID uuid.UUID, currency string) (*model.TransferAllowance, error)
+	Update(ctx context.Context, userID uuid.UUID, update TransferLimitUpdate) (*model.TransferAllowance, error)
+	Check(ctx context.Context, userID, toAccountID uuid.UUID, amount decimal.Decimal, currency string, excludeID uint64) error
+}
+
+// TransferLimitUpdate holds the limits a user lowers.
+// Nil fields are left untouched.
+type TransferLimitUpdate struct {
+	PerTransaction *decimal.Decimal
+	Daily          *decimal.Decimal
+	Monthly        *decimal.Decimal
+}
+
+// FXRateUpdate is an exchange rate to create or replace
+type FXRateUpdate struct {
+	BaseCurrency  string
+	QuoteCurrency string
+	Rate          decimal.Decimal
+}
+
+// StandingOrderService defines methods for standing order operations
+//go:generate mockgen -destination=./mocks/mock_standing_order_service.go -package=mocks VDM2-BankBE/internal/service StandingOrderService
+type StandingOrderService interface {
+	Create(ctx context.Context, order *model.StandingOrder) (*model.StandingOrder, error)
+	GetByID(ctx context.Context, accountID uuid.UUID, id uint64) (*model.StandingOrder, error)
 	GetByAccountID(ctx context.Context, accountID uuid.UUID, page, limit int) (*util.PaginatedResponse, error)
+	Update(ctx context.Context, accountID uuid.UUID, id uint64, update StandingOrderUpdate) (*model.StandingOrder, error)
+	Cancel(ctx context.Context, accountID uuid.UUID, id uint64) (*model.StandingOrder, error)
+	GetDue(ctx context.Context, now time.Time, limit int) ([]*model.StandingOrder, error)
+	Execute(ctx context.Context, order *model.StandingOrder) (*model.Transfer, error)
+}
+
+// StandingOrderUpdate holds the standing order fields a customer can change.
+// Nil fields are left untouched.
+type StandingOrderUpdate struct {
+	Amount      *decimal.Decimal
+	Description *string
+	EndDate     *time.Time
+	// Status can be set to "suspended" to pause the order or "active" to resume it
+	Status *string
+}
+
+// ReconciliationService defines methods for checking the consistency of balances, movements and transfers
+//go:generate mockgen -destination=./mocks/mock_reconciliation_service.go -package=mocks VDM2-BankBE/internal/service ReconciliationService
+type ReconciliationService interface {
+	Reconcile(ctx context.Context) (*model.ReconciliationReport, error)
+}
+
+// IdempotencyService defines methods for Idempotency-Key handling
+//go:generate mockgen -destination=./mocks/mock_idempotency_service.go -package=mocks VDM2-BankBE/internal/service IdempotencyService
+type IdempotencyService interface {
+	Begin(ctx context.Context, userID uuid.UUID, key, requestHash string) (*model.IdempotencyKey, error)
+	Complete(ctx context.Context, userID uuid.UUID, key string, statusCode int, body []byte) error
+	Release(ctx context.Context, userID uuid.UUID, key string) error
 }
 
 // Service combines all services
 type Service struct {
-	Auth     AuthService
-	Account  AccountService
-	Movement MovementService
-	Transfer TransferService
+	Auth           AuthService
+	Account        AccountService
+	Ledger         LedgerService
+	Movement       MovementService
+	Hold           HoldService
+	Transfer       TransferService
+	Fee            FeeService
+	FX             FXService
+	TransferBatch  TransferBatchService
+	TransferLimit  TransferLimitService
+	Beneficiary    BeneficiaryService
+	PaymentRequest PaymentRequestService
+	StandingOrder  StandingOrderService
+	Reconciliation ReconciliationService
+	Idempotency    IdempotencyService
 }
 
 // NewService creates a new service provider
 func NewService(
 	authService AuthService,
 	accountService AccountService,
+	ledgerService LedgerService,
 	movementService MovementService,
+	holdService HoldService,
 	transferService TransferService,
+	feeService FeeService,
+	fxService FXService,
+	transferBatchService TransferBatchService,
+	transferLimitService TransferLimitService,
+	beneficiaryService BeneficiaryService,
+	paymentRequestService PaymentRequestService,
+	standingOrderService StandingOrderService,
+	reconciliationService ReconciliationService,
+	idempotencyService IdempotencyService,
 ) *Service {
 	return &Service{
-		Auth:     authService,
-		Account:  accountService,
-		Movement: movementService,
-		Transfer: transferService,
+		Auth:           authService,
+		Account:        accountService,
+		Ledger:         ledgerService,
+		Movement:       movementService,
+		Hold:           holdService,
+		Transfer:       transferService,
+		Fee:            feeService,
+		FX:             fxService,
+		TransferBatch:  transferBatchService,
+		TransferLimit:  transferLimitService,
+		Beneficiary:    beneficiaryService,
+		PaymentRequest: paymentRequestService,
+		StandingOrder:  standingOrderService,
+		Reconciliation: reconciliationService,
+		Idempotency:    idempotencyService,
 	}
 }
@@ -0,0 +1,321 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+
+	"VDM2-BankBE/internal/config"
+	"VDM2-BankBE/internal/model"
+	"VDM2-BankBE/internal/repository"
+	"VDM2-BankBE/internal/util"
+)
+
+// DefaultStandingOrderService implements StandingOrderService
+type DefaultStandingOrderService struct {
+	standingOrderRepo repository.StandingOrderRepository
+	accountRepo       repository.AccountRepository
+	transferService   TransferService
+	db                TxDB // For transactions
+	config            *config.StandingOrderConfig
+}
+
+// NewStandingOrderService creates a new standing order service
+func NewStandingOrderService(
+	standingOrderRepo repository.StandingOrderRepository,
+	accountRepo repository.AccountRepository,
+	transferService TransferService,
+	db TxDB,
+	config *config.StandingOrderConfig,
+) StandingOrderService {
+	return &DefaultStandingOrderService{
+		standingOrderRepo: standingOrderRepo,
+		accountRepo:       accountRepo,
+		transferService:   transferService,
+		db:                db,
+		config:            config,
+	}
+}
+
+// Create validates and stores a new standing order; its first occurrence is on StartDate
+func (s *DefaultStandingOrderService) Create(ctx context.Context, order *model.StandingOrder) (*model.StandingOrder, error) {
+	// Validate amount
+	if order.Amount.LessThanOrEqual(decimal.Zero) {
+		return nil, util.NewBadRequestError("amount must be greater than zero")
+	}
+
+	// Validate schedule
+	if order.Frequency != "weekly" && order.Frequency != "monthly" {
+		return nil, util.NewBadRequestError("frequency must be 'weekly' or 'monthly'")
+	}
+	if order.Interval == 0 {
+		order.Interval = 1
+	}
+	if order.Interval < 0 {
+		return nil, util.NewBadRequestError("interval must be greater than zero")
+	}
+	if !order.StartDate.After(time.Now()) {
+		return nil, util.NewBadRequestError("start_date must be in the future")
+	}
+	if order.EndDate != nil && order.EndDate.Before(order.StartDate) {
+		return nil, util.NewBadRequestError("end_date must not be before start_date")
+	}
+
+	// Check if accounts exist
+	if _, err := s.accountRepo.GetByID(ctx, order.FromAccount); err != nil {
+		return nil, errors.Wrap(err, "failed to get source account")
+	}
+
+	if _, err := s.accountRepo.GetByID(ctx, order.ToAccount); err != nil {
+		return nil, errors.Wrap(err, "failed to get destination account")
+	}
+
+	// Check for self-transfer
+	if order.FromAccount == order.ToAccount {
+		return nil, util.NewBadRequestError("cannot transfer to the same account")
+	}
+
+	nextRunAt := order.StartDate
+	order.Status = "active"
+	order.NextRunAt = &nextRunAt
+	order.Occurrences = 0
+	order.ConsecutiveFailures = 0
+
+	if err := s.standingOrderRepo.Create(ctx, order); err != nil {
+		return nil, errors.Wrap(err, "failed to create standing order")
+	}
+
+	return order, nil
+}
+
+// GetByID retrieves a standing order of accountID
+func (s *DefaultStandingOrderService) GetByID(ctx context.Context, accountID uuid.UUID, id uint64) (*model.StandingOrder, error) {
+	order, err := s.standingOrderRepo.GetByID(ctx, id)
+	if err != nil {
+		if _, ok := err.(*util.APIError); ok {
+			return nil, err
+		}
+		return nil, errors.Wrap(err, "failed to get standing order")
+	}
+
+	// Orders of other accounts are reported as missing
+	if order.FromAccount != accountID {
+		return nil, util.NewNotFoundError("standing order not found")
+	}
+
+	return order, nil
+}
+
+// GetByAccountID retrieves the standing orders of an account with pagination
+func (s *DefaultStandingOrderService) GetByAccountID(ctx context.Context, accountID uuid.UUID, page, limit int) (*util.PaginatedResponse, error) {
+	// Create pagination params
+	params, err := util.NewPaginationParams(strconv.Itoa(page), strconv.Itoa(limit))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid pagination parameters")
+	}
+
+	// Get standing orders
+	orders, count, err := s.standingOrderRepo.GetByAccountID(ctx, accountID, params)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get standing orders")
+	}
+
+	// Create paginated response
+	response := util.NewPaginatedResponse(orders, params, count)
+	return response, nil
+}
+
+// Update changes the amount, description or end date of a standing order, or pauses and resumes it
+func (s *DefaultStandingOrderService) Update(
+	ctx context.Context,
+	accountID uuid.UUID,
+	id uint64,
+	update StandingOrderUpdate,
+) (*model.StandingOrder, error) {
+	order, err := s.GetByID(ctx, accountID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if order.Status == "completed" || order.Status == "cancelled" {
+		return nil, util.NewConflictError("standing order is " + order.Status)
+	}
+
+	if update.Amount != nil {
+		if update.Amount.LessThanOrEqual(decimal.Zero) {
+			return nil, util.NewBadRequestError("amount must be greater than zero")
+		}
+		order.Amount = *update.Amount
+	}
+
+	if update.Description != nil {
+		order.Description = *update.Description
+	}
+
+	if update.EndDate != nil {
+		if update.EndDate.Before(order.StartDate) {
+			return nil, util.NewBadRequestError("end_date must not be before start_date")
+		}
+		order.EndDate = update.EndDate
+	}
+
+	if update.Status != nil && *update.Status != order.Status {
+		switch *update.Status {
+		case "suspended":
+			order.Status = "suspended"
+		case "active":
+			// Resume from the next occurrence instead of catching up on the missed ones
+			order.Status = "active"
+			order.ConsecutiveFailures = 0
+			s.advance(order, time.Now())
+		default:
+			return nil, util.NewBadRequestError("status must be 'active' or 'suspended'")
+		}
+	}
+
+	// The end date may now be before the next occurrence
+	if order.Status == "active" && order.EndDate != nil && order.NextRunAt != nil && order.NextRunAt.After(*order.EndDate) {
+		order.Status = "completed"
+		order.NextRunAt = nil
+	}
+
+	if err := s.standingOrderRepo.Update(ctx, order); err != nil {
+		return nil, errors.Wrap(err, "failed to update standing order")
+	}
+
+	return order, nil
+}
+
+// Cancel stops a standing order for good
+func (s *DefaultStandingOrderService) Cancel(ctx context.Context, accountID uuid.UUID, id uint64) (*model.StandingOrder, error) {
+	order, err := s.GetByID(ctx, accountID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if order.Status == "completed" || order.Status == "cancelled" {
+		return nil, util.NewConflictError("standing order is " + order.Status)
+	}
+
+	order.Status = "cancelled"
+	order.NextRunAt = nil
+	if err := s.standingOrderRepo.Update(ctx, order); err != nil {
+		return nil, errors.Wrap(err, "failed to cancel standing order")
+	}
+
+	return order, nil
+}
+
+// GetDue retrieves up to limit active standing orders that are due at now
+func (s *DefaultStandingOrderService) GetDue(ctx context.Context, now time.Time, limit int) ([]*model.StandingOrder, error) {
+	orders, err := s.standingOrderRepo.GetDue(ctx, now, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get due standing orders")
+	}
+
+	return orders, nil
+}
+
+// Execute materialises the current occurrence of a due standing order as a transfer.
+// The transfer and the occurrence are recorded in one transaction, so that the occurrence is paid once.
+// A failed occurrence is retried after the configured delay, and the order is suspended
+// once it has failed MaxConsecutiveFailures times in a row.
+func (s *DefaultStandingOrderService) Execute(ctx context.Context, order *model.StandingOrder) (*model.Transfer, error) {
+	if order.NextRunAt == nil {
+		return nil, util.NewConflictError("standing order is not scheduled")
+	}
+
+	// Claim the occurrence so that it is executed once, even with several schedulers running.
+	// Should the process die mid-way, the occurrence is picked up again once the lease expires.
+	now := time.Now()
+	leaseUntil := now.Add(s.config.RetryDelay)
+	claimed, err := s.standingOrderRepo.Claim(ctx, order.ID, *order.NextRunAt, leaseUntil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to claim standing order")
+	}
+	if !claimed {
+		return nil, util.NewConflictError("standing order occurrence already claimed")
+	}
+	order.NextRunAt = &leaseUntil
+	order.LastRunAt = &now
+
+	// The transfer joins the transaction through the context, and is rolled back when the order changed meanwhile
+	var transfer *model.Transfer
+	var transferErr error
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		txCtx := repository.WithTx(ctx, tx)
+
+		transfer, transferErr = s.transferService.TransferStandingOrder(txCtx, order.FromAccount, order.ToAccount, order.Amount, order.Description)
+		if transferErr != nil {
+			return transferErr
+		}
+
+		run := *order
+		run.ConsecutiveFailures = 0
+		run.LastError = ""
+		run.LastTransferID = &transfer.ID
+		run.Occurrences++
+		s.advance(&run, time.Time{})
+		if err := s.recordRun(txCtx, &run, leaseUntil); err != nil {
+			return err
+		}
+
+		*order = run
+		return nil
+	})
+	if transferErr != nil {
+		order.ConsecutiveFailures++
+		order.LastError = util.ClientMessage(transferErr, "transfer failed")
+		if order.ConsecutiveFailures >= s.config.MaxConsecutiveFailures {
+			order.Status = "suspended"
+		}
+		if err := s.recordRun(ctx, order, leaseUntil); err != nil {
+			return nil, err
+		}
+		return nil, transferErr
+	}
+	if err != nil {
+		if _, ok := err.(*util.APIError); ok {
+			return nil, err
+		}
+		return nil, errors.Wrap(err, "failed to execute standing order")
+	}
+
+	return transfer, nil
+}
+
+// recordRun saves the outcome of an occurrence claimed until leaseUntil, failing with a conflict when the order
+// was cancelled or edited in the meantime
+func (s *DefaultStandingOrderService) recordRun(ctx context.Context, order *model.StandingOrder, leaseUntil time.Time) error {
+	recorded, err := s.standingOrderRepo.RecordRun(ctx, order, leaseUntil)
+	if err != nil {
+		return errors.Wrap(err, "failed to update standing order")
+	}
+	if !recorded {
+		return util.NewConflictError("standing order changed while executing it")
+	}
+
+	return nil
+}
+
+// advance moves NextRunAt to the first occurrence not before notBefore, completing the order after its end date
+func (s *DefaultStandingOrderService) advance(order *model.StandingOrder, notBefore time.Time) {
+	next := order.Occurrence(order.Occurrences)
+	for next.Before(notBefore) {
+		order.Occurrences++
+		next = order.Occurrence(order.Occurrences)
+	}
+
+	if order.EndDate != nil && next.After(*order.EndDate) {
+		order.Status = "completed"
+		order.NextRunAt = nil
+		return
+	}
+
+	order.NextRunAt = &next
+}
@@ -0,0 +1,440 @@
+package service_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+
+	"VDM2-BankBE/internal/config"
+	"VDM2-BankBE/internal/model"
+	"VDM2-BankBE/internal/repository"
+	repmocks "VDM2-BankBE/internal/repository/mocks"
+	"VDM2-BankBE/internal/service"
+	servicemocks "VDM2-BankBE/internal/service/mocks"
+	"VDM2-BankBE/internal/util"
+)
+
+var standingOrderConfig = &config.StandingOrderConfig{RetryDelay: 6 * time.Hour, MaxConsecutiveFailures: 3}
+
+func TestStandingOrderService_Create(t *testing.T) {
+	t.Parallel()
+
+	fromAccountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440400")
+	toAccountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440401")
+	amount, _ := decimal.NewFromString("25.00")
+	startDate := time.Now().Add(24 * time.Hour)
+
+	tests := []struct {
+		name       string
+		order      model.StandingOrder
+		buildMocks func(ctrl *gomock.Controller) (*repmocks.MockStandingOrderRepository, *repmocks.MockAccountRepository)
+		wantCode   int
+	}{
+		{
+			name:  "start date in the past returns 400",
+			order: model.StandingOrder{FromAccount: fromAccountID, ToAccount: toAccountID, Amount: amount, Frequency: "monthly", StartDate: time.Now().Add(-time.Hour)},
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockStandingOrderRepository, *repmocks.MockAccountRepository) {
+				return repmocks.NewMockStandingOrderRepository(ctrl), repmocks.NewMockAccountRepository(ctrl)
+			},
+			wantCode: 400,
+		},
+		{
+			name:  "unknown frequency returns 400",
+			order: model.StandingOrder{FromAccount: fromAccountID, ToAccount: toAccountID, Amount: amount, Frequency: "daily", StartDate: startDate},
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockStandingOrderRepository, *repmocks.MockAccountRepository) {
+				return repmocks.NewMockStandingOrderRepository(ctrl), repmocks.NewMockAccountRepository(ctrl)
+			},
+			wantCode: 400,
+		},
+		{
+			name:  "success defaults interval and schedules the first occurrence on the start date",
+			order: model.StandingOrder{FromAccount: fromAccountID, ToAccount: toAccountID, Amount: amount, Frequency: "weekly", StartDate: startDate},
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockStandingOrderRepository, *repmocks.MockAccountRepository) {
+				standingOrderRepo := repmocks.NewMockStandingOrderRepository(ctrl)
+				accountRepo := repmocks.NewMockAccountRepository(ctrl)
+
+				accountRepo.EXPECT().GetByID(gomock.Any(), fromAccountID).Return(&model.Account{ID: fromAccountID}, nil)
+				accountRepo.EXPECT().GetByID(gomock.Any(), toAccountID).Return(&model.Account{ID: toAccountID}, nil)
+				standingOrderRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+				return standingOrderRepo, accountRepo
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			standingOrderRepo, accountRepo := tc.buildMocks(ctrl)
+			svc := service.NewStandingOrderService(standingOrderRepo, accountRepo, servicemocks.NewMockTransferService(ctrl), servicemocks.NewMockTxDB(ctrl), standingOrderConfig)
+
+			order := tc.order
+			got, err := svc.Create(context.Background(), &order)
+			if tc.wantCode != 0 {
+				apiErr, ok := err.(*util.APIError)
+				if !ok || apiErr.Code != tc.wantCode {
+					t.Fatalf("expected %d APIError, got %#v", tc.wantCode, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Status != "active" || got.Interval != 1 {
+				t.Fatalf("unexpected standing order: %+v", got)
+			}
+			if got.NextRunAt == nil || !got.NextRunAt.Equal(startDate) {
+				t.Fatalf("unexpected next run: %v", got.NextRunAt)
+			}
+		})
+	}
+}
+
+func TestStandingOrderService_Execute(t *testing.T) {
+	t.Parallel()
+
+	fromAccountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440410")
+	toAccountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440411")
+	amount, _ := decimal.NewFromString("25.00")
+
+	// Monthly orders starting on the 31st fall on the last day of shorter months
+	startDate := time.Date(2025, time.January, 31, 9, 0, 0, 0, time.UTC)
+	endDate := time.Date(2025, time.February, 27, 0, 0, 0, 0, time.UTC)
+
+	newOrder := func(failures int) *model.StandingOrder {
+		nextRunAt := startDate
+		return &model.StandingOrder{
+			ID:                  9,
+			FromAccount:         fromAccountID,
+			ToAccount:           toAccountID,
+			Amount:              amount,
+			Description:         "rent",
+			Frequency:           "monthly",
+			Interval:            1,
+			StartDate:           startDate,
+			Status:              "active",
+			NextRunAt:           &nextRunAt,
+			ConsecutiveFailures: failures,
+		}
+	}
+
+	inTransaction := func(ctrl *gomock.Controller) *servicemocks.MockTxDB {
+		txdb := servicemocks.NewMockTxDB(ctrl)
+		txdb.EXPECT().
+			Transaction(gomock.Any()).
+			DoAndReturn(func(fc func(tx *gorm.DB) error, opts ...*sql.TxOptions) error {
+				return fc(&gorm.DB{})
+			})
+		return txdb
+	}
+
+	tests := []struct {
+		name       string
+		order      *model.StandingOrder
+		buildMocks func(ctrl *gomock.Controller) (*repmocks.MockStandingOrderRepository, *servicemocks.MockTransferService, *servicemocks.MockTxDB)
+		wantCode   int
+		assert     func(t *testing.T, order *model.StandingOrder)
+	}{
+		{
+			name:  "occurrence claimed by another scheduler returns 409",
+			order: newOrder(0),
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockStandingOrderRepository, *servicemocks.MockTransferService, *servicemocks.MockTxDB) {
+				standingOrderRepo := repmocks.NewMockStandingOrderRepository(ctrl)
+				standingOrderRepo.EXPECT().Claim(gomock.Any(), uint64(9), startDate, gomock.Any()).Return(false, nil)
+				return standingOrderRepo, servicemocks.NewMockTransferService(ctrl), servicemocks.NewMockTxDB(ctrl)
+			},
+			wantCode: 409,
+		},
+		{
+			name:  "success advances to the end of the next month",
+			order: newOrder(2),
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockStandingOrderRepository, *servicemocks.MockTransferService, *servicemocks.MockTxDB) {
+				standingOrderRepo := repmocks.NewMockStandingOrderRepository(ctrl)
+				transferSvc := servicemocks.NewMockTransferService(ctrl)
+
+				var leaseUntil time.Time
+				standingOrderRepo.EXPECT().Claim(gomock.Any(), uint64(9), startDate, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ uint64, _, lease time.Time) (bool, error) {
+						leaseUntil = lease
+						return true, nil
+					})
+				// The transfer and the occurrence are recorded in the same transaction
+				transferSvc.EXPECT().TransferStandingOrder(gomock.Any(), fromAccountID, toAccountID, amount, "rent").
+					DoAndReturn(func(ctx context.Context, _, _ uuid.UUID, _ decimal.Decimal, _ string) (*model.Transfer, error) {
+						if _, ok := repository.TxFromContext(ctx); !ok {
+							t.Fatal("expected the transfer to join the transaction")
+						}
+						return &model.Transfer{ID: 77, Status: "completed"}, nil
+					})
+				standingOrderRepo.EXPECT().RecordRun(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, _ *model.StandingOrder, lease time.Time) (bool, error) {
+						if _, ok := repository.TxFromContext(ctx); !ok || !lease.Equal(leaseUntil) {
+							t.Fatalf("expected the run to be recorded in the transaction for the claimed lease, got %v", lease)
+						}
+						return true, nil
+					})
+
+				return standingOrderRepo, transferSvc, inTransaction(ctrl)
+			},
+			assert: func(t *testing.T, order *model.StandingOrder) {
+				want := time.Date(2025, time.February, 28, 9, 0, 0, 0, time.UTC)
+				if order.NextRunAt == nil || !order.NextRunAt.Equal(want) {
+					t.Fatalf("unexpected next run: got=%v want=%v", order.NextRunAt, want)
+				}
+				if order.Occurrences != 1 || order.ConsecutiveFailures != 0 || *order.LastTransferID != 77 {
+					t.Fatalf("unexpected standing order: %+v", order)
+				}
+			},
+		},
+		{
+			name:  "order cancelled while executing rolls the transfer back",
+			order: newOrder(0),
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockStandingOrderRepository, *servicemocks.MockTransferService, *servicemocks.MockTxDB) {
+				standingOrderRepo := repmocks.NewMockStandingOrderRepository(ctrl)
+				transferSvc := servicemocks.NewMockTransferService(ctrl)
+
+				standingOrderRepo.EXPECT().Claim(gomock.Any(), uint64(9), startDate, gomock.Any()).Return(true, nil)
+				transferSvc.EXPECT().TransferStandingOrder(gomock.Any(), fromAccountID, toAccountID, amount, "rent").Return(&model.Transfer{ID: 79, Status: "completed"}, nil)
+				standingOrderRepo.EXPECT().RecordRun(gomock.Any(), gomock.Any(), gomock.Any()).Return(false, nil)
+
+				return standingOrderRepo, transferSvc, inTransaction(ctrl)
+			},
+			wantCode: 409,
+			assert: func(t *testing.T, order *model.StandingOrder) {
+				if order.Occurrences != 0 || order.LastTransferID != nil {
+					t.Fatalf("expected the occurrence not to be recorded, got %+v", order)
+				}
+			},
+		},
+		{
+			name: "last occurrence before the end date completes the order",
+			order: func() *model.StandingOrder {
+				order := newOrder(0)
+				order.EndDate = &endDate
+				return order
+			}(),
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockStandingOrderRepository, *servicemocks.MockTransferService, *servicemocks.MockTxDB) {
+				standingOrderRepo := repmocks.NewMockStandingOrderRepository(ctrl)
+				transferSvc := servicemocks.NewMockTransferService(ctrl)
+
+				standingOrderRepo.EXPECT().Claim(gomock.Any(), uint64(9), startDate, gomock.Any()).Return(true, nil)
+				transferSvc.EXPECT().TransferStandingOrder(gomock.Any(), fromAccountID, toAccountID, amount, "rent").Return(&model.Transfer{ID: 78, Status: "completed"}, nil)
+				standingOrderRepo.EXPECT().RecordRun(gomock.Any(), gomock.Any(), gomock.Any()).Return(true, nil)
+
+				return standingOrderRepo, transferSvc, inTransaction(ctrl)
+			},
+			assert: func(t *testing.T, order *model.StandingOrder) {
+				if order.Status != "completed" || order.NextRunAt != nil {
+					t.Fatalf("expected completed order, got %+v", order)
+				}
+			},
+		},
+		{
+			name:  "failed transfer is retried later",
+			order: newOrder(0),
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockStandingOrderRepository, *servicemocks.MockTransferService, *servicemocks.MockTxDB) {
+				standingOrderRepo := repmocks.NewMockStandingOrderRepository(ctrl)
+				transferSvc := servicemocks.NewMockTransferService(ctrl)
+
+				standingOrderRepo.EXPECT().Claim(gomock.Any(), uint64(9), startDate, gomock.Any()).Return(true, nil)
+				transferSvc.EXPECT().TransferStandingOrder(gomock.Any(), fromAccountID, toAccountID, amount, "rent").Return(nil, util.NewBadRequestError("insufficient funds"))
+				standingOrderRepo.EXPECT().RecordRun(gomock.Any(), gomock.Any(), gomock.Any()).Return(true, nil)
+
+				return standingOrderRepo, transferSvc, inTransaction(ctrl)
+			},
+			wantCode: 400,
+			assert: func(t *testing.T, order *model.StandingOrder) {
+				if order.Status != "active" || order.ConsecutiveFailures != 1 || order.LastError != "insufficient funds" {
+					t.Fatalf("unexpected standing order: %+v", order)
+				}
+				if order.NextRunAt == nil || !order.NextRunAt.After(time.Now().Add(5*time.Hour)) {
+					t.Fatalf("expected retry after the configured delay, got %v", order.NextRunAt)
+				}
+			},
+		},
+		{
+			name:  "transfer from a business account needing approval is not counted as an occurrence",
+			order: newOrder(0),
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockStandingOrderRepository, *servicemocks.MockTransferService, *servicemocks.MockTxDB) {
+				standingOrderRepo := repmocks.NewMockStandingOrderRepository(ctrl)
+				transferSvc := servicemocks.NewMockTransferService(ctrl)
+
+				standingOrderRepo.EXPECT().Claim(gomock.Any(), uint64(9), startDate, gomock.Any()).Return(true, nil)
+				transferSvc.EXPECT().TransferStandingOrder(gomock.Any(), fromAccountID, toAccountID, amount, "rent").
+					Return(nil, util.NewUnprocessableEntityError("transfers needing approval cannot be executed immediately"))
+				standingOrderRepo.EXPECT().RecordRun(gomock.Any(), gomock.Any(), gomock.Any()).Return(true, nil)
+
+				return standingOrderRepo, transferSvc, inTransaction(ctrl)
+			},
+			wantCode: 422,
+			assert: func(t *testing.T, order *model.StandingOrder) {
+				if order.Occurrences != 0 || order.LastTransferID != nil || order.ConsecutiveFailures != 1 ||
+					order.LastError != "transfers needing approval cannot be executed immediately" {
+					t.Fatalf("unexpected standing order: %+v", order)
+				}
+			},
+		},
+		{
+			name:  "too many consecutive failures suspend the order",
+			order: newOrder(2),
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockStandingOrderRepository, *servicemocks.MockTransferService, *servicemocks.MockTxDB) {
+				standingOrderRepo := repmocks.NewMockStandingOrderRepository(ctrl)
+				transferSvc := servicemocks.NewMockTransferService(ctrl)
+
+				standingOrderRepo.EXPECT().Claim(gomock.Any(), uint64(9), startDate, gomock.Any()).Return(true, nil)
+				transferSvc.EXPECT().TransferStandingOrder(gomock.Any(), fromAccountID, toAccountID, amount, "rent").Return(nil, util.NewBadRequestError("insufficient funds"))
+				standingOrderRepo.EXPECT().RecordRun(gomock.Any(), gomock.Any(), gomock.Any()).Return(true, nil)
+
+				return standingOrderRepo, transferSvc, inTransaction(ctrl)
+			},
+			wantCode: 400,
+			assert: func(t *testing.T, order *model.StandingOrder) {
+				if order.Status != "suspended" || order.ConsecutiveFailures != 3 {
+					t.Fatalf("expected suspended order, got %+v", order)
+				}
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			standingOrderRepo, transferSvc, txdb := tc.buildMocks(ctrl)
+			svc := service.NewStandingOrderService(standingOrderRepo, repmocks.NewMockAccountRepository(ctrl), transferSvc, txdb, standingOrderConfig)
+
+			_, err := svc.Execute(context.Background(), tc.order)
+			if tc.wantCode != 0 {
+				apiErr, ok := err.(*util.APIError)
+				if !ok || apiErr.Code != tc.wantCode {
+					t.Fatalf("expected %d APIError, got %#v", tc.wantCode, err)
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tc.assert != nil {
+				tc.assert(t, tc.order)
+			}
+		})
+	}
+}
+
+func TestStandingOrderService_Update(t *testing.T) {
+	t.Parallel()
+
+	accountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440420")
+	id := uint64(11)
+	active, suspended := "active", "suspended"
+
+	tests := []struct {
+		name       string
+		status     string
+		update     service.StandingOrderUpdate
+		buildMocks func(ctrl *gomock.Controller, order *model.StandingOrder) *repmocks.MockStandingOrderRepository
+		wantCode   int
+		assert     func(t *testing.T, got *model.StandingOrder)
+	}{
+		{
+			name:   "cancelled order returns 409",
+			status: "cancelled",
+			update: service.StandingOrderUpdate{Status: &active},
+			buildMocks: func(ctrl *gomock.Controller, order *model.StandingOrder) *repmocks.MockStandingOrderRepository {
+				standingOrderRepo := repmocks.NewMockStandingOrderRepository(ctrl)
+				standingOrderRepo.EXPECT().GetByID(gomock.Any(), id).Return(order, nil)
+				return standingOrderRepo
+			},
+			wantCode: 409,
+		},
+		{
+			name:   "suspend keeps the schedule",
+			status: "active",
+			update: service.StandingOrderUpdate{Status: &suspended},
+			buildMocks: func(ctrl *gomock.Controller, order *model.StandingOrder) *repmocks.MockStandingOrderRepository {
+				standingOrderRepo := repmocks.NewMockStandingOrderRepository(ctrl)
+				standingOrderRepo.EXPECT().GetByID(gomock.Any(), id).Return(order, nil)
+				standingOrderRepo.EXPECT().Update(gomock.Any(), order).Return(nil)
+				return standingOrderRepo
+			},
+			assert: func(t *testing.T, got *model.StandingOrder) {
+				if got.Status != "suspended" || got.Occurrences != 0 {
+					t.Fatalf("unexpected standing order: %+v", got)
+				}
+			},
+		},
+		{
+			name:   "resume skips the missed occurrences",
+			status: "suspended",
+			update: service.StandingOrderUpdate{Status: &active},
+			buildMocks: func(ctrl *gomock.Controller, order *model.StandingOrder) *repmocks.MockStandingOrderRepository {
+				standingOrderRepo := repmocks.NewMockStandingOrderRepository(ctrl)
+				standingOrderRepo.EXPECT().GetByID(gomock.Any(), id).Return(order, nil)
+				standingOrderRepo.EXPECT().Update(gomock.Any(), order).Return(nil)
+				return standingOrderRepo
+			},
+			assert: func(t *testing.T, got *model.StandingOrder) {
+				if got.Status != "active" || got.ConsecutiveFailures != 0 || got.Occurrences != 3 {
+					t.Fatalf("unexpected standing order: %+v", got)
+				}
+				if got.NextRunAt == nil || got.NextRunAt.Before(time.Now()) {
+					t.Fatalf("expected next run in the future, got %v", got.NextRunAt)
+				}
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Weekly order started 15 days ago: occurrences 0, 1 and 2 are in the past
+			startDate := time.Now().Add(-15 * 24 * time.Hour)
+			order := &model.StandingOrder{
+				ID:                  id,
+				FromAccount:         accountID,
+				Frequency:           "weekly",
+				Interval:            1,
+				StartDate:           startDate,
+				Status:              tc.status,
+				NextRunAt:           &startDate,
+				ConsecutiveFailures: 3,
+			}
+
+			svc := service.NewStandingOrderService(
+				tc.buildMocks(ctrl, order),
+				repmocks.NewMockAccountRepository(ctrl),
+				servicemocks.NewMockTransferService(ctrl),
+				servicemocks.NewMockTxDB(ctrl),
+				standingOrderConfig,
+			)
+
+			got, err := svc.Update(context.Background(), accountID, id, tc.update)
+			if tc.wantCode != 0 {
+				apiErr, ok := err.(*util.APIError)
+				if !ok || apiErr.Code != tc.wantCode {
+					t.Fatalf("expected %d APIError, got %#v", tc.wantCode, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			tc.assert(t, got)
+		})
+	}
+}
@@ -0,0 +1,467 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+
+	"VDM2-BankBE/internal/config"
+	"VDM2-BankBE/internal/model"
+	"VDM2-BankBE/internal/repository"
+	"VDM2-BankBE/internal/util"
+)
+
+// DefaultTransferBatchService implements TransferBatchService
+type DefaultTransferBatchService struct {
+	batchRepo       repository.TransferBatchRepository
+	accountRepo     repository.AccountRepository
+	holdRepo        repository.HoldRepository
+	transferService TransferService
+	limitService    TransferLimitService
+	feeService      FeeService
+	db              TxDB // For transactions
+	config          *config.TransferBatchConfig
+}
+
+// NewTransferBatchService creates a new transfer batch service
+func NewTransferBatchService(
+	batchRepo repository.TransferBatchRepository,
+	accountRepo repository.AccountRepository,
+	holdRepo repository.HoldRepository,
+	transferService TransferService,
+	limitService TransferLimitService,
+	feeService FeeService,
+	db TxDB,
+	config *config.TransferBatchConfig,
+) TransferBatchService {
+	return &DefaultTransferBatchService{
+		batchRepo:       batchRepo,
+		accountRepo:     accountRepo,
+		holdRepo:        holdRepo,
+		transferService: transferService,
+		limitService:    limitService,
+		feeService:      feeService,
+		db:              db,
+		config:          config,
+	}
+}
+
+// Create validates every line of a batch sent from fromAccountID, reserves the total on the account, fees included,
+// and stores the batch to be processed asynchronously. Invalid lines are all reported at once in the details of a 422 error.
+func (s *DefaultTransferBatchService) Create(
+	ctx context.Context,
+	fromAccountID uuid.UUID,
+	mode string,
+	lines []TransferBatchLine,
+) (*model.TransferBatch, error) {
+	// Validate mode and size
+	if mode == "" {
+		mode = "all_or_nothing"
+	}
+	if mode != "all_or_nothing" && mode != "best_effort" {
+		return nil, util.NewBadRequestError("mode must be all_or_nothing or best_effort")
+	}
+	if len(lines) == 0 {
+		return nil, util.NewBadRequestError("batch has no transfers")
+	}
+	if len(lines) > s.config.MaxItems {
+		return nil, util.NewBadRequestError("batch has more than " + strconv.Itoa(s.config.MaxItems) + " transfers")
+	}
+
+	// Check that the source account can be debited
+	fromAccount, err := s.accountRepo.GetByID(ctx, fromAccountID)
+	if err != nil {
+		if _, ok := err.(*util.APIError); ok {
+			return nil, err
+		}
+		return nil, errors.Wrap(err, "failed to get source account")
+	}
+	if !fromAccount.CanSend() {
+		return nil, util.NewConflictError("source account is " + fromAccount.Status)
+	}
+
+	// The allowance is converted to the currency of the batch
+	allowance, err := s.limitService.GetAllowance(ctx, fromAccount.UserID, fromAccount.Currency)
+	if err != nil {
+		if _, ok := err.(*util.APIError); ok {
+			return nil, err
+		}
+		return nil, errors.Wrap(err, "failed to get transfer limits")
+	}
+
+	batch := &model.TransferBatch{
+		FromAccount: fromAccountID,
+		Mode:        mode,
+		Status:      "pending",
+		ItemCount:   len(lines),
+	}
+
+	// Validate every line, collecting the errors instead of stopping at the first one
+	var details []util.ErrorDetail
+	limited := decimal.Zero
+	reserved := decimal.Zero
+	for i, line := range lines {
+		item, toAccount, err := s.parseLine(ctx, fromAccount, line)
+		if err == nil && toAccount.UserID != fromAccount.UserID {
+			if item.Amount.GreaterThan(allowance.PerTransactionLimit) {
+				err = util.NewTransferLimitError("per_transaction")
+			} else {
+				err = s.applyFee(ctx, item, fromAccount.Currency)
+			}
+		}
+		if err != nil {
+			apiErr, ok := err.(*util.APIError)
+			if !ok {
+				return nil, err
+			}
+			details = append(details, util.ErrorDetail{Line: i + 1, Message: apiErr.Message})
+			continue
+		}
+
+		item.Line = i + 1
+		item.Status = "pending"
+		batch.Items = append(batch.Items, item)
+		batch.TotalAmount = batch.TotalAmount.Add(item.Amount)
+		reserved = reserved.Add(item.Amount).Add(item.Fee)
+
+		// Transfers between accounts of the same user are not limited
+		if toAccount.UserID != fromAccount.UserID {
+			limited = limited.Add(item.Amount)
+		}
+	}
+	if len(details) > 0 {
+		message := strconv.Itoa(len(details)) + " of " + strconv.Itoa(len(lines)) + " transfers are invalid"
+		return nil, util.NewUnprocessableEntityError(message).WithDetails(details)
+	}
+
+	// The limits are checked again for every transfer when it is executed
+	if limited.GreaterThan(allowance.DailyRemaining) {
+		return nil, util.NewTransferLimitError("daily")
+	}
+	if limited.GreaterThan(allowance.MonthlyRemaining) {
+		return nil, util.NewTransferLimitError("monthly")
+	}
+
+	// Reserve the total and the fees and store the batch together; the hold repository checks the available balance
+	hold := &model.Hold{
+		AccountID:   fromAccountID,
+		Amount:      reserved,
+		Description: "Transfer batch of " + strconv.Itoa(len(lines)) + " transfers",
+		Status:      "active",
+		ExpiresAt:   time.Now().Add(s.config.HoldTTL),
+	}
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		txCtx := repository.WithTx(ctx, tx)
+
+		if err := s.holdRepo.Create(txCtx, hold); err != nil {
+			if _, ok := err.(*util.APIError); ok {
+				return err
+			}
+			return errors.Wrap(err, "failed to reserve batch total")
+		}
+
+		batch.HoldID = &hold.ID
+		if err := s.batchRepo.Create(txCtx, batch); err != nil {
+			return errors.Wrap(err, "failed to create transfer batch")
+		}
+
+		return nil
+	})
+	if err != nil {
+		if _, ok := err.(*util.APIError); ok {
+			return nil, err
+		}
+		return nil, errors.Wrap(err, "failed to create transfer batch")
+	}
+
+	return batch, nil
+}
+
+// parseLine validates a line of a batch and resolves its recipient
+func (s *DefaultTransferBatchService) parseLine(
+	ctx context.Context,
+	fromAccount *model.Account,
+	line TransferBatchLine,
+) (*model.TransferBatchItem, *model.Account, error) {
+	// Validate amount
+	amount, err := decimal.NewFromString(strings.TrimSpace(line.Amount))
+	if err != nil {
+		return nil, nil, util.NewBadRequestError("invalid amount")
+	}
+	if amount.LessThanOrEqual(decimal.Zero) {
+		return nil, nil, util.NewBadRequestError("amount must be greater than zero")
+	}
+	if err := util.ValidateAmountPrecision(amount, fromAccount.Currency); err != nil {
+		return nil, nil, util.NewBadRequestError(err.Error())
+	}
+
+	// Resolve the recipient
+	toAccountID := strings.TrimSpace(line.ToAccount)
+	toIBAN := util.NormalizeIBAN(line.ToIBAN)
+	var toAccount *model.Account
+	switch {
+	case (toAccountID == "") == (toIBAN == ""):
+		return nil, nil, util.NewBadRequestError("exactly one of to_account and to_iban is required")
+	case toAccountID != "":
+		id, err := uuid.Parse(toAccountID)
+		if err != nil {
+			return nil, nil, util.NewBadRequestError("invalid to_account")
+		}
+		toAccount, err = s.accountRepo.GetByID(ctx, id)
+		if err != nil {
+			return nil, nil, err
+		}
+	default:
+		if err := util.ValidateIBAN(toIBAN); err != nil {
+			return nil, nil, util.NewBadRequestError(err.Error())
+		}
+		toAccount, err = s.accountRepo.GetByIBAN(ctx, toIBAN)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if toAccount.ID == fromAccount.ID {
+		return nil, nil, util.NewBadRequestError("cannot transfer to the same account")
+	}
+	if !toAccount.CanReceive() {
+		return nil, nil, util.NewConflictError("destination account is " + toAccount.Status)
+	}
+	// Batches are not converted, as quotes expire before batches are processed
+	if toAccount.Currency != fromAccount.Currency {
+		return nil, nil, util.NewUnprocessableEntityError("destination account is in " + toAccount.Currency + ", not " + fromAccount.Currency)
+	}
+
+	item := &model.TransferBatchItem{
+		ToAccount:   toAccount.ID,
+		Amount:      amount,
+		Description: strings.TrimSpace(line.Description),
+	}
+	return item, toAccount, nil
+}
+
+// applyFee sets the fee of an item to the one of the instant transfer it is executed as
+func (s *DefaultTransferBatchService) applyFee(ctx context.Context, item *model.TransferBatchItem, currency string) error {
+	breakdown, err := s.feeService.Calculate(ctx, FeeOperationInstantTransfer, item.Amount, currency)
+	if err != nil {
+		if _, ok := err.(*util.APIError); ok {
+			return err
+		}
+		return errors.Wrap(err, "failed to calculate fee")
+	}
+
+	item.Fee = breakdown.Total
+	return nil
+}
+
+// GetByID retrieves a batch sent from accountID with its items
+func (s *DefaultTransferBatchService) GetByID(ctx context.Context, accountID uuid.UUID, id uint64) (*model.TransferBatch, error) {
+	batch, err := s.batchRepo.GetByID(ctx, id)
+	if err != nil {
+		if _, ok := err.(*util.APIError); ok {
+			return nil, err
+		}
+		return nil, errors.Wrap(err, "failed to get transfer batch")
+	}
+
+	// Batches of other accounts are reported as missing
+	if batch.FromAccount != accountID {
+		return nil, util.NewNotFoundError("transfer batch not found")
+	}
+
+	return batch, nil
+}
+
+// GetPending retrieves up to limit batches waiting to be processed, including those whose processing stopped
+// mid-way and whose lease expired
+func (s *DefaultTransferBatchService) GetPending(ctx context.Context, limit int) ([]*model.TransferBatch, error) {
+	batches, err := s.batchRepo.GetPending(ctx, time.Now(), limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get pending transfer batches")
+	}
+
+	return batches, nil
+}
+
+// Process claims a pending batch and executes its items according to its mode. The returned batch reports the
+// outcome of every item; an error is only returned when the batch could not be processed at all.
+func (s *DefaultTransferBatchService) Process(ctx context.Context, id uint64) (*model.TransferBatch, error) {
+	// Claim the batch so that it is processed once, even with several processors running.
+	// Should the processing stop mid-way, the batch is claimed again and resumed once the lease expires.
+	now := time.Now()
+	claimed, err := s.batchRepo.Claim(ctx, id, now, now.Add(s.config.Lease))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to claim transfer batch")
+	}
+	if !claimed {
+		return nil, util.NewConflictError("transfer batch is no longer pending")
+	}
+
+	batch, err := s.batchRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get transfer batch")
+	}
+	batch.Status = "processing"
+
+	var hold *model.Hold
+	if batch.HoldID != nil {
+		hold, err = s.holdRepo.GetByID(ctx, *batch.HoldID)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get transfer batch hold")
+		}
+	}
+
+	if batch.Mode == "all_or_nothing" {
+		err = s.processAll(ctx, batch, hold)
+	} else {
+		err = s.processEach(ctx, batch, hold)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return batch, nil
+}
+
+// processAll executes all the items of a batch in a single transaction. When one of them fails the batch fails
+// and the other items are skipped. The hold is released and the outcome recorded in the same transaction, so that
+// an interrupted batch is processed again from scratch.
+func (s *DefaultTransferBatchService) processAll(ctx context.Context, batch *model.TransferBatch, hold *model.Hold) error {
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		txCtx := repository.WithTx(ctx, tx)
+
+		// Give the reserved total back first, so that the transfers can use it
+		if hold != nil {
+			if _, err := s.holdRepo.Resolve(txCtx, hold, "released"); err != nil {
+				return errors.Wrap(err, "failed to release transfer batch hold")
+			}
+		}
+
+		transfers := make([]*model.Transfer, len(batch.Items))
+		for i, item := range batch.Items {
+			transfers[i] = &model.Transfer{
+				FromAccount: batch.FromAccount,
+				ToAccount:   item.ToAccount,
+				Amount:      item.Amount,
+				Description: batchItemDescription(batch, item),
+			}
+		}
+
+		// The transfers are rolled back on their own when one of them fails
+		failed, err := s.transferService.TransferAll(txCtx, transfers)
+		for i, item := range batch.Items {
+			switch {
+			case err == nil:
+				item.Status = "completed"
+				item.TransferID = &transfers[i].ID
+				batch.CompletedCount++
+			case i == failed:
+				item.Status = "failed"
+				item.Error = util.ClientMessage(err, "transfer failed")
+				batch.FailedCount++
+			default:
+				item.Status = "skipped"
+			}
+
+			if err := s.batchRepo.UpdateItem(txCtx, item); err != nil {
+				return errors.Wrap(err, "failed to record transfer batch item")
+			}
+		}
+
+		return s.finish(txCtx, batch)
+	})
+}
+
+// processEach executes the items of a batch one by one, recording the progress after each of them.
+// Failed items do not stop the others. Every item gives its part of the hold back, is executed and recorded in
+// one transaction, so that a batch resumed after an interruption executes each item once. When ctx is cancelled
+// the batch goes back to pending, to be resumed from the first item not executed yet.
+func (s *DefaultTransferBatchService) processEach(ctx context.Context, batch *model.TransferBatch, hold *model.Hold) error {
+	for _, item := range batch.Items {
+		if item.Status != "pending" {
+			continue
+		}
+		if ctx.Err() != nil {
+			batch.Status = "pending"
+			return s.batchRepo.UpdateProgress(context.WithoutCancel(ctx), batch)
+		}
+
+		err := s.db.Transaction(func(tx *gorm.DB) error {
+			txCtx := repository.WithTx(ctx, tx)
+
+			// Give the reserved amount and fee of the item back, so that the transfer can use them
+			if hold != nil && hold.Status == "active" {
+				if _, err := s.holdRepo.Reduce(txCtx, hold, item.Amount.Add(item.Fee)); err != nil {
+					return errors.Wrap(err, "failed to release transfer batch hold")
+				}
+			}
+
+			// A failed transfer is rolled back on its own and recorded on the item
+			transfer, err := s.transferService.Transfer(txCtx, batch.FromAccount, item.ToAccount, item.Amount, batchItemDescription(batch, item))
+			if err != nil {
+				item.Status = "failed"
+				item.Error = util.ClientMessage(err, "transfer failed")
+				batch.FailedCount++
+			} else {
+				item.Status = "completed"
+				item.TransferID = &transfer.ID
+				batch.CompletedCount++
+			}
+
+			if err := s.batchRepo.UpdateItem(txCtx, item); err != nil {
+				return errors.Wrap(err, "failed to record transfer batch item")
+			}
+			if err := s.batchRepo.UpdateProgress(txCtx, batch); err != nil {
+				return errors.Wrap(err, "failed to record transfer batch progress")
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Whatever is left of the hold, e.g. after a resumed batch, is not needed anymore
+	if hold != nil && hold.Status == "active" {
+		if _, err := s.holdRepo.Resolve(ctx, hold, "released"); err != nil {
+			return errors.Wrap(err, "failed to release transfer batch hold")
+		}
+	}
+
+	return s.finish(ctx, batch)
+}
+
+// finish records the final status of a processed batch
+func (s *DefaultTransferBatchService) finish(ctx context.Context, batch *model.TransferBatch) error {
+	switch {
+	case batch.FailedCount == 0:
+		batch.Status = "completed"
+	case batch.CompletedCount == 0:
+		batch.Status = "failed"
+	default:
+		batch.Status = "partially_completed"
+	}
+
+	now := time.Now()
+	batch.CompletedAt = &now
+	if err := s.batchRepo.UpdateProgress(ctx, batch); err != nil {
+		return errors.Wrap(err, "failed to record transfer batch status")
+	}
+
+	return nil
+}
+
+// batchItemDescription returns the description of the transfer of an item, naming the batch when the item has none
+func batchItemDescription(batch *model.TransferBatch, item *model.TransferBatchItem) string {
+	if item.Description != "" {
+		return item.Description
+	}
+	return "Transfer batch #" + uintToString(batch.ID) + ", line " + strconv.Itoa(item.Line)
+}
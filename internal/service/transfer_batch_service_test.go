@@ -0,0 +1,402 @@
+package service_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+
+	"VDM2-BankBE/internal/config"
+	"VDM2-BankBE/internal/model"
+	repmocks "VDM2-BankBE/internal/repository/mocks"
+	"VDM2-BankBE/internal/service"
+	servicemocks "VDM2-BankBE/internal/service/mocks"
+	"VDM2-BankBE/internal/util"
+)
+
+var transferBatchConfig = &config.TransferBatchConfig{MaxItems: 3, HoldTTL: 24 * time.Hour, Lease: 10 * time.Minute}
+
+// batchFees charges 0.50 for every instant transfer
+var batchFees = service.NewFeeService(nil, &config.FeeConfig{
+	Currency:        "EUR",
+	InstantTransfer: &config.FeeRule{Type: "flat", Amount: decimal.RequireFromString("0.50")},
+})
+
+func TestTransferBatchService_Create(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440b00")
+	otherUserID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440b01")
+	fromAccountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440b02")
+	toAccountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440b03")
+	ownAccountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440b04")
+
+	fromAccount := &model.Account{ID: fromAccountID, UserID: userID, Status: "active", Currency: "EUR"}
+	toAccount := &model.Account{ID: toAccountID, UserID: otherUserID, Status: "active", Currency: "EUR"}
+	ownAccount := &model.Account{ID: ownAccountID, UserID: userID, Status: "active", Currency: "EUR"}
+	allowance := &model.TransferAllowance{
+		PerTransactionLimit: decimal.NewFromInt(1000),
+		DailyRemaining:      decimal.NewFromInt(100),
+		MonthlyRemaining:    decimal.NewFromInt(5000),
+	}
+
+	tests := []struct {
+		name       string
+		mode       string
+		lines      []service.TransferBatchLine
+		buildMocks func(ctrl *gomock.Controller) (*repmocks.MockTransferBatchRepository, *repmocks.MockAccountRepository, *repmocks.MockHoldRepository, *servicemocks.MockTransferLimitService, *servicemocks.MockTxDB)
+		wantCode   int
+		wantLines  []int
+	}{
+		{
+			name:  "too many transfers returns 400",
+			lines: make([]service.TransferBatchLine, 4),
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockTransferBatchRepository, *repmocks.MockAccountRepository, *repmocks.MockHoldRepository, *servicemocks.MockTransferLimitService, *servicemocks.MockTxDB) {
+				return repmocks.NewMockTransferBatchRepository(ctrl), repmocks.NewMockAccountRepository(ctrl), repmocks.NewMockHoldRepository(ctrl), servicemocks.NewMockTransferLimitService(ctrl), servicemocks.NewMockTxDB(ctrl)
+			},
+			wantCode: 400,
+		},
+		{
+			name: "invalid lines are all reported with a 422",
+			lines: []service.TransferBatchLine{
+				{ToAccount: toAccountID.String(), Amount: "abc"},
+				{ToAccount: toAccountID.String(), Amount: "10.00"},
+				{ToAccount: fromAccountID.String(), Amount: "5.00"},
+			},
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockTransferBatchRepository, *repmocks.MockAccountRepository, *repmocks.MockHoldRepository, *servicemocks.MockTransferLimitService, *servicemocks.MockTxDB) {
+				accountRepo := repmocks.NewMockAccountRepository(ctrl)
+				limitSvc := servicemocks.NewMockTransferLimitService(ctrl)
+
+				accountRepo.EXPECT().GetByID(gomock.Any(), fromAccountID).Return(fromAccount, nil).Times(2)
+				accountRepo.EXPECT().GetByID(gomock.Any(), toAccountID).Return(toAccount, nil)
+				limitSvc.EXPECT().GetAllowance(gomock.Any(), userID, "EUR").Return(allowance, nil)
+
+				return repmocks.NewMockTransferBatchRepository(ctrl), accountRepo, repmocks.NewMockHoldRepository(ctrl), limitSvc, servicemocks.NewMockTxDB(ctrl)
+			},
+			wantCode:  422,
+			wantLines: []int{1, 3},
+		},
+		{
+			name: "total over the daily limit returns 422",
+			lines: []service.TransferBatchLine{
+				{ToAccount: toAccountID.String(), Amount: "60.00"},
+				{ToAccount: toAccountID.String(), Amount: "60.00"},
+			},
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockTransferBatchRepository, *repmocks.MockAccountRepository, *repmocks.MockHoldRepository, *servicemocks.MockTransferLimitService, *servicemocks.MockTxDB) {
+				accountRepo := repmocks.NewMockAccountRepository(ctrl)
+				limitSvc := servicemocks.NewMockTransferLimitService(ctrl)
+
+				accountRepo.EXPECT().GetByID(gomock.Any(), fromAccountID).Return(fromAccount, nil)
+				accountRepo.EXPECT().GetByID(gomock.Any(), toAccountID).Return(toAccount, nil).Times(2)
+				limitSvc.EXPECT().GetAllowance(gomock.Any(), userID, "EUR").Return(allowance, nil)
+
+				return repmocks.NewMockTransferBatchRepository(ctrl), accountRepo, repmocks.NewMockHoldRepository(ctrl), limitSvc, servicemocks.NewMockTxDB(ctrl)
+			},
+			wantCode: 422,
+		},
+		{
+			name: "success reserves the total and the fees and stores the batch",
+			mode: "best_effort",
+			lines: []service.TransferBatchLine{
+				{ToAccount: toAccountID.String(), Amount: "30.00", Description: "rent"},
+				{ToAccount: toAccountID.String(), Amount: "20.50"},
+				{ToAccount: ownAccountID.String(), Amount: "5.00"},
+			},
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockTransferBatchRepository, *repmocks.MockAccountRepository, *repmocks.MockHoldRepository, *servicemocks.MockTransferLimitService, *servicemocks.MockTxDB) {
+				batchRepo := repmocks.NewMockTransferBatchRepository(ctrl)
+				accountRepo := repmocks.NewMockAccountRepository(ctrl)
+				holdRepo := repmocks.NewMockHoldRepository(ctrl)
+				limitSvc := servicemocks.NewMockTransferLimitService(ctrl)
+				txdb := servicemocks.NewMockTxDB(ctrl)
+
+				accountRepo.EXPECT().GetByID(gomock.Any(), fromAccountID).Return(fromAccount, nil)
+				accountRepo.EXPECT().GetByID(gomock.Any(), toAccountID).Return(toAccount, nil).Times(2)
+				accountRepo.EXPECT().GetByID(gomock.Any(), ownAccountID).Return(ownAccount, nil)
+				limitSvc.EXPECT().GetAllowance(gomock.Any(), userID, "EUR").Return(allowance, nil)
+				txdb.EXPECT().
+					Transaction(gomock.Any()).
+					DoAndReturn(func(fc func(tx *gorm.DB) error, opts ...*sql.TxOptions) error {
+						return fc(&gorm.DB{})
+					})
+				holdRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, hold *model.Hold) error {
+					// Transfers to the own account of the user are free
+					if !hold.Amount.Equal(decimal.RequireFromString("56.50")) || hold.AccountID != fromAccountID {
+						t.Fatalf("unexpected hold: %+v", hold)
+					}
+					hold.ID = 6
+					return nil
+				})
+				batchRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, batch *model.TransferBatch) error {
+					if batch.HoldID == nil || *batch.HoldID != 6 || batch.Mode != "best_effort" || len(batch.Items) != 3 {
+						t.Fatalf("unexpected batch: %+v", batch)
+					}
+					if !batch.TotalAmount.Equal(decimal.RequireFromString("55.50")) {
+						t.Fatalf("unexpected batch total: %s", batch.TotalAmount)
+					}
+					if batch.Items[1].Line != 2 || batch.Items[1].Status != "pending" || !batch.Items[1].Fee.Equal(decimal.RequireFromString("0.50")) {
+						t.Fatalf("unexpected batch item: %+v", batch.Items[1])
+					}
+					if !batch.Items[2].Fee.IsZero() {
+						t.Fatalf("unexpected fee of a transfer to an own account: %s", batch.Items[2].Fee)
+					}
+					return nil
+				})
+
+				return batchRepo, accountRepo, holdRepo, limitSvc, txdb
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			batchRepo, accountRepo, holdRepo, limitSvc, txdb := tc.buildMocks(ctrl)
+			svc := service.NewTransferBatchService(batchRepo, accountRepo, holdRepo, servicemocks.NewMockTransferService(ctrl), limitSvc, batchFees, txdb, transferBatchConfig)
+
+			_, err := svc.Create(context.Background(), fromAccountID, tc.mode, tc.lines)
+			if tc.wantCode == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+
+			apiErr, ok := err.(*util.APIError)
+			if !ok || apiErr.Code != tc.wantCode {
+				t.Fatalf("expected %d APIError, got %#v", tc.wantCode, err)
+			}
+			if tc.wantLines != nil {
+				if len(apiErr.Details) != len(tc.wantLines) {
+					t.Fatalf("unexpected details: %+v", apiErr.Details)
+				}
+				for i, line := range tc.wantLines {
+					if apiErr.Details[i].Line != line {
+						t.Fatalf("unexpected details: %+v", apiErr.Details)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestTransferBatchService_Process(t *testing.T) {
+	t.Parallel()
+
+	fromAccountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440b10")
+	toAccountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440b11")
+	holdID := uint64(6)
+
+	newBatch := func(mode string) *model.TransferBatch {
+		return &model.TransferBatch{
+			ID:          3,
+			FromAccount: fromAccountID,
+			Mode:        mode,
+			Status:      "processing",
+			ItemCount:   2,
+			HoldID:      &holdID,
+			Items: []*model.TransferBatchItem{
+				{ID: 1, Line: 1, ToAccount: toAccountID, Amount: decimal.NewFromInt(10), Fee: decimal.RequireFromString("0.50"), Status: "pending"},
+				{ID: 2, Line: 2, ToAccount: toAccountID, Amount: decimal.NewFromInt(20), Fee: decimal.RequireFromString("0.50"), Status: "pending"},
+			},
+		}
+	}
+	newHold := func() *model.Hold {
+		return &model.Hold{ID: holdID, AccountID: fromAccountID, Amount: decimal.NewFromInt(31), Status: "active"}
+	}
+
+	tests := []struct {
+		name       string
+		buildMocks func(ctrl *gomock.Controller) (*repmocks.MockTransferBatchRepository, *repmocks.MockHoldRepository, *servicemocks.MockTransferService)
+		// transactions is the number of transactions the batch is processed in
+		transactions int
+		wantCode     int
+		wantStatus   string
+		wantItems    []string
+		wantErrors   []string
+	}{
+		{
+			name: "batch claimed by another processor returns 409",
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockTransferBatchRepository, *repmocks.MockHoldRepository, *servicemocks.MockTransferService) {
+				batchRepo := repmocks.NewMockTransferBatchRepository(ctrl)
+				batchRepo.EXPECT().Claim(gomock.Any(), uint64(3), gomock.Any(), gomock.Any()).Return(false, nil)
+				return batchRepo, repmocks.NewMockHoldRepository(ctrl), servicemocks.NewMockTransferService(ctrl)
+			},
+			wantCode: 409,
+		},
+		{
+			name: "all or nothing failure skips the other transfers",
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockTransferBatchRepository, *repmocks.MockHoldRepository, *servicemocks.MockTransferService) {
+				batchRepo := repmocks.NewMockTransferBatchRepository(ctrl)
+				holdRepo := repmocks.NewMockHoldRepository(ctrl)
+				transferSvc := servicemocks.NewMockTransferService(ctrl)
+
+				batchRepo.EXPECT().Claim(gomock.Any(), uint64(3), gomock.Any(), gomock.Any()).Return(true, nil)
+				batchRepo.EXPECT().GetByID(gomock.Any(), uint64(3)).Return(newBatch("all_or_nothing"), nil)
+				holdRepo.EXPECT().GetByID(gomock.Any(), holdID).Return(newHold(), nil)
+				holdRepo.EXPECT().Resolve(gomock.Any(), gomock.Any(), "released").Return(true, nil)
+				transferSvc.EXPECT().TransferAll(gomock.Any(), gomock.Len(2)).Return(1, util.NewConflictError("insufficient funds"))
+				batchRepo.EXPECT().UpdateItem(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+				batchRepo.EXPECT().UpdateProgress(gomock.Any(), gomock.Any()).Return(nil)
+
+				return batchRepo, holdRepo, transferSvc
+			},
+			transactions: 1,
+			wantStatus:   "failed",
+			wantItems:    []string{"skipped", "failed"},
+		},
+		{
+			name: "best effort keeps going after a failure",
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockTransferBatchRepository, *repmocks.MockHoldRepository, *servicemocks.MockTransferService) {
+				batchRepo := repmocks.NewMockTransferBatchRepository(ctrl)
+				holdRepo := repmocks.NewMockHoldRepository(ctrl)
+				transferSvc := servicemocks.NewMockTransferService(ctrl)
+
+				batchRepo.EXPECT().Claim(gomock.Any(), uint64(3), gomock.Any(), gomock.Any()).Return(true, nil)
+				batchRepo.EXPECT().GetByID(gomock.Any(), uint64(3)).Return(newBatch("best_effort"), nil)
+				holdRepo.EXPECT().GetByID(gomock.Any(), holdID).Return(newHold(), nil)
+				// Each item gives back its amount and its fee
+				released := []string{"10.50", "20.50"}
+				holdRepo.EXPECT().Reduce(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ *model.Hold, amount decimal.Decimal) (bool, error) {
+					if !amount.Equal(decimal.RequireFromString(released[0])) {
+						t.Fatalf("unexpected amount given back: got=%s want=%s", amount, released[0])
+					}
+					released = released[1:]
+					return true, nil
+				}).Times(2)
+				transferSvc.EXPECT().
+					Transfer(gomock.Any(), fromAccountID, toAccountID, decimal.NewFromInt(10), "Transfer batch #3, line 1").
+					Return(nil, util.NewConflictError("destination account is closed"))
+				transferSvc.EXPECT().
+					Transfer(gomock.Any(), fromAccountID, toAccountID, decimal.NewFromInt(20), "Transfer batch #3, line 2").
+					Return(&model.Transfer{ID: 40}, nil)
+				batchRepo.EXPECT().UpdateItem(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+				batchRepo.EXPECT().UpdateProgress(gomock.Any(), gomock.Any()).Return(nil).Times(3)
+				holdRepo.EXPECT().Resolve(gomock.Any(), gomock.Any(), "released").Return(true, nil)
+
+				return batchRepo, holdRepo, transferSvc
+			},
+			transactions: 2,
+			wantStatus:   "partially_completed",
+			wantItems:    []string{"failed", "completed"},
+		},
+		{
+			name: "batch resumed after an interruption executes the remaining items only",
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockTransferBatchRepository, *repmocks.MockHoldRepository, *servicemocks.MockTransferService) {
+				batchRepo := repmocks.NewMockTransferBatchRepository(ctrl)
+				holdRepo := repmocks.NewMockHoldRepository(ctrl)
+				transferSvc := servicemocks.NewMockTransferService(ctrl)
+
+				// The first item was executed before the processing stopped and its lease expired
+				batch := newBatch("best_effort")
+				batch.Items[0].Status = "completed"
+				batch.CompletedCount = 1
+				hold := newHold()
+				hold.Amount = decimal.RequireFromString("20.50")
+
+				batchRepo.EXPECT().Claim(gomock.Any(), uint64(3), gomock.Any(), gomock.Any()).Return(true, nil)
+				batchRepo.EXPECT().GetByID(gomock.Any(), uint64(3)).Return(batch, nil)
+				holdRepo.EXPECT().GetByID(gomock.Any(), holdID).Return(hold, nil)
+				holdRepo.EXPECT().Reduce(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, h *model.Hold, amount decimal.Decimal) (bool, error) {
+					if !amount.Equal(decimal.RequireFromString("20.50")) {
+						t.Fatalf("unexpected amount given back: %s", amount)
+					}
+					h.Status = "released"
+					return true, nil
+				})
+				transferSvc.EXPECT().
+					Transfer(gomock.Any(), fromAccountID, toAccountID, decimal.NewFromInt(20), "Transfer batch #3, line 2").
+					Return(&model.Transfer{ID: 42}, nil)
+				batchRepo.EXPECT().UpdateItem(gomock.Any(), gomock.Any()).Return(nil)
+				batchRepo.EXPECT().UpdateProgress(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+				return batchRepo, holdRepo, transferSvc
+			},
+			transactions: 1,
+			wantStatus:   "completed",
+			wantItems:    []string{"completed", "completed"},
+		},
+		{
+			name: "transfers from a business account needing approval fail their items",
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockTransferBatchRepository, *repmocks.MockHoldRepository, *servicemocks.MockTransferService) {
+				batchRepo := repmocks.NewMockTransferBatchRepository(ctrl)
+				holdRepo := repmocks.NewMockHoldRepository(ctrl)
+				transferSvc := servicemocks.NewMockTransferService(ctrl)
+
+				batchRepo.EXPECT().Claim(gomock.Any(), uint64(3), gomock.Any(), gomock.Any()).Return(true, nil)
+				batchRepo.EXPECT().GetByID(gomock.Any(), uint64(3)).Return(newBatch("best_effort"), nil)
+				holdRepo.EXPECT().GetByID(gomock.Any(), holdID).Return(newHold(), nil)
+				holdRepo.EXPECT().Reduce(gomock.Any(), gomock.Any(), gomock.Any()).Return(true, nil).Times(2)
+				// Items above the threshold are not left held for approval while the batch reports them done
+				transferSvc.EXPECT().
+					Transfer(gomock.Any(), fromAccountID, toAccountID, decimal.NewFromInt(10), "Transfer batch #3, line 1").
+					Return(&model.Transfer{ID: 41}, nil)
+				transferSvc.EXPECT().
+					Transfer(gomock.Any(), fromAccountID, toAccountID, decimal.NewFromInt(20), "Transfer batch #3, line 2").
+					Return(nil, util.NewUnprocessableEntityError("transfers needing approval cannot be executed immediately"))
+				batchRepo.EXPECT().UpdateItem(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+				batchRepo.EXPECT().UpdateProgress(gomock.Any(), gomock.Any()).Return(nil).Times(3)
+				holdRepo.EXPECT().Resolve(gomock.Any(), gomock.Any(), "released").Return(true, nil)
+
+				return batchRepo, holdRepo, transferSvc
+			},
+			transactions: 2,
+			wantStatus:   "partially_completed",
+			wantItems:    []string{"completed", "failed"},
+			wantErrors:   []string{"", "transfers needing approval cannot be executed immediately"},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			batchRepo, holdRepo, transferSvc := tc.buildMocks(ctrl)
+			txdb := servicemocks.NewMockTxDB(ctrl)
+			txdb.EXPECT().
+				Transaction(gomock.Any()).
+				DoAndReturn(func(fc func(tx *gorm.DB) error, opts ...*sql.TxOptions) error {
+					return fc(&gorm.DB{})
+				}).
+				Times(tc.transactions)
+			svc := service.NewTransferBatchService(batchRepo, repmocks.NewMockAccountRepository(ctrl), holdRepo, transferSvc, servicemocks.NewMockTransferLimitService(ctrl), noFees, txdb, transferBatchConfig)
+
+			batch, err := svc.Process(context.Background(), 3)
+			if tc.wantCode != 0 {
+				apiErr, ok := err.(*util.APIError)
+				if !ok || apiErr.Code != tc.wantCode {
+					t.Fatalf("expected %d APIError, got %#v", tc.wantCode, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if batch.Status != tc.wantStatus || batch.CompletedAt == nil {
+				t.Fatalf("unexpected batch status: %s", batch.Status)
+			}
+			for i, status := range tc.wantItems {
+				if batch.Items[i].Status != status {
+					t.Fatalf("unexpected status of line %d: got=%s want=%s", i+1, batch.Items[i].Status, status)
+				}
+			}
+			for i, message := range tc.wantErrors {
+				if batch.Items[i].Error != message {
+					t.Fatalf("unexpected error of line %d: got=%q want=%q", i+1, batch.Items[i].Error, message)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,330 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+
+	"VDM2-BankBE/internal/config"
+	"VDM2-BankBE/internal/model"
+	"VDM2-BankBE/internal/repository"
+	"VDM2-BankBE/internal/util"
+)
+
+// DefaultTransferLimitService implements TransferLimitService
+type DefaultTransferLimitService struct {
+	limitRepo         repository.TransferLimitRepository
+	transferRepo      repository.TransferRepository
+	beneficiaryRepo   repository.BeneficiaryRepository
+	fxRepo            repository.FXRepository
+	config            *config.LimitConfig
+	beneficiaryConfig *config.BeneficiaryConfig
+}
+
+// NewTransferLimitService creates a new transfer limit service
+func NewTransferLimitService(
+	limitRepo repository.TransferLimitRepository,
+	transferRepo repository.TransferRepository,
+	beneficiaryRepo repository.BeneficiaryRepository,
+	fxRepo repository.FXRepository,
+	config *config.LimitConfig,
+	beneficiaryConfig *config.BeneficiaryConfig,
+) TransferLimitService {
+	return &DefaultTransferLimitService{
+		limitRepo:         limitRepo,
+		transferRepo:      transferRepo,
+		beneficiaryRepo:   beneficiaryRepo,
+		fxRepo:            fxRepo,
+		config:            config,
+		beneficiaryConfig: beneficiaryConfig,
+	}
+}
+
+// GetAllowance returns the limits in force for a user and how much of the daily and monthly limits is left,
+// converted to currency at the current exchange rate; they are in the currency of the limits when it is empty
+func (s *DefaultTransferLimitService) GetAllowance(
+	ctx context.Context,
+	userID uuid.UUID,
+	currency string,
+) (*model.TransferAllowance, error) {
+	allowance, err := s.allowance(ctx, userID, 0)
+	if err != nil {
+		return nil, err
+	}
+	if currency == "" || currency == allowance.Currency {
+		return allowance, nil
+	}
+
+	rate, err := exchangeRate(ctx, s.fxRepo, allowance.Currency, currency)
+	if err != nil {
+		return nil, err
+	}
+	convert := func(amount decimal.Decimal) decimal.Decimal {
+		return util.RoundAmount(amount.Mul(rate), currency)
+	}
+
+	return &model.TransferAllowance{
+		Currency:            currency,
+		PerTransactionLimit: convert(allowance.PerTransactionLimit),
+		DailyLimit:          convert(allowance.DailyLimit),
+		DailyUsed:           convert(allowance.DailyUsed),
+		DailyRemaining:      convert(allowance.DailyRemaining),
+		MonthlyLimit:        convert(allowance.MonthlyLimit),
+		MonthlyUsed:         convert(allowance.MonthlyUsed),
+		MonthlyRemaining:    convert(allowance.MonthlyRemaining),
+	}, nil
+}
+
+// Update lowers the limits of a user. A limit can be lowered below the one in force but never raised.
+func (s *DefaultTransferLimitService) Update(
+	ctx context.Context,
+	userID uuid.UUID,
+	update TransferLimitUpdate,
+) (*model.TransferAllowance, error) {
+	limit, err := s.limitRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get transfer limits")
+	}
+
+	perTransaction, daily, monthly := s.effectiveLimits(limit)
+	if err := checkLowered("per_transaction", update.PerTransaction, perTransaction); err != nil {
+		return nil, err
+	}
+	if err := checkLowered("daily", update.Daily, daily); err != nil {
+		return nil, err
+	}
+	if err := checkLowered("monthly", update.Monthly, monthly); err != nil {
+		return nil, err
+	}
+
+	if update.PerTransaction != nil {
+		limit.PerTransaction = update.PerTransaction
+	}
+	if update.Daily != nil {
+		limit.Daily = update.Daily
+	}
+	if update.Monthly != nil {
+		limit.Monthly = update.Monthly
+	}
+	limit.UpdatedAt = time.Now()
+
+	if err := s.limitRepo.Upsert(ctx, limit); err != nil {
+		return nil, errors.Wrap(err, "failed to update transfer limits")
+	}
+
+	return s.allowance(ctx, userID, 0)
+}
+
+// Check tells whether a user can send amount, in currency, to toAccountID of another user without exceeding their
+// limits, nor the cooling-off limit when toAccountID is a beneficiary the user added recently. It must run in the
+// transaction of the transfer: it locks the user until the transaction ends, so that concurrent transfers cannot
+// exceed the limits together. excludeID is the ID of the transfer being checked when it is already persisted.
+func (s *DefaultTransferLimitService) Check(
+	ctx context.Context,
+	userID, toAccountID uuid.UUID,
+	amount decimal.Decimal,
+	currency string,
+	excludeID uint64,
+) error {
+	if err := s.limitRepo.Lock(ctx, userID); err != nil {
+		if _, ok := err.(*util.APIError); ok {
+			return err
+		}
+		return errors.Wrap(err, "failed to lock transfer limits")
+	}
+
+	allowance, err := s.allowance(ctx, userID, excludeID)
+	if err != nil {
+		return err
+	}
+
+	amount, err = s.toLimitCurrency(ctx, amount, currency)
+	if err != nil {
+		return err
+	}
+
+	if amount.GreaterThan(allowance.PerTransactionLimit) {
+		return util.NewTransferLimitError("per_transaction")
+	}
+	if amount.GreaterThan(allowance.DailyRemaining) {
+		return util.NewTransferLimitError("daily")
+	}
+	if amount.GreaterThan(allowance.MonthlyRemaining) {
+		return util.NewTransferLimitError("monthly")
+	}
+
+	return s.checkCoolingOff(ctx, userID, toAccountID, amount, excludeID)
+}
+
+// checkCoolingOff tells whether a user can send amount, in the currency of the limits, to toAccountID when it is a
+// beneficiary added less than the cooling-off period ago: what the user sent to it since it was added, amount
+// included, cannot exceed the cooling-off limit
+func (s *DefaultTransferLimitService) checkCoolingOff(
+	ctx context.Context,
+	userID, toAccountID uuid.UUID,
+	amount decimal.Decimal,
+	excludeID uint64,
+) error {
+	if s.beneficiaryConfig.CoolingOff <= 0 {
+		return nil
+	}
+
+	beneficiary, err := s.beneficiaryRepo.GetByAccountID(ctx, userID, toAccountID)
+	if err != nil {
+		// Accounts outside the address book are not subject to the rule
+		if _, ok := err.(*util.APIError); ok {
+			return nil
+		}
+		return errors.Wrap(err, "failed to get beneficiary")
+	}
+
+	coolingOffEnd := beneficiary.CreatedAt.Add(s.beneficiaryConfig.CoolingOff)
+	if !time.Now().Before(coolingOffEnd) {
+		return nil
+	}
+
+	totals, err := s.transferRepo.SumOutgoingTo(ctx, userID, toAccountID, beneficiary.CreatedAt, excludeID)
+	if err != nil {
+		return errors.Wrap(err, "failed to sum transfers to beneficiary")
+	}
+	sent, err := s.sum(ctx, totals)
+	if err != nil {
+		return err
+	}
+
+	limit := s.beneficiaryConfig.CoolingOffLimit
+	if sent.Add(amount).GreaterThan(limit) {
+		return util.NewUnprocessableEntityError(
+			"transfers to this beneficiary are limited to " + limit.StringFixed(util.CurrencyMinorUnits(s.config.Currency)) +
+				" " + s.config.Currency + " until " + coolingOffEnd.UTC().Format(time.RFC3339),
+		)
+	}
+
+	return nil
+}
+
+// allowance sums the outgoing transfers of the current day and month in the currency of the limits, leaving out
+// the transfer excludeID
+func (s *DefaultTransferLimitService) allowance(
+	ctx context.Context,
+	userID uuid.UUID,
+	excludeID uint64,
+) (*model.TransferAllowance, error) {
+	limit, err := s.limitRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get transfer limits")
+	}
+	perTransaction, daily, monthly := s.effectiveLimits(limit)
+
+	now := time.Now()
+	dayStart := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+
+	dailyUsed, err := s.used(ctx, userID, dayStart, excludeID)
+	if err != nil {
+		if _, ok := err.(*util.APIError); ok {
+			return nil, err
+		}
+		return nil, errors.Wrap(err, "failed to sum daily transfers")
+	}
+	monthlyUsed, err := s.used(ctx, userID, monthStart, excludeID)
+	if err != nil {
+		if _, ok := err.(*util.APIError); ok {
+			return nil, err
+		}
+		return nil, errors.Wrap(err, "failed to sum monthly transfers")
+	}
+
+	return &model.TransferAllowance{
+		Currency:            s.config.Currency,
+		PerTransactionLimit: perTransaction,
+		DailyLimit:          daily,
+		DailyUsed:           dailyUsed,
+		DailyRemaining:      decimal.Max(daily.Sub(dailyUsed), decimal.Zero),
+		MonthlyLimit:        monthly,
+		MonthlyUsed:         monthlyUsed,
+		MonthlyRemaining:    decimal.Max(monthly.Sub(monthlyUsed), decimal.Zero),
+	}, nil
+}
+
+// used sums the outgoing transfers of a user since the given time, leaving out the transfer excludeID. Transfers
+// in other currencies than the one of the limits are converted at the current exchange rates.
+func (s *DefaultTransferLimitService) used(
+	ctx context.Context,
+	userID uuid.UUID,
+	since time.Time,
+	excludeID uint64,
+) (decimal.Decimal, error) {
+	totals, err := s.transferRepo.SumOutgoing(ctx, userID, since, excludeID)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	return s.sum(ctx, totals)
+}
+
+// sum adds up totals by currency in the currency of the limits, converted at the current exchange rates
+func (s *DefaultTransferLimitService) sum(ctx context.Context, totals map[string]decimal.Decimal) (decimal.Decimal, error) {
+	sum := decimal.Zero
+	for currency, total := range totals {
+		converted, err := s.toLimitCurrency(ctx, total, currency)
+		if err != nil {
+			return decimal.Zero, err
+		}
+		sum = sum.Add(converted)
+	}
+
+	return util.RoundAmount(sum, s.config.Currency), nil
+}
+
+// toLimitCurrency converts amount from currency to the currency of the limits at the current exchange rate
+func (s *DefaultTransferLimitService) toLimitCurrency(
+	ctx context.Context,
+	amount decimal.Decimal,
+	currency string,
+) (decimal.Decimal, error) {
+	if currency == s.config.Currency {
+		return amount, nil
+	}
+
+	rate, err := exchangeRate(ctx, s.fxRepo, currency, s.config.Currency)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	return amount.Mul(rate), nil
+}
+
+// effectiveLimits applies the limits a user set over the configured defaults
+func (s *DefaultTransferLimitService) effectiveLimits(limit *model.TransferLimit) (perTransaction, daily, monthly decimal.Decimal) {
+	perTransaction, daily, monthly = s.config.PerTransaction, s.config.Daily, s.config.Monthly
+	if limit.PerTransaction != nil {
+		perTransaction = *limit.PerTransaction
+	}
+	if limit.Daily != nil {
+		daily = *limit.Daily
+	}
+	if limit.Monthly != nil {
+		monthly = *limit.Monthly
+	}
+
+	return perTransaction, daily, monthly
+}
+
+// checkLowered validates a new value of the limit called name against the one in force
+func checkLowered(name string, value *decimal.Decimal, current decimal.Decimal) error {
+	if value == nil {
+		return nil
+	}
+	if !value.IsPositive() {
+		return util.NewBadRequestError(name + " limit must be greater than zero")
+	}
+	if value.GreaterThan(current) {
+		return util.NewForbiddenError(name + " limit can only be lowered")
+	}
+
+	return nil
+}
@@ -0,0 +1,422 @@
+package service_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"VDM2-BankBE/internal/config"
+	"VDM2-BankBE/internal/model"
+	repmocks "VDM2-BankBE/internal/repository/mocks"
+	"VDM2-BankBE/internal/service"
+	"VDM2-BankBE/internal/util"
+)
+
+var limitConfig = &config.LimitConfig{
+	Currency:       "EUR",
+	PerTransaction: decimal.RequireFromString("1000.00"),
+	Daily:          decimal.RequireFromString("2000.00"),
+	Monthly:        decimal.RequireFromString("5000.00"),
+}
+
+var noCoolingOff = &config.BeneficiaryConfig{}
+
+var coolingOffConfig = &config.BeneficiaryConfig{CoolingOff: 24 * time.Hour, CoolingOffLimit: decimal.RequireFromString("500.00")}
+
+func TestTransferLimitService_Check(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440800")
+	toAccountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440803")
+	lowered := decimal.RequireFromString("300.00")
+
+	tests := []struct {
+		name        string
+		amount      decimal.Decimal
+		limit       *model.TransferLimit
+		dailyUsed   string
+		monthlyUsed string
+		wantMessage string
+		wantWindow  string
+	}{
+		{
+			name:        "within the limits",
+			amount:      decimal.RequireFromString("500.00"),
+			limit:       &model.TransferLimit{UserID: userID},
+			dailyUsed:   "1500.00",
+			monthlyUsed: "1500.00",
+		},
+		{
+			name:        "amount above the per-transaction limit",
+			amount:      decimal.RequireFromString("1000.01"),
+			limit:       &model.TransferLimit{UserID: userID},
+			dailyUsed:   "0",
+			monthlyUsed: "0",
+			wantMessage: "per-transaction transfer limit exceeded",
+			wantWindow:  "per_transaction",
+		},
+		{
+			name:        "amount above the lowered per-transaction limit",
+			amount:      decimal.RequireFromString("300.01"),
+			limit:       &model.TransferLimit{UserID: userID, PerTransaction: &lowered},
+			dailyUsed:   "0",
+			monthlyUsed: "0",
+			wantMessage: "per-transaction transfer limit exceeded",
+			wantWindow:  "per_transaction",
+		},
+		{
+			name:        "amount above what is left for the day",
+			amount:      decimal.RequireFromString("500.01"),
+			limit:       &model.TransferLimit{UserID: userID},
+			dailyUsed:   "1500.00",
+			monthlyUsed: "1500.00",
+			wantMessage: "daily transfer limit exceeded",
+			wantWindow:  "daily",
+		},
+		{
+			name:        "amount above what is left for the month",
+			amount:      decimal.RequireFromString("100.00"),
+			limit:       &model.TransferLimit{UserID: userID},
+			dailyUsed:   "0",
+			monthlyUsed: "4950.00",
+			wantMessage: "monthly transfer limit exceeded",
+			wantWindow:  "monthly",
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			limitRepo := repmocks.NewMockTransferLimitRepository(ctrl)
+			transferRepo := repmocks.NewMockTransferRepository(ctrl)
+
+			gomock.InOrder(
+				limitRepo.EXPECT().Lock(gomock.Any(), userID).Return(nil),
+				limitRepo.EXPECT().GetByUserID(gomock.Any(), userID).Return(tc.limit, nil),
+				transferRepo.EXPECT().SumOutgoing(gomock.Any(), userID, gomock.Any(), uint64(7)).Return(map[string]decimal.Decimal{"EUR": decimal.RequireFromString(tc.dailyUsed)}, nil),
+				transferRepo.EXPECT().SumOutgoing(gomock.Any(), userID, gomock.Any(), uint64(7)).Return(map[string]decimal.Decimal{"EUR": decimal.RequireFromString(tc.monthlyUsed)}, nil),
+			)
+
+			svc := service.NewTransferLimitService(limitRepo, transferRepo, repmocks.NewMockBeneficiaryRepository(ctrl), repmocks.NewMockFXRepository(ctrl), limitConfig, noCoolingOff)
+			err := svc.Check(context.Background(), userID, toAccountID, tc.amount, "EUR", 7)
+			if tc.wantMessage == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			apiErr, ok := err.(*util.APIError)
+			if !ok || apiErr.Code != 422 || apiErr.Message != tc.wantMessage {
+				t.Fatalf("expected 422 APIError %q, got %#v", tc.wantMessage, err)
+			}
+			if apiErr.Reason != util.ReasonTransferLimitExceeded || apiErr.Window != tc.wantWindow {
+				t.Fatalf("unexpected limit of the error: reason=%q window=%q", apiErr.Reason, apiErr.Window)
+			}
+		})
+	}
+}
+
+func TestTransferLimitService_CheckConverts(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440801")
+	toAccountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440803")
+	usdToEUR := &model.FXRate{BaseCurrency: "USD", QuoteCurrency: "EUR", Rate: decimal.RequireFromString("0.90")}
+
+	tests := []struct {
+		name        string
+		amount      string
+		currency    string
+		dailyUsed   map[string]decimal.Decimal
+		buildMocks  func(fxRepo *repmocks.MockFXRepository)
+		wantCode    int
+		wantMessage string
+	}{
+		{
+			name:      "used amounts of every currency count in the currency of the limits",
+			amount:    "500.00",
+			currency:  "EUR",
+			dailyUsed: map[string]decimal.Decimal{"EUR": decimal.RequireFromString("1000.00"), "USD": decimal.RequireFromString("500.00")},
+			buildMocks: func(fxRepo *repmocks.MockFXRepository) {
+				fxRepo.EXPECT().GetRate(gomock.Any(), "USD", "EUR").Return(usdToEUR, nil).Times(2)
+			},
+		},
+		{
+			name:      "amount in another currency is converted before it is checked",
+			amount:    "650.00",
+			currency:  "USD",
+			dailyUsed: map[string]decimal.Decimal{"EUR": decimal.RequireFromString("1450.00")},
+			buildMocks: func(fxRepo *repmocks.MockFXRepository) {
+				fxRepo.EXPECT().GetRate(gomock.Any(), "USD", "EUR").Return(usdToEUR, nil)
+			},
+			wantCode:    422,
+			wantMessage: "daily transfer limit exceeded",
+		},
+		{
+			name:      "currency without an exchange rate returns 422",
+			amount:    "10.00",
+			currency:  "CHF",
+			dailyUsed: map[string]decimal.Decimal{},
+			buildMocks: func(fxRepo *repmocks.MockFXRepository) {
+				fxRepo.EXPECT().GetRate(gomock.Any(), "CHF", "EUR").Return(nil, util.NewNotFoundError("exchange rate not found"))
+				fxRepo.EXPECT().GetRate(gomock.Any(), "EUR", "CHF").Return(nil, util.NewNotFoundError("exchange rate not found"))
+			},
+			wantCode:    422,
+			wantMessage: "no exchange rate from CHF to EUR",
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			limitRepo := repmocks.NewMockTransferLimitRepository(ctrl)
+			transferRepo := repmocks.NewMockTransferRepository(ctrl)
+			fxRepo := repmocks.NewMockFXRepository(ctrl)
+
+			limitRepo.EXPECT().Lock(gomock.Any(), userID).Return(nil)
+			limitRepo.EXPECT().GetByUserID(gomock.Any(), userID).Return(&model.TransferLimit{UserID: userID}, nil)
+			transferRepo.EXPECT().SumOutgoing(gomock.Any(), userID, gomock.Any(), uint64(0)).Return(tc.dailyUsed, nil).Times(2)
+			tc.buildMocks(fxRepo)
+
+			svc := service.NewTransferLimitService(limitRepo, transferRepo, repmocks.NewMockBeneficiaryRepository(ctrl), fxRepo, limitConfig, noCoolingOff)
+			err := svc.Check(context.Background(), userID, toAccountID, decimal.RequireFromString(tc.amount), tc.currency, 0)
+			if tc.wantCode == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			apiErr, ok := err.(*util.APIError)
+			if !ok || apiErr.Code != tc.wantCode || apiErr.Message != tc.wantMessage {
+				t.Fatalf("expected %d APIError %q, got %#v", tc.wantCode, tc.wantMessage, err)
+			}
+		})
+	}
+}
+
+func TestTransferLimitService_CheckCoolingOff(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440804")
+	toAccountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440805")
+	usdToEUR := &model.FXRate{BaseCurrency: "USD", QuoteCurrency: "EUR", Rate: decimal.RequireFromString("0.90")}
+
+	tests := []struct {
+		name       string
+		amount     string
+		currency   string
+		buildMocks func(beneficiaryRepo *repmocks.MockBeneficiaryRepository, transferRepo *repmocks.MockTransferRepository, fxRepo *repmocks.MockFXRepository)
+		wantCode   int
+	}{
+		{
+			name:     "account outside the address book is not capped",
+			amount:   "900.00",
+			currency: "EUR",
+			buildMocks: func(beneficiaryRepo *repmocks.MockBeneficiaryRepository, transferRepo *repmocks.MockTransferRepository, fxRepo *repmocks.MockFXRepository) {
+				beneficiaryRepo.EXPECT().GetByAccountID(gomock.Any(), userID, toAccountID).Return(nil, util.NewNotFoundError("beneficiary not found"))
+			},
+		},
+		{
+			name:     "beneficiary added before the cooling-off period is not capped",
+			amount:   "900.00",
+			currency: "EUR",
+			buildMocks: func(beneficiaryRepo *repmocks.MockBeneficiaryRepository, transferRepo *repmocks.MockTransferRepository, fxRepo *repmocks.MockFXRepository) {
+				beneficiaryRepo.EXPECT().GetByAccountID(gomock.Any(), userID, toAccountID).
+					Return(&model.Beneficiary{UserID: userID, AccountID: toAccountID, CreatedAt: time.Now().Add(-25 * time.Hour)}, nil)
+			},
+		},
+		{
+			name:     "amount within what is left of the cooling-off limit is allowed",
+			amount:   "200.00",
+			currency: "EUR",
+			buildMocks: func(beneficiaryRepo *repmocks.MockBeneficiaryRepository, transferRepo *repmocks.MockTransferRepository, fxRepo *repmocks.MockFXRepository) {
+				beneficiaryRepo.EXPECT().GetByAccountID(gomock.Any(), userID, toAccountID).
+					Return(&model.Beneficiary{UserID: userID, AccountID: toAccountID, CreatedAt: time.Now().Add(-time.Hour)}, nil)
+				transferRepo.EXPECT().SumOutgoingTo(gomock.Any(), userID, toAccountID, gomock.Any(), uint64(0)).
+					Return(map[string]decimal.Decimal{"EUR": decimal.RequireFromString("300.00")}, nil)
+			},
+		},
+		{
+			name:     "transfers sent since the beneficiary was added count towards the cooling-off limit",
+			amount:   "200.01",
+			currency: "EUR",
+			buildMocks: func(beneficiaryRepo *repmocks.MockBeneficiaryRepository, transferRepo *repmocks.MockTransferRepository, fxRepo *repmocks.MockFXRepository) {
+				beneficiaryRepo.EXPECT().GetByAccountID(gomock.Any(), userID, toAccountID).
+					Return(&model.Beneficiary{UserID: userID, AccountID: toAccountID, CreatedAt: time.Now().Add(-time.Hour)}, nil)
+				transferRepo.EXPECT().SumOutgoingTo(gomock.Any(), userID, toAccountID, gomock.Any(), uint64(0)).
+					Return(map[string]decimal.Decimal{"EUR": decimal.RequireFromString("300.00")}, nil)
+			},
+			wantCode: 422,
+		},
+		{
+			name:     "amounts in other currencies are converted to the currency of the limits",
+			amount:   "250.00",
+			currency: "USD",
+			buildMocks: func(beneficiaryRepo *repmocks.MockBeneficiaryRepository, transferRepo *repmocks.MockTransferRepository, fxRepo *repmocks.MockFXRepository) {
+				beneficiaryRepo.EXPECT().GetByAccountID(gomock.Any(), userID, toAccountID).
+					Return(&model.Beneficiary{UserID: userID, AccountID: toAccountID, CreatedAt: time.Now().Add(-time.Hour)}, nil)
+				transferRepo.EXPECT().SumOutgoingTo(gomock.Any(), userID, toAccountID, gomock.Any(), uint64(0)).
+					Return(map[string]decimal.Decimal{"USD": decimal.RequireFromString("300.00")}, nil)
+				// 250 USD and 300 USD are 225 EUR and 270 EUR, within the limit of 500 EUR
+				fxRepo.EXPECT().GetRate(gomock.Any(), "USD", "EUR").Return(usdToEUR, nil)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			limitRepo := repmocks.NewMockTransferLimitRepository(ctrl)
+			transferRepo := repmocks.NewMockTransferRepository(ctrl)
+			beneficiaryRepo := repmocks.NewMockBeneficiaryRepository(ctrl)
+			fxRepo := repmocks.NewMockFXRepository(ctrl)
+
+			limitRepo.EXPECT().Lock(gomock.Any(), userID).Return(nil)
+			limitRepo.EXPECT().GetByUserID(gomock.Any(), userID).Return(&model.TransferLimit{UserID: userID}, nil)
+			transferRepo.EXPECT().SumOutgoing(gomock.Any(), userID, gomock.Any(), uint64(0)).Return(map[string]decimal.Decimal{}, nil).Times(2)
+			if tc.currency != limitConfig.Currency {
+				fxRepo.EXPECT().GetRate(gomock.Any(), tc.currency, limitConfig.Currency).Return(usdToEUR, nil)
+			}
+			tc.buildMocks(beneficiaryRepo, transferRepo, fxRepo)
+
+			svc := service.NewTransferLimitService(limitRepo, transferRepo, beneficiaryRepo, fxRepo, limitConfig, coolingOffConfig)
+			err := svc.Check(context.Background(), userID, toAccountID, decimal.RequireFromString(tc.amount), tc.currency, 0)
+			if tc.wantCode == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			apiErr, ok := err.(*util.APIError)
+			if !ok || apiErr.Code != tc.wantCode || !strings.HasPrefix(apiErr.Message, "transfers to this beneficiary are limited to 500.00 EUR until ") {
+				t.Fatalf("expected %d APIError, got %#v", tc.wantCode, err)
+			}
+		})
+	}
+}
+
+func TestTransferLimitService_GetAllowance(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440802")
+
+	limitRepo := repmocks.NewMockTransferLimitRepository(ctrl)
+	transferRepo := repmocks.NewMockTransferRepository(ctrl)
+	fxRepo := repmocks.NewMockFXRepository(ctrl)
+
+	limitRepo.EXPECT().GetByUserID(gomock.Any(), userID).Return(&model.TransferLimit{UserID: userID}, nil)
+	transferRepo.EXPECT().SumOutgoing(gomock.Any(), userID, gomock.Any(), uint64(0)).Return(map[string]decimal.Decimal{"EUR": decimal.RequireFromString("500.00")}, nil).Times(2)
+	fxRepo.EXPECT().GetRate(gomock.Any(), "EUR", "USD").
+		Return(&model.FXRate{BaseCurrency: "EUR", QuoteCurrency: "USD", Rate: decimal.RequireFromString("1.10")}, nil)
+
+	svc := service.NewTransferLimitService(limitRepo, transferRepo, repmocks.NewMockBeneficiaryRepository(ctrl), fxRepo, limitConfig, noCoolingOff)
+	got, err := svc.GetAllowance(context.Background(), userID, "USD")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Currency != "USD" || !got.PerTransactionLimit.Equal(decimal.RequireFromString("1100.00")) || !got.DailyRemaining.Equal(decimal.RequireFromString("1650.00")) {
+		t.Fatalf("unexpected allowance: %+v", got)
+	}
+}
+
+func TestTransferLimitService_Update(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440810")
+	lowered := decimal.RequireFromString("800.00")
+	newLimit := func(value string) *decimal.Decimal {
+		limit := decimal.RequireFromString(value)
+		return &limit
+	}
+
+	tests := []struct {
+		name     string
+		update   service.TransferLimitUpdate
+		current  *model.TransferLimit
+		wantCode int
+	}{
+		{
+			name:     "raising above the default returns 403",
+			update:   service.TransferLimitUpdate{Daily: newLimit("2500.00")},
+			current:  &model.TransferLimit{UserID: userID},
+			wantCode: 403,
+		},
+		{
+			name:     "raising a lowered limit back returns 403",
+			update:   service.TransferLimitUpdate{Daily: newLimit("1000.00")},
+			current:  &model.TransferLimit{UserID: userID, Daily: &lowered},
+			wantCode: 403,
+		},
+		{
+			name:     "zero limit returns 400",
+			update:   service.TransferLimitUpdate{Monthly: newLimit("0")},
+			current:  &model.TransferLimit{UserID: userID},
+			wantCode: 400,
+		},
+		{
+			name:    "lowering keeps the other limits",
+			update:  service.TransferLimitUpdate{PerTransaction: newLimit("250.00")},
+			current: &model.TransferLimit{UserID: userID, Daily: &lowered},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			limitRepo := repmocks.NewMockTransferLimitRepository(ctrl)
+			transferRepo := repmocks.NewMockTransferRepository(ctrl)
+
+			limitRepo.EXPECT().GetByUserID(gomock.Any(), userID).Return(tc.current, nil)
+			if tc.wantCode == 0 {
+				limitRepo.EXPECT().Upsert(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, limit *model.TransferLimit) error {
+					if !limit.PerTransaction.Equal(*tc.update.PerTransaction) || !limit.Daily.Equal(lowered) || limit.Monthly != nil {
+						t.Fatalf("unexpected limits: %+v", limit)
+					}
+					return nil
+				})
+				limitRepo.EXPECT().GetByUserID(gomock.Any(), userID).Return(tc.current, nil)
+				transferRepo.EXPECT().SumOutgoing(gomock.Any(), userID, gomock.Any(), uint64(0)).Times(2).Return(map[string]decimal.Decimal{"EUR": decimal.RequireFromString("100.00")}, nil)
+			}
+
+			svc := service.NewTransferLimitService(limitRepo, transferRepo, repmocks.NewMockBeneficiaryRepository(ctrl), repmocks.NewMockFXRepository(ctrl), limitConfig, noCoolingOff)
+			got, err := svc.Update(context.Background(), userID, tc.update)
+			if tc.wantCode != 0 {
+				apiErr, ok := err.(*util.APIError)
+				if !ok || apiErr.Code != tc.wantCode {
+					t.Fatalf("expected %d APIError, got %#v", tc.wantCode, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !got.DailyLimit.Equal(lowered) || !got.DailyRemaining.Equal(decimal.RequireFromString("700.00")) || !got.MonthlyLimit.Equal(limitConfig.Monthly) {
+				t.Fatalf("unexpected allowance: %+v", got)
+			}
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/shopspring/decimal"
 	"gorm.io/gorm"
 
+	"VDM2-BankBE/internal/config"
 	"VDM2-BankBE/internal/model"
 	"VDM2-BankBE/internal/repository"
 	"VDM2-BankBE/internal/util"
@@ -17,140 +18,1358 @@ import (
 
 // DefaultTransferService implements TransferService
 type DefaultTransferService struct {
-	transferRepo repository.TransferRepository
-	accountRepo  repository.AccountRepository
-	movementRepo repository.MovementRepository
-	redisClient  CacheClient
-	db           TxDB // For transactions
+	transferRepo   repository.TransferRepository
+	approvalRepo   repository.TransferApprovalRepository
+	outboxRepo     repository.TransferOutboxRepository
+	accountRepo    repository.AccountRepository
+	userRepo       repository.UserRepository
+	cosignerRepo   repository.CosignerRepository
+	movementRepo   repository.MovementRepository
+	ledgerService  LedgerService
+	limitService   TransferLimitService
+	feeService     FeeService
+	fxRepo         repository.FXRepository
+	redisClient    CacheClient
+	db             TxDB // For transactions
+	approvalConfig *config.ApprovalConfig
+	outboxConfig   *config.OutboxConfig
 }
 
 // NewTransferService creates a new transfer service
 func NewTransferService(
 	transferRepo repository.TransferRepository,
+	approvalRepo repository.TransferApprovalRepository,
+	outboxRepo repository.TransferOutboxRepository,
 	accountRepo repository.AccountRepository,
+	userRepo repository.UserRepository,
+	cosignerRepo repository.CosignerRepository,
 	movementRepo repository.MovementRepository,
+	ledgerService LedgerService,
+	limitService TransferLimitService,
+	feeService FeeService,
+	fxRepo repository.FXRepository,
 	redisClient CacheClient,
 	db TxDB,
+	approvalConfig *config.ApprovalConfig,
+	outboxConfig *config.OutboxConfig,
 ) TransferService {
 	return &DefaultTransferService{
-		transferRepo: transferRepo,
-		accountRepo:  accountRepo,
-		movementRepo: movementRepo,
-		redisClient:  redisClient,
-		db:           db,
+		transferRepo:   transferRepo,
+		approvalRepo:   approvalRepo,
+		outboxRepo:     outboxRepo,
+		accountRepo:    accountRepo,
+		userRepo:       userRepo,
+		cosignerRepo:   cosignerRepo,
+		movementRepo:   movementRepo,
+		ledgerService:  ledgerService,
+		limitService:   limitService,
+		feeService:     feeService,
+		fxRepo:         fxRepo,
+		redisClient:    redisClient,
+		db:             db,
+		approvalConfig: approvalConfig,
+		outboxConfig:   outboxConfig,
 	}
 }
 
-// Transfer performs a funds transfer between accounts
+// Transfer performs a funds transfer between accounts. Transfers above the approval threshold from business
+// accounts to other users are refused, since the caller expects them to be executed: only Submit holds them until
+// a co-signer approves them.
 func (s *DefaultTransferService) Transfer(
 	ctx context.Context,
 	fromAccountID, toAccountID uuid.UUID,
 	amount decimal.Decimal,
 	description string,
 ) (*model.Transfer, error) {
+	return s.transferNow(ctx, fromAccountID, toAccountID, amount, description, transferInstant)
+}
+
+// TransferStandingOrder performs the transfer of an occurrence of a standing order. It is charged the scheduled
+// transfer fee rather than the instant one and, like Transfer, it refuses transfers needing approval.
+func (s *DefaultTransferService) TransferStandingOrder(
+	ctx context.Context,
+	fromAccountID, toAccountID uuid.UUID,
+	amount decimal.Decimal,
+	description string,
+) (*model.Transfer, error) {
+	return s.transferNow(ctx, fromAccountID, toAccountID, amount, description, transferStandingOrder)
+}
+
+// Sweep moves amount, the whole balance of a closing account, to another account. A sweep to another user is
+// limited and charged like any other transfer, except that its fee is taken from amount so that the balance leaves
+// the account in full; like Transfer, it refuses transfers needing approval.
+func (s *DefaultTransferService) Sweep(
+	ctx context.Context,
+	fromAccountID, toAccountID uuid.UUID,
+	amount decimal.Decimal,
+	description string,
+) (*model.Transfer, error) {
+	return s.transferNow(ctx, fromAccountID, toAccountID, amount, description, transferSweep)
+}
+
+// transferKind tells how a transfer is charged
+type transferKind int
+
+const (
+	// transferInstant is charged the fee of its operation on top of its amount
+	transferInstant transferKind = iota
+	// transferStandingOrder is charged the scheduled transfer fee
+	transferStandingOrder
+	// transferSweep pays the fee of its operation out of its amount
+	transferSweep
+)
+
+// transferNow executes a transfer right away, refusing the ones needing approval
+func (s *DefaultTransferService) transferNow(
+	ctx context.Context,
+	fromAccountID, toAccountID uuid.UUID,
+	amount decimal.Decimal,
+	description string,
+	kind transferKind,
+) (*model.Transfer, error) {
+	transfer, fromAccount, toAccount, err := s.prepare(ctx, fromAccountID, toAccountID, amount, description, kind)
+	if err != nil {
+		return nil, err
+	}
+
+	approval, err := s.needsApproval(ctx, fromAccount, toAccount, transfer.Amount)
+	if err != nil {
+		return nil, err
+	}
+	if approval {
+		return nil, util.NewUnprocessableEntityError("transfers needing approval cannot be executed immediately")
+	}
+
+	return s.execute(ctx, transfer, fromAccount, toAccount)
+}
+
+// Submit accepts a transfer from one account to another and queues its execution: the pending transfer and its
+// outbox message are written in the same transaction, and the outbox workers execute it (see ProcessQueued).
+// Limits are checked when the transfer is accepted and again when it is executed.
+func (s *DefaultTransferService) Submit(
+	ctx context.Context,
+	fromAccountID, toAccountID uuid.UUID,
+	amount decimal.Decimal,
+	description string,
+) (*model.Transfer, error) {
+	transfer, fromAccount, toAccount, err := s.prepare(ctx, fromAccountID, toAccountID, amount, description, transferInstant)
+	if err != nil {
+		return nil, err
+	}
+
+	approval, err := s.needsApproval(ctx, fromAccount, toAccount, amount)
+	if err != nil {
+		return nil, err
+	}
+	if approval {
+		return s.awaitApproval(ctx, transfer, fromAccount)
+	}
+
+	// Refuse transfers over the limits upfront rather than failing them in the background
+	if fromAccount.UserID != toAccount.UserID {
+		if err := s.limitService.Check(ctx, fromAccount.UserID, toAccount.ID, amount, fromAccount.Currency, 0); err != nil {
+			if _, ok := err.(*util.APIError); ok {
+				return nil, err
+			}
+			return nil, errors.Wrap(err, "failed to check transfer limits")
+		}
+	}
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		txCtx := repository.WithTx(ctx, tx)
+
+		if err := s.transferRepo.Create(txCtx, transfer); err != nil {
+			return errors.Wrap(err, "failed to create transfer record")
+		}
+
+		return s.queue(txCtx, transfer)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to queue transfer")
+	}
+
+	return transfer, nil
+}
+
+// prepare validates a transfer of amount between two accounts of the same currency and builds it, fee included.
+// The fee of a sweep is taken from amount rather than charged on top of it.
+func (s *DefaultTransferService) prepare(
+	ctx context.Context,
+	fromAccountID, toAccountID uuid.UUID,
+	amount decimal.Decimal,
+	description string,
+	kind transferKind,
+) (*model.Transfer, *model.Account, *model.Account, error) {
+	// Validate amount
+	if amount.LessThanOrEqual(decimal.Zero) {
+		return nil, nil, nil, util.NewBadRequestError("amount must be greater than zero")
+	}
+
+	// Check if accounts exist
+	fromAccount, err := s.accountRepo.GetByID(ctx, fromAccountID)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "failed to get source account")
+	}
+
+	toAccount, err := s.accountRepo.GetByID(ctx, toAccountID)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "failed to get destination account")
+	}
+
+	// Check for self-transfer
+	if fromAccountID == toAccountID {
+		return nil, nil, nil, util.NewBadRequestError("cannot transfer to the same account")
+	}
+
+	if err := checkCanTransfer(fromAccount, toAccount); err != nil {
+		return nil, nil, nil, err
+	}
+	if err := checkSameCurrency(fromAccount, toAccount, amount); err != nil {
+		return nil, nil, nil, err
+	}
+
+	// Create transfer record
+	transfer := &model.Transfer{
+		FromAccount: fromAccountID,
+		ToAccount:   toAccountID,
+		Amount:      amount,
+		Currency:    fromAccount.Currency,
+		Description: description,
+		Status:      "pending",
+		InitiatedAt: time.Now(),
+	}
+	if err := s.applyFee(ctx, transfer, fromAccount, toAccount, kind == transferStandingOrder); err != nil {
+		return nil, nil, nil, err
+	}
+	if kind == transferSweep && transfer.Fee.IsPositive() {
+		if amount.LessThanOrEqual(transfer.Fee) {
+			return nil, nil, nil, util.NewUnprocessableEntityError("balance does not cover the fee of the sweep")
+		}
+		transfer.Amount = amount.Sub(transfer.Fee)
+	}
+
+	// Check if source account has sufficient funds for the amount and the fee, overdraft included
+	if fromAccount.AvailableBalance().LessThan(transfer.Amount.Add(transfer.Fee)) {
+		return nil, nil, nil, util.NewBadRequestError("insufficient funds")
+	}
+
+	return transfer, fromAccount, toAccount, nil
+}
+
+// TransferConverted performs a transfer to an account of another currency, converting amount at the rate locked
+// by an FX quote of the sender. The quote must be for the currencies of the accounts and for amount, and it can
+// be used once before it expires.
+func (s *DefaultTransferService) TransferConverted(
+	ctx context.Context,
+	fromAccountID, toAccountID uuid.UUID,
+	amount decimal.Decimal,
+	description string,
+	quoteID uuid.UUID,
+) (*model.Transfer, error) {
+	// Validate amount
+	if amount.LessThanOrEqual(decimal.Zero) {
+		return nil, util.NewBadRequestError("amount must be greater than zero")
+	}
+
+	// Check if accounts exist
+	fromAccount, err := s.accountRepo.GetByID(ctx, fromAccountID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get source account")
+	}
+
+	toAccount, err := s.accountRepo.GetByID(ctx, toAccountID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get destination account")
+	}
+
+	// Check for self-transfer
+	if fromAccountID == toAccountID {
+		return nil, util.NewBadRequestError("cannot transfer to the same account")
+	}
+
+	if err := checkCanTransfer(fromAccount, toAccount); err != nil {
+		return nil, err
+	}
+	if fromAccount.Currency == toAccount.Currency {
+		return nil, util.NewBadRequestError("accounts are both in " + fromAccount.Currency + ", no fx quote is needed")
+	}
+
+	// Quotes expire before a co-signer could approve the transfer
+	approval, err := s.needsApproval(ctx, fromAccount, toAccount, amount)
+	if err != nil {
+		return nil, err
+	}
+	if approval {
+		return nil, util.NewUnprocessableEntityError("transfers needing approval cannot be converted")
+	}
+
+	// Check the quote; it is claimed when the transfer is posted
+	quote, err := s.fxRepo.GetQuote(ctx, quoteID)
+	if err != nil {
+		if _, ok := err.(*util.APIError); ok {
+			return nil, err
+		}
+		return nil, errors.Wrap(err, "failed to get fx quote")
+	}
+	if quote.UserID != fromAccount.UserID {
+		return nil, util.NewNotFoundError("fx quote not found")
+	}
+	if quote.FromCurrency != fromAccount.Currency || quote.ToCurrency != toAccount.Currency {
+		return nil, util.NewUnprocessableEntityError("fx quote is for " + quote.FromCurrency + " to " + quote.ToCurrency +
+			", not " + fromAccount.Currency + " to " + toAccount.Currency)
+	}
+	if !quote.Amount.Equal(amount) {
+		return nil, util.NewUnprocessableEntityError("fx quote is for an amount of " + quote.Amount.String() + " " + quote.FromCurrency)
+	}
+	if quote.UsedAt != nil {
+		return nil, util.NewConflictError("fx quote has already been used")
+	}
+	if !quote.ExpiresAt.After(time.Now()) {
+		return nil, util.NewUnprocessableEntityError("fx quote has expired")
+	}
+
+	// Create transfer record
+	transfer := &model.Transfer{
+		FromAccount:       fromAccountID,
+		ToAccount:         toAccountID,
+		Amount:            amount,
+		Currency:          fromAccount.Currency,
+		ConvertedAmount:   &quote.ConvertedAmount,
+		ConvertedCurrency: &quote.ToCurrency,
+		FXRate:            &quote.Rate,
+		QuoteID:           &quote.ID,
+		Description:       description,
+		Status:            "pending",
+		InitiatedAt:       time.Now(),
+	}
+	if err := s.applyFee(ctx, transfer, fromAccount, toAccount, false); err != nil {
+		return nil, err
+	}
+
+	// Check if source account has sufficient funds for the amount and the fee, overdraft included
+	if fromAccount.AvailableBalance().LessThan(amount.Add(transfer.Fee)) {
+		return nil, util.NewBadRequestError("insufficient funds")
+	}
+
+	return s.execute(ctx, transfer, fromAccount, toAccount)
+}
+
+// TransferAll performs the transfers in a single transaction, within the transaction carried by ctx if any, so that
+// either all of them or none are executed. When it fails it also returns the index of the transfer that could not
+// be executed.
+func (s *DefaultTransferService) TransferAll(ctx context.Context, transfers []*model.Transfer) (int, error) {
+	// Validate every transfer before moving any funds; the ledger checks the balances while posting
+	accounts := make(map[uuid.UUID]*model.Account)
+	getAccount := func(id uuid.UUID) (*model.Account, error) {
+		if account, ok := accounts[id]; ok {
+			return account, nil
+		}
+		account, err := s.accountRepo.GetByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		accounts[id] = account
+		return account, nil
+	}
+
+	for i, transfer := range transfers {
+		if transfer.Amount.LessThanOrEqual(decimal.Zero) {
+			return i, util.NewBadRequestError("amount must be greater than zero")
+		}
+		if transfer.FromAccount == transfer.ToAccount {
+			return i, util.NewBadRequestError("cannot transfer to the same account")
+		}
+
+		fromAccount, err := getAccount(transfer.FromAccount)
+		if err != nil {
+			return i, errors.Wrap(err, "failed to get source account")
+		}
+		toAccount, err := getAccount(transfer.ToAccount)
+		if err != nil {
+			return i, errors.Wrap(err, "failed to get destination account")
+		}
+		if err := checkCanTransfer(fromAccount, toAccount); err != nil {
+			return i, err
+		}
+		if err := checkSameCurrency(fromAccount, toAccount, transfer.Amount); err != nil {
+			return i, err
+		}
+		approval, err := s.needsApproval(ctx, fromAccount, toAccount, transfer.Amount)
+		if err != nil {
+			return i, err
+		}
+		if approval {
+			return i, util.NewUnprocessableEntityError("transfers needing approval cannot be batched")
+		}
+
+		transfer.Currency = fromAccount.Currency
+		transfer.Status = "pending"
+		transfer.InitiatedAt = time.Now()
+		if err := s.applyFee(ctx, transfer, fromAccount, toAccount, false); err != nil {
+			return i, err
+		}
+	}
+
+	// Execute the transfers in one transaction; repositories join it through the context
+	failed := 0
+	balances := make(map[uuid.UUID]decimal.Decimal)
+	err := inTx(ctx, s.db, func(txCtx context.Context) error {
+		for i, transfer := range transfers {
+			failed = i
+			entry, err := s.post(txCtx, transfer, accounts[transfer.FromAccount], accounts[transfer.ToAccount])
+			if err != nil {
+				return err
+			}
+			balances[transfer.FromAccount] = entry.Postings[0].BalanceAfter
+			balances[transfer.ToAccount] = entry.Postings[1].BalanceAfter
+			if transfer.Fee.IsPositive() {
+				balances[transfer.FromAccount] = balances[transfer.FromAccount].Sub(transfer.Fee)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		if _, ok := err.(*util.APIError); ok {
+			return failed, err
+		}
+		return failed, errors.Wrap(err, "transfers failed")
+	}
+
+	// Update balance cache
+	for accountID, balance := range balances {
+		_ = s.redisClient.SetBalanceCache(ctx, accountID, balance)
+	}
+
+	for _, transfer := range transfers {
+		transfer.Status = "completed"
+	}
+
+	return 0, nil
+}
+
+// Schedule creates a transfer to be executed at executeAt by the scheduled transfer executor.
+// Funds are only checked when the transfer is executed.
+func (s *DefaultTransferService) Schedule(
+	ctx context.Context,
+	fromAccountID, toAccountID uuid.UUID,
+	amount decimal.Decimal,
+	description string,
+	executeAt time.Time,
+) (*model.Transfer, error) {
+	// Validate amount
+	if amount.LessThanOrEqual(decimal.Zero) {
+		return nil, util.NewBadRequestError("amount must be greater than zero")
+	}
+
+	// Validate execution date
+	if !executeAt.After(time.Now()) {
+		return nil, util.NewBadRequestError("execute_at must be in the future")
+	}
+
+	// Check if accounts exist
+	fromAccount, err := s.accountRepo.GetByID(ctx, fromAccountID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get source account")
+	}
+
+	toAccount, err := s.accountRepo.GetByID(ctx, toAccountID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get destination account")
+	}
+
+	// Check for self-transfer
+	if fromAccountID == toAccountID {
+		return nil, util.NewBadRequestError("cannot transfer to the same account")
+	}
+
+	// Account statuses are checked again when the transfer is executed
+	if err := checkCanTransfer(fromAccount, toAccount); err != nil {
+		return nil, err
+	}
+
+	// Quotes expire too soon to be used by a scheduled transfer
+	if err := checkSameCurrency(fromAccount, toAccount, amount); err != nil {
+		return nil, err
+	}
+
+	transfer := &model.Transfer{
+		FromAccount: fromAccountID,
+		ToAccount:   toAccountID,
+		Amount:      amount,
+		Currency:    fromAccount.Currency,
+		Description: description,
+		Status:      "scheduled",
+		InitiatedAt: time.Now(),
+		ExecuteAt:   &executeAt,
+	}
+
+	// The fee is set when scheduling so that it is known upfront; it is charged when the transfer is executed
+	if err := s.applyFee(ctx, transfer, fromAccount, toAccount, false); err != nil {
+		return nil, err
+	}
+	if err := s.transferRepo.Create(ctx, transfer); err != nil {
+		return nil, errors.Wrap(err, "failed to create scheduled transfer")
+	}
+
+	return transfer, nil
+}
+
+// Cancel cancels a scheduled transfer sent from accountID
+func (s *DefaultTransferService) Cancel(ctx context.Context, accountID uuid.UUID, id uint64) (*model.Transfer, error) {
+	transfer, err := s.transferRepo.GetByID(ctx, id)
+	if err != nil {
+		if _, ok := err.(*util.APIError); ok {
+			return nil, err
+		}
+		return nil, errors.Wrap(err, "failed to get transfer")
+	}
+
+	// Transfers of other accounts are reported as missing
+	if transfer.FromAccount != accountID {
+		return nil, util.NewNotFoundError("transfer not found")
+	}
+
+	if transfer.Status != "scheduled" {
+		return nil, util.NewConflictError("only scheduled transfers can be cancelled")
+	}
+
+	// The executor may have picked the transfer up in the meantime
+	cancelled, err := s.transferRepo.TransitionStatus(ctx, id, "scheduled", "cancelled")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to cancel transfer")
+	}
+	if !cancelled {
+		return nil, util.NewConflictError("only scheduled transfers can be cancelled")
+	}
+
+	transfer.Status = "cancelled"
+	return transfer, nil
+}
+
+// GetDueScheduled retrieves up to limit scheduled transfers that are due at now
+func (s *DefaultTransferService) GetDueScheduled(ctx context.Context, now time.Time, limit int) ([]*model.Transfer, error) {
+	transfers, err := s.transferRepo.GetDueScheduled(ctx, now, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get due scheduled transfers")
+	}
+
+	return transfers, nil
+}
+
+// ExecuteScheduled claims a due scheduled transfer and queues its execution, or holds it for approval when it
+// needs one, in the transaction that claims it; the outbox workers execute it (see ProcessQueued). Transfers that
+// can no longer be sent are marked as failed.
+func (s *DefaultTransferService) ExecuteScheduled(ctx context.Context, id uint64) (*model.Transfer, error) {
+	transfer, err := s.transferRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get scheduled transfer")
+	}
+	if transfer.Status != "scheduled" {
+		return nil, util.NewConflictError("transfer is no longer scheduled")
+	}
+
+	fromAccount, err := s.accountRepo.GetByID(ctx, transfer.FromAccount)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get source account")
+	}
+	toAccount, err := s.accountRepo.GetByID(ctx, transfer.ToAccount)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get destination account")
+	}
+
+	approval, err := s.needsApproval(ctx, fromAccount, toAccount, transfer.Amount)
+	if err != nil {
+		if apiErr, ok := err.(*util.APIError); ok {
+			if _, err := s.transferRepo.TransitionStatus(ctx, id, "scheduled", "failed"); err != nil {
+				return nil, errors.Wrap(err, "failed to fail scheduled transfer")
+			}
+			return nil, apiErr
+		}
+		return nil, err
+	}
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		txCtx := repository.WithTx(ctx, tx)
+
+		// Claim the transfer so that it is executed once, even with several executors running
+		claimed, err := s.transferRepo.TransitionStatus(txCtx, id, "scheduled", "pending")
+		if err != nil {
+			return errors.Wrap(err, "failed to claim scheduled transfer")
+		}
+		if !claimed {
+			return util.NewConflictError("transfer is no longer scheduled")
+		}
+
+		if approval {
+			return s.holdForApproval(txCtx, transfer, fromAccount)
+		}
+		transfer.Status = "pending"
+		return s.queue(txCtx, transfer)
+	})
+	if err != nil {
+		if _, ok := err.(*util.APIError); ok {
+			return nil, err
+		}
+		return nil, errors.Wrap(err, "failed to queue scheduled transfer")
+	}
+
+	return transfer, nil
+}
+
+// ClaimQueued claims up to limit outbox messages due by now, hiding them from the other workers for the lease
+func (s *DefaultTransferService) ClaimQueued(ctx context.Context, now time.Time, limit int) ([]*model.TransferOutbox, error) {
+	messages, err := s.outboxRepo.ClaimDue(ctx, now, s.outboxConfig.Lease, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to claim queued transfers")
+	}
+
+	return messages, nil
+}
+
+// ProcessQueued executes the transfer of a claimed outbox message. The transfer is completed, or marked as failed
+// when it can no longer be executed, in the same transaction that marks the message as processed. Other errors
+// release the message for a retry with exponential backoff, until the attempts run out and the transfer fails.
+func (s *DefaultTransferService) ProcessQueued(ctx context.Context, message *model.TransferOutbox) (*model.Transfer, error) {
+	transfer, err := s.transferRepo.GetByID(ctx, message.TransferID)
+	if err != nil {
+		return nil, s.retryQueued(ctx, message, errors.Wrap(err, "failed to get queued transfer"))
+	}
+
+	// The transfer may have been settled elsewhere, e.g. failed by the reconciliation
+	if transfer.Status != "pending" {
+		if _, err := s.outboxRepo.MarkProcessed(ctx, message.ID, time.Now(), ""); err != nil {
+			return nil, errors.Wrap(err, "failed to mark queued transfer as processed")
+		}
+		return transfer, nil
+	}
+
+	var fromAccount, toAccount *model.Account
+	processed := true
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		txCtx := repository.WithTx(ctx, tx)
+
+		// Processing the message first locks it, so that a worker claiming it again after the lease expired
+		// cannot execute the transfer twice
+		processed, err = s.outboxRepo.MarkProcessed(txCtx, message.ID, time.Now(), "")
+		if err != nil || !processed {
+			return err
+		}
+
+		fromAccount, err = s.accountRepo.GetByID(txCtx, transfer.FromAccount)
+		if err != nil {
+			return errors.Wrap(err, "failed to get source account")
+		}
+		toAccount, err = s.accountRepo.GetByID(txCtx, transfer.ToAccount)
+		if err != nil {
+			return errors.Wrap(err, "failed to get destination account")
+		}
+		if err := checkExecutable(transfer, fromAccount, toAccount); err != nil {
+			return err
+		}
+
+		_, err = s.post(txCtx, transfer, fromAccount, toAccount)
+		return err
+	})
+	if err != nil {
+		// Business rule violations will not go away by retrying
+		if apiErr, ok := err.(*util.APIError); ok {
+			if err := s.failQueued(ctx, message, apiErr.Message); err != nil {
+				return nil, err
+			}
+			return nil, apiErr
+		}
+		return nil, s.retryQueued(ctx, message, err)
+	}
+	if !processed {
+		return transfer, nil
+	}
+
+	// Update balance cache
+	_ = s.redisClient.SetBalanceCache(ctx, fromAccount.ID, fromAccount.Balance.Sub(transfer.Amount).Sub(transfer.Fee))
+	_ = s.redisClient.SetBalanceCache(ctx, toAccount.ID, toAccount.Balance.Add(transfer.Amount))
+
+	// Get the updated transfer
+	updatedTransfer, err := s.transferRepo.GetByID(ctx, transfer.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get updated transfer")
+	}
+
+	return updatedTransfer, nil
+}
+
+// Approve approves a transfer awaiting approval on behalf of userID, a co-signer of the sending account, and
+// queues its execution in the same transaction; the outbox workers execute it (see ProcessQueued)
+func (s *DefaultTransferService) Approve(ctx context.Context, userID uuid.UUID, id uint64, note string) (*model.Transfer, error) {
+	transfer, err := s.getAwaitingDecision(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		txCtx := repository.WithTx(ctx, tx)
+
+		// Claim the transfer so that it is decided once, even when approved and rejected concurrently
+		claimed, err := s.transferRepo.TransitionStatus(txCtx, id, "awaiting_approval", "pending")
+		if err != nil {
+			return errors.Wrap(err, "failed to claim transfer awaiting approval")
+		}
+		if !claimed {
+			return util.NewConflictError("transfer is no longer awaiting approval")
+		}
+
+		if err := s.recordDecision(txCtx, id, &userID, "approved", note); err != nil {
+			return err
+		}
+
+		return s.queue(txCtx, transfer)
+	})
+	if err != nil {
+		if _, ok := err.(*util.APIError); ok {
+			return nil, err
+		}
+		return nil, errors.Wrap(err, "failed to approve transfer")
+	}
+
+	transfer.Status = "pending"
+	return transfer, nil
+}
+
+// Reject rejects a transfer awaiting approval on behalf of userID, a co-signer of the sending account, marking it
+// as failed and recording the decision in the same transaction
+func (s *DefaultTransferService) Reject(ctx context.Context, userID uuid.UUID, id uint64, note string) (*model.Transfer, error) {
+	transfer, err := s.getAwaitingDecision(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		txCtx := repository.WithTx(ctx, tx)
+
+		rejected, err := s.transferRepo.TransitionStatus(txCtx, id, "awaiting_approval", "failed")
+		if err != nil {
+			return errors.Wrap(err, "failed to claim transfer awaiting approval")
+		}
+		if !rejected {
+			return util.NewConflictError("transfer is no longer awaiting approval")
+		}
+
+		return s.recordDecision(txCtx, id, &userID, "rejected", note)
+	})
+	if err != nil {
+		if _, ok := err.(*util.APIError); ok {
+			return nil, err
+		}
+		return nil, errors.Wrap(err, "failed to reject transfer")
+	}
+
+	transfer.Status = "failed"
+	return transfer, nil
+}
+
+// GetAwaitingApproval retrieves the transfers awaiting approval that userID can decide on as a co-signer, with
+// pagination
+func (s *DefaultTransferService) GetAwaitingApproval(ctx context.Context, userID uuid.UUID, page, limit int) (*util.PaginatedResponse, error) {
+	// Create pagination params
+	params, err := util.NewPaginationParams(strconv.Itoa(page), strconv.Itoa(limit))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid pagination parameters")
+	}
+
+	// Get transfers
+	transfers, count, err := s.transferRepo.GetAwaitingApproval(ctx, userID, params)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get transfers awaiting approval")
+	}
+
+	// Create paginated response
+	response := util.NewPaginatedResponse(transfers, params, count)
+	return response, nil
+}
+
+// GetApprovals retrieves the decision trail of a transfer sent from an account of userID or from an account userID
+// co-signs
+func (s *DefaultTransferService) GetApprovals(ctx context.Context, userID uuid.UUID, id uint64) ([]*model.TransferApproval, error) {
+	transfer, err := s.transferRepo.GetByID(ctx, id)
+	if err != nil {
+		if _, ok := err.(*util.APIError); ok {
+			return nil, err
+		}
+		return nil, errors.Wrap(err, "failed to get transfer")
+	}
+
+	fromAccount, err := s.accountRepo.GetByID(ctx, transfer.FromAccount)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get source account")
+	}
+
+	// Transfers of other users are reported as missing
+	if fromAccount.UserID != userID {
+		cosigner, err := s.cosignerRepo.Exists(ctx, fromAccount.ID, userID)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to check co-signer")
+		}
+		if !cosigner {
+			return nil, util.NewNotFoundError("transfer not found")
+		}
+	}
+
+	approvals, err := s.approvalRepo.ListByTransferID(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get transfer approvals")
+	}
+
+	return approvals, nil
+}
+
+// ExpireApprovals marks as failed up to limit transfers whose approval expired by now, recording their expiry in
+// their decision trail, and returns how many were expired
+func (s *DefaultTransferService) ExpireApprovals(ctx context.Context, now time.Time, limit int) (int, error) {
+	transfers, err := s.transferRepo.GetExpiredApprovals(ctx, now, limit)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get transfers with expired approvals")
+	}
+
+	expired := 0
+	for _, transfer := range transfers {
+		// A co-signer may have decided on the transfer in the meantime
+		ok, err := s.transferRepo.TransitionStatus(ctx, transfer.ID, "awaiting_approval", "failed")
+		if err != nil {
+			return expired, errors.Wrap(err, "failed to expire transfer approval")
+		}
+		if !ok {
+			continue
+		}
+		if err := s.recordDecision(ctx, transfer.ID, nil, "expired", ""); err != nil {
+			return expired, err
+		}
+		expired++
+	}
+
+	return expired, nil
+}
+
+// Reverse gives back amount of a completed transfer through a compensating transfer in the opposite direction.
+// A zero amount reverses whatever has not been reversed yet. When accountID is set only transfers received
+// by that account can be reversed; admins pass nil to reverse any transfer.
+func (s *DefaultTransferService) Reverse(
+	ctx context.Context,
+	accountID *uuid.UUID,
+	id uint64,
+	amount decimal.Decimal,
+	description string,
+) (*model.Transfer, error) {
+	original, err := s.transferRepo.GetByID(ctx, id)
+	if err != nil {
+		if _, ok := err.(*util.APIError); ok {
+			return nil, err
+		}
+		return nil, errors.Wrap(err, "failed to get transfer")
+	}
+
+	// Only the receiving account may give the funds back
+	if accountID != nil && original.ToAccount != *accountID {
+		if original.FromAccount == *accountID {
+			return nil, util.NewForbiddenError("only the receiving account can reverse a transfer")
+		}
+		return nil, util.NewNotFoundError("transfer not found")
+	}
+
+	if original.ReversalOf != nil {
+		return nil, util.NewConflictError("a reversal cannot be reversed")
+	}
+	if original.Status != "completed" {
+		return nil, util.NewConflictError("only completed transfers can be reversed")
+	}
+	if original.ConvertedAmount != nil {
+		return nil, util.NewConflictError("converted transfers cannot be reversed")
+	}
+
 	// Validate amount
-	if amount.LessThanOrEqual(decimal.Zero) {
+	reversible := original.ReversibleAmount()
+	if reversible.LessThanOrEqual(decimal.Zero) {
+		return nil, util.NewConflictError("transfer already reversed")
+	}
+	if amount.IsZero() {
+		amount = reversible
+	}
+	if amount.LessThan(decimal.Zero) {
 		return nil, util.NewBadRequestError("amount must be greater than zero")
 	}
+	if amount.GreaterThan(reversible) {
+		return nil, util.NewBadRequestError("amount exceeds the reversible amount of " +
+			reversible.StringFixed(util.CurrencyMinorUnits(original.Currency)))
+	}
 
-	// Check if accounts exist
-	fromAccount, err := s.accountRepo.GetByID(ctx, fromAccountID)
+	// The funds go back from the receiving account
+	fromAccount, err := s.accountRepo.GetByID(ctx, original.ToAccount)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get source account")
 	}
 
-	toAccount, err := s.accountRepo.GetByID(ctx, toAccountID)
+	toAccount, err := s.accountRepo.GetByID(ctx, original.FromAccount)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get destination account")
 	}
 
-	// Check for self-transfer
-	if fromAccountID == toAccountID {
-		return nil, util.NewBadRequestError("cannot transfer to the same account")
+	if err := checkCanTransfer(fromAccount, toAccount); err != nil {
+		return nil, err
 	}
 
-	// Check if source account has sufficient funds
-	if fromAccount.Balance.LessThan(amount) {
+	if fromAccount.AvailableBalance().LessThan(amount) {
 		return nil, util.NewBadRequestError("insufficient funds")
 	}
 
-	// Create transfer record
-	transfer := &model.Transfer{
-		FromAccount: fromAccountID,
-		ToAccount:   toAccountID,
+	if description == "" {
+		description = "Reversal of transfer #" + uintToString(original.ID)
+	}
+
+	reversal := &model.Transfer{
+		FromAccount: original.ToAccount,
+		ToAccount:   original.FromAccount,
 		Amount:      amount,
+		Currency:    original.Currency,
+		Description: description,
 		Status:      "pending",
 		InitiatedAt: time.Now(),
+		ReversalOf:  &original.ID,
 	}
 
-	// Execute transfer in a transaction
-	err = s.db.Transaction(func(tx *gorm.DB) error {
-		// Create transfer record
+	return s.execute(ctx, reversal, fromAccount, toAccount)
+}
+
+// inTx runs fn in a transaction carried by the context passed to it. When ctx already carries a transaction, fn
+// runs in a savepoint of it, so that callers can make the work part of their own transaction.
+func inTx(ctx context.Context, db TxDB, fn func(txCtx context.Context) error) error {
+	if tx, ok := repository.TxFromContext(ctx); ok {
+		db = tx
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		return fn(repository.WithTx(ctx, tx))
+	})
+}
+
+// execute moves the funds of a validated transfer in a transaction and marks it as completed, within the
+// transaction carried by ctx if any. The transfer record is created within the transaction when it has not been
+// persisted yet.
+func (s *DefaultTransferService) execute(
+	ctx context.Context,
+	transfer *model.Transfer,
+	fromAccount, toAccount *model.Account,
+) (*model.Transfer, error) {
+	fromAccountID := transfer.FromAccount
+	toAccountID := transfer.ToAccount
+	amount := transfer.Amount
+
+	// Execute transfer in a transaction; repositories join it through the context
+	err := inTx(ctx, s.db, func(txCtx context.Context) error {
+		_, err := s.post(txCtx, transfer, fromAccount, toAccount)
+		return err
+	})
+
+	if err != nil {
+		// The transfer record, if created, is rolled back with the funds it would have moved
+		if _, ok := err.(*util.APIError); ok {
+			return nil, err
+		}
+		return nil, errors.Wrap(err, "transfer failed")
+	}
+
+	// Update balance cache; converted transfers credit the converted amount
+	credited := amount
+	if transfer.ConvertedAmount != nil {
+		credited = *transfer.ConvertedAmount
+	}
+	_ = s.redisClient.SetBalanceCache(ctx, fromAccountID, fromAccount.Balance.Sub(amount).Sub(transfer.Fee))
+	_ = s.redisClient.SetBalanceCache(ctx, toAccountID, toAccount.Balance.Add(credited))
+
+	// Get the updated transfer
+	updatedTransfer, err := s.transferRepo.GetByID(ctx, transfer.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get updated transfer")
+	}
+
+	return updatedTransfer, nil
+}
+
+// post moves the funds of a validated transfer within the transaction carried by ctx and marks it as completed,
+// returning the journal entry. The transfer record is created when it has not been persisted yet.
+func (s *DefaultTransferService) post(
+	ctx context.Context,
+	transfer *model.Transfer,
+	fromAccount, toAccount *model.Account,
+) (*model.JournalEntry, error) {
+	fromAccountID := transfer.FromAccount
+	toAccountID := transfer.ToAccount
+	amount := transfer.Amount
+	persisted := transfer.ID != 0
+
+	// Limits apply to funds leaving the user; reversals and transfers between accounts of the same user
+	// are not limited
+	if transfer.ReversalOf == nil && fromAccount.UserID != toAccount.UserID {
+		if err := s.limitService.Check(ctx, fromAccount.UserID, toAccount.ID, amount, transfer.Currency, transfer.ID); err != nil {
+			if _, ok := err.(*util.APIError); ok {
+				return nil, err
+			}
+			return nil, errors.Wrap(err, "failed to check transfer limits")
+		}
+	}
+
+	// Create transfer record
+	if !persisted {
 		if err := s.transferRepo.Create(ctx, transfer); err != nil {
-			return errors.Wrap(err, "failed to create transfer record")
+			return nil, errors.Wrap(err, "failed to create transfer record")
 		}
+	}
 
-		// Create debit movement
-		debitMovement := &model.Movement{
-			AccountID:   fromAccountID,
-			Amount:      amount,
-			Type:        "debit",
-			Description: description + " (Transfer #" + uintToString(transfer.ID) + ")",
-			OccurredAt:  time.Now(),
+	// Converted transfers claim their quote, which also guards against it being used twice concurrently
+	if transfer.QuoteID != nil {
+		used, err := s.fxRepo.UseQuote(ctx, *transfer.QuoteID, time.Now())
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to use fx quote")
 		}
-		if err := s.movementRepo.Create(ctx, debitMovement); err != nil {
-			return errors.Wrap(err, "failed to create debit movement")
+		if !used {
+			return nil, util.NewConflictError("fx quote has expired or has already been used")
 		}
+	}
 
-		// Create credit movement
-		creditMovement := &model.Movement{
-			AccountID:   toAccountID,
-			Amount:      amount,
-			Type:        "credit",
-			Description: description + " (Transfer #" + uintToString(transfer.ID) + ")",
-			OccurredAt:  time.Now(),
+	// Reversals consume the reversible amount of the original transfer, which also guards against
+	// concurrent reversals exceeding it
+	if transfer.ReversalOf != nil {
+		reversed, err := s.transferRepo.AddReversedAmount(ctx, *transfer.ReversalOf, amount)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to update reversed amount")
+		}
+		if !reversed {
+			return nil, util.NewConflictError("amount exceeds the reversible amount of the transfer")
 		}
-		if err := s.movementRepo.Create(ctx, creditMovement); err != nil {
-			return errors.Wrap(err, "failed to create credit movement")
+	}
+
+	description := transfer.Description + " (Transfer #" + uintToString(transfer.ID) + ")"
+
+	// Post to the ledger, which updates both account balances
+	entry := transferEntry(transfer, description)
+	if err := s.ledgerService.Post(ctx, entry); err != nil {
+		if _, ok := err.(*util.APIError); ok {
+			return nil, err
 		}
+		return nil, errors.Wrap(err, "failed to update account balances")
+	}
+
+	// Create debit movement
+	debitMovement := &model.Movement{
+		AccountID:      fromAccountID,
+		Amount:         amount,
+		Type:           "debit",
+		Description:    description,
+		OccurredAt:     time.Now(),
+		BalanceAfter:   entry.Postings[0].BalanceAfter,
+		JournalEntryID: &entry.ID,
+	}
+	setConversion(debitMovement, transfer)
+	if err := s.movementRepo.Create(ctx, debitMovement); err != nil {
+		return nil, errors.Wrap(err, "failed to create debit movement")
+	}
+
+	// Create credit movement, for the converted amount when converting
+	credited := amount
+	if transfer.ConvertedAmount != nil {
+		credited = *transfer.ConvertedAmount
+	}
+	creditMovement := &model.Movement{
+		AccountID:      toAccountID,
+		Amount:         credited,
+		Type:           "credit",
+		Description:    description,
+		OccurredAt:     time.Now(),
+		BalanceAfter:   entry.Postings[1].BalanceAfter,
+		JournalEntryID: &entry.ID,
+	}
+	setConversion(creditMovement, transfer)
+	if err := s.movementRepo.Create(ctx, creditMovement); err != nil {
+		return nil, errors.Wrap(err, "failed to create credit movement")
+	}
 
-		// Update source account balance
-		if err := s.accountRepo.UpdateBalance(ctx, fromAccountID, amount.Neg()); err != nil {
-			return errors.Wrap(err, "failed to update source account balance")
+	// Charge the fee as a movement of its own
+	if transfer.Fee.IsPositive() {
+		feeDescription := "Fee for transfer #" + uintToString(transfer.ID)
+		if _, err := chargeFee(ctx, s.ledgerService, s.movementRepo, fromAccountID, transfer.Fee, transfer.Currency, feeDescription); err != nil {
+			return nil, err
 		}
+	}
+
+	// Update transfer status
+	now := time.Now().Format(time.RFC3339)
+	if err := s.transferRepo.UpdateStatus(ctx, transfer.ID, "completed", &now); err != nil {
+		return nil, errors.Wrap(err, "failed to update transfer status")
+	}
+
+	return entry, nil
+}
+
+// checkExecutable checks that a stored transfer can still be executed between its accounts
+func checkExecutable(transfer *model.Transfer, fromAccount, toAccount *model.Account) error {
+	if err := checkCanTransfer(fromAccount, toAccount); err != nil {
+		return err
+	}
+	if fromAccount.Currency != toAccount.Currency {
+		return util.NewUnprocessableEntityError("accounts are no longer in the same currency")
+	}
+
+	// Check if source account has sufficient funds for the amount and the fee, overdraft included
+	if fromAccount.AvailableBalance().LessThan(transfer.Amount.Add(transfer.Fee)) {
+		return util.NewBadRequestError("insufficient funds")
+	}
+
+	return nil
+}
 
-		// Update destination account balance
-		if err := s.accountRepo.UpdateBalance(ctx, toAccountID, amount); err != nil {
-			return errors.Wrap(err, "failed to update destination account balance")
+// needsApproval tells whether a transfer of amount between the accounts has to be approved by a co-signer: it is
+// sent from a business account to another user and is above the approval threshold, converted to the currency of
+// the account. Such transfers cannot be made from accounts without co-signers.
+func (s *DefaultTransferService) needsApproval(
+	ctx context.Context,
+	fromAccount, toAccount *model.Account,
+	amount decimal.Decimal,
+) (bool, error) {
+	threshold := s.approvalConfig.Threshold
+	if fromAccount.Type != "business" || fromAccount.UserID == toAccount.UserID || !threshold.IsPositive() {
+		return false, nil
+	}
+	if fromAccount.Currency != s.approvalConfig.Currency {
+		rate, err := exchangeRate(ctx, s.fxRepo, s.approvalConfig.Currency, fromAccount.Currency)
+		if err != nil {
+			return false, err
 		}
+		threshold = threshold.Mul(rate).Round(util.CurrencyMinorUnits(fromAccount.Currency))
+	}
+	if amount.LessThanOrEqual(threshold) {
+		return false, nil
+	}
+
+	cosigners, err := s.cosignerRepo.ListByAccountID(ctx, fromAccount.ID)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to get co-signers")
+	}
+	if len(cosigners) == 0 {
+		return false, util.NewUnprocessableEntityError("transfers above " +
+			threshold.StringFixed(util.CurrencyMinorUnits(fromAccount.Currency)) + " " + fromAccount.Currency +
+			" need a co-signer to approve them")
+	}
+
+	return true, nil
+}
+
+// awaitApproval holds a validated transfer for approval and records the request in its decision trail, in a
+// transaction. The transfer record is created when it has not been persisted yet.
+func (s *DefaultTransferService) awaitApproval(
+	ctx context.Context,
+	transfer *model.Transfer,
+	fromAccount *model.Account,
+) (*model.Transfer, error) {
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		return s.holdForApproval(repository.WithTx(ctx, tx), transfer, fromAccount)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to hold transfer for approval")
+	}
+
+	return transfer, nil
+}
+
+// holdForApproval holds a transfer for approval within the transaction carried by ctx, creating its record when
+// it has not been persisted yet
+func (s *DefaultTransferService) holdForApproval(ctx context.Context, transfer *model.Transfer, fromAccount *model.Account) error {
+	expiresAt := time.Now().Add(s.approvalConfig.TTL)
+	transfer.Status = "awaiting_approval"
+	transfer.ApprovalExpiresAt = &expiresAt
 
-		// Update transfer status
-		now := time.Now().Format(time.RFC3339)
-		if err := s.transferRepo.UpdateStatus(ctx, transfer.ID, "completed", &now); err != nil {
-			return errors.Wrap(err, "failed to update transfer status")
+	if transfer.ID == 0 {
+		if err := s.transferRepo.Create(ctx, transfer); err != nil {
+			return errors.Wrap(err, "failed to create transfer record")
 		}
+	} else if err := s.transferRepo.AwaitApproval(ctx, transfer.ID, expiresAt); err != nil {
+		return err
+	}
 
-		return nil
+	return s.recordDecision(ctx, transfer.ID, &fromAccount.UserID, "requested", "")
+}
+
+// queue adds an outbox message for a pending transfer within the transaction carried by ctx, so that the outbox
+// workers execute it
+func (s *DefaultTransferService) queue(ctx context.Context, transfer *model.Transfer) error {
+	now := time.Now()
+	return s.outboxRepo.Create(ctx, &model.TransferOutbox{
+		TransferID:    transfer.ID,
+		NextAttemptAt: now,
+		CreatedAt:     now,
 	})
+}
 
+// getAwaitingDecision retrieves a transfer awaiting approval that userID can decide on as a co-signer of the
+// sending account
+func (s *DefaultTransferService) getAwaitingDecision(ctx context.Context, userID uuid.UUID, id uint64) (*model.Transfer, error) {
+	transfer, err := s.transferRepo.GetByID(ctx, id)
 	if err != nil {
-		// If transaction failed, mark transfer as failed
-		now := time.Now().Format(time.RFC3339)
-		_ = s.transferRepo.UpdateStatus(ctx, transfer.ID, "failed", &now)
-		return nil, errors.Wrap(err, "transfer failed")
+		if _, ok := err.(*util.APIError); ok {
+			return nil, err
+		}
+		return nil, errors.Wrap(err, "failed to get transfer")
 	}
 
-	// Update balance cache
-	_ = s.redisClient.SetBalanceCache(ctx, fromAccountID, fromAccount.Balance.Sub(amount))
-	_ = s.redisClient.SetBalanceCache(ctx, toAccountID, toAccount.Balance.Add(amount))
+	// Transfers of accounts the user does not co-sign are reported as missing
+	cosigner, err := s.cosignerRepo.Exists(ctx, transfer.FromAccount, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to check co-signer")
+	}
+	if !cosigner {
+		return nil, util.NewNotFoundError("transfer not found")
+	}
 
-	// Get the updated transfer
-	updatedTransfer, err := s.transferRepo.GetByID(ctx, transfer.ID)
+	if transfer.Status != "awaiting_approval" {
+		return nil, util.NewConflictError("transfer is not awaiting approval")
+	}
+	if transfer.ApprovalExpiresAt != nil && !transfer.ApprovalExpiresAt.After(time.Now()) {
+		return nil, util.NewConflictError("approval has expired")
+	}
+
+	return transfer, nil
+}
+
+// recordDecision adds a decision to the trail of a transfer held for approval
+func (s *DefaultTransferService) recordDecision(ctx context.Context, id uint64, userID *uuid.UUID, decision, note string) error {
+	approval := &model.TransferApproval{
+		TransferID: id,
+		UserID:     userID,
+		Decision:   decision,
+		Note:       note,
+		CreatedAt:  time.Now(),
+	}
+	if err := s.approvalRepo.Create(ctx, approval); err != nil {
+		return errors.Wrap(err, "failed to record approval decision")
+	}
+
+	return nil
+}
+
+// retryQueued releases an outbox message whose processing failed on cause for another attempt, after a delay
+// doubling with each attempt. The transfer is marked as failed once the attempts run out. It returns cause.
+func (s *DefaultTransferService) retryQueued(ctx context.Context, message *model.TransferOutbox, cause error) error {
+	if message.Attempts >= s.outboxConfig.MaxAttempts {
+		if err := s.failQueued(ctx, message, cause.Error()); err != nil {
+			return err
+		}
+		return cause
+	}
+
+	delay := s.outboxConfig.RetryDelay
+	for i := 1; i < message.Attempts && delay < s.outboxConfig.MaxRetryDelay; i++ {
+		delay *= 2
+	}
+	if delay > s.outboxConfig.MaxRetryDelay {
+		delay = s.outboxConfig.MaxRetryDelay
+	}
+	if err := s.outboxRepo.Retry(ctx, message.ID, time.Now().Add(delay), cause.Error()); err != nil {
+		return errors.Wrap(err, "failed to retry queued transfer")
+	}
+
+	return cause
+}
+
+// failQueued marks the transfer of an outbox message as failed and the message as processed, in one transaction
+func (s *DefaultTransferService) failQueued(ctx context.Context, message *model.TransferOutbox, reason string) error {
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		txCtx := repository.WithTx(ctx, tx)
+		now := time.Now()
+
+		processed, err := s.outboxRepo.MarkProcessed(txCtx, message.ID, now, reason)
+		if err != nil || !processed {
+			return err
+		}
+
+		completedAt := now.Format(time.RFC3339)
+		return s.transferRepo.UpdateStatus(txCtx, message.TransferID, "failed", &completedAt)
+	})
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to get updated transfer")
+		return errors.Wrap(err, "failed to fail queued transfer")
 	}
 
-	return updatedTransfer, nil
+	return nil
+}
+
+// applyFee sets the fee of a transfer from the rule of its operation type: cross-currency when it is converted,
+// scheduled when it has an execution date or is an occurrence of a standing order, instant otherwise. Reversals and
+// transfers between accounts of the same user are free.
+func (s *DefaultTransferService) applyFee(
+	ctx context.Context,
+	transfer *model.Transfer,
+	fromAccount, toAccount *model.Account,
+	standingOrder bool,
+) error {
+	if transfer.ReversalOf != nil || fromAccount.UserID == toAccount.UserID {
+		return nil
+	}
+
+	operation := FeeOperationInstantTransfer
+	if transfer.ConvertedAmount != nil {
+		operation = FeeOperationCrossCurrencyTransfer
+	} else if transfer.ExecuteAt != nil || standingOrder {
+		operation = FeeOperationScheduledTransfer
+	}
+
+	breakdown, err := s.feeService.Calculate(ctx, operation, transfer.Amount, transfer.Currency)
+	if err != nil {
+		if _, ok := err.(*util.APIError); ok {
+			return err
+		}
+		return errors.Wrap(err, "failed to calculate fee")
+	}
+	if breakdown.Total.IsPositive() {
+		transfer.Fee = breakdown.Total
+		transfer.FeeBreakdown = breakdown
+	}
+
+	return nil
+}
+
+// checkCanTransfer tells whether the statuses of the accounts let funds move from one to the other
+func checkCanTransfer(fromAccount, toAccount *model.Account) error {
+	if !fromAccount.CanSend() {
+		return util.NewConflictError("source account is " + fromAccount.Status)
+	}
+	if !toAccount.CanReceive() {
+		return util.NewConflictError("destination account is " + toAccount.Status)
+	}
+
+	return nil
+}
+
+// checkSameCurrency tells whether funds can move between the accounts without converting them, and whether the
+// amount fits the minor unit of their currency
+func checkSameCurrency(fromAccount, toAccount *model.Account, amount decimal.Decimal) error {
+	if fromAccount.Currency != toAccount.Currency {
+		return util.NewUnprocessableEntityError("transfers from " + fromAccount.Currency + " to " + toAccount.Currency +
+			" need an fx quote")
+	}
+	if err := util.ValidateAmountPrecision(amount, fromAccount.Currency); err != nil {
+		return util.NewBadRequestError(err.Error())
+	}
+
+	return nil
+}
+
+// setConversion records on a movement of a converted transfer the amounts on both sides and the rate
+func setConversion(movement *model.Movement, transfer *model.Transfer) {
+	if transfer.ConvertedAmount == nil {
+		return
+	}
+
+	movement.OriginalAmount = &transfer.Amount
+	movement.OriginalCurrency = &transfer.Currency
+	movement.ConvertedAmount = transfer.ConvertedAmount
+	movement.ConvertedCurrency = transfer.ConvertedCurrency
+	movement.FXRate = transfer.FXRate
 }
 
 // GetByID retrieves a transfer by ID
@@ -163,8 +1382,91 @@ func (s *DefaultTransferService) GetByID(ctx context.Context, id uint64) (*model
 	return transfer, nil
 }
 
-// GetByAccountID retrieves transfers for an account with pagination
-func (s *DefaultTransferService) GetByAccountID(ctx context.Context, accountID uuid.UUID, page, limit int) (*util.PaginatedResponse, error) {
+// GetForUser retrieves the details of a transfer for userID, who must own one of its accounts or co-sign the
+// sending one: the masked name of the owner of the account on the other side and the movements the transfer posted
+// on the accounts the user can see. Other transfers are reported as missing.
+func (s *DefaultTransferService) GetForUser(ctx context.Context, userID uuid.UUID, id uint64) (*model.TransferDetails, error) {
+	transfer, err := s.transferRepo.GetByID(ctx, id)
+	if err != nil {
+		if _, ok := err.(*util.APIError); ok {
+			return nil, err
+		}
+		return nil, errors.Wrap(err, "failed to get transfer")
+	}
+
+	fromAccount, err := s.accountRepo.GetByID(ctx, transfer.FromAccount)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get source account")
+	}
+	toAccount, err := s.accountRepo.GetByID(ctx, transfer.ToAccount)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get destination account")
+	}
+
+	sender := fromAccount.UserID == userID
+	receiver := toAccount.UserID == userID
+	if !sender && !receiver {
+		cosigner, err := s.cosignerRepo.Exists(ctx, transfer.FromAccount, userID)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to check co-signer")
+		}
+		if !cosigner {
+			return nil, util.NewNotFoundError("transfer not found")
+		}
+		sender = true
+	}
+
+	// The counterparty of transfers between accounts of the user is the user themselves
+	counterpartyID := fromAccount.UserID
+	if sender {
+		counterpartyID = toAccount.UserID
+	}
+	counterpartyName, err := maskedUserName(ctx, s.userRepo, counterpartyID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Movements on the other side would disclose the balance of its account
+	movements, err := s.movementRepo.GetByTransferID(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get transfer movements")
+	}
+	visible := make([]*model.Movement, 0, len(movements))
+	for _, movement := range movements {
+		if (sender && movement.AccountID == fromAccount.ID) || (receiver && movement.AccountID == toAccount.ID) {
+			visible = append(visible, movement)
+		}
+	}
+
+	return &model.TransferDetails{
+		Transfer:         *transfer,
+		CounterpartyName: counterpartyName,
+		Movements:        visible,
+	}, nil
+}
+
+// maskedUserName returns the masked name of a user, as shown to the other side of a transfer
+func maskedUserName(ctx context.Context, userRepo repository.UserRepository, userID uuid.UUID) (string, error) {
+	user, err := userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get counterparty")
+	}
+
+	return util.MaskName(user.FirstName, user.LastName), nil
+}
+
+// GetByAccountID retrieves the transfers of an account matching filter with pagination, each with its direction
+// relative to the account
+func (s *DefaultTransferService) GetByAccountID(
+	ctx context.Context,
+	accountID uuid.UUID,
+	filter *model.TransferFilter,
+	page, limit int,
+) (*util.PaginatedResponse, error) {
+	if err := validateTransferFilter(filter); err != nil {
+		return nil, err
+	}
+
 	// Check if account exists
 	_, err := s.accountRepo.GetByID(ctx, accountID)
 	if err != nil {
@@ -178,10 +1480,111 @@ func (s *DefaultTransferService) GetByAccountID(ctx context.Context, accountID u
 	}
 
 	// Get transfers
-	transfers, count, err := s.transferRepo.GetByAccountID(ctx, accountID, params)
+	transfers, count, err := s.transferRepo.GetByAccountID(ctx, accountID, filter, params)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get transfers")
+	}
+	setDirections(transfers, accountID)
+
+	// Create paginated response
+	response := util.NewPaginatedResponse(transfers, params, count)
+	return response, nil
+}
+
+// GetByAccountIDWithCursor retrieves the transfers of an account matching filter by keyset pagination, from the
+// position of cursor (empty for the first page), without counting them
+func (s *DefaultTransferService) GetByAccountIDWithCursor(
+	ctx context.Context,
+	accountID uuid.UUID,
+	filter *model.TransferFilter,
+	cursor string,
+	limit int,
+) (*util.CursorResponse, error) {
+	if err := validateTransferFilter(filter); err != nil {
+		return nil, err
+	}
+
+	params, err := util.NewCursorParams(cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if account exists
+	_, err = s.accountRepo.GetByID(ctx, accountID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get account")
+	}
+
+	// Get transfers
+	transfers, more, err := s.transferRepo.GetByAccountIDWithCursor(ctx, accountID, filter, params)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get transfers")
 	}
+	setDirections(transfers, accountID)
+
+	var first, last *util.Cursor
+	if len(transfers) > 0 {
+		first = &util.Cursor{Time: transfers[0].InitiatedAt, ID: transfers[0].ID}
+		last = &util.Cursor{Time: transfers[len(transfers)-1].InitiatedAt, ID: transfers[len(transfers)-1].ID}
+	}
+
+	return util.NewCursorResponse(transfers, params, first, last, more), nil
+}
+
+// transferStatuses are the statuses a transfer list can be filtered by
+var transferStatuses = map[string]bool{
+	"scheduled":         true,
+	"awaiting_approval": true,
+	"pending":           true,
+	"completed":         true,
+	"failed":            true,
+	"cancelled":         true,
+}
+
+// validateTransferFilter checks that the bounds of a transfer filter are consistent and its options known
+func validateTransferFilter(filter *model.TransferFilter) error {
+	if filter.Direction != "" && filter.Direction != "in" && filter.Direction != "out" {
+		return util.NewBadRequestError("direction must be 'in' or 'out'")
+	}
+	if filter.Status != "" && !transferStatuses[filter.Status] {
+		return util.NewBadRequestError("unknown status '" + filter.Status + "'")
+	}
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		return util.NewBadRequestError("from must be before to")
+	}
+	if (filter.MinAmount != nil && filter.MinAmount.IsNegative()) || (filter.MaxAmount != nil && filter.MaxAmount.IsNegative()) {
+		return util.NewBadRequestError("amount bounds must not be negative")
+	}
+	if filter.MinAmount != nil && filter.MaxAmount != nil && filter.MinAmount.GreaterThan(*filter.MaxAmount) {
+		return util.NewBadRequestError("min_amount must not be greater than max_amount")
+	}
+
+	return nil
+}
+
+// setDirections sets the direction of transfers relative to the account they are listed for
+func setDirections(transfers []*model.Transfer, accountID uuid.UUID) {
+	for _, transfer := range transfers {
+		transfer.Direction = "in"
+		if transfer.FromAccount == accountID {
+			transfer.Direction = "out"
+		}
+	}
+}
+
+// GetScheduledByAccountID retrieves the pending scheduled transfers of an account with pagination
+func (s *DefaultTransferService) GetScheduledByAccountID(ctx context.Context, accountID uuid.UUID, page, limit int) (*util.PaginatedResponse, error) {
+	// Create pagination params
+	params, err := util.NewPaginationParams(strconv.Itoa(page), strconv.Itoa(limit))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid pagination parameters")
+	}
+
+	// Get scheduled transfers
+	transfers, count, err := s.transferRepo.GetScheduledByAccountID(ctx, accountID, params)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get scheduled transfers")
+	}
 
 	// Create paginated response
 	response := util.NewPaginatedResponse(transfers, params, count)
@@ -3,6 +3,7 @@ package service_test
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"testing"
 	"time"
 
@@ -11,13 +12,26 @@ import (
 	"github.com/shopspring/decimal"
 	"gorm.io/gorm"
 
+	"VDM2-BankBE/internal/config"
 	"VDM2-BankBE/internal/model"
+	"VDM2-BankBE/internal/repository"
 	repmocks "VDM2-BankBE/internal/repository/mocks"
 	"VDM2-BankBE/internal/service"
 	servicemocks "VDM2-BankBE/internal/service/mocks"
 	"VDM2-BankBE/internal/util"
 )
 
+// noApprovals never holds a transfer for approval
+var noApprovals = &config.ApprovalConfig{}
+
+// outboxConfig gives up on a queued transfer after its third attempt
+var outboxConfig = &config.OutboxConfig{
+	Lease:         time.Minute,
+	MaxAttempts:   3,
+	RetryDelay:    2 * time.Second,
+	MaxRetryDelay: 5 * time.Minute,
+}
+
 func TestTransferService_Transfer(t *testing.T) {
 	t.Parallel()
 
@@ -35,6 +49,7 @@ func TestTransferService_Transfer(t *testing.T) {
 			*repmocks.MockTransferRepository,
 			*repmocks.MockAccountRepository,
 			*repmocks.MockMovementRepository,
+			*servicemocks.MockLedgerService,
 			*servicemocks.MockCacheClient,
 			*servicemocks.MockTxDB,
 		)
@@ -47,12 +62,14 @@ func TestTransferService_Transfer(t *testing.T) {
 				*repmocks.MockTransferRepository,
 				*repmocks.MockAccountRepository,
 				*repmocks.MockMovementRepository,
+				*servicemocks.MockLedgerService,
 				*servicemocks.MockCacheClient,
 				*servicemocks.MockTxDB,
 			) {
 				return repmocks.NewMockTransferRepository(ctrl),
 					repmocks.NewMockAccountRepository(ctrl),
 					repmocks.NewMockMovementRepository(ctrl),
+					servicemocks.NewMockLedgerService(ctrl),
 					servicemocks.NewMockCacheClient(ctrl),
 					servicemocks.NewMockTxDB(ctrl)
 			},
@@ -66,6 +83,93 @@ func TestTransferService_Transfer(t *testing.T) {
 				}
 			},
 		},
+		{
+			name:   "debit-blocked source returns 409",
+			amount: amount,
+			buildMocks: func(ctrl *gomock.Controller) (
+				*repmocks.MockTransferRepository,
+				*repmocks.MockAccountRepository,
+				*repmocks.MockMovementRepository,
+				*servicemocks.MockLedgerService,
+				*servicemocks.MockCacheClient,
+				*servicemocks.MockTxDB,
+			) {
+				accountRepo := repmocks.NewMockAccountRepository(ctrl)
+				accountRepo.EXPECT().GetByID(gomock.Any(), fromAccountID).Return(&model.Account{ID: fromAccountID, Status: "debit_blocked", Balance: startFromBalance}, nil)
+				accountRepo.EXPECT().GetByID(gomock.Any(), toAccountID).Return(&model.Account{ID: toAccountID, Status: "active", Balance: startToBalance}, nil)
+
+				return repmocks.NewMockTransferRepository(ctrl),
+					accountRepo,
+					repmocks.NewMockMovementRepository(ctrl),
+					servicemocks.NewMockLedgerService(ctrl),
+					servicemocks.NewMockCacheClient(ctrl),
+					servicemocks.NewMockTxDB(ctrl)
+			},
+			assert: func(t *testing.T, got *model.Transfer, err error) {
+				apiErr, ok := err.(*util.APIError)
+				if !ok || apiErr.Code != 409 || apiErr.Message != "source account is debit_blocked" {
+					t.Fatalf("expected 409 APIError, got %#v", err)
+				}
+			},
+		},
+		{
+			name:   "frozen destination returns 409",
+			amount: amount,
+			buildMocks: func(ctrl *gomock.Controller) (
+				*repmocks.MockTransferRepository,
+				*repmocks.MockAccountRepository,
+				*repmocks.MockMovementRepository,
+				*servicemocks.MockLedgerService,
+				*servicemocks.MockCacheClient,
+				*servicemocks.MockTxDB,
+			) {
+				accountRepo := repmocks.NewMockAccountRepository(ctrl)
+				accountRepo.EXPECT().GetByID(gomock.Any(), fromAccountID).Return(&model.Account{ID: fromAccountID, Status: "active", Balance: startFromBalance}, nil)
+				accountRepo.EXPECT().GetByID(gomock.Any(), toAccountID).Return(&model.Account{ID: toAccountID, Status: "frozen", Balance: startToBalance}, nil)
+
+				return repmocks.NewMockTransferRepository(ctrl),
+					accountRepo,
+					repmocks.NewMockMovementRepository(ctrl),
+					servicemocks.NewMockLedgerService(ctrl),
+					servicemocks.NewMockCacheClient(ctrl),
+					servicemocks.NewMockTxDB(ctrl)
+			},
+			assert: func(t *testing.T, got *model.Transfer, err error) {
+				apiErr, ok := err.(*util.APIError)
+				if !ok || apiErr.Code != 409 || apiErr.Message != "destination account is frozen" {
+					t.Fatalf("expected 409 APIError, got %#v", err)
+				}
+			},
+		},
+		{
+			name:   "destination in another currency returns 422",
+			amount: amount,
+			buildMocks: func(ctrl *gomock.Controller) (
+				*repmocks.MockTransferRepository,
+				*repmocks.MockAccountRepository,
+				*repmocks.MockMovementRepository,
+				*servicemocks.MockLedgerService,
+				*servicemocks.MockCacheClient,
+				*servicemocks.MockTxDB,
+			) {
+				accountRepo := repmocks.NewMockAccountRepository(ctrl)
+				accountRepo.EXPECT().GetByID(gomock.Any(), fromAccountID).Return(&model.Account{ID: fromAccountID, Status: "active", Balance: startFromBalance, Currency: "EUR"}, nil)
+				accountRepo.EXPECT().GetByID(gomock.Any(), toAccountID).Return(&model.Account{ID: toAccountID, Status: "active", Balance: startToBalance, Currency: "USD"}, nil)
+
+				return repmocks.NewMockTransferRepository(ctrl),
+					accountRepo,
+					repmocks.NewMockMovementRepository(ctrl),
+					servicemocks.NewMockLedgerService(ctrl),
+					servicemocks.NewMockCacheClient(ctrl),
+					servicemocks.NewMockTxDB(ctrl)
+			},
+			assert: func(t *testing.T, got *model.Transfer, err error) {
+				apiErr, ok := err.(*util.APIError)
+				if !ok || apiErr.Code != 422 || apiErr.Message != "transfers from EUR to USD need an fx quote" {
+					t.Fatalf("expected 422 APIError, got %#v", err)
+				}
+			},
+		},
 		{
 			name:   "insufficient funds returns 400",
 			amount: amount,
@@ -73,19 +177,21 @@ func TestTransferService_Transfer(t *testing.T) {
 				*repmocks.MockTransferRepository,
 				*repmocks.MockAccountRepository,
 				*repmocks.MockMovementRepository,
+				*servicemocks.MockLedgerService,
 				*servicemocks.MockCacheClient,
 				*servicemocks.MockTxDB,
 			) {
 				transferRepo := repmocks.NewMockTransferRepository(ctrl)
 				accountRepo := repmocks.NewMockAccountRepository(ctrl)
 				movementRepo := repmocks.NewMockMovementRepository(ctrl)
+				ledgerSvc := servicemocks.NewMockLedgerService(ctrl)
 				cache := servicemocks.NewMockCacheClient(ctrl)
 				txdb := servicemocks.NewMockTxDB(ctrl)
 
-				accountRepo.EXPECT().GetByID(gomock.Any(), fromAccountID).Return(&model.Account{ID: fromAccountID, Balance: decimal.NewFromInt(1)}, nil)
-				accountRepo.EXPECT().GetByID(gomock.Any(), toAccountID).Return(&model.Account{ID: toAccountID, Balance: startToBalance}, nil)
+				accountRepo.EXPECT().GetByID(gomock.Any(), fromAccountID).Return(&model.Account{ID: fromAccountID, Status: "active", Balance: decimal.NewFromInt(1)}, nil)
+				accountRepo.EXPECT().GetByID(gomock.Any(), toAccountID).Return(&model.Account{ID: toAccountID, Status: "active", Balance: startToBalance}, nil)
 
-				return transferRepo, accountRepo, movementRepo, cache, txdb
+				return transferRepo, accountRepo, movementRepo, ledgerSvc, cache, txdb
 			},
 			assert: func(t *testing.T, got *model.Transfer, err error) {
 				if got != nil {
@@ -97,6 +203,52 @@ func TestTransferService_Transfer(t *testing.T) {
 				}
 			},
 		},
+		{
+			name:   "overdraft covers the missing funds",
+			amount: amount,
+			buildMocks: func(ctrl *gomock.Controller) (
+				*repmocks.MockTransferRepository,
+				*repmocks.MockAccountRepository,
+				*repmocks.MockMovementRepository,
+				*servicemocks.MockLedgerService,
+				*servicemocks.MockCacheClient,
+				*servicemocks.MockTxDB,
+			) {
+				transferRepo := repmocks.NewMockTransferRepository(ctrl)
+				accountRepo := repmocks.NewMockAccountRepository(ctrl)
+				movementRepo := repmocks.NewMockMovementRepository(ctrl)
+				ledgerSvc := servicemocks.NewMockLedgerService(ctrl)
+				cache := servicemocks.NewMockCacheClient(ctrl)
+				txdb := servicemocks.NewMockTxDB(ctrl)
+
+				accountRepo.EXPECT().GetByID(gomock.Any(), fromAccountID).Return(&model.Account{ID: fromAccountID, Status: "active", Balance: decimal.NewFromInt(1), OverdraftLimit: decimal.NewFromInt(100)}, nil)
+				accountRepo.EXPECT().GetByID(gomock.Any(), toAccountID).Return(&model.Account{ID: toAccountID, Status: "active", Balance: startToBalance}, nil)
+
+				txdb.EXPECT().
+					Transaction(gomock.Any()).
+					DoAndReturn(func(fc func(tx *gorm.DB) error, opts ...*sql.TxOptions) error {
+						return fc(&gorm.DB{})
+					})
+				transferRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+				movementRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(2).Return(nil)
+				ledgerSvc.EXPECT().Post(gomock.Any(), gomock.Any()).Return(nil)
+				transferRepo.EXPECT().UpdateStatus(gomock.Any(), uint64(0), "completed", gomock.Any()).Return(nil)
+
+				cache.EXPECT().SetBalanceCache(gomock.Any(), fromAccountID, decimal.NewFromInt(1).Sub(amount)).Return(nil)
+				cache.EXPECT().SetBalanceCache(gomock.Any(), toAccountID, startToBalance.Add(amount)).Return(nil)
+				transferRepo.EXPECT().GetByID(gomock.Any(), uint64(0)).Return(&model.Transfer{FromAccount: fromAccountID, ToAccount: toAccountID, Amount: amount, Status: "completed"}, nil)
+
+				return transferRepo, accountRepo, movementRepo, ledgerSvc, cache, txdb
+			},
+			assert: func(t *testing.T, got *model.Transfer, err error) {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if got == nil || got.Status != "completed" {
+					t.Fatalf("unexpected transfer: %+v", got)
+				}
+			},
+		},
 		{
 			name:   "success runs transaction and updates cache",
 			amount: amount,
@@ -104,17 +256,19 @@ func TestTransferService_Transfer(t *testing.T) {
 				*repmocks.MockTransferRepository,
 				*repmocks.MockAccountRepository,
 				*repmocks.MockMovementRepository,
+				*servicemocks.MockLedgerService,
 				*servicemocks.MockCacheClient,
 				*servicemocks.MockTxDB,
 			) {
 				transferRepo := repmocks.NewMockTransferRepository(ctrl)
 				accountRepo := repmocks.NewMockAccountRepository(ctrl)
 				movementRepo := repmocks.NewMockMovementRepository(ctrl)
+				ledgerSvc := servicemocks.NewMockLedgerService(ctrl)
 				cache := servicemocks.NewMockCacheClient(ctrl)
 				txdb := servicemocks.NewMockTxDB(ctrl)
 
-				fromAccount := &model.Account{ID: fromAccountID, Balance: startFromBalance}
-				toAccount := &model.Account{ID: toAccountID, Balance: startToBalance}
+				fromAccount := &model.Account{ID: fromAccountID, Status: "active", Balance: startFromBalance}
+				toAccount := &model.Account{ID: toAccountID, Status: "active", Balance: startToBalance}
 
 				accountRepo.EXPECT().GetByID(gomock.Any(), fromAccountID).Return(fromAccount, nil)
 				accountRepo.EXPECT().GetByID(gomock.Any(), toAccountID).Return(toAccount, nil)
@@ -136,8 +290,18 @@ func TestTransferService_Transfer(t *testing.T) {
 				})
 
 				movementRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(2).Return(nil)
-				accountRepo.EXPECT().UpdateBalance(gomock.Any(), fromAccountID, amount.Neg()).Return(nil)
-				accountRepo.EXPECT().UpdateBalance(gomock.Any(), toAccountID, amount).Return(nil)
+				ledgerSvc.EXPECT().Post(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, entry *model.JournalEntry) error {
+					if len(entry.Postings) != 2 {
+						t.Fatalf("unexpected postings: %+v", entry.Postings)
+					}
+					if *entry.Postings[0].AccountID != fromAccountID || !entry.Postings[0].Amount.Equal(amount.Neg()) {
+						t.Fatalf("unexpected debit posting: %+v", entry.Postings[0])
+					}
+					if *entry.Postings[1].AccountID != toAccountID || !entry.Postings[1].Amount.Equal(amount) {
+						t.Fatalf("unexpected credit posting: %+v", entry.Postings[1])
+					}
+					return nil
+				})
 				transferRepo.EXPECT().UpdateStatus(gomock.Any(), uint64(0), "completed", gomock.Any()).Return(nil)
 
 				cache.EXPECT().SetBalanceCache(gomock.Any(), fromAccountID, startFromBalance.Sub(amount)).Return(nil)
@@ -153,7 +317,7 @@ func TestTransferService_Transfer(t *testing.T) {
 				}
 				transferRepo.EXPECT().GetByID(gomock.Any(), uint64(0)).Return(updated, nil)
 
-				return transferRepo, accountRepo, movementRepo, cache, txdb
+				return transferRepo, accountRepo, movementRepo, ledgerSvc, cache, txdb
 			},
 			assert: func(t *testing.T, got *model.Transfer, err error) {
 				if err != nil {
@@ -173,8 +337,8 @@ func TestTransferService_Transfer(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			transferRepo, accountRepo, movementRepo, cache, txdb := tc.buildMocks(ctrl)
-			svc := service.NewTransferService(transferRepo, accountRepo, movementRepo, cache, txdb)
+			transferRepo, accountRepo, movementRepo, ledgerSvc, cache, txdb := tc.buildMocks(ctrl)
+			svc := service.NewTransferService(transferRepo, repmocks.NewMockTransferApprovalRepository(ctrl), repmocks.NewMockTransferOutboxRepository(ctrl), accountRepo, repmocks.NewMockUserRepository(ctrl), repmocks.NewMockCosignerRepository(ctrl), movementRepo, ledgerSvc, servicemocks.NewMockTransferLimitService(ctrl), noFees, repmocks.NewMockFXRepository(ctrl), cache, txdb, noApprovals, outboxConfig)
 
 			got, err := svc.Transfer(context.Background(), fromAccountID, toAccountID, tc.amount, "desc")
 			tc.assert(t, got, err)
@@ -182,3 +346,2169 @@ func TestTransferService_Transfer(t *testing.T) {
 	}
 }
 
+func TestTransferService_TransferChargesFee(t *testing.T) {
+	t.Parallel()
+
+	fromUserID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440340")
+	toUserID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440341")
+	fromAccountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440342")
+	toAccountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440343")
+
+	amount := decimal.RequireFromString("25.00")
+	fee := decimal.RequireFromString("0.50")
+	breakdown := &model.FeeBreakdown{Operation: service.FeeOperationInstantTransfer, Rule: "flat", Amount: amount, Currency: "EUR", Flat: fee, Total: fee}
+
+	tests := []struct {
+		name        string
+		fromBalance string
+		buildMocks  func(ctrl *gomock.Controller) (
+			*repmocks.MockTransferRepository,
+			*repmocks.MockMovementRepository,
+			*servicemocks.MockLedgerService,
+			*servicemocks.MockTransferLimitService,
+			*servicemocks.MockCacheClient,
+			*servicemocks.MockTxDB,
+		)
+		wantCode int
+	}{
+		{
+			name:        "fee is posted to the fee account as a movement of its own",
+			fromBalance: "100.00",
+			buildMocks: func(ctrl *gomock.Controller) (
+				*repmocks.MockTransferRepository,
+				*repmocks.MockMovementRepository,
+				*servicemocks.MockLedgerService,
+				*servicemocks.MockTransferLimitService,
+				*servicemocks.MockCacheClient,
+				*servicemocks.MockTxDB,
+			) {
+				transferRepo := repmocks.NewMockTransferRepository(ctrl)
+				movementRepo := repmocks.NewMockMovementRepository(ctrl)
+				ledgerSvc := servicemocks.NewMockLedgerService(ctrl)
+				limitSvc := servicemocks.NewMockTransferLimitService(ctrl)
+				cache := servicemocks.NewMockCacheClient(ctrl)
+				txdb := servicemocks.NewMockTxDB(ctrl)
+
+				txdb.EXPECT().
+					Transaction(gomock.Any()).
+					DoAndReturn(func(fc func(tx *gorm.DB) error, opts ...*sql.TxOptions) error {
+						return fc(&gorm.DB{})
+					})
+				limitSvc.EXPECT().Check(gomock.Any(), fromUserID, toAccountID, amount, "EUR", uint64(0)).Return(nil)
+				transferRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, tr *model.Transfer) error {
+					if !tr.Fee.Equal(fee) || tr.FeeBreakdown != breakdown {
+						t.Fatalf("unexpected transfer fee: %s %+v", tr.Fee, tr.FeeBreakdown)
+					}
+					return nil
+				})
+				gomock.InOrder(
+					ledgerSvc.EXPECT().Post(gomock.Any(), gomock.Any()).Return(nil),
+					ledgerSvc.EXPECT().Post(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, entry *model.JournalEntry) error {
+						if len(entry.Postings) != 2 || *entry.Postings[0].AccountID != fromAccountID || !entry.Postings[0].Amount.Equal(fee.Neg()) {
+							t.Fatalf("unexpected fee posting: %+v", entry.Postings)
+						}
+						if entry.Postings[1].SystemAccount == nil || *entry.Postings[1].SystemAccount != "fees" {
+							t.Fatalf("unexpected fee account: %+v", entry.Postings[1])
+						}
+						return nil
+					}),
+				)
+				movementRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(2).Return(nil)
+				movementRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, movement *model.Movement) error {
+					if movement.Type != "debit" || !movement.Amount.Equal(fee) || movement.Description != "Fee for transfer #0" {
+						t.Fatalf("unexpected fee movement: %+v", movement)
+					}
+					return nil
+				})
+				transferRepo.EXPECT().UpdateStatus(gomock.Any(), uint64(0), "completed", gomock.Any()).Return(nil)
+
+				cache.EXPECT().SetBalanceCache(gomock.Any(), fromAccountID, decimal.RequireFromString("74.50")).Return(nil)
+				cache.EXPECT().SetBalanceCache(gomock.Any(), toAccountID, gomock.Any()).Return(nil)
+				transferRepo.EXPECT().GetByID(gomock.Any(), uint64(0)).Return(&model.Transfer{Status: "completed", Fee: fee}, nil)
+
+				return transferRepo, movementRepo, ledgerSvc, limitSvc, cache, txdb
+			},
+		},
+		{
+			name:        "funds must cover the amount and the fee",
+			fromBalance: "25.00",
+			buildMocks: func(ctrl *gomock.Controller) (
+				*repmocks.MockTransferRepository,
+				*repmocks.MockMovementRepository,
+				*servicemocks.MockLedgerService,
+				*servicemocks.MockTransferLimitService,
+				*servicemocks.MockCacheClient,
+				*servicemocks.MockTxDB,
+			) {
+				return repmocks.NewMockTransferRepository(ctrl),
+					repmocks.NewMockMovementRepository(ctrl),
+					servicemocks.NewMockLedgerService(ctrl),
+					servicemocks.NewMockTransferLimitService(ctrl),
+					servicemocks.NewMockCacheClient(ctrl),
+					servicemocks.NewMockTxDB(ctrl)
+			},
+			wantCode: 400,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			accountRepo := repmocks.NewMockAccountRepository(ctrl)
+			accountRepo.EXPECT().GetByID(gomock.Any(), fromAccountID).
+				Return(&model.Account{ID: fromAccountID, UserID: fromUserID, Status: "active", Currency: "EUR", Balance: decimal.RequireFromString(tc.fromBalance)}, nil)
+			accountRepo.EXPECT().GetByID(gomock.Any(), toAccountID).
+				Return(&model.Account{ID: toAccountID, UserID: toUserID, Status: "active", Currency: "EUR"}, nil)
+
+			feeSvc := servicemocks.NewMockFeeService(ctrl)
+			feeSvc.EXPECT().Calculate(gomock.Any(), service.FeeOperationInstantTransfer, amount, "EUR").Return(breakdown, nil)
+
+			transferRepo, movementRepo, ledgerSvc, limitSvc, cache, txdb := tc.buildMocks(ctrl)
+			svc := service.NewTransferService(transferRepo, repmocks.NewMockTransferApprovalRepository(ctrl), repmocks.NewMockTransferOutboxRepository(ctrl), accountRepo, repmocks.NewMockUserRepository(ctrl), repmocks.NewMockCosignerRepository(ctrl), movementRepo, ledgerSvc, limitSvc, feeSvc, repmocks.NewMockFXRepository(ctrl), cache, txdb, noApprovals, outboxConfig)
+
+			got, err := svc.Transfer(context.Background(), fromAccountID, toAccountID, amount, "desc")
+			if tc.wantCode != 0 {
+				apiErr, ok := err.(*util.APIError)
+				if !ok || apiErr.Code != tc.wantCode {
+					t.Fatalf("expected %d APIError, got %#v", tc.wantCode, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !got.Fee.Equal(fee) {
+				t.Fatalf("unexpected transfer: %+v", got)
+			}
+		})
+	}
+}
+
+func TestTransferService_TransferStandingOrder(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	fromUserID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440344")
+	fromAccountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440345")
+	toAccountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440346")
+	amount := decimal.RequireFromString("25.00")
+	fee := decimal.RequireFromString("0.20")
+	breakdown := &model.FeeBreakdown{Operation: service.FeeOperationScheduledTransfer, Rule: "flat", Amount: amount, Currency: "EUR", Flat: fee, Total: fee}
+
+	accountRepo := repmocks.NewMockAccountRepository(ctrl)
+	accountRepo.EXPECT().GetByID(gomock.Any(), fromAccountID).
+		Return(&model.Account{ID: fromAccountID, UserID: fromUserID, Status: "active", Currency: "EUR", Balance: decimal.RequireFromString("100.00")}, nil)
+	accountRepo.EXPECT().GetByID(gomock.Any(), toAccountID).
+		Return(&model.Account{ID: toAccountID, UserID: uuid.MustParse("550e8400-e29b-41d4-a716-446655440347"), Status: "active", Currency: "EUR"}, nil)
+	feeSvc := servicemocks.NewMockFeeService(ctrl)
+	feeSvc.EXPECT().Calculate(gomock.Any(), service.FeeOperationScheduledTransfer, amount, "EUR").Return(breakdown, nil)
+	limitSvc := servicemocks.NewMockTransferLimitService(ctrl)
+	limitSvc.EXPECT().Check(gomock.Any(), fromUserID, toAccountID, amount, "EUR", uint64(0)).Return(nil)
+	txdb := servicemocks.NewMockTxDB(ctrl)
+	txdb.EXPECT().
+		Transaction(gomock.Any()).
+		DoAndReturn(func(fc func(tx *gorm.DB) error, opts ...*sql.TxOptions) error {
+			return fc(&gorm.DB{})
+		})
+	transferRepo := repmocks.NewMockTransferRepository(ctrl)
+	transferRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, tr *model.Transfer) error {
+		if !tr.Fee.Equal(fee) || tr.FeeBreakdown != breakdown || tr.ExecuteAt != nil {
+			t.Fatalf("unexpected transfer: %+v", tr)
+		}
+		return nil
+	})
+	transferRepo.EXPECT().UpdateStatus(gomock.Any(), uint64(0), "completed", gomock.Any()).Return(nil)
+	transferRepo.EXPECT().GetByID(gomock.Any(), uint64(0)).Return(&model.Transfer{Status: "completed", Fee: fee}, nil)
+	ledgerSvc := servicemocks.NewMockLedgerService(ctrl)
+	ledgerSvc.EXPECT().Post(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+	movementRepo := repmocks.NewMockMovementRepository(ctrl)
+	movementRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(3).Return(nil)
+	cache := servicemocks.NewMockCacheClient(ctrl)
+	cache.EXPECT().SetBalanceCache(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+	svc := service.NewTransferService(transferRepo, repmocks.NewMockTransferApprovalRepository(ctrl), repmocks.NewMockTransferOutboxRepository(ctrl), accountRepo, repmocks.NewMockUserRepository(ctrl), repmocks.NewMockCosignerRepository(ctrl), movementRepo, ledgerSvc, limitSvc, feeSvc, repmocks.NewMockFXRepository(ctrl), cache, txdb, noApprovals, outboxConfig)
+
+	got, err := svc.TransferStandingOrder(context.Background(), fromAccountID, toAccountID, amount, "rent")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !got.Fee.Equal(fee) {
+		t.Fatalf("unexpected transfer: %+v", got)
+	}
+}
+
+func TestTransferService_TransferConverted(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440350")
+	otherUserID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440351")
+	fromAccountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440352")
+	toAccountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440353")
+	quoteID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440354")
+
+	amount := decimal.RequireFromString("100.00")
+	converted := decimal.RequireFromString("108.50")
+	rate := decimal.RequireFromString("1.085")
+
+	fromAccount := &model.Account{ID: fromAccountID, UserID: userID, Status: "active", Balance: decimal.NewFromInt(500), Currency: "EUR"}
+	toAccount := &model.Account{ID: toAccountID, UserID: userID, Status: "active", Balance: decimal.NewFromInt(10), Currency: "USD"}
+	validQuote := func() *model.FXQuote {
+		return &model.FXQuote{
+			ID:              quoteID,
+			UserID:          userID,
+			FromCurrency:    "EUR",
+			ToCurrency:      "USD",
+			Rate:            rate,
+			Amount:          amount,
+			ConvertedAmount: converted,
+			ExpiresAt:       time.Now().Add(time.Minute),
+		}
+	}
+	usedAt := time.Now().Add(-time.Second)
+
+	tests := []struct {
+		name       string
+		toAccount  *model.Account
+		quote      *model.FXQuote
+		buildMocks func(ctrl *gomock.Controller) (
+			*repmocks.MockTransferRepository,
+			*repmocks.MockMovementRepository,
+			*servicemocks.MockLedgerService,
+			*repmocks.MockFXRepository,
+			*servicemocks.MockCacheClient,
+			*servicemocks.MockTxDB,
+		)
+		wantCode int
+	}{
+		{
+			name:      "accounts in the same currency return 400",
+			toAccount: &model.Account{ID: toAccountID, UserID: userID, Status: "active", Currency: "EUR"},
+			wantCode:  400,
+		},
+		{
+			name:      "quote of another user returns 404",
+			toAccount: toAccount,
+			quote: func() *model.FXQuote {
+				quote := validQuote()
+				quote.UserID = otherUserID
+				return quote
+			}(),
+			wantCode: 404,
+		},
+		{
+			name:      "quote for another amount returns 422",
+			toAccount: toAccount,
+			quote: func() *model.FXQuote {
+				quote := validQuote()
+				quote.Amount = decimal.RequireFromString("99.99")
+				return quote
+			}(),
+			wantCode: 422,
+		},
+		{
+			name:      "expired quote returns 422",
+			toAccount: toAccount,
+			quote: func() *model.FXQuote {
+				quote := validQuote()
+				quote.ExpiresAt = time.Now().Add(-time.Second)
+				return quote
+			}(),
+			wantCode: 422,
+		},
+		{
+			name:      "used quote returns 409",
+			toAccount: toAccount,
+			quote: func() *model.FXQuote {
+				quote := validQuote()
+				quote.UsedAt = &usedAt
+				return quote
+			}(),
+			wantCode: 409,
+		},
+		{
+			name:      "quote claimed concurrently returns 409",
+			toAccount: toAccount,
+			quote:     validQuote(),
+			buildMocks: func(ctrl *gomock.Controller) (
+				*repmocks.MockTransferRepository,
+				*repmocks.MockMovementRepository,
+				*servicemocks.MockLedgerService,
+				*repmocks.MockFXRepository,
+				*servicemocks.MockCacheClient,
+				*servicemocks.MockTxDB,
+			) {
+				transferRepo := repmocks.NewMockTransferRepository(ctrl)
+				fxRepo := repmocks.NewMockFXRepository(ctrl)
+				txdb := servicemocks.NewMockTxDB(ctrl)
+
+				txdb.EXPECT().
+					Transaction(gomock.Any()).
+					DoAndReturn(func(fc func(tx *gorm.DB) error, opts ...*sql.TxOptions) error {
+						return fc(&gorm.DB{})
+					})
+				transferRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+				fxRepo.EXPECT().UseQuote(gomock.Any(), quoteID, gomock.Any()).Return(false, nil)
+
+				return transferRepo,
+					repmocks.NewMockMovementRepository(ctrl),
+					servicemocks.NewMockLedgerService(ctrl),
+					fxRepo,
+					servicemocks.NewMockCacheClient(ctrl),
+					txdb
+			},
+			wantCode: 409,
+		},
+		{
+			name:      "success credits the converted amount",
+			toAccount: toAccount,
+			quote:     validQuote(),
+			buildMocks: func(ctrl *gomock.Controller) (
+				*repmocks.MockTransferRepository,
+				*repmocks.MockMovementRepository,
+				*servicemocks.MockLedgerService,
+				*repmocks.MockFXRepository,
+				*servicemocks.MockCacheClient,
+				*servicemocks.MockTxDB,
+			) {
+				transferRepo := repmocks.NewMockTransferRepository(ctrl)
+				movementRepo := repmocks.NewMockMovementRepository(ctrl)
+				ledgerSvc := servicemocks.NewMockLedgerService(ctrl)
+				fxRepo := repmocks.NewMockFXRepository(ctrl)
+				cache := servicemocks.NewMockCacheClient(ctrl)
+				txdb := servicemocks.NewMockTxDB(ctrl)
+
+				txdb.EXPECT().
+					Transaction(gomock.Any()).
+					DoAndReturn(func(fc func(tx *gorm.DB) error, opts ...*sql.TxOptions) error {
+						return fc(&gorm.DB{})
+					})
+				transferRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, tr *model.Transfer) error {
+					if tr.Currency != "EUR" || !tr.ConvertedAmount.Equal(converted) || *tr.ConvertedCurrency != "USD" || *tr.QuoteID != quoteID {
+						t.Errorf("unexpected transfer: %+v", tr)
+					}
+					return nil
+				})
+				fxRepo.EXPECT().UseQuote(gomock.Any(), quoteID, gomock.Any()).Return(true, nil)
+				ledgerSvc.EXPECT().Post(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, entry *model.JournalEntry) error {
+					if len(entry.Postings) != 4 {
+						t.Errorf("unexpected postings: %+v", entry.Postings)
+					}
+					return nil
+				})
+				movementRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, m *model.Movement) error {
+					want := amount
+					if m.Type == "credit" {
+						want = converted
+					}
+					if !m.Amount.Equal(want) || !m.OriginalAmount.Equal(amount) || !m.ConvertedAmount.Equal(converted) || !m.FXRate.Equal(rate) {
+						t.Errorf("unexpected %s movement: %+v", m.Type, m)
+					}
+					return nil
+				}).Times(2)
+				transferRepo.EXPECT().UpdateStatus(gomock.Any(), uint64(0), "completed", gomock.Any()).Return(nil)
+				cache.EXPECT().SetBalanceCache(gomock.Any(), fromAccountID, decimal.RequireFromString("400.00")).Return(nil)
+				cache.EXPECT().SetBalanceCache(gomock.Any(), toAccountID, decimal.RequireFromString("118.50")).Return(nil)
+				transferRepo.EXPECT().GetByID(gomock.Any(), uint64(0)).Return(&model.Transfer{Status: "completed"}, nil)
+
+				return transferRepo, movementRepo, ledgerSvc, fxRepo, cache, txdb
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			transferRepo := repmocks.NewMockTransferRepository(ctrl)
+			movementRepo := repmocks.NewMockMovementRepository(ctrl)
+			ledgerSvc := servicemocks.NewMockLedgerService(ctrl)
+			fxRepo := repmocks.NewMockFXRepository(ctrl)
+			cache := servicemocks.NewMockCacheClient(ctrl)
+			txdb := servicemocks.NewMockTxDB(ctrl)
+			if tc.buildMocks != nil {
+				transferRepo, movementRepo, ledgerSvc, fxRepo, cache, txdb = tc.buildMocks(ctrl)
+			}
+
+			accountRepo := repmocks.NewMockAccountRepository(ctrl)
+			accountRepo.EXPECT().GetByID(gomock.Any(), fromAccountID).Return(fromAccount, nil)
+			accountRepo.EXPECT().GetByID(gomock.Any(), toAccountID).Return(tc.toAccount, nil)
+			if tc.quote != nil {
+				fxRepo.EXPECT().GetQuote(gomock.Any(), quoteID).Return(tc.quote, nil)
+			}
+
+			svc := service.NewTransferService(transferRepo, repmocks.NewMockTransferApprovalRepository(ctrl), repmocks.NewMockTransferOutboxRepository(ctrl), accountRepo, repmocks.NewMockUserRepository(ctrl), repmocks.NewMockCosignerRepository(ctrl), movementRepo, ledgerSvc, servicemocks.NewMockTransferLimitService(ctrl), noFees, fxRepo, cache, txdb, noApprovals, outboxConfig)
+
+			got, err := svc.TransferConverted(context.Background(), fromAccountID, toAccountID, amount, "desc", quoteID)
+			if tc.wantCode != 0 {
+				apiErr, ok := err.(*util.APIError)
+				if !ok || apiErr.Code != tc.wantCode {
+					t.Fatalf("expected %d APIError, got %#v", tc.wantCode, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Status != "completed" {
+				t.Fatalf("unexpected transfer: %+v", got)
+			}
+		})
+	}
+}
+
+func TestTransferService_Schedule(t *testing.T) {
+	t.Parallel()
+
+	fromAccountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440310")
+	toAccountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440311")
+	amount, _ := decimal.NewFromString("25.00")
+
+	tests := []struct {
+		name       string
+		executeAt  time.Time
+		buildMocks func(ctrl *gomock.Controller) (*repmocks.MockTransferRepository, *repmocks.MockAccountRepository)
+		assert     func(t *testing.T, got *model.Transfer, err error)
+	}{
+		{
+			name:      "past execution date returns 400",
+			executeAt: time.Now().Add(-time.Hour),
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockTransferRepository, *repmocks.MockAccountRepository) {
+				return repmocks.NewMockTransferRepository(ctrl), repmocks.NewMockAccountRepository(ctrl)
+			},
+			assert: func(t *testing.T, got *model.Transfer, err error) {
+				apiErr, ok := err.(*util.APIError)
+				if !ok || apiErr.Code != 400 || apiErr.Message != "execute_at must be in the future" {
+					t.Fatalf("unexpected error: %#v", err)
+				}
+			},
+		},
+		{
+			name:      "success stores a scheduled transfer without moving funds",
+			executeAt: time.Now().Add(24 * time.Hour),
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockTransferRepository, *repmocks.MockAccountRepository) {
+				transferRepo := repmocks.NewMockTransferRepository(ctrl)
+				accountRepo := repmocks.NewMockAccountRepository(ctrl)
+
+				accountRepo.EXPECT().GetByID(gomock.Any(), fromAccountID).Return(&model.Account{ID: fromAccountID, Status: "active"}, nil)
+				accountRepo.EXPECT().GetByID(gomock.Any(), toAccountID).Return(&model.Account{ID: toAccountID, Status: "active"}, nil)
+				transferRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, tr *model.Transfer) error {
+					if tr.Status != "scheduled" || tr.ExecuteAt == nil || tr.Description != "rent" {
+						t.Fatalf("unexpected transfer: %+v", tr)
+					}
+					return nil
+				})
+
+				return transferRepo, accountRepo
+			},
+			assert: func(t *testing.T, got *model.Transfer, err error) {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if got == nil || got.Status != "scheduled" {
+					t.Fatalf("unexpected transfer: %+v", got)
+				}
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			transferRepo, accountRepo := tc.buildMocks(ctrl)
+			svc := service.NewTransferService(
+				transferRepo,
+				repmocks.NewMockTransferApprovalRepository(ctrl),
+				repmocks.NewMockTransferOutboxRepository(ctrl),
+				accountRepo,
+				repmocks.NewMockUserRepository(ctrl),
+				repmocks.NewMockCosignerRepository(ctrl),
+				repmocks.NewMockMovementRepository(ctrl),
+				servicemocks.NewMockLedgerService(ctrl),
+				servicemocks.NewMockTransferLimitService(ctrl),
+				noFees,
+				repmocks.NewMockFXRepository(ctrl),
+				servicemocks.NewMockCacheClient(ctrl),
+				servicemocks.NewMockTxDB(ctrl),
+				noApprovals,
+				outboxConfig,
+			)
+
+			got, err := svc.Schedule(context.Background(), fromAccountID, toAccountID, amount, "rent", tc.executeAt)
+			tc.assert(t, got, err)
+		})
+	}
+}
+
+func TestTransferService_Cancel(t *testing.T) {
+	t.Parallel()
+
+	accountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440320")
+	otherAccountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440321")
+	id := uint64(7)
+
+	tests := []struct {
+		name       string
+		buildMocks func(ctrl *gomock.Controller) *repmocks.MockTransferRepository
+		wantCode   int
+	}{
+		{
+			name: "transfer of another account returns 404",
+			buildMocks: func(ctrl *gomock.Controller) *repmocks.MockTransferRepository {
+				transferRepo := repmocks.NewMockTransferRepository(ctrl)
+				transferRepo.EXPECT().GetByID(gomock.Any(), id).Return(&model.Transfer{ID: id, FromAccount: otherAccountID, Status: "scheduled"}, nil)
+				return transferRepo
+			},
+			wantCode: 404,
+		},
+		{
+			name: "executed transfer returns 409",
+			buildMocks: func(ctrl *gomock.Controller) *repmocks.MockTransferRepository {
+				transferRepo := repmocks.NewMockTransferRepository(ctrl)
+				transferRepo.EXPECT().GetByID(gomock.Any(), id).Return(&model.Transfer{ID: id, FromAccount: accountID, Status: "completed"}, nil)
+				return transferRepo
+			},
+			wantCode: 409,
+		},
+		{
+			name: "transfer picked up by the executor meanwhile returns 409",
+			buildMocks: func(ctrl *gomock.Controller) *repmocks.MockTransferRepository {
+				transferRepo := repmocks.NewMockTransferRepository(ctrl)
+				transferRepo.EXPECT().GetByID(gomock.Any(), id).Return(&model.Transfer{ID: id, FromAccount: accountID, Status: "scheduled"}, nil)
+				transferRepo.EXPECT().TransitionStatus(gomock.Any(), id, "scheduled", "cancelled").Return(false, nil)
+				return transferRepo
+			},
+			wantCode: 409,
+		},
+		{
+			name: "success",
+			buildMocks: func(ctrl *gomock.Controller) *repmocks.MockTransferRepository {
+				transferRepo := repmocks.NewMockTransferRepository(ctrl)
+				transferRepo.EXPECT().GetByID(gomock.Any(), id).Return(&model.Transfer{ID: id, FromAccount: accountID, Status: "scheduled"}, nil)
+				transferRepo.EXPECT().TransitionStatus(gomock.Any(), id, "scheduled", "cancelled").Return(true, nil)
+				return transferRepo
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			svc := service.NewTransferService(
+				tc.buildMocks(ctrl),
+				repmocks.NewMockTransferApprovalRepository(ctrl),
+				repmocks.NewMockTransferOutboxRepository(ctrl),
+				repmocks.NewMockAccountRepository(ctrl),
+				repmocks.NewMockUserRepository(ctrl),
+				repmocks.NewMockCosignerRepository(ctrl),
+				repmocks.NewMockMovementRepository(ctrl),
+				servicemocks.NewMockLedgerService(ctrl),
+				servicemocks.NewMockTransferLimitService(ctrl),
+				noFees,
+				repmocks.NewMockFXRepository(ctrl),
+				servicemocks.NewMockCacheClient(ctrl),
+				servicemocks.NewMockTxDB(ctrl),
+				noApprovals,
+				outboxConfig,
+			)
+
+			got, err := svc.Cancel(context.Background(), accountID, id)
+			if tc.wantCode != 0 {
+				apiErr, ok := err.(*util.APIError)
+				if !ok || apiErr.Code != tc.wantCode {
+					t.Fatalf("expected %d APIError, got %#v", tc.wantCode, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Status != "cancelled" {
+				t.Fatalf("unexpected status: %q", got.Status)
+			}
+		})
+	}
+}
+
+func TestTransferService_ExecuteScheduled(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440332")
+	fromAccountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440330")
+	toAccountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440331")
+	id := uint64(8)
+	amount, _ := decimal.NewFromString("25000.00")
+	approvals := &config.ApprovalConfig{Threshold: decimal.RequireFromString("10000.00"), Currency: "EUR", TTL: 72 * time.Hour}
+
+	scheduled := func() *model.Transfer {
+		return &model.Transfer{ID: id, FromAccount: fromAccountID, ToAccount: toAccountID, Amount: amount, Description: "rent", Status: "scheduled"}
+	}
+	accounts := func(ctrl *gomock.Controller, accountType string) *repmocks.MockAccountRepository {
+		accountRepo := repmocks.NewMockAccountRepository(ctrl)
+		accountRepo.EXPECT().GetByID(gomock.Any(), fromAccountID).Return(&model.Account{ID: fromAccountID, UserID: userID, Type: accountType, Status: "active", Currency: "EUR"}, nil)
+		accountRepo.EXPECT().GetByID(gomock.Any(), toAccountID).Return(&model.Account{ID: toAccountID, UserID: uuid.New(), Status: "active"}, nil)
+		return accountRepo
+	}
+	inTransaction := func(ctrl *gomock.Controller) *servicemocks.MockTxDB {
+		txdb := servicemocks.NewMockTxDB(ctrl)
+		txdb.EXPECT().
+			Transaction(gomock.Any()).
+			DoAndReturn(func(fc func(tx *gorm.DB) error, opts ...*sql.TxOptions) error {
+				return fc(&gorm.DB{})
+			})
+		return txdb
+	}
+
+	tests := []struct {
+		name       string
+		buildMocks func(ctrl *gomock.Controller) (
+			*repmocks.MockTransferRepository,
+			*repmocks.MockAccountRepository,
+			*repmocks.MockCosignerRepository,
+			*repmocks.MockTransferApprovalRepository,
+			*repmocks.MockTransferOutboxRepository,
+			*servicemocks.MockTxDB,
+		)
+		wantCode   int
+		wantStatus string
+	}{
+		{
+			name: "transfer no longer scheduled returns 409",
+			buildMocks: func(ctrl *gomock.Controller) (
+				*repmocks.MockTransferRepository,
+				*repmocks.MockAccountRepository,
+				*repmocks.MockCosignerRepository,
+				*repmocks.MockTransferApprovalRepository,
+				*repmocks.MockTransferOutboxRepository,
+				*servicemocks.MockTxDB,
+			) {
+				transfer := scheduled()
+				transfer.Status = "cancelled"
+
+				transferRepo := repmocks.NewMockTransferRepository(ctrl)
+				transferRepo.EXPECT().GetByID(gomock.Any(), id).Return(transfer, nil)
+				return transferRepo,
+					repmocks.NewMockAccountRepository(ctrl),
+					repmocks.NewMockCosignerRepository(ctrl),
+					repmocks.NewMockTransferApprovalRepository(ctrl),
+					repmocks.NewMockTransferOutboxRepository(ctrl),
+					servicemocks.NewMockTxDB(ctrl)
+			},
+			wantCode: 409,
+		},
+		{
+			name: "transfer claimed elsewhere returns 409",
+			buildMocks: func(ctrl *gomock.Controller) (
+				*repmocks.MockTransferRepository,
+				*repmocks.MockAccountRepository,
+				*repmocks.MockCosignerRepository,
+				*repmocks.MockTransferApprovalRepository,
+				*repmocks.MockTransferOutboxRepository,
+				*servicemocks.MockTxDB,
+			) {
+				transferRepo := repmocks.NewMockTransferRepository(ctrl)
+				transferRepo.EXPECT().GetByID(gomock.Any(), id).Return(scheduled(), nil)
+				transferRepo.EXPECT().TransitionStatus(gomock.Any(), id, "scheduled", "pending").Return(false, nil)
+				return transferRepo,
+					accounts(ctrl, "personal"),
+					repmocks.NewMockCosignerRepository(ctrl),
+					repmocks.NewMockTransferApprovalRepository(ctrl),
+					repmocks.NewMockTransferOutboxRepository(ctrl),
+					inTransaction(ctrl)
+			},
+			wantCode: 409,
+		},
+		{
+			name: "claimed transfer is queued in the same transaction",
+			buildMocks: func(ctrl *gomock.Controller) (
+				*repmocks.MockTransferRepository,
+				*repmocks.MockAccountRepository,
+				*repmocks.MockCosignerRepository,
+				*repmocks.MockTransferApprovalRepository,
+				*repmocks.MockTransferOutboxRepository,
+				*servicemocks.MockTxDB,
+			) {
+				transferRepo := repmocks.NewMockTransferRepository(ctrl)
+				outboxRepo := repmocks.NewMockTransferOutboxRepository(ctrl)
+
+				// The outbox workers execute it, or fail it with its outbox message when it can no longer be sent
+				transferRepo.EXPECT().GetByID(gomock.Any(), id).Return(scheduled(), nil)
+				gomock.InOrder(
+					transferRepo.EXPECT().TransitionStatus(gomock.Any(), id, "scheduled", "pending").Return(true, nil),
+					outboxRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, m *model.TransferOutbox) error {
+						if _, ok := repository.TxFromContext(ctx); !ok || m.TransferID != id {
+							t.Fatalf("unexpected outbox message: %+v", m)
+						}
+						return nil
+					}),
+				)
+				return transferRepo,
+					accounts(ctrl, "personal"),
+					repmocks.NewMockCosignerRepository(ctrl),
+					repmocks.NewMockTransferApprovalRepository(ctrl),
+					outboxRepo,
+					inTransaction(ctrl)
+			},
+			wantStatus: "pending",
+		},
+		{
+			name: "transfer above the threshold is held for approval",
+			buildMocks: func(ctrl *gomock.Controller) (
+				*repmocks.MockTransferRepository,
+				*repmocks.MockAccountRepository,
+				*repmocks.MockCosignerRepository,
+				*repmocks.MockTransferApprovalRepository,
+				*repmocks.MockTransferOutboxRepository,
+				*servicemocks.MockTxDB,
+			) {
+				transferRepo := repmocks.NewMockTransferRepository(ctrl)
+				cosignerRepo := repmocks.NewMockCosignerRepository(ctrl)
+				approvalRepo := repmocks.NewMockTransferApprovalRepository(ctrl)
+
+				transferRepo.EXPECT().GetByID(gomock.Any(), id).Return(scheduled(), nil)
+				cosignerRepo.EXPECT().ListByAccountID(gomock.Any(), fromAccountID).
+					Return([]*model.AccountCosigner{{AccountID: fromAccountID, UserID: uuid.New()}}, nil)
+				gomock.InOrder(
+					transferRepo.EXPECT().TransitionStatus(gomock.Any(), id, "scheduled", "pending").Return(true, nil),
+					transferRepo.EXPECT().AwaitApproval(gomock.Any(), id, gomock.Any()).Return(nil),
+					approvalRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil),
+				)
+				return transferRepo,
+					accounts(ctrl, "business"),
+					cosignerRepo,
+					approvalRepo,
+					repmocks.NewMockTransferOutboxRepository(ctrl),
+					inTransaction(ctrl)
+			},
+			wantStatus: "awaiting_approval",
+		},
+		{
+			name: "transfer that can no longer be approved is marked as failed",
+			buildMocks: func(ctrl *gomock.Controller) (
+				*repmocks.MockTransferRepository,
+				*repmocks.MockAccountRepository,
+				*repmocks.MockCosignerRepository,
+				*repmocks.MockTransferApprovalRepository,
+				*repmocks.MockTransferOutboxRepository,
+				*servicemocks.MockTxDB,
+			) {
+				transferRepo := repmocks.NewMockTransferRepository(ctrl)
+				cosignerRepo := repmocks.NewMockCosignerRepository(ctrl)
+
+				transferRepo.EXPECT().GetByID(gomock.Any(), id).Return(scheduled(), nil)
+				cosignerRepo.EXPECT().ListByAccountID(gomock.Any(), fromAccountID).Return(nil, nil)
+				transferRepo.EXPECT().TransitionStatus(gomock.Any(), id, "scheduled", "failed").Return(true, nil)
+				return transferRepo,
+					accounts(ctrl, "business"),
+					cosignerRepo,
+					repmocks.NewMockTransferApprovalRepository(ctrl),
+					repmocks.NewMockTransferOutboxRepository(ctrl),
+					servicemocks.NewMockTxDB(ctrl)
+			},
+			wantCode: 422,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			transferRepo, accountRepo, cosignerRepo, approvalRepo, outboxRepo, txdb := tc.buildMocks(ctrl)
+			svc := service.NewTransferService(transferRepo, approvalRepo, outboxRepo, accountRepo, repmocks.NewMockUserRepository(ctrl), cosignerRepo, repmocks.NewMockMovementRepository(ctrl), servicemocks.NewMockLedgerService(ctrl), servicemocks.NewMockTransferLimitService(ctrl), noFees, repmocks.NewMockFXRepository(ctrl), servicemocks.NewMockCacheClient(ctrl), txdb, approvals, outboxConfig)
+
+			got, err := svc.ExecuteScheduled(context.Background(), id)
+			if tc.wantCode != 0 {
+				apiErr, ok := err.(*util.APIError)
+				if !ok || apiErr.Code != tc.wantCode {
+					t.Fatalf("expected %d APIError, got %#v", tc.wantCode, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Status != tc.wantStatus {
+				t.Fatalf("unexpected transfer: %+v", got)
+			}
+		})
+	}
+}
+
+func TestTransferService_Reverse(t *testing.T) {
+	t.Parallel()
+
+	senderID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440340")
+	receiverID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440341")
+	id := uint64(21)
+
+	original, _ := decimal.NewFromString("100.00")
+	reversed, _ := decimal.NewFromString("60.00")
+	remaining, _ := decimal.NewFromString("40.00")
+	tooMuch, _ := decimal.NewFromString("40.01")
+	receiverBalance, _ := decimal.NewFromString("500.00")
+	senderBalance, _ := decimal.NewFromString("10.00")
+
+	completed := func() *model.Transfer {
+		return &model.Transfer{ID: id, FromAccount: senderID, ToAccount: receiverID, Amount: original, ReversedAmount: reversed, Currency: "EUR", Status: "completed"}
+	}
+
+	tests := []struct {
+		name       string
+		accountID  *uuid.UUID
+		amount     decimal.Decimal
+		buildMocks func(ctrl *gomock.Controller) (
+			*repmocks.MockTransferRepository,
+			*repmocks.MockAccountRepository,
+			*repmocks.MockMovementRepository,
+			*servicemocks.MockLedgerService,
+			*servicemocks.MockCacheClient,
+			*servicemocks.MockTxDB,
+		)
+		wantCode    int
+		wantMessage string
+	}{
+		{
+			name:      "sender cannot reverse its own transfer",
+			accountID: &senderID,
+			buildMocks: func(ctrl *gomock.Controller) (
+				*repmocks.MockTransferRepository,
+				*repmocks.MockAccountRepository,
+				*repmocks.MockMovementRepository,
+				*servicemocks.MockLedgerService,
+				*servicemocks.MockCacheClient,
+				*servicemocks.MockTxDB,
+			) {
+				transferRepo := repmocks.NewMockTransferRepository(ctrl)
+				transferRepo.EXPECT().GetByID(gomock.Any(), id).Return(completed(), nil)
+				return transferRepo,
+					repmocks.NewMockAccountRepository(ctrl),
+					repmocks.NewMockMovementRepository(ctrl),
+					servicemocks.NewMockLedgerService(ctrl),
+					servicemocks.NewMockCacheClient(ctrl),
+					servicemocks.NewMockTxDB(ctrl)
+			},
+			wantCode: 403,
+		},
+		{
+			name:      "fully reversed transfer returns 409",
+			accountID: &receiverID,
+			buildMocks: func(ctrl *gomock.Controller) (
+				*repmocks.MockTransferRepository,
+				*repmocks.MockAccountRepository,
+				*repmocks.MockMovementRepository,
+				*servicemocks.MockLedgerService,
+				*servicemocks.MockCacheClient,
+				*servicemocks.MockTxDB,
+			) {
+				transfer := completed()
+				transfer.ReversedAmount = original
+
+				transferRepo := repmocks.NewMockTransferRepository(ctrl)
+				transferRepo.EXPECT().GetByID(gomock.Any(), id).Return(transfer, nil)
+				return transferRepo,
+					repmocks.NewMockAccountRepository(ctrl),
+					repmocks.NewMockMovementRepository(ctrl),
+					servicemocks.NewMockLedgerService(ctrl),
+					servicemocks.NewMockCacheClient(ctrl),
+					servicemocks.NewMockTxDB(ctrl)
+			},
+			wantCode: 409,
+		},
+		{
+			name:      "amount above what is left returns 400",
+			accountID: &receiverID,
+			amount:    tooMuch,
+			buildMocks: func(ctrl *gomock.Controller) (
+				*repmocks.MockTransferRepository,
+				*repmocks.MockAccountRepository,
+				*repmocks.MockMovementRepository,
+				*servicemocks.MockLedgerService,
+				*servicemocks.MockCacheClient,
+				*servicemocks.MockTxDB,
+			) {
+				transferRepo := repmocks.NewMockTransferRepository(ctrl)
+				transferRepo.EXPECT().GetByID(gomock.Any(), id).Return(completed(), nil)
+				return transferRepo,
+					repmocks.NewMockAccountRepository(ctrl),
+					repmocks.NewMockMovementRepository(ctrl),
+					servicemocks.NewMockLedgerService(ctrl),
+					servicemocks.NewMockCacheClient(ctrl),
+					servicemocks.NewMockTxDB(ctrl)
+			},
+			wantCode:    400,
+			wantMessage: "amount exceeds the reversible amount of 40.00",
+		},
+		{
+			name:      "reversible amount is shown in the minor unit of the currency",
+			accountID: &receiverID,
+			amount:    decimal.NewFromInt(41),
+			buildMocks: func(ctrl *gomock.Controller) (
+				*repmocks.MockTransferRepository,
+				*repmocks.MockAccountRepository,
+				*repmocks.MockMovementRepository,
+				*servicemocks.MockLedgerService,
+				*servicemocks.MockCacheClient,
+				*servicemocks.MockTxDB,
+			) {
+				transfer := completed()
+				transfer.Currency = "JPY"
+
+				transferRepo := repmocks.NewMockTransferRepository(ctrl)
+				transferRepo.EXPECT().GetByID(gomock.Any(), id).Return(transfer, nil)
+				return transferRepo,
+					repmocks.NewMockAccountRepository(ctrl),
+					repmocks.NewMockMovementRepository(ctrl),
+					servicemocks.NewMockLedgerService(ctrl),
+					servicemocks.NewMockCacheClient(ctrl),
+					servicemocks.NewMockTxDB(ctrl)
+			},
+			wantCode:    400,
+			wantMessage: "amount exceeds the reversible amount of 40",
+		},
+		{
+			name:      "concurrent reversal consuming the amount returns 409",
+			accountID: &receiverID,
+			buildMocks: func(ctrl *gomock.Controller) (
+				*repmocks.MockTransferRepository,
+				*repmocks.MockAccountRepository,
+				*repmocks.MockMovementRepository,
+				*servicemocks.MockLedgerService,
+				*servicemocks.MockCacheClient,
+				*servicemocks.MockTxDB,
+			) {
+				transferRepo := repmocks.NewMockTransferRepository(ctrl)
+				accountRepo := repmocks.NewMockAccountRepository(ctrl)
+				txdb := servicemocks.NewMockTxDB(ctrl)
+
+				transferRepo.EXPECT().GetByID(gomock.Any(), id).Return(completed(), nil)
+				accountRepo.EXPECT().GetByID(gomock.Any(), receiverID).Return(&model.Account{ID: receiverID, Status: "active", Balance: receiverBalance}, nil)
+				accountRepo.EXPECT().GetByID(gomock.Any(), senderID).Return(&model.Account{ID: senderID, Status: "active", Balance: senderBalance}, nil)
+				txdb.EXPECT().
+					Transaction(gomock.Any()).
+					DoAndReturn(func(fc func(tx *gorm.DB) error, opts ...*sql.TxOptions) error {
+						return fc(&gorm.DB{})
+					})
+				transferRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+				transferRepo.EXPECT().AddReversedAmount(gomock.Any(), id, remaining).Return(false, nil)
+
+				return transferRepo, accountRepo, repmocks.NewMockMovementRepository(ctrl), servicemocks.NewMockLedgerService(ctrl), servicemocks.NewMockCacheClient(ctrl), txdb
+			},
+			wantCode: 409,
+		},
+		{
+			name: "admin reverses what is left back to the sender",
+			buildMocks: func(ctrl *gomock.Controller) (
+				*repmocks.MockTransferRepository,
+				*repmocks.MockAccountRepository,
+				*repmocks.MockMovementRepository,
+				*servicemocks.MockLedgerService,
+				*servicemocks.MockCacheClient,
+				*servicemocks.MockTxDB,
+			) {
+				transferRepo := repmocks.NewMockTransferRepository(ctrl)
+				accountRepo := repmocks.NewMockAccountRepository(ctrl)
+				movementRepo := repmocks.NewMockMovementRepository(ctrl)
+				ledgerSvc := servicemocks.NewMockLedgerService(ctrl)
+				cache := servicemocks.NewMockCacheClient(ctrl)
+				txdb := servicemocks.NewMockTxDB(ctrl)
+
+				transferRepo.EXPECT().GetByID(gomock.Any(), id).Return(completed(), nil)
+				accountRepo.EXPECT().GetByID(gomock.Any(), receiverID).Return(&model.Account{ID: receiverID, Status: "active", Balance: receiverBalance}, nil)
+				accountRepo.EXPECT().GetByID(gomock.Any(), senderID).Return(&model.Account{ID: senderID, Status: "active", Balance: senderBalance}, nil)
+				txdb.EXPECT().
+					Transaction(gomock.Any()).
+					DoAndReturn(func(fc func(tx *gorm.DB) error, opts ...*sql.TxOptions) error {
+						return fc(&gorm.DB{})
+					})
+				transferRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, tr *model.Transfer) error {
+					if tr.FromAccount != receiverID || tr.ToAccount != senderID || !tr.Amount.Equal(remaining) {
+						t.Fatalf("unexpected reversal: %+v", tr)
+					}
+					if tr.ReversalOf == nil || *tr.ReversalOf != id {
+						t.Fatalf("reversal not linked to the original transfer: %+v", tr)
+					}
+					tr.ID = 22
+					return nil
+				})
+				transferRepo.EXPECT().AddReversedAmount(gomock.Any(), id, remaining).Return(true, nil)
+				movementRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, m *model.Movement) error {
+					if m.Type != "debit" || m.AccountID != receiverID {
+						t.Fatalf("unexpected debit movement: %+v", m)
+					}
+					return nil
+				})
+				movementRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, m *model.Movement) error {
+					if m.Type != "credit" || m.AccountID != senderID {
+						t.Fatalf("unexpected credit movement: %+v", m)
+					}
+					return nil
+				})
+				ledgerSvc.EXPECT().Post(gomock.Any(), gomock.Any()).Return(nil)
+				transferRepo.EXPECT().UpdateStatus(gomock.Any(), uint64(22), "completed", gomock.Any()).Return(nil)
+				cache.EXPECT().SetBalanceCache(gomock.Any(), receiverID, receiverBalance.Sub(remaining)).Return(nil)
+				cache.EXPECT().SetBalanceCache(gomock.Any(), senderID, senderBalance.Add(remaining)).Return(nil)
+				transferRepo.EXPECT().GetByID(gomock.Any(), uint64(22)).Return(&model.Transfer{ID: 22, ReversalOf: &id, Status: "completed"}, nil)
+
+				return transferRepo, accountRepo, movementRepo, ledgerSvc, cache, txdb
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			transferRepo, accountRepo, movementRepo, ledgerSvc, cache, txdb := tc.buildMocks(ctrl)
+			svc := service.NewTransferService(transferRepo, repmocks.NewMockTransferApprovalRepository(ctrl), repmocks.NewMockTransferOutboxRepository(ctrl), accountRepo, repmocks.NewMockUserRepository(ctrl), repmocks.NewMockCosignerRepository(ctrl), movementRepo, ledgerSvc, servicemocks.NewMockTransferLimitService(ctrl), noFees, repmocks.NewMockFXRepository(ctrl), cache, txdb, noApprovals, outboxConfig)
+
+			got, err := svc.Reverse(context.Background(), tc.accountID, id, tc.amount, "")
+			if tc.wantCode != 0 {
+				apiErr, ok := err.(*util.APIError)
+				if !ok || apiErr.Code != tc.wantCode || (tc.wantMessage != "" && apiErr.Message != tc.wantMessage) {
+					t.Fatalf("expected %d APIError %q, got %#v", tc.wantCode, tc.wantMessage, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Status != "completed" || got.ReversalOf == nil {
+				t.Fatalf("unexpected reversal: %+v", got)
+			}
+		})
+	}
+}
+
+func TestTransferService_TransferLimitExceeded(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440390")
+	fromAccountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440391")
+	toAccountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440392")
+	amount := decimal.RequireFromString("25.00")
+
+	transferRepo := repmocks.NewMockTransferRepository(ctrl)
+	accountRepo := repmocks.NewMockAccountRepository(ctrl)
+	limitSvc := servicemocks.NewMockTransferLimitService(ctrl)
+	txdb := servicemocks.NewMockTxDB(ctrl)
+
+	accountRepo.EXPECT().GetByID(gomock.Any(), fromAccountID).Return(&model.Account{ID: fromAccountID, UserID: userID, Status: "active", Currency: "EUR", Balance: decimal.RequireFromString("100.00")}, nil)
+	accountRepo.EXPECT().GetByID(gomock.Any(), toAccountID).Return(&model.Account{ID: toAccountID, UserID: uuid.New(), Status: "active", Currency: "EUR"}, nil)
+	txdb.EXPECT().
+		Transaction(gomock.Any()).
+		DoAndReturn(func(fc func(tx *gorm.DB) error, opts ...*sql.TxOptions) error {
+			return fc(&gorm.DB{})
+		})
+	limitSvc.EXPECT().Check(gomock.Any(), userID, toAccountID, amount, "EUR", uint64(0)).Return(util.NewTransferLimitError("daily"))
+
+	svc := service.NewTransferService(transferRepo, repmocks.NewMockTransferApprovalRepository(ctrl), repmocks.NewMockTransferOutboxRepository(ctrl), accountRepo, repmocks.NewMockUserRepository(ctrl), repmocks.NewMockCosignerRepository(ctrl), repmocks.NewMockMovementRepository(ctrl), servicemocks.NewMockLedgerService(ctrl), limitSvc, noFees, repmocks.NewMockFXRepository(ctrl), servicemocks.NewMockCacheClient(ctrl), txdb, noApprovals, outboxConfig)
+
+	_, err := svc.Transfer(context.Background(), fromAccountID, toAccountID, amount, "rent")
+	apiErr, ok := err.(*util.APIError)
+	if !ok || apiErr.Code != 422 || apiErr.Message != "daily transfer limit exceeded" {
+		t.Fatalf("expected 422 APIError, got %#v", err)
+	}
+}
+
+func TestTransferService_Sweep(t *testing.T) {
+	t.Parallel()
+
+	fromUserID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440393")
+	fromAccountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440394")
+	toAccountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440395")
+	balance := decimal.RequireFromString("100.00")
+	fee := decimal.RequireFromString("0.50")
+	swept := decimal.RequireFromString("99.50")
+	breakdown := &model.FeeBreakdown{Operation: service.FeeOperationInstantTransfer, Rule: "flat", Amount: balance, Currency: "EUR", Flat: fee, Total: fee}
+
+	tests := []struct {
+		name        string
+		toUserID    uuid.UUID
+		buildMocks  func(ctrl *gomock.Controller, limitSvc *servicemocks.MockTransferLimitService, feeSvc *servicemocks.MockFeeService) (*repmocks.MockTransferRepository, *repmocks.MockMovementRepository, *servicemocks.MockLedgerService, *servicemocks.MockCacheClient)
+		wantAmount  decimal.Decimal
+		wantMessage string
+	}{
+		{
+			name:     "sweep to another user pays the fee out of the balance",
+			toUserID: uuid.MustParse("550e8400-e29b-41d4-a716-446655440396"),
+			buildMocks: func(ctrl *gomock.Controller, limitSvc *servicemocks.MockTransferLimitService, feeSvc *servicemocks.MockFeeService) (*repmocks.MockTransferRepository, *repmocks.MockMovementRepository, *servicemocks.MockLedgerService, *servicemocks.MockCacheClient) {
+				transferRepo := repmocks.NewMockTransferRepository(ctrl)
+				movementRepo := repmocks.NewMockMovementRepository(ctrl)
+				ledgerSvc := servicemocks.NewMockLedgerService(ctrl)
+				cache := servicemocks.NewMockCacheClient(ctrl)
+
+				feeSvc.EXPECT().Calculate(gomock.Any(), service.FeeOperationInstantTransfer, balance, "EUR").Return(breakdown, nil)
+				limitSvc.EXPECT().Check(gomock.Any(), fromUserID, toAccountID, swept, "EUR", uint64(0)).Return(nil)
+				transferRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, tr *model.Transfer) error {
+					if !tr.Amount.Equal(swept) || !tr.Fee.Equal(fee) {
+						t.Fatalf("unexpected sweep: %+v", tr)
+					}
+					return nil
+				})
+				ledgerSvc.EXPECT().Post(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+				movementRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(3).Return(nil)
+				transferRepo.EXPECT().UpdateStatus(gomock.Any(), uint64(0), "completed", gomock.Any()).Return(nil)
+				cache.EXPECT().SetBalanceCache(gomock.Any(), fromAccountID, gomock.Any()).DoAndReturn(func(_ context.Context, _ uuid.UUID, left decimal.Decimal) error {
+					if !left.IsZero() {
+						t.Fatalf("unexpected balance left: %s", left)
+					}
+					return nil
+				})
+				cache.EXPECT().SetBalanceCache(gomock.Any(), toAccountID, gomock.Any()).Return(nil)
+				transferRepo.EXPECT().GetByID(gomock.Any(), uint64(0)).Return(&model.Transfer{Amount: swept, Fee: fee, Status: "completed"}, nil)
+
+				return transferRepo, movementRepo, ledgerSvc, cache
+			},
+			wantAmount: swept,
+		},
+		{
+			name:     "sweep to another user over a limit is refused",
+			toUserID: uuid.MustParse("550e8400-e29b-41d4-a716-446655440396"),
+			buildMocks: func(ctrl *gomock.Controller, limitSvc *servicemocks.MockTransferLimitService, feeSvc *servicemocks.MockFeeService) (*repmocks.MockTransferRepository, *repmocks.MockMovementRepository, *servicemocks.MockLedgerService, *servicemocks.MockCacheClient) {
+				feeSvc.EXPECT().Calculate(gomock.Any(), service.FeeOperationInstantTransfer, balance, "EUR").Return(breakdown, nil)
+				limitSvc.EXPECT().Check(gomock.Any(), fromUserID, toAccountID, swept, "EUR", uint64(0)).Return(util.NewTransferLimitError("daily"))
+
+				return repmocks.NewMockTransferRepository(ctrl), repmocks.NewMockMovementRepository(ctrl), servicemocks.NewMockLedgerService(ctrl), servicemocks.NewMockCacheClient(ctrl)
+			},
+			wantMessage: "daily transfer limit exceeded",
+		},
+		{
+			name:     "sweep to an account of the same user is free and not limited",
+			toUserID: fromUserID,
+			buildMocks: func(ctrl *gomock.Controller, limitSvc *servicemocks.MockTransferLimitService, feeSvc *servicemocks.MockFeeService) (*repmocks.MockTransferRepository, *repmocks.MockMovementRepository, *servicemocks.MockLedgerService, *servicemocks.MockCacheClient) {
+				transferRepo := repmocks.NewMockTransferRepository(ctrl)
+				movementRepo := repmocks.NewMockMovementRepository(ctrl)
+				ledgerSvc := servicemocks.NewMockLedgerService(ctrl)
+				cache := servicemocks.NewMockCacheClient(ctrl)
+
+				transferRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, tr *model.Transfer) error {
+					if !tr.Amount.Equal(balance) || !tr.Fee.IsZero() || tr.FeeBreakdown != nil {
+						t.Fatalf("unexpected sweep: %+v", tr)
+					}
+					return nil
+				})
+				ledgerSvc.EXPECT().Post(gomock.Any(), gomock.Any()).Return(nil)
+				movementRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(2).Return(nil)
+				transferRepo.EXPECT().UpdateStatus(gomock.Any(), uint64(0), "completed", gomock.Any()).Return(nil)
+				cache.EXPECT().SetBalanceCache(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(2)
+				transferRepo.EXPECT().GetByID(gomock.Any(), uint64(0)).Return(&model.Transfer{Amount: balance, Status: "completed"}, nil)
+
+				return transferRepo, movementRepo, ledgerSvc, cache
+			},
+			wantAmount: balance,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			accountRepo := repmocks.NewMockAccountRepository(ctrl)
+			accountRepo.EXPECT().GetByID(gomock.Any(), fromAccountID).
+				Return(&model.Account{ID: fromAccountID, UserID: fromUserID, Status: "active", Currency: "EUR", Balance: balance}, nil)
+			accountRepo.EXPECT().GetByID(gomock.Any(), toAccountID).
+				Return(&model.Account{ID: toAccountID, UserID: tc.toUserID, Status: "active", Currency: "EUR"}, nil)
+			txdb := servicemocks.NewMockTxDB(ctrl)
+			txdb.EXPECT().
+				Transaction(gomock.Any()).
+				DoAndReturn(func(fc func(tx *gorm.DB) error, opts ...*sql.TxOptions) error {
+					return fc(&gorm.DB{})
+				})
+			limitSvc := servicemocks.NewMockTransferLimitService(ctrl)
+			feeSvc := servicemocks.NewMockFeeService(ctrl)
+
+			transferRepo, movementRepo, ledgerSvc, cache := tc.buildMocks(ctrl, limitSvc, feeSvc)
+			svc := service.NewTransferService(transferRepo, repmocks.NewMockTransferApprovalRepository(ctrl), repmocks.NewMockTransferOutboxRepository(ctrl), accountRepo, repmocks.NewMockUserRepository(ctrl), repmocks.NewMockCosignerRepository(ctrl), movementRepo, ledgerSvc, limitSvc, feeSvc, repmocks.NewMockFXRepository(ctrl), cache, txdb, noApprovals, outboxConfig)
+
+			got, err := svc.Sweep(context.Background(), fromAccountID, toAccountID, balance, "Closure of account")
+			if tc.wantMessage != "" {
+				apiErr, ok := err.(*util.APIError)
+				if !ok || apiErr.Code != 422 || apiErr.Message != tc.wantMessage {
+					t.Fatalf("expected 422 APIError %q, got %#v", tc.wantMessage, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Status != "completed" || !got.Amount.Equal(tc.wantAmount) {
+				t.Fatalf("unexpected transfer: %+v", got)
+			}
+		})
+	}
+}
+
+func TestTransferService_SubmitHeldForApproval(t *testing.T) {
+	t.Parallel()
+
+	ownerID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440400")
+	cosignerID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440401")
+	fromAccountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440402")
+	toAccountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440403")
+	amount := decimal.RequireFromString("15000.00")
+	approvals := &config.ApprovalConfig{Threshold: decimal.RequireFromString("10000.00"), Currency: "EUR", TTL: 72 * time.Hour}
+
+	tests := []struct {
+		name string
+		// currency is the currency of the accounts, EUR when empty, and rate its exchange rate from EUR, if any
+		currency   string
+		rate       string
+		buildMocks func(ctrl *gomock.Controller) (
+			*repmocks.MockTransferRepository,
+			*repmocks.MockTransferApprovalRepository,
+			*repmocks.MockCosignerRepository,
+			*servicemocks.MockTxDB,
+		)
+		wantCode    int
+		wantMessage string
+	}{
+		{
+			name: "transfers above the threshold wait for a co-signer",
+			buildMocks: func(ctrl *gomock.Controller) (
+				*repmocks.MockTransferRepository,
+				*repmocks.MockTransferApprovalRepository,
+				*repmocks.MockCosignerRepository,
+				*servicemocks.MockTxDB,
+			) {
+				transferRepo := repmocks.NewMockTransferRepository(ctrl)
+				approvalRepo := repmocks.NewMockTransferApprovalRepository(ctrl)
+				cosignerRepo := repmocks.NewMockCosignerRepository(ctrl)
+				txdb := servicemocks.NewMockTxDB(ctrl)
+
+				cosignerRepo.EXPECT().ListByAccountID(gomock.Any(), fromAccountID).
+					Return([]*model.AccountCosigner{{AccountID: fromAccountID, UserID: cosignerID}}, nil)
+				txdb.EXPECT().
+					Transaction(gomock.Any()).
+					DoAndReturn(func(fc func(tx *gorm.DB) error, opts ...*sql.TxOptions) error {
+						return fc(&gorm.DB{})
+					})
+				// Nothing is posted until a co-signer approves the transfer
+				transferRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, tr *model.Transfer) error {
+					if tr.Status != "awaiting_approval" || tr.ApprovalExpiresAt == nil {
+						t.Fatalf("unexpected transfer: %+v", tr)
+					}
+					tr.ID = 21
+					return nil
+				})
+				approvalRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, a *model.TransferApproval) error {
+					if a.TransferID != 21 || a.Decision != "requested" || a.UserID == nil || *a.UserID != ownerID {
+						t.Fatalf("unexpected approval: %+v", a)
+					}
+					return nil
+				})
+
+				return transferRepo, approvalRepo, cosignerRepo, txdb
+			},
+		},
+		{
+			name: "accounts without co-signers cannot send them",
+			buildMocks: func(ctrl *gomock.Controller) (
+				*repmocks.MockTransferRepository,
+				*repmocks.MockTransferApprovalRepository,
+				*repmocks.MockCosignerRepository,
+				*servicemocks.MockTxDB,
+			) {
+				cosignerRepo := repmocks.NewMockCosignerRepository(ctrl)
+				cosignerRepo.EXPECT().ListByAccountID(gomock.Any(), fromAccountID).Return(nil, nil)
+				return repmocks.NewMockTransferRepository(ctrl),
+					repmocks.NewMockTransferApprovalRepository(ctrl),
+					cosignerRepo,
+					servicemocks.NewMockTxDB(ctrl)
+			},
+			wantCode:    422,
+			wantMessage: "transfers above 10000.00 EUR need a co-signer to approve them",
+		},
+		{
+			name:     "threshold is converted to the currency of the account",
+			currency: "GBP",
+			rate:     "0.85",
+			buildMocks: func(ctrl *gomock.Controller) (
+				*repmocks.MockTransferRepository,
+				*repmocks.MockTransferApprovalRepository,
+				*repmocks.MockCosignerRepository,
+				*servicemocks.MockTxDB,
+			) {
+				cosignerRepo := repmocks.NewMockCosignerRepository(ctrl)
+				cosignerRepo.EXPECT().ListByAccountID(gomock.Any(), fromAccountID).Return(nil, nil)
+				return repmocks.NewMockTransferRepository(ctrl),
+					repmocks.NewMockTransferApprovalRepository(ctrl),
+					cosignerRepo,
+					servicemocks.NewMockTxDB(ctrl)
+			},
+			wantCode:    422,
+			wantMessage: "transfers above 8500.00 GBP need a co-signer to approve them",
+		},
+		{
+			name:     "account currency without an exchange rate returns 422",
+			currency: "CHF",
+			buildMocks: func(ctrl *gomock.Controller) (
+				*repmocks.MockTransferRepository,
+				*repmocks.MockTransferApprovalRepository,
+				*repmocks.MockCosignerRepository,
+				*servicemocks.MockTxDB,
+			) {
+				return repmocks.NewMockTransferRepository(ctrl),
+					repmocks.NewMockTransferApprovalRepository(ctrl),
+					repmocks.NewMockCosignerRepository(ctrl),
+					servicemocks.NewMockTxDB(ctrl)
+			},
+			wantCode:    422,
+			wantMessage: "no exchange rate from EUR to CHF",
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			currency := "EUR"
+			fxRepo := repmocks.NewMockFXRepository(ctrl)
+			if tc.currency != "" {
+				currency = tc.currency
+				if tc.rate != "" {
+					fxRepo.EXPECT().GetRate(gomock.Any(), "EUR", currency).
+						Return(&model.FXRate{BaseCurrency: "EUR", QuoteCurrency: currency, Rate: decimal.RequireFromString(tc.rate)}, nil)
+				} else {
+					fxRepo.EXPECT().GetRate(gomock.Any(), "EUR", currency).Return(nil, util.NewNotFoundError("exchange rate not found"))
+					fxRepo.EXPECT().GetRate(gomock.Any(), currency, "EUR").Return(nil, util.NewNotFoundError("exchange rate not found"))
+				}
+			}
+
+			accountRepo := repmocks.NewMockAccountRepository(ctrl)
+			accountRepo.EXPECT().GetByID(gomock.Any(), fromAccountID).
+				Return(&model.Account{ID: fromAccountID, UserID: ownerID, Type: "business", Status: "active", Currency: currency, Balance: decimal.RequireFromString("20000.00")}, nil)
+			accountRepo.EXPECT().GetByID(gomock.Any(), toAccountID).
+				Return(&model.Account{ID: toAccountID, UserID: uuid.New(), Status: "active", Currency: currency}, nil)
+
+			transferRepo, approvalRepo, cosignerRepo, txdb := tc.buildMocks(ctrl)
+			svc := service.NewTransferService(transferRepo, approvalRepo, repmocks.NewMockTransferOutboxRepository(ctrl), accountRepo, repmocks.NewMockUserRepository(ctrl), cosignerRepo, repmocks.NewMockMovementRepository(ctrl), servicemocks.NewMockLedgerService(ctrl), servicemocks.NewMockTransferLimitService(ctrl), noFees, fxRepo, servicemocks.NewMockCacheClient(ctrl), txdb, approvals, outboxConfig)
+
+			got, err := svc.Submit(context.Background(), fromAccountID, toAccountID, amount, "invoice")
+			if tc.wantCode != 0 {
+				apiErr, ok := err.(*util.APIError)
+				if !ok || apiErr.Code != tc.wantCode || apiErr.Message != tc.wantMessage {
+					t.Fatalf("expected %d APIError %q, got %#v", tc.wantCode, tc.wantMessage, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.ID != 21 || got.Status != "awaiting_approval" {
+				t.Fatalf("unexpected transfer: %+v", got)
+			}
+		})
+	}
+}
+
+func TestTransferService_TransferNeedingApproval(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	fromAccountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440404")
+	toAccountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440405")
+	approvals := &config.ApprovalConfig{Threshold: decimal.RequireFromString("10000.00"), Currency: "EUR", TTL: 72 * time.Hour}
+
+	accountRepo := repmocks.NewMockAccountRepository(ctrl)
+	accountRepo.EXPECT().GetByID(gomock.Any(), fromAccountID).
+		Return(&model.Account{ID: fromAccountID, UserID: uuid.New(), Type: "business", Status: "active", Currency: "EUR", Balance: decimal.RequireFromString("20000.00")}, nil)
+	accountRepo.EXPECT().GetByID(gomock.Any(), toAccountID).
+		Return(&model.Account{ID: toAccountID, UserID: uuid.New(), Status: "active", Currency: "EUR"}, nil)
+	cosignerRepo := repmocks.NewMockCosignerRepository(ctrl)
+	cosignerRepo.EXPECT().ListByAccountID(gomock.Any(), fromAccountID).
+		Return([]*model.AccountCosigner{{AccountID: fromAccountID, UserID: uuid.New()}}, nil)
+
+	// Callers of Transfer expect the funds to move, so nothing is held or posted
+	svc := service.NewTransferService(repmocks.NewMockTransferRepository(ctrl), repmocks.NewMockTransferApprovalRepository(ctrl), repmocks.NewMockTransferOutboxRepository(ctrl), accountRepo, repmocks.NewMockUserRepository(ctrl), cosignerRepo, repmocks.NewMockMovementRepository(ctrl), servicemocks.NewMockLedgerService(ctrl), servicemocks.NewMockTransferLimitService(ctrl), noFees, repmocks.NewMockFXRepository(ctrl), servicemocks.NewMockCacheClient(ctrl), servicemocks.NewMockTxDB(ctrl), approvals, outboxConfig)
+
+	_, err := svc.Transfer(context.Background(), fromAccountID, toAccountID, decimal.RequireFromString("15000.00"), "invoice")
+	apiErr, ok := err.(*util.APIError)
+	if !ok || apiErr.Code != 422 {
+		t.Fatalf("expected 422 APIError, got %#v", err)
+	}
+}
+
+func TestTransferService_Approve(t *testing.T) {
+	t.Parallel()
+
+	cosignerID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440410")
+	fromAccountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440411")
+	toAccountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440412")
+	id := uint64(22)
+	expiresAt := time.Now().Add(time.Hour)
+	expiredAt := time.Now().Add(-time.Hour)
+	awaiting := &model.Transfer{ID: id, FromAccount: fromAccountID, ToAccount: toAccountID, Amount: decimal.RequireFromString("15000.00"), Status: "awaiting_approval", ApprovalExpiresAt: &expiresAt}
+
+	tests := []struct {
+		name       string
+		buildMocks func(ctrl *gomock.Controller) (*repmocks.MockTransferRepository, *repmocks.MockCosignerRepository)
+		wantCode   int
+		wantMsg    string
+	}{
+		{
+			name: "users who do not co-sign the account get 404",
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockTransferRepository, *repmocks.MockCosignerRepository) {
+				transferRepo := repmocks.NewMockTransferRepository(ctrl)
+				cosignerRepo := repmocks.NewMockCosignerRepository(ctrl)
+				transferRepo.EXPECT().GetByID(gomock.Any(), id).Return(awaiting, nil)
+				cosignerRepo.EXPECT().Exists(gomock.Any(), fromAccountID, cosignerID).Return(false, nil)
+				return transferRepo, cosignerRepo
+			},
+			wantCode: 404,
+			wantMsg:  "transfer not found",
+		},
+		{
+			name: "transfers not awaiting approval return 409",
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockTransferRepository, *repmocks.MockCosignerRepository) {
+				transferRepo := repmocks.NewMockTransferRepository(ctrl)
+				cosignerRepo := repmocks.NewMockCosignerRepository(ctrl)
+				transferRepo.EXPECT().GetByID(gomock.Any(), id).Return(&model.Transfer{ID: id, FromAccount: fromAccountID, Status: "failed"}, nil)
+				cosignerRepo.EXPECT().Exists(gomock.Any(), fromAccountID, cosignerID).Return(true, nil)
+				return transferRepo, cosignerRepo
+			},
+			wantCode: 409,
+			wantMsg:  "transfer is not awaiting approval",
+		},
+		{
+			name: "expired approvals return 409",
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockTransferRepository, *repmocks.MockCosignerRepository) {
+				transferRepo := repmocks.NewMockTransferRepository(ctrl)
+				cosignerRepo := repmocks.NewMockCosignerRepository(ctrl)
+				transferRepo.EXPECT().GetByID(gomock.Any(), id).
+					Return(&model.Transfer{ID: id, FromAccount: fromAccountID, Status: "awaiting_approval", ApprovalExpiresAt: &expiredAt}, nil)
+				cosignerRepo.EXPECT().Exists(gomock.Any(), fromAccountID, cosignerID).Return(true, nil)
+				return transferRepo, cosignerRepo
+			},
+			wantCode: 409,
+			wantMsg:  "approval has expired",
+		},
+		{
+			name: "transfers decided concurrently return 409",
+			buildMocks: func(ctrl *gomock.Controller) (*repmocks.MockTransferRepository, *repmocks.MockCosignerRepository) {
+				transferRepo := repmocks.NewMockTransferRepository(ctrl)
+				cosignerRepo := repmocks.NewMockCosignerRepository(ctrl)
+				transferRepo.EXPECT().GetByID(gomock.Any(), id).Return(awaiting, nil)
+				cosignerRepo.EXPECT().Exists(gomock.Any(), fromAccountID, cosignerID).Return(true, nil)
+				transferRepo.EXPECT().TransitionStatus(gomock.Any(), id, "awaiting_approval", "pending").Return(false, nil)
+				return transferRepo, cosignerRepo
+			},
+			wantCode: 409,
+			wantMsg:  "transfer is no longer awaiting approval",
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// Transfers still awaiting approval are claimed in a transaction
+			txdb := servicemocks.NewMockTxDB(ctrl)
+			txdb.EXPECT().
+				Transaction(gomock.Any()).
+				DoAndReturn(func(fc func(tx *gorm.DB) error, opts ...*sql.TxOptions) error {
+					return fc(&gorm.DB{})
+				}).
+				AnyTimes()
+
+			transferRepo, cosignerRepo := tc.buildMocks(ctrl)
+			svc := service.NewTransferService(transferRepo, repmocks.NewMockTransferApprovalRepository(ctrl), repmocks.NewMockTransferOutboxRepository(ctrl), repmocks.NewMockAccountRepository(ctrl), repmocks.NewMockUserRepository(ctrl), cosignerRepo, repmocks.NewMockMovementRepository(ctrl), servicemocks.NewMockLedgerService(ctrl), servicemocks.NewMockTransferLimitService(ctrl), noFees, repmocks.NewMockFXRepository(ctrl), servicemocks.NewMockCacheClient(ctrl), txdb, noApprovals, outboxConfig)
+
+			_, err := svc.Approve(context.Background(), cosignerID, id, "ok")
+			apiErr, ok := err.(*util.APIError)
+			if !ok || apiErr.Code != tc.wantCode || apiErr.Message != tc.wantMsg {
+				t.Fatalf("expected %d %q APIError, got %#v", tc.wantCode, tc.wantMsg, err)
+			}
+		})
+	}
+}
+
+func TestTransferService_ApproveQueuesTransfer(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cosignerID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440421")
+	fromAccountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440422")
+	toAccountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440423")
+	id := uint64(23)
+	amount := decimal.RequireFromString("15000.00")
+	expiresAt := time.Now().Add(time.Hour)
+
+	transferRepo := repmocks.NewMockTransferRepository(ctrl)
+	approvalRepo := repmocks.NewMockTransferApprovalRepository(ctrl)
+	outboxRepo := repmocks.NewMockTransferOutboxRepository(ctrl)
+	cosignerRepo := repmocks.NewMockCosignerRepository(ctrl)
+	txdb := servicemocks.NewMockTxDB(ctrl)
+
+	transferRepo.EXPECT().GetByID(gomock.Any(), id).
+		Return(&model.Transfer{ID: id, FromAccount: fromAccountID, ToAccount: toAccountID, Amount: amount, Description: "invoice", Status: "awaiting_approval", ApprovalExpiresAt: &expiresAt}, nil)
+	cosignerRepo.EXPECT().Exists(gomock.Any(), fromAccountID, cosignerID).Return(true, nil)
+
+	// The claim, the decision and the outbox message are written together; the outbox workers execute the
+	// transfer, or fail it with its message when it can no longer be sent
+	txdb.EXPECT().
+		Transaction(gomock.Any()).
+		DoAndReturn(func(fc func(tx *gorm.DB) error, opts ...*sql.TxOptions) error {
+			return fc(&gorm.DB{})
+		})
+	gomock.InOrder(
+		transferRepo.EXPECT().TransitionStatus(gomock.Any(), id, "awaiting_approval", "pending").Return(true, nil),
+		approvalRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, a *model.TransferApproval) error {
+			if a.TransferID != id || a.Decision != "approved" || a.UserID == nil || *a.UserID != cosignerID || a.Note != "ok" {
+				t.Fatalf("unexpected approval: %+v", a)
+			}
+			return nil
+		}),
+		outboxRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, m *model.TransferOutbox) error {
+			if _, ok := repository.TxFromContext(ctx); !ok || m.TransferID != id {
+				t.Fatalf("unexpected outbox message: %+v", m)
+			}
+			return nil
+		}),
+	)
+
+	svc := service.NewTransferService(transferRepo, approvalRepo, outboxRepo, repmocks.NewMockAccountRepository(ctrl), repmocks.NewMockUserRepository(ctrl), cosignerRepo, repmocks.NewMockMovementRepository(ctrl), servicemocks.NewMockLedgerService(ctrl), servicemocks.NewMockTransferLimitService(ctrl), noFees, repmocks.NewMockFXRepository(ctrl), servicemocks.NewMockCacheClient(ctrl), txdb, noApprovals, outboxConfig)
+
+	got, err := svc.Approve(context.Background(), cosignerID, id, "ok")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Status != "pending" {
+		t.Fatalf("unexpected transfer: %+v", got)
+	}
+}
+
+func TestTransferService_Reject(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cosignerID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440430")
+	fromAccountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440431")
+	id := uint64(24)
+	expiresAt := time.Now().Add(time.Hour)
+
+	transferRepo := repmocks.NewMockTransferRepository(ctrl)
+	approvalRepo := repmocks.NewMockTransferApprovalRepository(ctrl)
+	cosignerRepo := repmocks.NewMockCosignerRepository(ctrl)
+
+	transferRepo.EXPECT().GetByID(gomock.Any(), id).
+		Return(&model.Transfer{ID: id, FromAccount: fromAccountID, Status: "awaiting_approval", ApprovalExpiresAt: &expiresAt}, nil)
+	cosignerRepo.EXPECT().Exists(gomock.Any(), fromAccountID, cosignerID).Return(true, nil)
+
+	// The transfer fails and the decision is recorded in the same transaction
+	txdb := servicemocks.NewMockTxDB(ctrl)
+	txdb.EXPECT().
+		Transaction(gomock.Any()).
+		DoAndReturn(func(fc func(tx *gorm.DB) error, opts ...*sql.TxOptions) error {
+			return fc(&gorm.DB{})
+		})
+	transferRepo.EXPECT().TransitionStatus(gomock.Any(), id, "awaiting_approval", "failed").
+		DoAndReturn(func(ctx context.Context, _ uint64, _, _ string) (bool, error) {
+			if _, ok := repository.TxFromContext(ctx); !ok {
+				t.Fatal("transfer rejected outside of the transaction")
+			}
+			return true, nil
+		})
+	approvalRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, a *model.TransferApproval) error {
+		if _, ok := repository.TxFromContext(ctx); !ok || a.Decision != "rejected" || a.Note != "unknown payee" {
+			t.Fatalf("unexpected approval: %+v", a)
+		}
+		return nil
+	})
+
+	svc := service.NewTransferService(transferRepo, approvalRepo, repmocks.NewMockTransferOutboxRepository(ctrl), repmocks.NewMockAccountRepository(ctrl), repmocks.NewMockUserRepository(ctrl), cosignerRepo, repmocks.NewMockMovementRepository(ctrl), servicemocks.NewMockLedgerService(ctrl), servicemocks.NewMockTransferLimitService(ctrl), noFees, repmocks.NewMockFXRepository(ctrl), servicemocks.NewMockCacheClient(ctrl), txdb, noApprovals, outboxConfig)
+
+	got, err := svc.Reject(context.Background(), cosignerID, id, "unknown payee")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Status != "failed" {
+		t.Fatalf("unexpected transfer: %+v", got)
+	}
+}
+
+func TestTransferService_Submit(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ownerID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440440")
+	fromAccountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440441")
+	toAccountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440442")
+	amount := decimal.RequireFromString("25.00")
+
+	transferRepo := repmocks.NewMockTransferRepository(ctrl)
+	outboxRepo := repmocks.NewMockTransferOutboxRepository(ctrl)
+	accountRepo := repmocks.NewMockAccountRepository(ctrl)
+	limitSvc := servicemocks.NewMockTransferLimitService(ctrl)
+	txdb := servicemocks.NewMockTxDB(ctrl)
+
+	accountRepo.EXPECT().GetByID(gomock.Any(), fromAccountID).
+		Return(&model.Account{ID: fromAccountID, UserID: ownerID, Status: "active", Currency: "EUR", Balance: decimal.RequireFromString("100.00")}, nil)
+	accountRepo.EXPECT().GetByID(gomock.Any(), toAccountID).Return(&model.Account{ID: toAccountID, UserID: uuid.New(), Status: "active", Currency: "EUR"}, nil)
+	limitSvc.EXPECT().Check(gomock.Any(), ownerID, toAccountID, amount, "EUR", uint64(0)).Return(nil)
+
+	// The pending transfer and its outbox message are written together, without moving any funds
+	txdb.EXPECT().
+		Transaction(gomock.Any()).
+		DoAndReturn(func(fc func(tx *gorm.DB) error, opts ...*sql.TxOptions) error {
+			return fc(&gorm.DB{})
+		})
+	transferRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, tr *model.Transfer) error {
+		if tr.Status != "pending" {
+			t.Fatalf("unexpected transfer: %+v", tr)
+		}
+		tr.ID = 31
+		return nil
+	})
+	outboxRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, m *model.TransferOutbox) error {
+		if m.TransferID != 31 || m.Attempts != 0 || m.ProcessedAt != nil {
+			t.Fatalf("unexpected outbox message: %+v", m)
+		}
+		return nil
+	})
+
+	svc := service.NewTransferService(transferRepo, repmocks.NewMockTransferApprovalRepository(ctrl), outboxRepo, accountRepo, repmocks.NewMockUserRepository(ctrl), repmocks.NewMockCosignerRepository(ctrl), repmocks.NewMockMovementRepository(ctrl), servicemocks.NewMockLedgerService(ctrl), limitSvc, noFees, repmocks.NewMockFXRepository(ctrl), servicemocks.NewMockCacheClient(ctrl), txdb, noApprovals, outboxConfig)
+
+	got, err := svc.Submit(context.Background(), fromAccountID, toAccountID, amount, "rent")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.ID != 31 || got.Status != "pending" {
+		t.Fatalf("unexpected transfer: %+v", got)
+	}
+}
+
+func TestTransferService_ProcessQueued(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ownerID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440450")
+	fromAccountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440451")
+	toAccountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440452")
+	id := uint64(32)
+	amount := decimal.RequireFromString("25.00")
+	message := &model.TransferOutbox{ID: 5, TransferID: id, Attempts: 1}
+
+	transferRepo := repmocks.NewMockTransferRepository(ctrl)
+	outboxRepo := repmocks.NewMockTransferOutboxRepository(ctrl)
+	accountRepo := repmocks.NewMockAccountRepository(ctrl)
+	movementRepo := repmocks.NewMockMovementRepository(ctrl)
+	ledgerSvc := servicemocks.NewMockLedgerService(ctrl)
+	limitSvc := servicemocks.NewMockTransferLimitService(ctrl)
+	cache := servicemocks.NewMockCacheClient(ctrl)
+	txdb := servicemocks.NewMockTxDB(ctrl)
+
+	transferRepo.EXPECT().GetByID(gomock.Any(), id).
+		Return(&model.Transfer{ID: id, FromAccount: fromAccountID, ToAccount: toAccountID, Amount: amount, Currency: "EUR", Status: "pending"}, nil)
+
+	// The transfer executes on its existing record and the message is processed in the same transaction
+	txdb.EXPECT().
+		Transaction(gomock.Any()).
+		DoAndReturn(func(fc func(tx *gorm.DB) error, opts ...*sql.TxOptions) error {
+			return fc(&gorm.DB{})
+		})
+	accountRepo.EXPECT().GetByID(gomock.Any(), fromAccountID).
+		Return(&model.Account{ID: fromAccountID, UserID: ownerID, Status: "active", Currency: "EUR", Balance: decimal.RequireFromString("100.00")}, nil)
+	accountRepo.EXPECT().GetByID(gomock.Any(), toAccountID).Return(&model.Account{ID: toAccountID, UserID: uuid.New(), Status: "active", Currency: "EUR"}, nil)
+	limitSvc.EXPECT().Check(gomock.Any(), ownerID, toAccountID, amount, "EUR", id).Return(nil)
+	ledgerSvc.EXPECT().Post(gomock.Any(), gomock.Any()).Return(nil)
+	movementRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(2).Return(nil)
+	transferRepo.EXPECT().UpdateStatus(gomock.Any(), id, "completed", gomock.Any()).Return(nil)
+	outboxRepo.EXPECT().MarkProcessed(gomock.Any(), uint64(5), gomock.Any(), "").Return(true, nil)
+	cache.EXPECT().SetBalanceCache(gomock.Any(), gomock.Any(), gomock.Any()).Times(2).Return(nil)
+	transferRepo.EXPECT().GetByID(gomock.Any(), id).Return(&model.Transfer{ID: id, Status: "completed"}, nil)
+
+	svc := service.NewTransferService(transferRepo, repmocks.NewMockTransferApprovalRepository(ctrl), outboxRepo, accountRepo, repmocks.NewMockUserRepository(ctrl), repmocks.NewMockCosignerRepository(ctrl), movementRepo, ledgerSvc, limitSvc, noFees, repmocks.NewMockFXRepository(ctrl), cache, txdb, noApprovals, outboxConfig)
+
+	got, err := svc.ProcessQueued(context.Background(), message)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Status != "completed" {
+		t.Fatalf("unexpected transfer: %+v", got)
+	}
+}
+
+func TestTransferService_ProcessQueuedFailures(t *testing.T) {
+	t.Parallel()
+
+	fromAccountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440460")
+	toAccountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440461")
+	id := uint64(33)
+	amount := decimal.RequireFromString("25.00")
+	transfer := model.Transfer{ID: id, FromAccount: fromAccountID, ToAccount: toAccountID, Amount: amount, Status: "pending"}
+	dbErr := errors.New("connection reset")
+
+	tests := []struct {
+		name       string
+		attempts   int
+		buildMocks func(ctrl *gomock.Controller) (
+			*repmocks.MockTransferRepository,
+			*repmocks.MockTransferOutboxRepository,
+			*repmocks.MockAccountRepository,
+			*servicemocks.MockTxDB,
+		)
+		wantErr   func(err error) bool
+		wantDelay time.Duration
+	}{
+		{
+			name:     "insufficient funds fail the transfer without a retry",
+			attempts: 1,
+			buildMocks: func(ctrl *gomock.Controller) (
+				*repmocks.MockTransferRepository,
+				*repmocks.MockTransferOutboxRepository,
+				*repmocks.MockAccountRepository,
+				*servicemocks.MockTxDB,
+			) {
+				transferRepo := repmocks.NewMockTransferRepository(ctrl)
+				outboxRepo := repmocks.NewMockTransferOutboxRepository(ctrl)
+				accountRepo := repmocks.NewMockAccountRepository(ctrl)
+				txdb := servicemocks.NewMockTxDB(ctrl)
+
+				pending := transfer
+				transferRepo.EXPECT().GetByID(gomock.Any(), id).Return(&pending, nil)
+				txdb.EXPECT().
+					Transaction(gomock.Any()).
+					Times(2).
+					DoAndReturn(func(fc func(tx *gorm.DB) error, opts ...*sql.TxOptions) error {
+						return fc(&gorm.DB{})
+					})
+				outboxRepo.EXPECT().MarkProcessed(gomock.Any(), uint64(6), gomock.Any(), "").Return(true, nil)
+				accountRepo.EXPECT().GetByID(gomock.Any(), fromAccountID).Return(&model.Account{ID: fromAccountID, Status: "active", Balance: decimal.RequireFromString("10.00")}, nil)
+				accountRepo.EXPECT().GetByID(gomock.Any(), toAccountID).Return(&model.Account{ID: toAccountID, Status: "active"}, nil)
+				transferRepo.EXPECT().UpdateStatus(gomock.Any(), id, "failed", gomock.Any()).Return(nil)
+				outboxRepo.EXPECT().MarkProcessed(gomock.Any(), uint64(6), gomock.Any(), "insufficient funds").Return(true, nil)
+
+				return transferRepo, outboxRepo, accountRepo, txdb
+			},
+			wantErr: func(err error) bool {
+				apiErr, ok := err.(*util.APIError)
+				return ok && apiErr.Code == 400
+			},
+		},
+		{
+			name:     "transient error retries after the base delay",
+			attempts: 1,
+			buildMocks: func(ctrl *gomock.Controller) (
+				*repmocks.MockTransferRepository,
+				*repmocks.MockTransferOutboxRepository,
+				*repmocks.MockAccountRepository,
+				*servicemocks.MockTxDB,
+			) {
+				transferRepo := repmocks.NewMockTransferRepository(ctrl)
+				txdb := servicemocks.NewMockTxDB(ctrl)
+
+				transferRepo.EXPECT().GetByID(gomock.Any(), id).Return(nil, dbErr)
+
+				return transferRepo, repmocks.NewMockTransferOutboxRepository(ctrl), repmocks.NewMockAccountRepository(ctrl), txdb
+			},
+			wantErr:   func(err error) bool { return errors.Is(err, dbErr) },
+			wantDelay: 2 * time.Second,
+		},
+		{
+			name:     "retry delay doubles with each attempt",
+			attempts: 2,
+			buildMocks: func(ctrl *gomock.Controller) (
+				*repmocks.MockTransferRepository,
+				*repmocks.MockTransferOutboxRepository,
+				*repmocks.MockAccountRepository,
+				*servicemocks.MockTxDB,
+			) {
+				transferRepo := repmocks.NewMockTransferRepository(ctrl)
+				outboxRepo := repmocks.NewMockTransferOutboxRepository(ctrl)
+				accountRepo := repmocks.NewMockAccountRepository(ctrl)
+				txdb := servicemocks.NewMockTxDB(ctrl)
+
+				pending := transfer
+				transferRepo.EXPECT().GetByID(gomock.Any(), id).Return(&pending, nil)
+				txdb.EXPECT().
+					Transaction(gomock.Any()).
+					DoAndReturn(func(fc func(tx *gorm.DB) error, opts ...*sql.TxOptions) error {
+						return fc(&gorm.DB{})
+					})
+				outboxRepo.EXPECT().MarkProcessed(gomock.Any(), uint64(6), gomock.Any(), "").Return(true, nil)
+				accountRepo.EXPECT().GetByID(gomock.Any(), fromAccountID).Return(nil, dbErr)
+
+				return transferRepo, outboxRepo, accountRepo, txdb
+			},
+			wantErr:   func(err error) bool { return errors.Is(err, dbErr) },
+			wantDelay: 4 * time.Second,
+		},
+		{
+			name:     "message processed by another worker is skipped",
+			attempts: 2,
+			buildMocks: func(ctrl *gomock.Controller) (
+				*repmocks.MockTransferRepository,
+				*repmocks.MockTransferOutboxRepository,
+				*repmocks.MockAccountRepository,
+				*servicemocks.MockTxDB,
+			) {
+				transferRepo := repmocks.NewMockTransferRepository(ctrl)
+				outboxRepo := repmocks.NewMockTransferOutboxRepository(ctrl)
+				txdb := servicemocks.NewMockTxDB(ctrl)
+
+				pending := transfer
+				transferRepo.EXPECT().GetByID(gomock.Any(), id).Return(&pending, nil)
+				txdb.EXPECT().
+					Transaction(gomock.Any()).
+					DoAndReturn(func(fc func(tx *gorm.DB) error, opts ...*sql.TxOptions) error {
+						return fc(&gorm.DB{})
+					})
+				outboxRepo.EXPECT().MarkProcessed(gomock.Any(), uint64(6), gomock.Any(), "").Return(false, nil)
+
+				return transferRepo, outboxRepo, repmocks.NewMockAccountRepository(ctrl), txdb
+			},
+			wantErr: func(err error) bool { return err == nil },
+		},
+		{
+			name:     "last attempt fails the transfer",
+			attempts: 3,
+			buildMocks: func(ctrl *gomock.Controller) (
+				*repmocks.MockTransferRepository,
+				*repmocks.MockTransferOutboxRepository,
+				*repmocks.MockAccountRepository,
+				*servicemocks.MockTxDB,
+			) {
+				transferRepo := repmocks.NewMockTransferRepository(ctrl)
+				outboxRepo := repmocks.NewMockTransferOutboxRepository(ctrl)
+				txdb := servicemocks.NewMockTxDB(ctrl)
+
+				transferRepo.EXPECT().GetByID(gomock.Any(), id).Return(nil, dbErr)
+				txdb.EXPECT().
+					Transaction(gomock.Any()).
+					DoAndReturn(func(fc func(tx *gorm.DB) error, opts ...*sql.TxOptions) error {
+						return fc(&gorm.DB{})
+					})
+				transferRepo.EXPECT().UpdateStatus(gomock.Any(), id, "failed", gomock.Any()).Return(nil)
+				outboxRepo.EXPECT().MarkProcessed(gomock.Any(), uint64(6), gomock.Any(), "failed to get queued transfer: connection reset").Return(true, nil)
+
+				return transferRepo, outboxRepo, repmocks.NewMockAccountRepository(ctrl), txdb
+			},
+			wantErr: func(err error) bool { return errors.Is(err, dbErr) },
+		},
+		{
+			name:     "settled transfer only processes the message",
+			attempts: 1,
+			buildMocks: func(ctrl *gomock.Controller) (
+				*repmocks.MockTransferRepository,
+				*repmocks.MockTransferOutboxRepository,
+				*repmocks.MockAccountRepository,
+				*servicemocks.MockTxDB,
+			) {
+				transferRepo := repmocks.NewMockTransferRepository(ctrl)
+				outboxRepo := repmocks.NewMockTransferOutboxRepository(ctrl)
+
+				failed := transfer
+				failed.Status = "failed"
+				transferRepo.EXPECT().GetByID(gomock.Any(), id).Return(&failed, nil)
+				outboxRepo.EXPECT().MarkProcessed(gomock.Any(), uint64(6), gomock.Any(), "").Return(true, nil)
+
+				return transferRepo, outboxRepo, repmocks.NewMockAccountRepository(ctrl), servicemocks.NewMockTxDB(ctrl)
+			},
+			wantErr: func(err error) bool { return err == nil },
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			transferRepo, outboxRepo, accountRepo, txdb := tc.buildMocks(ctrl)
+			before := time.Now()
+			if tc.wantDelay > 0 {
+				outboxRepo.EXPECT().Retry(gomock.Any(), uint64(6), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ uint64, nextAttemptAt time.Time, lastError string) error {
+						if nextAttemptAt.Before(before.Add(tc.wantDelay)) || nextAttemptAt.After(time.Now().Add(tc.wantDelay)) {
+							t.Fatalf("expected a retry in %s, got %s", tc.wantDelay, nextAttemptAt.Sub(before))
+						}
+						return nil
+					})
+			}
+
+			svc := service.NewTransferService(transferRepo, repmocks.NewMockTransferApprovalRepository(ctrl), outboxRepo, accountRepo, repmocks.NewMockUserRepository(ctrl), repmocks.NewMockCosignerRepository(ctrl), repmocks.NewMockMovementRepository(ctrl), servicemocks.NewMockLedgerService(ctrl), servicemocks.NewMockTransferLimitService(ctrl), noFees, repmocks.NewMockFXRepository(ctrl), servicemocks.NewMockCacheClient(ctrl), txdb, noApprovals, outboxConfig)
+
+			_, err := svc.ProcessQueued(context.Background(), &model.TransferOutbox{ID: 6, TransferID: id, Attempts: tc.attempts})
+			if !tc.wantErr(err) {
+				t.Fatalf("unexpected error: %#v", err)
+			}
+		})
+	}
+}
+
+func TestTransferService_GetForUser(t *testing.T) {
+	t.Parallel()
+
+	senderID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440470")
+	receiverID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440471")
+	fromAccountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440472")
+	toAccountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440473")
+	id := uint64(34)
+
+	tests := []struct {
+		name       string
+		userID     uuid.UUID
+		buildMocks func(ctrl *gomock.Controller) (
+			*repmocks.MockUserRepository,
+			*repmocks.MockCosignerRepository,
+			*repmocks.MockMovementRepository,
+		)
+		assert func(t *testing.T, got *model.TransferDetails, err error)
+	}{
+		{
+			name:   "sender sees the receiver and the debit only",
+			userID: senderID,
+			buildMocks: func(ctrl *gomock.Controller) (
+				*repmocks.MockUserRepository,
+				*repmocks.MockCosignerRepository,
+				*repmocks.MockMovementRepository,
+			) {
+				userRepo := repmocks.NewMockUserRepository(ctrl)
+				movementRepo := repmocks.NewMockMovementRepository(ctrl)
+
+				userRepo.EXPECT().GetByID(gomock.Any(), receiverID).Return(&model.User{ID: receiverID, FirstName: "Mario", LastName: "Rossi"}, nil)
+				movementRepo.EXPECT().GetByTransferID(gomock.Any(), id).Return([]*model.Movement{
+					{ID: 1, AccountID: fromAccountID, Type: "debit"},
+					{ID: 2, AccountID: toAccountID, Type: "credit"},
+				}, nil)
+
+				return userRepo, repmocks.NewMockCosignerRepository(ctrl), movementRepo
+			},
+			assert: func(t *testing.T, got *model.TransferDetails, err error) {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if got.ID != id || got.CounterpartyName != "Ma*** R***" || len(got.Movements) != 1 || got.Movements[0].ID != 1 {
+					t.Fatalf("unexpected transfer: %+v", got)
+				}
+			},
+		},
+		{
+			name:   "receiver sees the sender and the credit only",
+			userID: receiverID,
+			buildMocks: func(ctrl *gomock.Controller) (
+				*repmocks.MockUserRepository,
+				*repmocks.MockCosignerRepository,
+				*repmocks.MockMovementRepository,
+			) {
+				userRepo := repmocks.NewMockUserRepository(ctrl)
+				movementRepo := repmocks.NewMockMovementRepository(ctrl)
+
+				userRepo.EXPECT().GetByID(gomock.Any(), senderID).Return(&model.User{ID: senderID, FirstName: "Anna", LastName: "Bianchi"}, nil)
+				movementRepo.EXPECT().GetByTransferID(gomock.Any(), id).Return([]*model.Movement{
+					{ID: 1, AccountID: fromAccountID, Type: "debit"},
+					{ID: 2, AccountID: toAccountID, Type: "credit"},
+				}, nil)
+
+				return userRepo, repmocks.NewMockCosignerRepository(ctrl), movementRepo
+			},
+			assert: func(t *testing.T, got *model.TransferDetails, err error) {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if got.CounterpartyName != "An*** B***" || len(got.Movements) != 1 || got.Movements[0].ID != 2 {
+					t.Fatalf("unexpected transfer: %+v", got)
+				}
+			},
+		},
+		{
+			name:   "other users get 404",
+			userID: uuid.MustParse("550e8400-e29b-41d4-a716-446655440474"),
+			buildMocks: func(ctrl *gomock.Controller) (
+				*repmocks.MockUserRepository,
+				*repmocks.MockCosignerRepository,
+				*repmocks.MockMovementRepository,
+			) {
+				cosignerRepo := repmocks.NewMockCosignerRepository(ctrl)
+				cosignerRepo.EXPECT().Exists(gomock.Any(), fromAccountID, gomock.Any()).Return(false, nil)
+
+				return repmocks.NewMockUserRepository(ctrl), cosignerRepo, repmocks.NewMockMovementRepository(ctrl)
+			},
+			assert: func(t *testing.T, got *model.TransferDetails, err error) {
+				apiErr, ok := err.(*util.APIError)
+				if !ok || apiErr.Code != 404 || apiErr.Message != "transfer not found" {
+					t.Fatalf("expected 404 APIError, got %#v", err)
+				}
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			transferRepo := repmocks.NewMockTransferRepository(ctrl)
+			accountRepo := repmocks.NewMockAccountRepository(ctrl)
+			transferRepo.EXPECT().GetByID(gomock.Any(), id).
+				Return(&model.Transfer{ID: id, FromAccount: fromAccountID, ToAccount: toAccountID, Status: "completed"}, nil)
+			accountRepo.EXPECT().GetByID(gomock.Any(), fromAccountID).Return(&model.Account{ID: fromAccountID, UserID: senderID}, nil)
+			accountRepo.EXPECT().GetByID(gomock.Any(), toAccountID).Return(&model.Account{ID: toAccountID, UserID: receiverID}, nil)
+
+			userRepo, cosignerRepo, movementRepo := tc.buildMocks(ctrl)
+			svc := service.NewTransferService(transferRepo, repmocks.NewMockTransferApprovalRepository(ctrl), repmocks.NewMockTransferOutboxRepository(ctrl), accountRepo, userRepo, cosignerRepo, movementRepo, servicemocks.NewMockLedgerService(ctrl), servicemocks.NewMockTransferLimitService(ctrl), noFees, repmocks.NewMockFXRepository(ctrl), servicemocks.NewMockCacheClient(ctrl), servicemocks.NewMockTxDB(ctrl), noApprovals, outboxConfig)
+
+			got, err := svc.GetForUser(context.Background(), tc.userID, id)
+			tc.assert(t, got, err)
+		})
+	}
+}
+
+func TestTransferService_GetByAccountID(t *testing.T) {
+	t.Parallel()
+
+	accountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440260")
+	otherID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440261")
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	low := decimal.NewFromInt(10)
+	high := decimal.NewFromInt(50)
+	negative := decimal.NewFromInt(-1)
+
+	tests := []struct {
+		name    string
+		filter  *model.TransferFilter
+		wantErr string
+	}{
+		{name: "unknown direction", filter: &model.TransferFilter{Direction: "both"}, wantErr: "direction must be 'in' or 'out'"},
+		{name: "unknown status", filter: &model.TransferFilter{Status: "done"}, wantErr: "unknown status 'done'"},
+		{name: "from not before to", filter: &model.TransferFilter{From: &from, To: &to}, wantErr: "from must be before to"},
+		{name: "negative bound", filter: &model.TransferFilter{MaxAmount: &negative}, wantErr: "amount bounds must not be negative"},
+		{name: "min above max", filter: &model.TransferFilter{MinAmount: &high, MaxAmount: &low}, wantErr: "min_amount must not be greater than max_amount"},
+		{name: "valid filter sets the direction of every transfer", filter: &model.TransferFilter{Status: "completed", From: &to, To: &from, MinAmount: &low, MaxAmount: &high, Counterparty: &otherID}},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			transferRepo := repmocks.NewMockTransferRepository(ctrl)
+			accountRepo := repmocks.NewMockAccountRepository(ctrl)
+			if tc.wantErr == "" {
+				accountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(&model.Account{ID: accountID}, nil)
+				transferRepo.EXPECT().GetByAccountID(gomock.Any(), accountID, tc.filter, gomock.Any()).
+					Return([]*model.Transfer{{ID: 2, FromAccount: accountID, ToAccount: otherID}, {ID: 1, FromAccount: otherID, ToAccount: accountID}}, 2, nil)
+			}
+
+			svc := service.NewTransferService(transferRepo, repmocks.NewMockTransferApprovalRepository(ctrl), repmocks.NewMockTransferOutboxRepository(ctrl), accountRepo, repmocks.NewMockUserRepository(ctrl), repmocks.NewMockCosignerRepository(ctrl), repmocks.NewMockMovementRepository(ctrl), servicemocks.NewMockLedgerService(ctrl), servicemocks.NewMockTransferLimitService(ctrl), noFees, repmocks.NewMockFXRepository(ctrl), servicemocks.NewMockCacheClient(ctrl), servicemocks.NewMockTxDB(ctrl), noApprovals, outboxConfig)
+
+			got, err := svc.GetByAccountID(context.Background(), accountID, tc.filter, 1, 10)
+			if tc.wantErr != "" {
+				apiErr, ok := err.(*util.APIError)
+				if !ok || apiErr.Code != 400 || apiErr.Message != tc.wantErr {
+					t.Fatalf("expected 400 %q, got %#v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			transfers := got.Data.([]*model.Transfer)
+			if transfers[0].Direction != "out" || transfers[1].Direction != "in" {
+				t.Fatalf("unexpected directions: %q, %q", transfers[0].Direction, transfers[1].Direction)
+			}
+		})
+	}
+}
+
+func TestTransferService_GetByAccountIDWithCursor(t *testing.T) {
+	t.Parallel()
+
+	accountID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440250")
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("pages by cursor without counting", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		transferRepo := repmocks.NewMockTransferRepository(ctrl)
+		accountRepo := repmocks.NewMockAccountRepository(ctrl)
+
+		cursor := util.Cursor{Time: now, ID: 9}
+		accountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(&model.Account{ID: accountID}, nil)
+		transferRepo.EXPECT().GetByAccountIDWithCursor(gomock.Any(), accountID, &model.TransferFilter{}, &util.CursorParams{Cursor: &cursor, Limit: 2}).
+			Return([]*model.Transfer{{ID: 8, InitiatedAt: now.Add(-time.Hour)}, {ID: 7, InitiatedAt: now.Add(-2 * time.Hour)}}, true, nil)
+
+		svc := service.NewTransferService(transferRepo, repmocks.NewMockTransferApprovalRepository(ctrl), repmocks.NewMockTransferOutboxRepository(ctrl), accountRepo, repmocks.NewMockUserRepository(ctrl), repmocks.NewMockCosignerRepository(ctrl), repmocks.NewMockMovementRepository(ctrl), servicemocks.NewMockLedgerService(ctrl), servicemocks.NewMockTransferLimitService(ctrl), noFees, repmocks.NewMockFXRepository(ctrl), servicemocks.NewMockCacheClient(ctrl), servicemocks.NewMockTxDB(ctrl), noApprovals, outboxConfig)
+
+		got, err := svc.GetByAccountIDWithCursor(context.Background(), accountID, &model.TransferFilter{}, cursor.Encode(), 2)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if want := (util.Cursor{Time: now.Add(-2 * time.Hour), ID: 7}).Encode(); got.Pagination.NextCursor != want {
+			t.Fatalf("unexpected next cursor: got=%q want=%q", got.Pagination.NextCursor, want)
+		}
+		if want := (util.Cursor{Time: now.Add(-time.Hour), ID: 8, Backward: true}).Encode(); got.Pagination.PrevCursor != want {
+			t.Fatalf("unexpected prev cursor: got=%q want=%q", got.Pagination.PrevCursor, want)
+		}
+	})
+
+	t.Run("invalid cursor returns 400", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc := service.NewTransferService(repmocks.NewMockTransferRepository(ctrl), repmocks.NewMockTransferApprovalRepository(ctrl), repmocks.NewMockTransferOutboxRepository(ctrl), repmocks.NewMockAccountRepository(ctrl), repmocks.NewMockUserRepository(ctrl), repmocks.NewMockCosignerRepository(ctrl), repmocks.NewMockMovementRepository(ctrl), servicemocks.NewMockLedgerService(ctrl), servicemocks.NewMockTransferLimitService(ctrl), noFees, repmocks.NewMockFXRepository(ctrl), servicemocks.NewMockCacheClient(ctrl), servicemocks.NewMockTxDB(ctrl), noApprovals, outboxConfig)
+
+		_, err := svc.GetByAccountIDWithCursor(context.Background(), accountID, &model.TransferFilter{}, "garbage!", 10)
+		apiErr, ok := err.(*util.APIError)
+		if !ok || apiErr.Code != 400 || apiErr.Message != "invalid cursor" {
+			t.Fatalf("expected 400 invalid cursor, got %#v", err)
+		}
+	})
+}
@@ -13,13 +13,22 @@ import (
 )
 
 type RouterDeps struct {
-	AuthHandler     *handler.AuthHandler
-	AccountHandler  *handler.AccountHandler
-	MovementHandler *handler.MovementHandler
-	TransferHandler *handler.TransferHandler
+	AuthHandler           *handler.AuthHandler
+	AccountHandler        *handler.AccountHandler
+	MovementHandler       *handler.MovementHandler
+	HoldHandler           *handler.HoldHandler
+	TransferHandler       *handler.TransferHandler
+	TransferBatchHandler  *handler.TransferBatchHandler
+	TransferLimitHandler  *handler.TransferLimitHandler
+	BeneficiaryHandler    *handler.BeneficiaryHandler
+	PaymentRequestHandler *handler.PaymentRequestHandler
+	StandingOrderHandler  *handler.StandingOrderHandler
+	FXHandler             *handler.FXHandler
+	FeeHandler            *handler.FeeHandler
 
-	AuthMiddleware      *middleware.AuthMiddleware
-	RateLimitMiddleware *middleware.RateLimitMiddleware
+	AuthMiddleware        *middleware.AuthMiddleware
+	RateLimitMiddleware   *middleware.RateLimitMiddleware
+	IdempotencyMiddleware *middleware.IdempotencyMiddleware
 }
 
 func SetupGinRouter(t *testing.T, deps RouterDeps) *gin.Engine {
@@ -27,6 +36,9 @@ func SetupGinRouter(t *testing.T, deps RouterDeps) *gin.Engine {
 
 	gin.SetMode(gin.TestMode)
 	r := gin.New()
+	if deps.IdempotencyMiddleware != nil {
+		r.Use(deps.IdempotencyMiddleware.Capture())
+	}
 
 	// Mirror production error envelope for OpenAPI parameter binding errors.
 	errorHandler := func(c *gin.Context, err error, statusCode int) {
@@ -35,7 +47,7 @@ func SetupGinRouter(t *testing.T, deps RouterDeps) *gin.Engine {
 		})
 	}
 
-	server := api.NewServer(deps.AuthHandler, deps.AccountHandler, deps.MovementHandler, deps.TransferHandler)
+	server := api.NewServer(deps.AuthHandler, deps.AccountHandler, deps.MovementHandler, deps.HoldHandler, deps.TransferHandler, deps.TransferBatchHandler, deps.TransferLimitHandler, deps.BeneficiaryHandler, deps.PaymentRequestHandler, deps.StandingOrderHandler, deps.FXHandler, deps.FeeHandler)
 
 	var mws []generated.MiddlewareFunc
 	if deps.AuthMiddleware != nil {
@@ -44,6 +56,9 @@ func SetupGinRouter(t *testing.T, deps RouterDeps) *gin.Engine {
 	if deps.RateLimitMiddleware != nil {
 		mws = append(mws, deps.RateLimitMiddleware.LimitByUserFunc())
 	}
+	if deps.IdempotencyMiddleware != nil {
+		mws = append(mws, deps.IdempotencyMiddleware.CheckFunc())
+	}
 
 	generated.RegisterHandlersWithOptions(r, server, generated.GinServerOptions{
 		Middlewares:  mws,
@@ -0,0 +1,69 @@
+package util
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+// DefaultCurrency is the currency of the accounts opened without picking one
+const DefaultCurrency = "EUR"
+
+// currencyMinorUnits holds the ISO 4217 currencies accounts can be opened in, with the number of digits of their
+// minor unit. Amounts are stored with up to three decimal places, the most any of these currencies uses.
+var currencyMinorUnits = map[string]int32{
+	"AUD": 2,
+	"CAD": 2,
+	"CHF": 2,
+	"CZK": 2,
+	"DKK": 2,
+	"EUR": 2,
+	"GBP": 2,
+	"HUF": 2,
+	"JPY": 0,
+	"KWD": 3,
+	"NOK": 2,
+	"PLN": 2,
+	"SEK": 2,
+	"USD": 2,
+}
+
+// NormalizeCurrency trims a currency code and upper-cases it
+func NormalizeCurrency(currency string) string {
+	return strings.ToUpper(strings.TrimSpace(currency))
+}
+
+// ValidateCurrency checks that a currency code is one of the supported ISO 4217 currencies
+func ValidateCurrency(currency string) error {
+	if _, ok := currencyMinorUnits[currency]; !ok {
+		return errors.Errorf("unsupported currency %q", currency)
+	}
+	return nil
+}
+
+// CurrencyMinorUnits returns the number of decimal places of the minor unit of a currency; unknown currencies
+// get two
+func CurrencyMinorUnits(currency string) int32 {
+	if units, ok := currencyMinorUnits[currency]; ok {
+		return units
+	}
+	return 2
+}
+
+// ValidateAmountPrecision checks that an amount has no more decimal places than the minor unit of its currency
+func ValidateAmountPrecision(amount decimal.Decimal, currency string) error {
+	units := CurrencyMinorUnits(currency)
+	if !amount.Equal(amount.Round(units)) {
+		if units == 0 {
+			return errors.Errorf("%s amounts cannot have decimal places", currency)
+		}
+		return errors.Errorf("%s amounts can have at most %d decimal places", currency, units)
+	}
+	return nil
+}
+
+// RoundAmount rounds an amount half away from zero to the minor unit of its currency
+func RoundAmount(amount decimal.Decimal, currency string) decimal.Decimal {
+	return amount.Round(CurrencyMinorUnits(currency))
+}
@@ -0,0 +1,82 @@
+package util_test
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+
+	"VDM2-BankBE/internal/util"
+)
+
+func TestValidateAmountPrecision(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		amount   string
+		currency string
+		wantErr  bool
+	}{
+		{name: "cents in euro", amount: "10.25", currency: "EUR"},
+		{name: "trailing zeros are ignored", amount: "10.2500", currency: "EUR"},
+		{name: "fractions of a cent in euro", amount: "10.255", currency: "EUR", wantErr: true},
+		{name: "whole yen", amount: "1500", currency: "JPY"},
+		{name: "fractions of a yen", amount: "1500.5", currency: "JPY", wantErr: true},
+		{name: "fils in dinar", amount: "1.125", currency: "KWD"},
+		{name: "fractions of a fils", amount: "1.1255", currency: "KWD", wantErr: true},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := util.ValidateAmountPrecision(decimal.RequireFromString(tc.amount), tc.currency)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("unexpected result for %s %s: err=%v wantErr=%v", tc.amount, tc.currency, err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestRoundAmount(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		amount   string
+		currency string
+		want     string
+	}{
+		{name: "euro rounds to cents", amount: "10.855", currency: "EUR", want: "10.86"},
+		{name: "yen rounds to units", amount: "1762.5", currency: "JPY", want: "1763"},
+		{name: "dinar rounds to fils", amount: "3.0764", currency: "KWD", want: "3.076"},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := util.RoundAmount(decimal.RequireFromString(tc.amount), tc.currency)
+			if !got.Equal(decimal.RequireFromString(tc.want)) {
+				t.Fatalf("unexpected amount: got=%s want=%s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestValidateCurrency(t *testing.T) {
+	t.Parallel()
+
+	for _, currency := range []string{"EUR", "USD", "JPY"} {
+		if err := util.ValidateCurrency(currency); err != nil {
+			t.Fatalf("expected %s to be supported: %v", currency, err)
+		}
+	}
+	for _, currency := range []string{"", "eur", "XYZ"} {
+		if err := util.ValidateCurrency(currency); err == nil {
+			t.Fatalf("expected %q to be rejected", currency)
+		}
+	}
+}
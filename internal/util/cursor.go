@@ -0,0 +1,120 @@
+package util
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+)
+
+// Cursor is the position of a row in a list sorted by time and ID, used for keyset pagination
+type Cursor struct {
+	Time time.Time `json:"t"`
+	ID   uint64    `json:"id"`
+	// Backward reads the rows before the position instead of the ones after it
+	Backward bool `json:"b,omitempty"`
+}
+
+// Encode returns the opaque token of the cursor
+func (c Cursor) Encode() string {
+	// Cannot fail: the cursor only holds a time, an integer and a bool
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// DecodeCursor parses a token returned by Cursor.Encode
+func DecodeCursor(token string) (*Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, NewBadRequestError("invalid cursor")
+	}
+
+	var c Cursor
+	if err := json.Unmarshal(raw, &c); err != nil || c.ID == 0 || c.Time.IsZero() {
+		return nil, NewBadRequestError("invalid cursor")
+	}
+
+	return &c, nil
+}
+
+// CursorParams stores keyset pagination parameters
+type CursorParams struct {
+	// Cursor is nil for the first page
+	Cursor *Cursor
+	Limit  int
+}
+
+// NewCursorParams creates keyset pagination parameters from a cursor token, empty for the first page
+func NewCursorParams(token string, limit int) (*CursorParams, error) {
+	params := &CursorParams{Limit: limit}
+	if params.Limit < 1 {
+		params.Limit = 10
+	}
+	if params.Limit > 100 {
+		params.Limit = 100 // Cap at 100 to prevent abuse
+	}
+
+	if token != "" {
+		c, err := DecodeCursor(token)
+		if err != nil {
+			return nil, err
+		}
+		params.Cursor = c
+	}
+
+	return params, nil
+}
+
+// Backward reports whether the page is read backward from the cursor
+func (p *CursorParams) Backward() bool {
+	return p.Cursor != nil && p.Cursor.Backward
+}
+
+// CursorResponse represents a keyset-paginated API response; it has no total count, and the cursors are omitted
+// when there are no rows in their direction
+type CursorResponse struct {
+	Data       interface{} `json:"data"`
+	Pagination struct {
+		PerPage    int    `json:"per_page"`
+		NextCursor string `json:"next_cursor,omitempty"`
+		PrevCursor string `json:"prev_cursor,omitempty"`
+	} `json:"pagination"`
+}
+
+// NewCursorResponse creates a keyset-paginated response for a page read with params. first and last are the
+// positions of the first and last rows of the page, nil when it is empty, and more reports whether further rows
+// exist in the direction the page was read.
+func NewCursorResponse(data interface{}, params *CursorParams, first, last *Cursor, more bool) *CursorResponse {
+	resp := &CursorResponse{
+		Data: data,
+	}
+	resp.Pagination.PerPage = params.Limit
+
+	// An empty page past either end can still be left in the direction it came from
+	if first == nil || last == nil {
+		if params.Cursor != nil {
+			back := *params.Cursor
+			back.Backward = !back.Backward
+			if back.Backward {
+				resp.Pagination.PrevCursor = back.Encode()
+			} else {
+				resp.Pagination.NextCursor = back.Encode()
+			}
+		}
+		return resp
+	}
+
+	hasNext := more
+	hasPrev := params.Cursor != nil
+	if params.Backward() {
+		hasNext, hasPrev = true, more
+	}
+
+	if hasNext {
+		resp.Pagination.NextCursor = Cursor{Time: last.Time, ID: last.ID}.Encode()
+	}
+	if hasPrev {
+		resp.Pagination.PrevCursor = Cursor{Time: first.Time, ID: first.ID, Backward: true}.Encode()
+	}
+
+	return resp
+}
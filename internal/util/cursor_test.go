@@ -0,0 +1,102 @@
+package util_test
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"VDM2-BankBE/internal/util"
+)
+
+func TestDecodeCursor(t *testing.T) {
+	t.Parallel()
+
+	at := time.Date(2026, 5, 4, 10, 30, 0, 123456000, time.UTC)
+
+	tests := []struct {
+		name    string
+		token   string
+		want    *util.Cursor
+		wantErr bool
+	}{
+		{name: "round trip", token: util.Cursor{Time: at, ID: 42}.Encode(), want: &util.Cursor{Time: at, ID: 42}},
+		{name: "backward round trip", token: util.Cursor{Time: at, ID: 7, Backward: true}.Encode(), want: &util.Cursor{Time: at, ID: 7, Backward: true}},
+		{name: "not base64", token: "not a cursor!", wantErr: true},
+		{name: "not json", token: base64.RawURLEncoding.EncodeToString([]byte("42")), wantErr: true},
+		{name: "missing id", token: base64.RawURLEncoding.EncodeToString([]byte(`{"t":"2026-05-04T10:30:00Z"}`)), wantErr: true},
+		{name: "missing time", token: base64.RawURLEncoding.EncodeToString([]byte(`{"id":42}`)), wantErr: true},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := util.DecodeCursor(tc.token)
+			if tc.wantErr {
+				apiErr, ok := err.(*util.APIError)
+				if !ok || apiErr.Code != 400 || apiErr.Message != "invalid cursor" {
+					t.Fatalf("expected 400 invalid cursor, got %#v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !got.Time.Equal(tc.want.Time) || got.ID != tc.want.ID || got.Backward != tc.want.Backward {
+				t.Fatalf("unexpected cursor: got=%+v want=%+v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestNewCursorResponse(t *testing.T) {
+	t.Parallel()
+
+	at := time.Date(2026, 5, 4, 10, 30, 0, 0, time.UTC)
+	first := &util.Cursor{Time: at, ID: 9}
+	last := &util.Cursor{Time: at.Add(-time.Hour), ID: 5}
+	position := &util.Cursor{Time: at.Add(time.Hour), ID: 10}
+	backFrom := &util.Cursor{Time: at.Add(-2 * time.Hour), ID: 4, Backward: true}
+
+	nextOfLast := util.Cursor{Time: last.Time, ID: last.ID}.Encode()
+	prevOfFirst := util.Cursor{Time: first.Time, ID: first.ID, Backward: true}.Encode()
+
+	tests := []struct {
+		name     string
+		params   *util.CursorParams
+		first    *util.Cursor
+		last     *util.Cursor
+		more     bool
+		wantNext string
+		wantPrev string
+	}{
+		{name: "first page with more", params: &util.CursorParams{Limit: 2}, first: first, last: last, more: true, wantNext: nextOfLast},
+		{name: "only page", params: &util.CursorParams{Limit: 2}, first: first, last: last},
+		{name: "middle page forward", params: &util.CursorParams{Cursor: position, Limit: 2}, first: first, last: last, more: true, wantNext: nextOfLast, wantPrev: prevOfFirst},
+		{name: "last page forward", params: &util.CursorParams{Cursor: position, Limit: 2}, first: first, last: last, wantPrev: prevOfFirst},
+		{name: "middle page backward", params: &util.CursorParams{Cursor: backFrom, Limit: 2}, first: first, last: last, more: true, wantNext: nextOfLast, wantPrev: prevOfFirst},
+		{name: "first page backward", params: &util.CursorParams{Cursor: backFrom, Limit: 2}, first: first, last: last, wantNext: nextOfLast},
+		{name: "empty first page", params: &util.CursorParams{Limit: 2}},
+		{name: "empty page past the end", params: &util.CursorParams{Cursor: position, Limit: 2}, wantPrev: util.Cursor{Time: position.Time, ID: position.ID, Backward: true}.Encode()},
+		{name: "empty page before the start", params: &util.CursorParams{Cursor: backFrom, Limit: 2}, wantNext: util.Cursor{Time: backFrom.Time, ID: backFrom.ID}.Encode()},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := util.NewCursorResponse([]int{}, tc.params, tc.first, tc.last, tc.more)
+			if resp.Pagination.PerPage != tc.params.Limit {
+				t.Fatalf("unexpected per page: %d", resp.Pagination.PerPage)
+			}
+			if resp.Pagination.NextCursor != tc.wantNext {
+				t.Fatalf("unexpected next cursor: got=%q want=%q", resp.Pagination.NextCursor, tc.wantNext)
+			}
+			if resp.Pagination.PrevCursor != tc.wantPrev {
+				t.Fatalf("unexpected prev cursor: got=%q want=%q", resp.Pagination.PrevCursor, tc.wantPrev)
+			}
+		})
+	}
+}
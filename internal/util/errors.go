@@ -2,15 +2,30 @@ package util
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
-	// "github.com/pkg/errors"
+	"github.com/pkg/errors"
 )
 
 // APIError represents a structured error response for the API
 type APIError struct {
 	Code    int    `json:"code"`
 	Message string `json:"message"`
+	// Reason is a stable machine-readable code, set on the errors clients are expected to act on
+	Reason string `json:"reason,omitempty"`
+	// Window is the transfer limit exceeded by a transfer_limit_exceeded error: per_transaction, daily or monthly
+	Window  string        `json:"window,omitempty"`
+	Details []ErrorDetail `json:"details,omitempty"`
+}
+
+// ReasonTransferLimitExceeded is the reason of the errors refusing a transfer over a limit of the sender
+const ReasonTransferLimitExceeded = "transfer_limit_exceeded"
+
+// ErrorDetail describes one of several problems reported by an APIError, such as an invalid line of a batch
+type ErrorDetail struct {
+	Line    int    `json:"line,omitempty"`
+	Message string `json:"message"`
 }
 
 // Error implements the error interface
@@ -26,6 +41,12 @@ func NewAPIError(code int, message string) *APIError {
 	}
 }
 
+// WithDetails attaches details to the error and returns it
+func (e *APIError) WithDetails(details []ErrorDetail) *APIError {
+	e.Details = details
+	return e
+}
+
 // NewBadRequestError creates a new 400 Bad Request error
 func NewBadRequestError(message string) *APIError {
 	return NewAPIError(http.StatusBadRequest, message)
@@ -46,6 +67,25 @@ func NewNotFoundError(message string) *APIError {
 	return NewAPIError(http.StatusNotFound, message)
 }
 
+// NewConflictError creates a new 409 Conflict error
+func NewConflictError(message string) *APIError {
+	return NewAPIError(http.StatusConflict, message)
+}
+
+// NewUnprocessableEntityError creates a new 422 Unprocessable Entity error
+func NewUnprocessableEntityError(message string) *APIError {
+	return NewAPIError(http.StatusUnprocessableEntity, message)
+}
+
+// NewTransferLimitError creates a 422 error refusing a transfer over the limit of window: per_transaction, daily
+// or monthly
+func NewTransferLimitError(window string) *APIError {
+	err := NewUnprocessableEntityError(strings.ReplaceAll(window, "_", "-") + " transfer limit exceeded")
+	err.Reason = ReasonTransferLimitExceeded
+	err.Window = window
+	return err
+}
+
 // NewInternalServerError creates a new 500 Internal Server Error
 func NewInternalServerError(message string) *APIError {
 	return NewAPIError(http.StatusInternalServerError, message)
@@ -69,3 +109,13 @@ func HandleError(c *gin.Context, err error) {
 	resp := ErrorResponse{Error: apiErr}
 	c.JSON(apiErr.Code, resp)
 }
+
+// ClientMessage returns the message of err that can be shown to the customer, such as the reason recorded on a
+// failed standing order run: the message of an API error, or fallback for internal errors, whose text is not
+// meant to leave the service
+func ClientMessage(err error, fallback string) string {
+	if apiErr, ok := errors.Cause(err).(*APIError); ok {
+		return apiErr.Message
+	}
+	return fallback
+}
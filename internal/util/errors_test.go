@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/gin-gonic/gin"
+	pkgerrors "github.com/pkg/errors"
 
 	"VDM2-BankBE/internal/util"
 )
@@ -23,6 +24,7 @@ func TestHandleError(t *testing.T) {
 		expectedStatus int
 		expectedCode   int
 		expectedMsg    string
+		expectedLines  []int
 	}{
 		{
 			name:           "APIError preserved",
@@ -31,6 +33,17 @@ func TestHandleError(t *testing.T) {
 			expectedCode:   http.StatusNotFound,
 			expectedMsg:    "account not found",
 		},
+		{
+			name: "APIError details preserved",
+			err: util.NewUnprocessableEntityError("batch has invalid lines").WithDetails([]util.ErrorDetail{
+				{Line: 2, Message: "amount must be greater than zero"},
+				{Line: 5, Message: "recipient not found"},
+			}),
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCode:   http.StatusUnprocessableEntity,
+			expectedMsg:    "batch has invalid lines",
+			expectedLines:  []int{2, 5},
+		},
 		{
 			name:           "generic error becomes 500 internal server error",
 			err:            errors.New("boom"),
@@ -68,7 +81,40 @@ func TestHandleError(t *testing.T) {
 			if got.Error.Message != tc.expectedMsg {
 				t.Fatalf("unexpected message: got=%q want=%q", got.Error.Message, tc.expectedMsg)
 			}
+			if len(got.Error.Details) != len(tc.expectedLines) {
+				t.Fatalf("unexpected details: got=%+v want lines %v", got.Error.Details, tc.expectedLines)
+			}
+			for i, line := range tc.expectedLines {
+				if got.Error.Details[i].Line != line {
+					t.Fatalf("unexpected detail line: got=%d want=%d", got.Error.Details[i].Line, line)
+				}
+			}
 		})
 	}
 }
 
+func TestClientMessage(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{name: "api error", err: util.NewUnprocessableEntityError("insufficient funds"), want: "insufficient funds"},
+		{name: "wrapped api error", err: pkgerrors.Wrap(util.NewNotFoundError("account not found"), "failed to transfer"), want: "account not found"},
+		{name: "internal error", err: errors.New("pq: connection refused"), want: "transfer failed"},
+		{name: "wrapped internal error", err: pkgerrors.Wrap(errors.New("pq: deadlock detected"), "failed to post"), want: "transfer failed"},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := util.ClientMessage(tc.err, "transfer failed"); got != tc.want {
+				t.Fatalf("unexpected message: got=%q want=%q", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package util
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// cinOddValues holds the value of the characters in odd positions of an Italian BBAN, indexed by
+// letter (A-Z) or digit (0-9, same value as A-J)
+var cinOddValues = [26]int{1, 0, 5, 7, 9, 13, 15, 17, 19, 21, 2, 4, 18, 20, 11, 3, 6, 8, 12, 14, 16, 10, 22, 25, 24, 23}
+
+// NormalizeIBAN removes the spaces from an IBAN and upper-cases it
+func NormalizeIBAN(iban string) string {
+	return strings.ToUpper(strings.Join(strings.Fields(iban), ""))
+}
+
+// ValidateIBAN checks the structure and the mod-97 check digits of an IBAN; Italian IBANs also get their
+// length, ABI, CAB and CIN checked
+func ValidateIBAN(iban string) error {
+	iban = NormalizeIBAN(iban)
+	if len(iban) < 15 || len(iban) > 34 {
+		return errors.New("iban must be between 15 and 34 characters long")
+	}
+	if !isLetters(iban[:2]) || !isDigits(iban[2:4]) || !isAlphanumeric(iban[4:]) {
+		return errors.New("iban is malformed")
+	}
+	if mod97(iban[4:]+iban[:4]) != 1 {
+		return errors.New("iban check digits are wrong")
+	}
+
+	if iban[:2] == "IT" {
+		if len(iban) != 27 {
+			return errors.New("italian iban must be 27 characters long")
+		}
+		abi, cab, number := iban[5:10], iban[10:15], iban[15:]
+		if !isDigits(abi) || !isDigits(cab) {
+			return errors.New("italian iban has a malformed ABI or CAB")
+		}
+		if cin := italianCIN(abi + cab + number); iban[4] != cin {
+			return errors.New("italian iban CIN is wrong")
+		}
+	}
+
+	return nil
+}
+
+// NewItalianIBAN builds the IBAN of an account from the 5-digit ABI and CAB codes of the bank branch and the
+// account number (up to 12 characters, left-padded with zeros), computing its CIN and check digits
+func NewItalianIBAN(abi, cab, accountNumber string) (string, error) {
+	if len(abi) != 5 || !isDigits(abi) {
+		return "", errors.Errorf("invalid ABI %q", abi)
+	}
+	if len(cab) != 5 || !isDigits(cab) {
+		return "", errors.Errorf("invalid CAB %q", cab)
+	}
+	accountNumber = strings.ToUpper(accountNumber)
+	if accountNumber == "" || len(accountNumber) > 12 || !isAlphanumeric(accountNumber) {
+		return "", errors.Errorf("invalid account number %q", accountNumber)
+	}
+	accountNumber = strings.Repeat("0", 12-len(accountNumber)) + accountNumber
+
+	bban := string(italianCIN(abi+cab+accountNumber)) + abi + cab + accountNumber
+	checkDigits := 98 - mod97(bban+"IT00")
+
+	return fmt.Sprintf("IT%02d%s", checkDigits, bban), nil
+}
+
+// italianCIN computes the control character of an Italian BBAN from its ABI, CAB and account number
+func italianCIN(s string) byte {
+	sum := 0
+	for i := 0; i < len(s); i++ {
+		value := charValue(s[i])
+		if i%2 == 0 {
+			value = cinOddValues[value]
+		}
+		sum += value
+	}
+
+	return byte('A' + sum%26)
+}
+
+// charValue maps a digit to its value and a letter to its position in the alphabet, starting from 0
+func charValue(c byte) int {
+	if c >= '0' && c <= '9' {
+		return int(c - '0')
+	}
+
+	return int(c - 'A')
+}
+
+// mod97 computes the remainder of the division by 97 of the number obtained replacing every letter with
+// its value (A=10, ..., Z=35)
+func mod97(s string) int {
+	remainder := 0
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c >= 'A' && c <= 'Z' {
+			remainder = (remainder*100 + int(c-'A') + 10) % 97
+			continue
+		}
+		remainder = (remainder*10 + int(c-'0')) % 97
+	}
+
+	return remainder
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isLetters(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < 'A' || s[i] > 'Z' {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isAlphanumeric(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if (s[i] < '0' || s[i] > '9') && (s[i] < 'A' || s[i] > 'Z') {
+			return false
+		}
+	}
+
+	return true
+}
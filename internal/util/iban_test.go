@@ -0,0 +1,76 @@
+package util_test
+
+import (
+	"testing"
+
+	"VDM2-BankBE/internal/util"
+)
+
+func TestValidateIBAN(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		iban    string
+		wantErr bool
+	}{
+		{name: "valid italian iban", iban: "IT60X0542811101000000123456"},
+		{name: "spaces and lower case are accepted", iban: "it60 x054 2811 1010 0000 0123 456"},
+		{name: "valid foreign iban", iban: "DE89370400440532013000"},
+		{name: "wrong check digits", iban: "IT61X0542811101000000123456", wantErr: true},
+		{name: "wrong CIN", iban: "IT60Y0542811101000000123456", wantErr: true},
+		{name: "wrong italian length", iban: "IT60X054281110100000012345", wantErr: true},
+		{name: "too short", iban: "IT60X", wantErr: true},
+		{name: "malformed country", iban: "1T60X0542811101000000123456", wantErr: true},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := util.ValidateIBAN(tc.iban)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("unexpected result for %q: err=%v wantErr=%v", tc.iban, err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewItalianIBAN(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		abi           string
+		cab           string
+		accountNumber string
+		want          string
+		wantErr       bool
+	}{
+		{name: "known iban", abi: "05428", cab: "11101", accountNumber: "000000123456", want: "IT60X0542811101000000123456"},
+		{name: "account number is zero padded", abi: "05428", cab: "11101", accountNumber: "123456", want: "IT60X0542811101000000123456"},
+		{name: "malformed ABI", abi: "0542", cab: "11101", accountNumber: "1", wantErr: true},
+		{name: "account number too long", abi: "05428", cab: "11101", accountNumber: "1234567890123", wantErr: true},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := util.NewItalianIBAN(tc.abi, tc.cab, tc.accountNumber)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tc.want {
+				t.Fatalf("unexpected iban: got=%s want=%s", got, tc.want)
+			}
+			if !tc.wantErr {
+				if err := util.ValidateIBAN(got); err != nil {
+					t.Fatalf("generated iban does not validate: %v", err)
+				}
+			}
+		})
+	}
+}
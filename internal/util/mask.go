@@ -0,0 +1,29 @@
+package util
+
+import "strings"
+
+// MaskName masks the full name of a user so that it can be shown to other users, keeping the first two
+// letters of every word of the first name and the first letter of every word of the last name
+// (e.g. "Mario Rossi" becomes "Ma*** R***")
+func MaskName(firstName, lastName string) string {
+	words := make([]string, 0, 2)
+	for _, word := range strings.Fields(firstName) {
+		words = append(words, maskWord(word, 2))
+	}
+	for _, word := range strings.Fields(lastName) {
+		words = append(words, maskWord(word, 1))
+	}
+
+	return strings.Join(words, " ")
+}
+
+// maskWord keeps the first keep letters of word and replaces the rest with a fixed-length mask, so that the
+// length of the word is not revealed
+func maskWord(word string, keep int) string {
+	runes := []rune(word)
+	if len(runes) < keep {
+		keep = len(runes)
+	}
+
+	return string(runes[:keep]) + "***"
+}
@@ -0,0 +1,35 @@
+package util_test
+
+import (
+	"testing"
+
+	"VDM2-BankBE/internal/util"
+)
+
+func TestMaskName(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		firstName string
+		lastName  string
+		want      string
+	}{
+		{name: "first and last name", firstName: "Mario", lastName: "Rossi", want: "Ma*** R***"},
+		{name: "every word is masked", firstName: "Anna Maria", lastName: "De Luca", want: "An*** Ma*** D*** L***"},
+		{name: "short words", firstName: "Al", lastName: "Li", want: "Al*** L***"},
+		{name: "non-ascii letters", firstName: "Élodie", lastName: "Ñúñez", want: "Él*** Ñ***"},
+		{name: "empty last name", firstName: "Mario", want: "Ma***"},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := util.MaskName(tc.firstName, tc.lastName); got != tc.want {
+				t.Fatalf("unexpected masked name: got=%q want=%q", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"VDM2-BankBE/internal/config"
+	"VDM2-BankBE/internal/service"
+)
+
+// HoldExpirer periodically releases the authorization holds that expired without being captured
+type HoldExpirer struct {
+	holdService service.HoldService
+	config      *config.SchedulerConfig
+	logger      *zap.Logger
+}
+
+// NewHoldExpirer creates a new hold expirer
+func NewHoldExpirer(
+	holdService service.HoldService,
+	config *config.SchedulerConfig,
+	logger *zap.Logger,
+) *HoldExpirer {
+	return &HoldExpirer{
+		holdService: holdService,
+		config:      config,
+		logger:      logger,
+	}
+}
+
+// Start runs the expirer every configured interval until ctx is cancelled
+func (e *HoldExpirer) Start(ctx context.Context) {
+	e.logger.Info("Starting hold expirer", zap.Duration("interval", e.config.Interval))
+
+	runEvery(ctx, e.config.Interval, func(ctx context.Context) {
+		e.RunOnce(ctx)
+	})
+
+	e.logger.Info("Hold expirer stopped")
+}
+
+// RunOnce expires the holds that are due and returns how many were expired
+func (e *HoldExpirer) RunOnce(ctx context.Context) int {
+	expired, err := e.holdService.ExpireDue(ctx, time.Now(), e.config.BatchSize)
+	if err != nil {
+		e.logger.Error("Failed to expire holds", zap.Error(err))
+	}
+
+	if expired > 0 {
+		e.logger.Info("Expired holds", zap.Int("count", expired))
+	}
+
+	return expired
+}
@@ -0,0 +1,60 @@
+package worker_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"go.uber.org/zap"
+
+	"VDM2-BankBE/internal/config"
+	servicemocks "VDM2-BankBE/internal/service/mocks"
+	"VDM2-BankBE/internal/worker"
+)
+
+func TestHoldExpirer_RunOnce(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.SchedulerConfig{Interval: time.Minute, BatchSize: 10}
+
+	tests := []struct {
+		name       string
+		buildMocks func(ctrl *gomock.Controller) *servicemocks.MockHoldService
+		want       int
+	}{
+		{
+			name: "expired holds are counted",
+			buildMocks: func(ctrl *gomock.Controller) *servicemocks.MockHoldService {
+				holdSvc := servicemocks.NewMockHoldService(ctrl)
+				holdSvc.EXPECT().ExpireDue(gomock.Any(), gomock.Any(), 10).Return(3, nil)
+				return holdSvc
+			},
+			want: 3,
+		},
+		{
+			name: "holds expired before an error are counted",
+			buildMocks: func(ctrl *gomock.Controller) *servicemocks.MockHoldService {
+				holdSvc := servicemocks.NewMockHoldService(ctrl)
+				holdSvc.EXPECT().ExpireDue(gomock.Any(), gomock.Any(), 10).Return(1, errors.New("db down"))
+				return holdSvc
+			},
+			want: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			expirer := worker.NewHoldExpirer(tc.buildMocks(ctrl), cfg, zap.NewNop())
+			if got := expirer.RunOnce(context.Background()); got != tc.want {
+				t.Fatalf("unexpected expired count: got=%d want=%d", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"VDM2-BankBE/internal/config"
+	"VDM2-BankBE/internal/service"
+)
+
+// PaymentRequestExpirer periodically expires the payment requests left open past their expiry
+type PaymentRequestExpirer struct {
+	paymentRequestService service.PaymentRequestService
+	config                *config.SchedulerConfig
+	logger                *zap.Logger
+}
+
+// NewPaymentRequestExpirer creates a new payment request expirer
+func NewPaymentRequestExpirer(
+	paymentRequestService service.PaymentRequestService,
+	config *config.SchedulerConfig,
+	logger *zap.Logger,
+) *PaymentRequestExpirer {
+	return &PaymentRequestExpirer{
+		paymentRequestService: paymentRequestService,
+		config:                config,
+		logger:                logger,
+	}
+}
+
+// Start runs the expirer every configured interval until ctx is cancelled
+func (e *PaymentRequestExpirer) Start(ctx context.Context) {
+	e.logger.Info("Starting payment request expirer", zap.Duration("interval", e.config.Interval))
+
+	runEvery(ctx, e.config.Interval, func(ctx context.Context) {
+		e.RunOnce(ctx)
+	})
+
+	e.logger.Info("Payment request expirer stopped")
+}
+
+// RunOnce expires the payment requests that are due and returns how many were expired
+func (e *PaymentRequestExpirer) RunOnce(ctx context.Context) int {
+	expired, err := e.paymentRequestService.ExpireDue(ctx, time.Now())
+	if err != nil {
+		e.logger.Error("Failed to expire payment requests", zap.Error(err))
+	}
+
+	if expired > 0 {
+		e.logger.Info("Expired payment requests", zap.Int("count", expired))
+	}
+
+	return expired
+}
@@ -0,0 +1,60 @@
+package worker_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"go.uber.org/zap"
+
+	"VDM2-BankBE/internal/config"
+	servicemocks "VDM2-BankBE/internal/service/mocks"
+	"VDM2-BankBE/internal/worker"
+)
+
+func TestPaymentRequestExpirer_RunOnce(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.SchedulerConfig{Interval: time.Minute, BatchSize: 10}
+
+	tests := []struct {
+		name       string
+		buildMocks func(ctrl *gomock.Controller) *servicemocks.MockPaymentRequestService
+		want       int
+	}{
+		{
+			name: "expired requests are counted",
+			buildMocks: func(ctrl *gomock.Controller) *servicemocks.MockPaymentRequestService {
+				paymentRequestSvc := servicemocks.NewMockPaymentRequestService(ctrl)
+				paymentRequestSvc.EXPECT().ExpireDue(gomock.Any(), gomock.Any()).Return(2, nil)
+				return paymentRequestSvc
+			},
+			want: 2,
+		},
+		{
+			name: "errors are logged and nothing is counted",
+			buildMocks: func(ctrl *gomock.Controller) *servicemocks.MockPaymentRequestService {
+				paymentRequestSvc := servicemocks.NewMockPaymentRequestService(ctrl)
+				paymentRequestSvc.EXPECT().ExpireDue(gomock.Any(), gomock.Any()).Return(0, errors.New("db down"))
+				return paymentRequestSvc
+			},
+			want: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			expirer := worker.NewPaymentRequestExpirer(tc.buildMocks(ctrl), cfg, zap.NewNop())
+			if got := expirer.RunOnce(context.Background()); got != tc.want {
+				t.Fatalf("unexpected expired count: got=%d want=%d", got, tc.want)
+			}
+		})
+	}
+}
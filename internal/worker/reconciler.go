@@ -0,0 +1,125 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+
+	"VDM2-BankBE/internal/config"
+	"VDM2-BankBE/internal/model"
+	"VDM2-BankBE/internal/service"
+)
+
+var (
+	reconciliationDiscrepancies = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "reconciliation_discrepancies",
+			Help: "Number of discrepancies found by the last reconciliation run, by check",
+		},
+		[]string{"check"},
+	)
+
+	reconciliationFailedTransfers = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "reconciliation_failed_transfers_total",
+			Help: "Total number of orphaned pending transfers marked as failed by reconciliation",
+		},
+	)
+
+	reconciliationLastSuccess = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "reconciliation_last_success_timestamp_seconds",
+			Help: "Unix time of the last successful reconciliation run",
+		},
+	)
+)
+
+// Reconciler periodically checks that balances, movements and transfers agree and exposes the result as metrics
+type Reconciler struct {
+	reconciliationService service.ReconciliationService
+	config                *config.ReconciliationConfig
+	logger                *zap.Logger
+}
+
+// NewReconciler creates a new reconciler
+func NewReconciler(
+	reconciliationService service.ReconciliationService,
+	config *config.ReconciliationConfig,
+	logger *zap.Logger,
+) *Reconciler {
+	return &Reconciler{
+		reconciliationService: reconciliationService,
+		config:                config,
+		logger:                logger,
+	}
+}
+
+// Start runs the reconciliation every configured interval until ctx is cancelled
+func (r *Reconciler) Start(ctx context.Context) {
+	r.logger.Info("Starting reconciler", zap.Duration("interval", r.config.Interval))
+
+	runEvery(ctx, r.config.Interval, func(ctx context.Context) {
+		r.RunOnce(ctx)
+	})
+
+	r.logger.Info("Reconciler stopped")
+}
+
+// RunOnce reconciles the books, logs every discrepancy and updates the metrics. It returns nil if the run failed.
+func (r *Reconciler) RunOnce(ctx context.Context) *model.ReconciliationReport {
+	report, err := r.reconciliationService.Reconcile(ctx)
+	if err != nil {
+		r.logger.Error("Reconciliation failed", zap.Error(err))
+		return nil
+	}
+
+	for _, d := range report.BalanceDiscrepancies {
+		r.logger.Warn("Account balance does not match its movements",
+			zap.String("account_id", d.AccountID.String()),
+			zap.String("balance", d.Balance.String()),
+			zap.String("movements_total", d.MovementsTotal.String()),
+		)
+	}
+	for _, d := range report.TransferDiscrepancies {
+		r.logger.Warn("Completed transfer does not have exactly one debit and one credit movement",
+			zap.Uint64("transfer_id", d.TransferID),
+			zap.Int64("debit_movements", d.DebitMovements),
+			zap.Int64("credit_movements", d.CreditMovements),
+		)
+	}
+
+	failed := 0
+	for _, t := range report.StalePendingTransfers {
+		if t.MarkedFailed {
+			failed++
+		}
+		r.logger.Warn("Transfer stuck in pending",
+			zap.Uint64("transfer_id", t.TransferID),
+			zap.Time("pending_since", t.PendingSince),
+			zap.Bool("orphaned", t.Orphaned),
+			zap.Bool("marked_failed", t.MarkedFailed),
+		)
+	}
+
+	reconciliationDiscrepancies.WithLabelValues("balance").Set(float64(len(report.BalanceDiscrepancies)))
+	reconciliationDiscrepancies.WithLabelValues("transfer_movements").Set(float64(len(report.TransferDiscrepancies)))
+	reconciliationDiscrepancies.WithLabelValues("stale_pending").Set(float64(len(report.StalePendingTransfers)))
+	reconciliationFailedTransfers.Add(float64(failed))
+	reconciliationLastSuccess.Set(float64(report.GeneratedAt.Unix()))
+
+	if report.HasDiscrepancies() {
+		r.logger.Warn("Reconciliation found discrepancies",
+			zap.Int("balances", len(report.BalanceDiscrepancies)),
+			zap.Int("transfers", len(report.TransferDiscrepancies)),
+			zap.Int("stale_pending", len(report.StalePendingTransfers)),
+			zap.Duration("elapsed", time.Since(report.GeneratedAt)),
+		)
+	} else {
+		r.logger.Info("Reconciliation completed without discrepancies", zap.Duration("elapsed", time.Since(report.GeneratedAt)))
+	}
+
+	return report
+}
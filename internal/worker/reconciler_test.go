@@ -0,0 +1,67 @@
+package worker_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"go.uber.org/zap"
+
+	"VDM2-BankBE/internal/config"
+	"VDM2-BankBE/internal/model"
+	servicemocks "VDM2-BankBE/internal/service/mocks"
+	"VDM2-BankBE/internal/worker"
+)
+
+func TestReconciler_RunOnce(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.ReconciliationConfig{Interval: 24 * time.Hour, StalePendingAfter: time.Hour}
+
+	tests := []struct {
+		name       string
+		buildMocks func(ctrl *gomock.Controller) *servicemocks.MockReconciliationService
+		wantReport bool
+	}{
+		{
+			name: "failed run returns no report",
+			buildMocks: func(ctrl *gomock.Controller) *servicemocks.MockReconciliationService {
+				reconciliationSvc := servicemocks.NewMockReconciliationService(ctrl)
+				reconciliationSvc.EXPECT().Reconcile(gomock.Any()).Return(nil, errors.New("db down"))
+				return reconciliationSvc
+			},
+			wantReport: false,
+		},
+		{
+			name: "discrepancies are returned",
+			buildMocks: func(ctrl *gomock.Controller) *servicemocks.MockReconciliationService {
+				report := &model.ReconciliationReport{
+					GeneratedAt:           time.Now(),
+					TransferDiscrepancies: []*model.TransferDiscrepancy{{TransferID: 4, DebitMovements: 1}},
+					StalePendingTransfers: []*model.StalePendingTransfer{{TransferID: 7, Orphaned: true, MarkedFailed: true}},
+				}
+
+				reconciliationSvc := servicemocks.NewMockReconciliationService(ctrl)
+				reconciliationSvc.EXPECT().Reconcile(gomock.Any()).Return(report, nil)
+				return reconciliationSvc
+			},
+			wantReport: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			reconciler := worker.NewReconciler(tc.buildMocks(ctrl), cfg, zap.NewNop())
+			if got := reconciler.RunOnce(context.Background()); (got != nil) != tc.wantReport {
+				t.Fatalf("unexpected report: %+v", got)
+			}
+		})
+	}
+}
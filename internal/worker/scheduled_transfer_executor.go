@@ -0,0 +1,74 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"VDM2-BankBE/internal/config"
+	"VDM2-BankBE/internal/service"
+)
+
+// ScheduledTransferExecutor periodically executes the scheduled transfers that are due
+type ScheduledTransferExecutor struct {
+	transferService service.TransferService
+	config          *config.SchedulerConfig
+	logger          *zap.Logger
+}
+
+// NewScheduledTransferExecutor creates a new scheduled transfer executor
+func NewScheduledTransferExecutor(
+	transferService service.TransferService,
+	config *config.SchedulerConfig,
+	logger *zap.Logger,
+) *ScheduledTransferExecutor {
+	return &ScheduledTransferExecutor{
+		transferService: transferService,
+		config:          config,
+		logger:          logger,
+	}
+}
+
+// Start runs the executor every configured interval until ctx is cancelled
+func (e *ScheduledTransferExecutor) Start(ctx context.Context) {
+	e.logger.Info("Starting scheduled transfer executor", zap.Duration("interval", e.config.Interval))
+
+	runEvery(ctx, e.config.Interval, func(ctx context.Context) {
+		e.RunOnce(ctx)
+	})
+
+	e.logger.Info("Scheduled transfer executor stopped")
+}
+
+// RunOnce queues the transfers that are due, or holds them for approval, and returns how many were claimed
+func (e *ScheduledTransferExecutor) RunOnce(ctx context.Context) int {
+	transfers, err := e.transferService.GetDueScheduled(ctx, time.Now(), e.config.BatchSize)
+	if err != nil {
+		e.logger.Error("Failed to get due scheduled transfers", zap.Error(err))
+		return 0
+	}
+
+	executed := 0
+	for _, transfer := range transfers {
+		if ctx.Err() != nil {
+			break
+		}
+
+		// Transfers that can no longer be sent are marked as failed by the service, keep going with the others
+		if _, err := e.transferService.ExecuteScheduled(ctx, transfer.ID); err != nil {
+			e.logger.Warn("Scheduled transfer not executed",
+				zap.Uint64("transfer_id", transfer.ID),
+				zap.Error(err),
+			)
+			continue
+		}
+		executed++
+	}
+
+	if executed > 0 {
+		e.logger.Info("Executed scheduled transfers", zap.Int("count", executed))
+	}
+
+	return executed
+}
@@ -0,0 +1,67 @@
+package worker_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"go.uber.org/zap"
+
+	"VDM2-BankBE/internal/config"
+	"VDM2-BankBE/internal/model"
+	servicemocks "VDM2-BankBE/internal/service/mocks"
+	"VDM2-BankBE/internal/util"
+	"VDM2-BankBE/internal/worker"
+)
+
+func TestScheduledTransferExecutor_RunOnce(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.SchedulerConfig{Interval: time.Minute, BatchSize: 10}
+
+	tests := []struct {
+		name       string
+		buildMocks func(ctrl *gomock.Controller) *servicemocks.MockTransferService
+		want       int
+	}{
+		{
+			name: "lookup error executes nothing",
+			buildMocks: func(ctrl *gomock.Controller) *servicemocks.MockTransferService {
+				transferSvc := servicemocks.NewMockTransferService(ctrl)
+				transferSvc.EXPECT().GetDueScheduled(gomock.Any(), gomock.Any(), 10).Return(nil, errors.New("db down"))
+				return transferSvc
+			},
+			want: 0,
+		},
+		{
+			name: "failed transfer does not stop the batch",
+			buildMocks: func(ctrl *gomock.Controller) *servicemocks.MockTransferService {
+				transferSvc := servicemocks.NewMockTransferService(ctrl)
+				transferSvc.EXPECT().GetDueScheduled(gomock.Any(), gomock.Any(), 10).Return([]*model.Transfer{{ID: 1}, {ID: 2}, {ID: 3}}, nil)
+				gomock.InOrder(
+					transferSvc.EXPECT().ExecuteScheduled(gomock.Any(), uint64(1)).Return(&model.Transfer{ID: 1, Status: "completed"}, nil),
+					transferSvc.EXPECT().ExecuteScheduled(gomock.Any(), uint64(2)).Return(nil, util.NewBadRequestError("insufficient funds")),
+					transferSvc.EXPECT().ExecuteScheduled(gomock.Any(), uint64(3)).Return(&model.Transfer{ID: 3, Status: "completed"}, nil),
+				)
+				return transferSvc
+			},
+			want: 2,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			executor := worker.NewScheduledTransferExecutor(tc.buildMocks(ctrl), cfg, zap.NewNop())
+			if got := executor.RunOnce(context.Background()); got != tc.want {
+				t.Fatalf("unexpected executed count: got=%d want=%d", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"VDM2-BankBE/internal/config"
+	"VDM2-BankBE/internal/service"
+)
+
+// StandingOrderScheduler periodically materialises the due occurrences of standing orders as transfers
+type StandingOrderScheduler struct {
+	standingOrderService service.StandingOrderService
+	config               *config.SchedulerConfig
+	logger               *zap.Logger
+}
+
+// NewStandingOrderScheduler creates a new standing order scheduler
+func NewStandingOrderScheduler(
+	standingOrderService service.StandingOrderService,
+	config *config.SchedulerConfig,
+	logger *zap.Logger,
+) *StandingOrderScheduler {
+	return &StandingOrderScheduler{
+		standingOrderService: standingOrderService,
+		config:               config,
+		logger:               logger,
+	}
+}
+
+// Start runs the scheduler every configured interval until ctx is cancelled
+func (s *StandingOrderScheduler) Start(ctx context.Context) {
+	s.logger.Info("Starting standing order scheduler", zap.Duration("interval", s.config.Interval))
+
+	runEvery(ctx, s.config.Interval, func(ctx context.Context) {
+		s.RunOnce(ctx)
+	})
+
+	s.logger.Info("Standing order scheduler stopped")
+}
+
+// RunOnce executes the standing orders that are due and returns how many transfers were made
+func (s *StandingOrderScheduler) RunOnce(ctx context.Context) int {
+	orders, err := s.standingOrderService.GetDue(ctx, time.Now(), s.config.BatchSize)
+	if err != nil {
+		s.logger.Error("Failed to get due standing orders", zap.Error(err))
+		return 0
+	}
+
+	executed := 0
+	for _, order := range orders {
+		if ctx.Err() != nil {
+			break
+		}
+
+		// Failures are recorded on the order by the service, keep going with the others
+		transfer, err := s.standingOrderService.Execute(ctx, order)
+		if err != nil {
+			s.logger.Warn("Standing order occurrence not executed",
+				zap.Uint64("standing_order_id", order.ID),
+				zap.Int("consecutive_failures", order.ConsecutiveFailures),
+				zap.String("status", order.Status),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		s.logger.Debug("Standing order occurrence executed",
+			zap.Uint64("standing_order_id", order.ID),
+			zap.Uint64("transfer_id", transfer.ID),
+		)
+		executed++
+	}
+
+	if executed > 0 {
+		s.logger.Info("Executed standing orders", zap.Int("count", executed))
+	}
+
+	return executed
+}
@@ -0,0 +1,69 @@
+package worker_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"go.uber.org/zap"
+
+	"VDM2-BankBE/internal/config"
+	"VDM2-BankBE/internal/model"
+	servicemocks "VDM2-BankBE/internal/service/mocks"
+	"VDM2-BankBE/internal/util"
+	"VDM2-BankBE/internal/worker"
+)
+
+func TestStandingOrderScheduler_RunOnce(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.SchedulerConfig{Interval: time.Minute, BatchSize: 10}
+
+	tests := []struct {
+		name       string
+		buildMocks func(ctrl *gomock.Controller) *servicemocks.MockStandingOrderService
+		want       int
+	}{
+		{
+			name: "lookup error executes nothing",
+			buildMocks: func(ctrl *gomock.Controller) *servicemocks.MockStandingOrderService {
+				standingOrderSvc := servicemocks.NewMockStandingOrderService(ctrl)
+				standingOrderSvc.EXPECT().GetDue(gomock.Any(), gomock.Any(), 10).Return(nil, errors.New("db down"))
+				return standingOrderSvc
+			},
+			want: 0,
+		},
+		{
+			name: "failed occurrence does not stop the batch",
+			buildMocks: func(ctrl *gomock.Controller) *servicemocks.MockStandingOrderService {
+				orders := []*model.StandingOrder{{ID: 1}, {ID: 2}, {ID: 3}}
+
+				standingOrderSvc := servicemocks.NewMockStandingOrderService(ctrl)
+				standingOrderSvc.EXPECT().GetDue(gomock.Any(), gomock.Any(), 10).Return(orders, nil)
+				gomock.InOrder(
+					standingOrderSvc.EXPECT().Execute(gomock.Any(), orders[0]).Return(&model.Transfer{ID: 10, Status: "completed"}, nil),
+					standingOrderSvc.EXPECT().Execute(gomock.Any(), orders[1]).Return(nil, util.NewBadRequestError("insufficient funds")),
+					standingOrderSvc.EXPECT().Execute(gomock.Any(), orders[2]).Return(&model.Transfer{ID: 11, Status: "completed"}, nil),
+				)
+				return standingOrderSvc
+			},
+			want: 2,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			scheduler := worker.NewStandingOrderScheduler(tc.buildMocks(ctrl), cfg, zap.NewNop())
+			if got := scheduler.RunOnce(context.Background()); got != tc.want {
+				t.Fatalf("unexpected executed count: got=%d want=%d", got, tc.want)
+			}
+		})
+	}
+}
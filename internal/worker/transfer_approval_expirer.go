@@ -0,0 +1,56 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"VDM2-BankBE/internal/config"
+	"VDM2-BankBE/internal/service"
+)
+
+// TransferApprovalExpirer periodically fails the transfers no co-signer approved before their approval expired
+type TransferApprovalExpirer struct {
+	transferService service.TransferService
+	config          *config.SchedulerConfig
+	logger          *zap.Logger
+}
+
+// NewTransferApprovalExpirer creates a new transfer approval expirer
+func NewTransferApprovalExpirer(
+	transferService service.TransferService,
+	config *config.SchedulerConfig,
+	logger *zap.Logger,
+) *TransferApprovalExpirer {
+	return &TransferApprovalExpirer{
+		transferService: transferService,
+		config:          config,
+		logger:          logger,
+	}
+}
+
+// Start runs the expirer every configured interval until ctx is cancelled
+func (e *TransferApprovalExpirer) Start(ctx context.Context) {
+	e.logger.Info("Starting transfer approval expirer", zap.Duration("interval", e.config.Interval))
+
+	runEvery(ctx, e.config.Interval, func(ctx context.Context) {
+		e.RunOnce(ctx)
+	})
+
+	e.logger.Info("Transfer approval expirer stopped")
+}
+
+// RunOnce expires a batch of the approvals that are due and returns how many were expired
+func (e *TransferApprovalExpirer) RunOnce(ctx context.Context) int {
+	expired, err := e.transferService.ExpireApprovals(ctx, time.Now(), e.config.BatchSize)
+	if err != nil {
+		e.logger.Error("Failed to expire transfer approvals", zap.Error(err))
+	}
+
+	if expired > 0 {
+		e.logger.Info("Expired transfer approvals", zap.Int("count", expired))
+	}
+
+	return expired
+}
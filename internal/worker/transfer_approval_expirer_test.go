@@ -0,0 +1,60 @@
+package worker_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"go.uber.org/zap"
+
+	"VDM2-BankBE/internal/config"
+	servicemocks "VDM2-BankBE/internal/service/mocks"
+	"VDM2-BankBE/internal/worker"
+)
+
+func TestTransferApprovalExpirer_RunOnce(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.SchedulerConfig{Interval: time.Minute, BatchSize: 10}
+
+	tests := []struct {
+		name       string
+		buildMocks func(ctrl *gomock.Controller) *servicemocks.MockTransferService
+		want       int
+	}{
+		{
+			name: "expired approvals are counted",
+			buildMocks: func(ctrl *gomock.Controller) *servicemocks.MockTransferService {
+				transferSvc := servicemocks.NewMockTransferService(ctrl)
+				transferSvc.EXPECT().ExpireApprovals(gomock.Any(), gomock.Any(), 10).Return(3, nil)
+				return transferSvc
+			},
+			want: 3,
+		},
+		{
+			name: "errors are logged and the approvals expired so far are counted",
+			buildMocks: func(ctrl *gomock.Controller) *servicemocks.MockTransferService {
+				transferSvc := servicemocks.NewMockTransferService(ctrl)
+				transferSvc.EXPECT().ExpireApprovals(gomock.Any(), gomock.Any(), 10).Return(1, errors.New("db down"))
+				return transferSvc
+			},
+			want: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			expirer := worker.NewTransferApprovalExpirer(tc.buildMocks(ctrl), cfg, zap.NewNop())
+			if got := expirer.RunOnce(context.Background()); got != tc.want {
+				t.Fatalf("unexpected expired count: got=%d want=%d", got, tc.want)
+			}
+		})
+	}
+}
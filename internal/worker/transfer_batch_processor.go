@@ -0,0 +1,77 @@
+package worker
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"VDM2-BankBE/internal/config"
+	"VDM2-BankBE/internal/service"
+)
+
+// TransferBatchProcessor periodically executes the transfer batches that are pending
+type TransferBatchProcessor struct {
+	transferBatchService service.TransferBatchService
+	config               *config.SchedulerConfig
+	logger               *zap.Logger
+}
+
+// NewTransferBatchProcessor creates a new transfer batch processor
+func NewTransferBatchProcessor(
+	transferBatchService service.TransferBatchService,
+	config *config.SchedulerConfig,
+	logger *zap.Logger,
+) *TransferBatchProcessor {
+	return &TransferBatchProcessor{
+		transferBatchService: transferBatchService,
+		config:               config,
+		logger:               logger,
+	}
+}
+
+// Start runs the processor every configured interval until ctx is cancelled
+func (p *TransferBatchProcessor) Start(ctx context.Context) {
+	p.logger.Info("Starting transfer batch processor", zap.Duration("interval", p.config.Interval))
+
+	runEvery(ctx, p.config.Interval, func(ctx context.Context) {
+		p.RunOnce(ctx)
+	})
+
+	p.logger.Info("Transfer batch processor stopped")
+}
+
+// RunOnce processes the pending batches and returns how many were processed
+func (p *TransferBatchProcessor) RunOnce(ctx context.Context) int {
+	batches, err := p.transferBatchService.GetPending(ctx, p.config.BatchSize)
+	if err != nil {
+		p.logger.Error("Failed to get pending transfer batches", zap.Error(err))
+		return 0
+	}
+
+	processed := 0
+	for _, batch := range batches {
+		if ctx.Err() != nil {
+			break
+		}
+
+		// A batch claimed by another instance is skipped, keep going with the others
+		result, err := p.transferBatchService.Process(ctx, batch.ID)
+		if err != nil {
+			p.logger.Warn("Transfer batch not processed",
+				zap.Uint64("batch_id", batch.ID),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		p.logger.Info("Processed transfer batch",
+			zap.Uint64("batch_id", result.ID),
+			zap.String("status", result.Status),
+			zap.Int("completed", result.CompletedCount),
+			zap.Int("failed", result.FailedCount),
+		)
+		processed++
+	}
+
+	return processed
+}
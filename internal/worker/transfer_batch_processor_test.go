@@ -0,0 +1,64 @@
+package worker_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"go.uber.org/zap"
+
+	"VDM2-BankBE/internal/config"
+	"VDM2-BankBE/internal/model"
+	servicemocks "VDM2-BankBE/internal/service/mocks"
+	"VDM2-BankBE/internal/util"
+	"VDM2-BankBE/internal/worker"
+)
+
+func TestTransferBatchProcessor_RunOnce(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.SchedulerConfig{Interval: time.Minute, BatchSize: 10}
+
+	tests := []struct {
+		name       string
+		buildMocks func(ctrl *gomock.Controller) *servicemocks.MockTransferBatchService
+		want       int
+	}{
+		{
+			name: "batches claimed elsewhere are not counted",
+			buildMocks: func(ctrl *gomock.Controller) *servicemocks.MockTransferBatchService {
+				batchSvc := servicemocks.NewMockTransferBatchService(ctrl)
+				batchSvc.EXPECT().GetPending(gomock.Any(), 10).Return([]*model.TransferBatch{{ID: 1}, {ID: 2}}, nil)
+				batchSvc.EXPECT().Process(gomock.Any(), uint64(1)).Return(&model.TransferBatch{ID: 1, Status: "completed"}, nil)
+				batchSvc.EXPECT().Process(gomock.Any(), uint64(2)).Return(nil, util.NewConflictError("transfer batch is no longer pending"))
+				return batchSvc
+			},
+			want: 1,
+		},
+		{
+			name: "error listing batches processes none",
+			buildMocks: func(ctrl *gomock.Controller) *servicemocks.MockTransferBatchService {
+				batchSvc := servicemocks.NewMockTransferBatchService(ctrl)
+				batchSvc.EXPECT().GetPending(gomock.Any(), 10).Return(nil, errors.New("db down"))
+				return batchSvc
+			},
+			want: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			processor := worker.NewTransferBatchProcessor(tc.buildMocks(ctrl), cfg, zap.NewNop())
+			if got := processor.RunOnce(context.Background()); got != tc.want {
+				t.Fatalf("unexpected processed count: got=%d want=%d", got, tc.want)
+			}
+		})
+	}
+}
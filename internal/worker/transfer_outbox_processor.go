@@ -0,0 +1,99 @@
+package worker
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"VDM2-BankBE/internal/config"
+	"VDM2-BankBE/internal/model"
+	"VDM2-BankBE/internal/service"
+)
+
+// TransferOutboxProcessor executes the transfers queued in the outbox with a pool of workers
+type TransferOutboxProcessor struct {
+	transferService service.TransferService
+	config          *config.OutboxConfig
+	logger          *zap.Logger
+}
+
+// NewTransferOutboxProcessor creates a new transfer outbox processor
+func NewTransferOutboxProcessor(
+	transferService service.TransferService,
+	config *config.OutboxConfig,
+	logger *zap.Logger,
+) *TransferOutboxProcessor {
+	return &TransferOutboxProcessor{
+		transferService: transferService,
+		config:          config,
+		logger:          logger,
+	}
+}
+
+// Start polls the outbox every configured interval until ctx is cancelled
+func (p *TransferOutboxProcessor) Start(ctx context.Context) {
+	p.logger.Info("Starting transfer outbox processor",
+		zap.Duration("interval", p.config.Interval),
+		zap.Int("workers", p.config.Workers),
+	)
+
+	runEvery(ctx, p.config.Interval, func(ctx context.Context) {
+		// Drain a backlog without waiting for the next poll
+		for ctx.Err() == nil {
+			if p.RunOnce(ctx) < p.config.BatchSize {
+				return
+			}
+		}
+	})
+
+	p.logger.Info("Transfer outbox processor stopped")
+}
+
+// RunOnce claims a batch of due messages, processes them with the worker pool and returns how many were claimed
+func (p *TransferOutboxProcessor) RunOnce(ctx context.Context) int {
+	messages, err := p.transferService.ClaimQueued(ctx, time.Now(), p.config.BatchSize)
+	if err != nil {
+		p.logger.Error("Failed to claim queued transfers", zap.Error(err))
+		return 0
+	}
+
+	queue := make(chan *model.TransferOutbox)
+	var wg sync.WaitGroup
+	for i := 0; i < p.config.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for message := range queue {
+				p.process(ctx, message)
+			}
+		}()
+	}
+
+	for _, message := range messages {
+		queue <- message
+	}
+	close(queue)
+	wg.Wait()
+
+	return len(messages)
+}
+
+// process executes the transfer of a message; failures are retried or settled by the service
+func (p *TransferOutboxProcessor) process(ctx context.Context, message *model.TransferOutbox) {
+	transfer, err := p.transferService.ProcessQueued(ctx, message)
+	if err != nil {
+		p.logger.Warn("Queued transfer not completed",
+			zap.Uint64("transfer_id", message.TransferID),
+			zap.Int("attempt", message.Attempts),
+			zap.Error(err),
+		)
+		return
+	}
+
+	p.logger.Info("Processed queued transfer",
+		zap.Uint64("transfer_id", transfer.ID),
+		zap.String("status", transfer.Status),
+	)
+}
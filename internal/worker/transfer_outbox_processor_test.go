@@ -0,0 +1,69 @@
+package worker_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"go.uber.org/zap"
+
+	"VDM2-BankBE/internal/config"
+	"VDM2-BankBE/internal/model"
+	servicemocks "VDM2-BankBE/internal/service/mocks"
+	"VDM2-BankBE/internal/worker"
+)
+
+func TestTransferOutboxProcessor_RunOnce(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.OutboxConfig{Workers: 2, Interval: time.Second, BatchSize: 10}
+
+	tests := []struct {
+		name       string
+		buildMocks func(ctrl *gomock.Controller) *servicemocks.MockTransferService
+		want       int
+	}{
+		{
+			name: "claimed messages are processed and counted, failures included",
+			buildMocks: func(ctrl *gomock.Controller) *servicemocks.MockTransferService {
+				transferSvc := servicemocks.NewMockTransferService(ctrl)
+				messages := []*model.TransferOutbox{
+					{ID: 1, TransferID: 11, Attempts: 1},
+					{ID: 2, TransferID: 12, Attempts: 1},
+					{ID: 3, TransferID: 13, Attempts: 2},
+				}
+				transferSvc.EXPECT().ClaimQueued(gomock.Any(), gomock.Any(), 10).Return(messages, nil)
+				transferSvc.EXPECT().ProcessQueued(gomock.Any(), messages[0]).Return(&model.Transfer{ID: 11, Status: "completed"}, nil)
+				transferSvc.EXPECT().ProcessQueued(gomock.Any(), messages[1]).Return(nil, errors.New("db down"))
+				transferSvc.EXPECT().ProcessQueued(gomock.Any(), messages[2]).Return(&model.Transfer{ID: 13, Status: "completed"}, nil)
+				return transferSvc
+			},
+			want: 3,
+		},
+		{
+			name: "claim errors are logged",
+			buildMocks: func(ctrl *gomock.Controller) *servicemocks.MockTransferService {
+				transferSvc := servicemocks.NewMockTransferService(ctrl)
+				transferSvc.EXPECT().ClaimQueued(gomock.Any(), gomock.Any(), 10).Return(nil, errors.New("db down"))
+				return transferSvc
+			},
+			want: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			processor := worker.NewTransferOutboxProcessor(tc.buildMocks(ctrl), cfg, zap.NewNop())
+			if got := processor.RunOnce(context.Background()); got != tc.want {
+				t.Fatalf("unexpected claimed count: got=%d want=%d", got, tc.want)
+			}
+		})
+	}
+}
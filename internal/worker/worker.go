@@ -0,0 +1,23 @@
+// Package worker contains the background jobs run inside the server process.
+package worker
+
+import (
+	"context"
+	"time"
+)
+
+// runEvery calls fn immediately and then every interval until ctx is cancelled
+func runEvery(ctx context.Context, interval time.Duration, fn func(ctx context.Context)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		fn(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
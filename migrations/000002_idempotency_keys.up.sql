@@ -0,0 +1,14 @@
+-- Idempotency keys table
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+  user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  key TEXT NOT NULL,
+  request_hash TEXT NOT NULL,
+  status_code INTEGER NOT NULL DEFAULT 0,
+  response_body BYTEA,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  expires_at TIMESTAMPTZ NOT NULL,
+  PRIMARY KEY (user_id, key)
+);
+
+-- Create indexes
+CREATE INDEX IF NOT EXISTS idx_idempotency_keys_expires_at ON idempotency_keys(expires_at);
@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS idx_transfers_due;
+
+UPDATE transfers SET status = 'failed' WHERE status IN ('scheduled','cancelled');
+ALTER TABLE transfers DROP CONSTRAINT IF EXISTS transfers_status_check;
+ALTER TABLE transfers ADD CONSTRAINT transfers_status_check
+  CHECK (status IN ('pending','completed','failed'));
+
+ALTER TABLE transfers DROP COLUMN IF EXISTS execute_at;
+ALTER TABLE transfers DROP COLUMN IF EXISTS description;
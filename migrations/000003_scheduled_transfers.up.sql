@@ -0,0 +1,10 @@
+-- Scheduled transfers
+ALTER TABLE transfers ADD COLUMN IF NOT EXISTS description TEXT NOT NULL DEFAULT '';
+ALTER TABLE transfers ADD COLUMN IF NOT EXISTS execute_at TIMESTAMPTZ;
+
+ALTER TABLE transfers DROP CONSTRAINT IF EXISTS transfers_status_check;
+ALTER TABLE transfers ADD CONSTRAINT transfers_status_check
+  CHECK (status IN ('scheduled','pending','completed','failed','cancelled'));
+
+-- Create indexes
+CREATE INDEX IF NOT EXISTS idx_transfers_due ON transfers(execute_at) WHERE status = 'scheduled';
@@ -0,0 +1,25 @@
+-- Standing orders table
+CREATE TABLE IF NOT EXISTS standing_orders (
+  id BIGSERIAL PRIMARY KEY,
+  from_account UUID NOT NULL REFERENCES accounts(id),
+  to_account UUID NOT NULL REFERENCES accounts(id),
+  amount NUMERIC(18,2) NOT NULL,
+  description TEXT NOT NULL DEFAULT '',
+  frequency TEXT NOT NULL CHECK (frequency IN ('weekly','monthly')),
+  repeat_interval INTEGER NOT NULL DEFAULT 1 CHECK (repeat_interval > 0),
+  start_date TIMESTAMPTZ NOT NULL,
+  end_date TIMESTAMPTZ,
+  status TEXT NOT NULL CHECK (status IN ('active','suspended','completed','cancelled')),
+  next_run_at TIMESTAMPTZ,
+  occurrences INTEGER NOT NULL DEFAULT 0,
+  consecutive_failures INTEGER NOT NULL DEFAULT 0,
+  last_error TEXT NOT NULL DEFAULT '',
+  last_run_at TIMESTAMPTZ,
+  last_transfer_id BIGINT REFERENCES transfers(id),
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Create indexes
+CREATE INDEX IF NOT EXISTS idx_standing_orders_from_account ON standing_orders(from_account);
+CREATE INDEX IF NOT EXISTS idx_standing_orders_due ON standing_orders(next_run_at) WHERE status = 'active';
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_transfers_reversal_of;
+
+ALTER TABLE transfers DROP CONSTRAINT IF EXISTS transfers_reversed_amount_check;
+ALTER TABLE transfers DROP COLUMN IF EXISTS reversed_amount;
+ALTER TABLE transfers DROP COLUMN IF EXISTS reversal_of;
+
+ALTER TABLE users DROP COLUMN IF EXISTS role;
@@ -0,0 +1,14 @@
+-- User roles
+ALTER TABLE users ADD COLUMN IF NOT EXISTS role TEXT NOT NULL DEFAULT 'user'
+  CHECK (role IN ('user','admin'));
+
+-- Transfer reversals
+ALTER TABLE transfers ADD COLUMN IF NOT EXISTS reversal_of BIGINT REFERENCES transfers(id);
+ALTER TABLE transfers ADD COLUMN IF NOT EXISTS reversed_amount NUMERIC(18,2) NOT NULL DEFAULT 0;
+
+ALTER TABLE transfers DROP CONSTRAINT IF EXISTS transfers_reversed_amount_check;
+ALTER TABLE transfers ADD CONSTRAINT transfers_reversed_amount_check
+  CHECK (reversed_amount >= 0 AND reversed_amount <= amount);
+
+-- Create indexes
+CREATE INDEX IF NOT EXISTS idx_transfers_reversal_of ON transfers(reversal_of) WHERE reversal_of IS NOT NULL;
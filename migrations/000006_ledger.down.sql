@@ -0,0 +1,5 @@
+ALTER TABLE movements DROP COLUMN IF EXISTS journal_entry_id;
+
+DROP TABLE IF EXISTS postings;
+DROP TABLE IF EXISTS journal_entries;
+DROP TABLE IF EXISTS system_accounts;
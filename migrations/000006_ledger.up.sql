@@ -0,0 +1,48 @@
+-- Bank-owned accounts on the other side of money entering or leaving the bank
+CREATE TABLE IF NOT EXISTS system_accounts (
+  code TEXT PRIMARY KEY,
+  balance NUMERIC(18,2) NOT NULL DEFAULT 0,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO system_accounts (code) VALUES ('cash_in'), ('cash_out'), ('fees')
+ON CONFLICT (code) DO NOTHING;
+
+-- Double-entry journal
+CREATE TABLE IF NOT EXISTS journal_entries (
+  id BIGSERIAL PRIMARY KEY,
+  description TEXT NOT NULL DEFAULT '',
+  transfer_id BIGINT REFERENCES transfers(id),
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS postings (
+  id BIGSERIAL PRIMARY KEY,
+  journal_entry_id BIGINT NOT NULL REFERENCES journal_entries(id) ON DELETE CASCADE,
+  account_id UUID REFERENCES accounts(id),
+  system_account TEXT REFERENCES system_accounts(code),
+  amount NUMERIC(18,2) NOT NULL CHECK (amount <> 0),
+  CHECK ((account_id IS NULL) <> (system_account IS NULL))
+);
+
+ALTER TABLE movements ADD COLUMN IF NOT EXISTS journal_entry_id BIGINT REFERENCES journal_entries(id);
+
+-- Create indexes
+CREATE INDEX IF NOT EXISTS idx_journal_entries_transfer_id ON journal_entries(transfer_id) WHERE transfer_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_postings_journal_entry_id ON postings(journal_entry_id);
+CREATE INDEX IF NOT EXISTS idx_postings_account_id ON postings(account_id) WHERE account_id IS NOT NULL;
+
+-- Opening entries, so that the postings of every account add up to the balance it held before the ledger existed
+DO $$
+DECLARE
+  acc RECORD;
+  entry_id BIGINT;
+BEGIN
+  FOR acc IN SELECT id, balance FROM accounts WHERE balance <> 0 LOOP
+    INSERT INTO journal_entries (description) VALUES ('Opening balance') RETURNING id INTO entry_id;
+    INSERT INTO postings (journal_entry_id, account_id, amount) VALUES (entry_id, acc.id, acc.balance);
+    INSERT INTO postings (journal_entry_id, system_account, amount) VALUES (entry_id, 'cash_in', -acc.balance);
+    UPDATE system_accounts SET balance = balance - acc.balance WHERE code = 'cash_in';
+  END LOOP;
+END $$;
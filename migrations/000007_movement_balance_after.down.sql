@@ -0,0 +1 @@
+ALTER TABLE movements DROP COLUMN IF EXISTS balance_after;
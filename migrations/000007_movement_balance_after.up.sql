@@ -0,0 +1,19 @@
+-- Running balance of the account after each movement
+ALTER TABLE movements ADD COLUMN IF NOT EXISTS balance_after NUMERIC(18,2);
+
+-- Backfill existing movements by walking back from the current balance
+UPDATE movements m
+SET balance_after = b.balance_after
+FROM (
+  SELECT mv.id,
+         a.balance - COALESCE(SUM(CASE WHEN mv.type = 'credit' THEN mv.amount ELSE -mv.amount END) OVER (
+           PARTITION BY mv.account_id
+           ORDER BY mv.occurred_at DESC, mv.id DESC
+           ROWS BETWEEN UNBOUNDED PRECEDING AND 1 PRECEDING
+         ), 0) AS balance_after
+  FROM movements mv
+  JOIN accounts a ON a.id = mv.account_id
+) b
+WHERE m.id = b.id AND m.balance_after IS NULL;
+
+ALTER TABLE movements ALTER COLUMN balance_after SET NOT NULL;
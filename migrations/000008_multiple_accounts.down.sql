@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_accounts_user_default;
+
+ALTER TABLE accounts DROP COLUMN IF EXISTS is_default;
+ALTER TABLE accounts DROP COLUMN IF EXISTS name;
+ALTER TABLE accounts DROP COLUMN IF EXISTS type;
@@ -0,0 +1,19 @@
+-- Account types and names
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS type TEXT NOT NULL DEFAULT 'checking'
+  CHECK (type IN ('checking','savings','pocket'));
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS name TEXT NOT NULL DEFAULT '';
+
+-- Default account, used when a request does not pick one
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS is_default BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- The oldest account of every user becomes the default one
+UPDATE accounts a SET is_default = TRUE
+WHERE a.id = (
+  SELECT b.id FROM accounts b
+  WHERE b.user_id = a.user_id
+  ORDER BY b.created_at, b.id
+  LIMIT 1
+) AND NOT EXISTS (SELECT 1 FROM accounts c WHERE c.user_id = a.user_id AND c.is_default);
+
+-- Create indexes
+CREATE UNIQUE INDEX IF NOT EXISTS idx_accounts_user_default ON accounts(user_id) WHERE is_default;
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_accounts_iban;
+
+ALTER TABLE accounts DROP COLUMN IF EXISTS iban;
+
+DROP SEQUENCE IF EXISTS account_number_seq;
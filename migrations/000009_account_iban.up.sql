@@ -0,0 +1,49 @@
+-- Account numbers, used to build the IBAN of every account
+CREATE SEQUENCE IF NOT EXISTS account_number_seq;
+
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS iban VARCHAR(34);
+
+-- CIN (control character) of an Italian BBAN, computed over ABI + CAB + account number
+CREATE FUNCTION pg_temp.italian_cin(bban TEXT) RETURNS CHAR AS $$
+DECLARE
+  odd_values INT[] := ARRAY[1,0,5,7,9,13,15,17,19,21,2,4,18,20,11,3,6,8,12,14,16,10,22,25,24,23];
+  total INT := 0;
+  char_value INT;
+BEGIN
+  FOR i IN 1..length(bban) LOOP
+    char_value := CASE WHEN substr(bban, i, 1) BETWEEN '0' AND '9'
+      THEN ascii(substr(bban, i, 1)) - ascii('0')
+      ELSE ascii(substr(bban, i, 1)) - ascii('A') END;
+    IF i % 2 = 1 THEN
+      char_value := odd_values[char_value + 1];
+    END IF;
+    total := total + char_value;
+  END LOOP;
+  RETURN chr(ascii('A') + total % 26);
+END;
+$$ LANGUAGE plpgsql;
+
+-- Italian IBAN with its mod-97 check digits (numeric account numbers only)
+CREATE FUNCTION pg_temp.italian_iban(abi TEXT, cab TEXT, account_number TEXT) RETURNS TEXT AS $$
+DECLARE
+  cin CHAR := pg_temp.italian_cin(abi || cab || account_number);
+  check_digits INT;
+BEGIN
+  -- The BBAN followed by "IT00", letters replaced by their value (I=18, T=29)
+  check_digits := 98 - ((ascii(cin) - 55)::text || abi || cab || account_number || '182900')::numeric % 97;
+  RETURN 'IT' || lpad(check_digits::text, 2, '0') || cin || abi || cab || account_number;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Backfill existing accounts with the bank codes used by the account repository
+UPDATE accounts
+SET iban = pg_temp.italian_iban('03599', '01800', lpad(nextval('account_number_seq')::text, 12, '0'))
+WHERE iban IS NULL;
+
+ALTER TABLE accounts ALTER COLUMN iban SET NOT NULL;
+
+DROP FUNCTION pg_temp.italian_iban(TEXT, TEXT, TEXT);
+DROP FUNCTION pg_temp.italian_cin(TEXT);
+
+-- Create indexes
+CREATE UNIQUE INDEX IF NOT EXISTS idx_accounts_iban ON accounts(iban);
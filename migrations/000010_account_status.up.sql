@@ -0,0 +1,5 @@
+-- Account lifecycle: frozen accounts can neither send nor receive funds, debit_blocked accounts can only
+-- receive them and closed accounts are read-only
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS status TEXT NOT NULL DEFAULT 'active'
+  CHECK (status IN ('active','frozen','debit_blocked','closed'));
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS closed_at TIMESTAMPTZ;
@@ -0,0 +1,2 @@
+ALTER TABLE accounts DROP COLUMN IF EXISTS overdraft_rate;
+ALTER TABLE accounts DROP COLUMN IF EXISTS overdraft_limit;
@@ -0,0 +1,6 @@
+-- Overdraft facilities: debits can take the balance down to -overdraft_limit, and the overdrawn balance
+-- is charged overdraft_rate percent a year
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS overdraft_limit NUMERIC(18,2) NOT NULL DEFAULT 0
+  CHECK (overdraft_limit >= 0);
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS overdraft_rate NUMERIC(5,2) NOT NULL DEFAULT 0
+  CHECK (overdraft_rate >= 0);
@@ -0,0 +1,3 @@
+ALTER TABLE movements DROP COLUMN IF EXISTS hold_id;
+DROP TABLE IF EXISTS holds;
+ALTER TABLE accounts DROP COLUMN IF EXISTS held_amount;
@@ -0,0 +1,22 @@
+-- Authorization holds: funds reserved on an account until they are captured into a movement, released or
+-- the hold expires. accounts.held_amount is the total of the active holds of the account.
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS held_amount NUMERIC(18,2) NOT NULL DEFAULT 0
+  CHECK (held_amount >= 0);
+
+CREATE TABLE IF NOT EXISTS holds (
+  id BIGSERIAL PRIMARY KEY,
+  account_id UUID NOT NULL REFERENCES accounts(id),
+  amount NUMERIC(18,2) NOT NULL CHECK (amount > 0),
+  captured_amount NUMERIC(18,2) NOT NULL DEFAULT 0,
+  description TEXT NOT NULL DEFAULT '',
+  status TEXT NOT NULL DEFAULT 'active' CHECK (status IN ('active','captured','released','expired')),
+  expires_at TIMESTAMPTZ NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_holds_account_id ON holds(account_id);
+CREATE INDEX IF NOT EXISTS idx_holds_expiring ON holds(expires_at) WHERE status = 'active';
+
+-- Movements created by capturing a hold
+ALTER TABLE movements ADD COLUMN IF NOT EXISTS hold_id BIGINT REFERENCES holds(id);
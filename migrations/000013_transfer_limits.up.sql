@@ -0,0 +1,8 @@
+-- Transfer limits a user lowered below the configured defaults. NULL limits fall back to the defaults.
+CREATE TABLE IF NOT EXISTS transfer_limits (
+  user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+  per_transaction NUMERIC(18,2) CHECK (per_transaction > 0),
+  daily NUMERIC(18,2) CHECK (daily > 0),
+  monthly NUMERIC(18,2) CHECK (monthly > 0),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,13 @@
+-- Address book of the accounts a user sends transfers to
+CREATE TABLE IF NOT EXISTS beneficiaries (
+  id BIGSERIAL PRIMARY KEY,
+  user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  nickname TEXT NOT NULL,
+  account_id UUID NOT NULL REFERENCES accounts(id),
+  iban VARCHAR(34) NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  last_used_at TIMESTAMPTZ
+);
+
+-- An account appears at most once in an address book
+CREATE UNIQUE INDEX IF NOT EXISTS idx_beneficiaries_user_account ON beneficiaries(user_id, account_id);
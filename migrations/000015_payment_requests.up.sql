@@ -0,0 +1,20 @@
+-- Requests for money sent by a user (requester) to another user (payer). Paying a request executes a regular
+-- transfer from an account of the payer to to_account, linked through transfer_id.
+CREATE TABLE IF NOT EXISTS payment_requests (
+  id BIGSERIAL PRIMARY KEY,
+  requester_id UUID NOT NULL REFERENCES users(id),
+  payer_id UUID NOT NULL REFERENCES users(id),
+  to_account UUID NOT NULL REFERENCES accounts(id),
+  amount NUMERIC(18,2) NOT NULL CHECK (amount > 0),
+  message TEXT NOT NULL DEFAULT '',
+  status TEXT NOT NULL DEFAULT 'open' CHECK (status IN ('open','paid','declined','expired','cancelled')),
+  expires_at TIMESTAMPTZ NOT NULL,
+  transfer_id BIGINT REFERENCES transfers(id),
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  CHECK (requester_id <> payer_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_payment_requests_requester_id ON payment_requests(requester_id);
+CREATE INDEX IF NOT EXISTS idx_payment_requests_payer_id ON payment_requests(payer_id);
+CREATE INDEX IF NOT EXISTS idx_payment_requests_expiring ON payment_requests(expires_at) WHERE status = 'open';
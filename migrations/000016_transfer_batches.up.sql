@@ -0,0 +1,34 @@
+-- Transfers uploaded together from one account and executed asynchronously. The total of the batch is reserved
+-- through hold_id until it is processed. In all_or_nothing mode every item is executed in a single database
+-- transaction; in best_effort mode each item is executed on its own and failures do not stop the others.
+CREATE TABLE IF NOT EXISTS transfer_batches (
+  id BIGSERIAL PRIMARY KEY,
+  from_account UUID NOT NULL REFERENCES accounts(id),
+  mode TEXT NOT NULL CHECK (mode IN ('all_or_nothing','best_effort')),
+  status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending','processing','completed','partially_completed','failed')),
+  total_amount NUMERIC(18,2) NOT NULL CHECK (total_amount > 0),
+  item_count INT NOT NULL CHECK (item_count > 0),
+  completed_count INT NOT NULL DEFAULT 0,
+  failed_count INT NOT NULL DEFAULT 0,
+  hold_id BIGINT REFERENCES holds(id),
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  completed_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_transfer_batches_from_account ON transfer_batches(from_account);
+CREATE INDEX IF NOT EXISTS idx_transfer_batches_pending ON transfer_batches(created_at) WHERE status = 'pending';
+
+-- One line of a batch; line is the 1-based position of the transfer within the batch
+CREATE TABLE IF NOT EXISTS transfer_batch_items (
+  id BIGSERIAL PRIMARY KEY,
+  batch_id BIGINT NOT NULL REFERENCES transfer_batches(id) ON DELETE CASCADE,
+  line INT NOT NULL,
+  to_account UUID NOT NULL REFERENCES accounts(id),
+  amount NUMERIC(18,2) NOT NULL CHECK (amount > 0),
+  description TEXT NOT NULL DEFAULT '',
+  status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending','completed','failed','skipped')),
+  transfer_id BIGINT REFERENCES transfers(id),
+  error TEXT NOT NULL DEFAULT '',
+  UNIQUE (batch_id, line)
+);
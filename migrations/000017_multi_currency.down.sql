@@ -0,0 +1,45 @@
+ALTER TABLE movements
+  DROP COLUMN IF EXISTS fx_rate,
+  DROP COLUMN IF EXISTS converted_currency,
+  DROP COLUMN IF EXISTS converted_amount,
+  DROP COLUMN IF EXISTS original_currency,
+  DROP COLUMN IF EXISTS original_amount;
+
+ALTER TABLE transfers
+  DROP COLUMN IF EXISTS quote_id,
+  DROP COLUMN IF EXISTS fx_rate,
+  DROP COLUMN IF EXISTS converted_currency,
+  DROP COLUMN IF EXISTS converted_amount,
+  DROP COLUMN IF EXISTS currency;
+
+DROP TABLE IF EXISTS fx_quotes;
+DROP TABLE IF EXISTS fx_rates;
+
+ALTER TABLE postings DROP CONSTRAINT IF EXISTS postings_system_account_fkey;
+DELETE FROM system_accounts WHERE currency <> 'EUR';
+ALTER TABLE system_accounts DROP CONSTRAINT IF EXISTS system_accounts_pkey;
+ALTER TABLE system_accounts ADD PRIMARY KEY (code);
+ALTER TABLE system_accounts DROP COLUMN IF EXISTS currency;
+ALTER TABLE postings DROP COLUMN IF EXISTS currency;
+ALTER TABLE postings ADD CONSTRAINT postings_system_account_fkey
+  FOREIGN KEY (system_account) REFERENCES system_accounts(code);
+
+ALTER TABLE postings ALTER COLUMN amount TYPE NUMERIC(18,2);
+ALTER TABLE system_accounts ALTER COLUMN balance TYPE NUMERIC(18,2);
+ALTER TABLE transfer_batch_items ALTER COLUMN amount TYPE NUMERIC(18,2);
+ALTER TABLE transfer_batches ALTER COLUMN total_amount TYPE NUMERIC(18,2);
+ALTER TABLE payment_requests ALTER COLUMN amount TYPE NUMERIC(18,2);
+ALTER TABLE holds
+  ALTER COLUMN captured_amount TYPE NUMERIC(18,2),
+  ALTER COLUMN amount TYPE NUMERIC(18,2);
+ALTER TABLE standing_orders ALTER COLUMN amount TYPE NUMERIC(18,2);
+ALTER TABLE transfers
+  ALTER COLUMN reversed_amount TYPE NUMERIC(18,2),
+  ALTER COLUMN amount TYPE NUMERIC(18,2);
+ALTER TABLE movements
+  ALTER COLUMN balance_after TYPE NUMERIC(18,2),
+  ALTER COLUMN amount TYPE NUMERIC(18,2);
+ALTER TABLE accounts
+  ALTER COLUMN held_amount TYPE NUMERIC(18,2),
+  ALTER COLUMN overdraft_limit TYPE NUMERIC(18,2),
+  ALTER COLUMN balance TYPE NUMERIC(18,2);
@@ -0,0 +1,83 @@
+-- Amounts are stored with three decimal places, the most any supported currency uses for its minor unit.
+-- Transfer limits stay in the default currency.
+ALTER TABLE accounts
+  ALTER COLUMN balance TYPE NUMERIC(19,3),
+  ALTER COLUMN overdraft_limit TYPE NUMERIC(19,3),
+  ALTER COLUMN held_amount TYPE NUMERIC(19,3);
+ALTER TABLE movements
+  ALTER COLUMN amount TYPE NUMERIC(19,3),
+  ALTER COLUMN balance_after TYPE NUMERIC(19,3);
+ALTER TABLE transfers
+  ALTER COLUMN amount TYPE NUMERIC(19,3),
+  ALTER COLUMN reversed_amount TYPE NUMERIC(19,3);
+ALTER TABLE standing_orders ALTER COLUMN amount TYPE NUMERIC(19,3);
+ALTER TABLE holds
+  ALTER COLUMN amount TYPE NUMERIC(19,3),
+  ALTER COLUMN captured_amount TYPE NUMERIC(19,3);
+ALTER TABLE payment_requests ALTER COLUMN amount TYPE NUMERIC(19,3);
+ALTER TABLE transfer_batches ALTER COLUMN total_amount TYPE NUMERIC(19,3);
+ALTER TABLE transfer_batch_items ALTER COLUMN amount TYPE NUMERIC(19,3);
+ALTER TABLE system_accounts ALTER COLUMN balance TYPE NUMERIC(19,3);
+ALTER TABLE postings ALTER COLUMN amount TYPE NUMERIC(19,3);
+
+-- System accounts are kept per currency, so that the postings of every currency sum to zero on their own.
+-- The fx accounts hold the bank's position in each currency after converting transfers.
+ALTER TABLE postings DROP CONSTRAINT IF EXISTS postings_system_account_fkey;
+ALTER TABLE system_accounts ADD COLUMN IF NOT EXISTS currency TEXT NOT NULL DEFAULT 'EUR';
+ALTER TABLE system_accounts DROP CONSTRAINT IF EXISTS system_accounts_pkey;
+ALTER TABLE system_accounts ADD PRIMARY KEY (code, currency);
+ALTER TABLE postings ADD COLUMN IF NOT EXISTS currency TEXT NOT NULL DEFAULT 'EUR';
+ALTER TABLE postings ADD CONSTRAINT postings_system_account_fkey
+  FOREIGN KEY (system_account, currency) REFERENCES system_accounts(code, currency);
+
+INSERT INTO system_accounts (code, currency)
+SELECT code, currency
+FROM (VALUES ('cash_in'), ('cash_out'), ('fees'), ('fx')) AS codes(code)
+CROSS JOIN (VALUES ('AUD'), ('CAD'), ('CHF'), ('CZK'), ('DKK'), ('EUR'), ('GBP'), ('HUF'), ('JPY'), ('KWD'),
+  ('NOK'), ('PLN'), ('SEK'), ('USD')) AS currencies(currency)
+ON CONFLICT (code, currency) DO NOTHING;
+
+-- Exchange rates: one unit of base_currency buys rate units of quote_currency
+CREATE TABLE IF NOT EXISTS fx_rates (
+  base_currency TEXT NOT NULL,
+  quote_currency TEXT NOT NULL,
+  rate NUMERIC(20,10) NOT NULL CHECK (rate > 0),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  PRIMARY KEY (base_currency, quote_currency),
+  CHECK (base_currency <> quote_currency)
+);
+
+-- Quotes lock a rate for converting an amount until expires_at; a quote is used by a single transfer
+CREATE TABLE IF NOT EXISTS fx_quotes (
+  id UUID PRIMARY KEY,
+  user_id UUID NOT NULL REFERENCES users(id),
+  from_currency TEXT NOT NULL,
+  to_currency TEXT NOT NULL,
+  rate NUMERIC(20,10) NOT NULL CHECK (rate > 0),
+  amount NUMERIC(19,3) NOT NULL CHECK (amount > 0),
+  converted_amount NUMERIC(19,3) NOT NULL CHECK (converted_amount > 0),
+  expires_at TIMESTAMPTZ NOT NULL,
+  used_at TIMESTAMPTZ,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_fx_quotes_user_id ON fx_quotes(user_id);
+
+-- Transfers between accounts of different currencies: amount is in the currency of the sending account,
+-- converted_amount in the one of the receiving account
+ALTER TABLE transfers
+  ADD COLUMN IF NOT EXISTS currency TEXT NOT NULL DEFAULT 'EUR',
+  ADD COLUMN IF NOT EXISTS converted_amount NUMERIC(19,3),
+  ADD COLUMN IF NOT EXISTS converted_currency TEXT,
+  ADD COLUMN IF NOT EXISTS fx_rate NUMERIC(20,10),
+  ADD COLUMN IF NOT EXISTS quote_id UUID REFERENCES fx_quotes(id);
+
+UPDATE transfers t SET currency = a.currency FROM accounts a WHERE a.id = t.from_account;
+
+-- Movements of converted transfers record both sides of the conversion and its rate
+ALTER TABLE movements
+  ADD COLUMN IF NOT EXISTS original_amount NUMERIC(19,3),
+  ADD COLUMN IF NOT EXISTS original_currency TEXT,
+  ADD COLUMN IF NOT EXISTS converted_amount NUMERIC(19,3),
+  ADD COLUMN IF NOT EXISTS converted_currency TEXT,
+  ADD COLUMN IF NOT EXISTS fx_rate NUMERIC(20,10);
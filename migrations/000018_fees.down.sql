@@ -0,0 +1,3 @@
+ALTER TABLE transfers
+  DROP COLUMN IF EXISTS fee_breakdown,
+  DROP COLUMN IF EXISTS fee;
@@ -0,0 +1,5 @@
+-- Fees charged on top of a transfer to the sending account, posted to the `fees` system account of its currency.
+-- fee_breakdown records how the fee was computed from the rule of the transfer's operation type.
+ALTER TABLE transfers
+  ADD COLUMN IF NOT EXISTS fee NUMERIC(19,3) NOT NULL DEFAULT 0 CHECK (fee >= 0),
+  ADD COLUMN IF NOT EXISTS fee_breakdown JSONB;
@@ -0,0 +1,17 @@
+DROP TABLE IF EXISTS transfer_approvals;
+
+DROP INDEX IF EXISTS idx_transfers_awaiting_approval;
+
+UPDATE transfers SET status = 'failed' WHERE status = 'awaiting_approval';
+ALTER TABLE transfers DROP CONSTRAINT IF EXISTS transfers_status_check;
+ALTER TABLE transfers ADD CONSTRAINT transfers_status_check
+  CHECK (status IN ('scheduled','pending','completed','failed','cancelled'));
+
+ALTER TABLE transfers DROP COLUMN IF EXISTS approval_expires_at;
+
+DROP TABLE IF EXISTS account_cosigners;
+
+UPDATE accounts SET type = 'checking' WHERE type = 'business';
+ALTER TABLE accounts DROP CONSTRAINT IF EXISTS accounts_type_check;
+ALTER TABLE accounts ADD CONSTRAINT accounts_type_check
+  CHECK (type IN ('checking','savings','pocket'));
@@ -0,0 +1,36 @@
+-- Business accounts and their co-signers, the other users who can approve transfers sent from them
+ALTER TABLE accounts DROP CONSTRAINT IF EXISTS accounts_type_check;
+ALTER TABLE accounts ADD CONSTRAINT accounts_type_check
+  CHECK (type IN ('checking','savings','pocket','business'));
+
+CREATE TABLE IF NOT EXISTS account_cosigners (
+  account_id UUID NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+  user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  PRIMARY KEY (account_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_account_cosigners_user_id ON account_cosigners(user_id);
+
+-- Transfers above the approval threshold from business accounts wait for a co-signer until approval_expires_at
+ALTER TABLE transfers ADD COLUMN IF NOT EXISTS approval_expires_at TIMESTAMPTZ;
+
+ALTER TABLE transfers DROP CONSTRAINT IF EXISTS transfers_status_check;
+ALTER TABLE transfers ADD CONSTRAINT transfers_status_check
+  CHECK (status IN ('scheduled','awaiting_approval','pending','completed','failed','cancelled'));
+
+CREATE INDEX IF NOT EXISTS idx_transfers_awaiting_approval ON transfers(approval_expires_at)
+  WHERE status = 'awaiting_approval';
+
+-- Decision trail of the transfers held for approval: who requested, approved or rejected them and when, and
+-- their expiry, recorded with no user
+CREATE TABLE IF NOT EXISTS transfer_approvals (
+  id BIGSERIAL PRIMARY KEY,
+  transfer_id BIGINT NOT NULL REFERENCES transfers(id),
+  user_id UUID REFERENCES users(id),
+  decision TEXT NOT NULL CHECK (decision IN ('requested','approved','rejected','expired')),
+  note TEXT NOT NULL DEFAULT '',
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_transfer_approvals_transfer_id ON transfer_approvals(transfer_id);
@@ -0,0 +1,16 @@
+-- Transfers accepted by the API are executed in the background: the outbox message is written in the same
+-- transaction as the pending transfer and processed by the outbox workers, retried with backoff until
+-- processed_at is set. locked_until hides a message claimed by a worker from the others.
+CREATE TABLE IF NOT EXISTS transfer_outbox (
+  id BIGSERIAL PRIMARY KEY,
+  transfer_id BIGINT NOT NULL UNIQUE REFERENCES transfers(id),
+  attempts INT NOT NULL DEFAULT 0,
+  next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  locked_until TIMESTAMPTZ,
+  last_error TEXT NOT NULL DEFAULT '',
+  processed_at TIMESTAMPTZ,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_transfer_outbox_due ON transfer_outbox(next_attempt_at)
+  WHERE processed_at IS NULL;
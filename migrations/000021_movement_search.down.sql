@@ -0,0 +1,3 @@
+-- pg_trgm is left installed, other database objects may rely on it
+DROP INDEX IF EXISTS idx_movements_account_occurred_at;
+DROP INDEX IF EXISTS idx_movements_description_trgm;
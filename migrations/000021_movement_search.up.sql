@@ -0,0 +1,6 @@
+-- Movement filters: a trigram index serves the case-insensitive substring search on the description, and the
+-- composite index the listing of an account by date, the default sort.
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX IF NOT EXISTS idx_movements_description_trgm ON movements USING GIN (description gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_movements_account_occurred_at ON movements(account_id, occurred_at);
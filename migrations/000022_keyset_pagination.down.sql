@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_transfers_to_account_initiated_at_id;
+DROP INDEX IF EXISTS idx_transfers_from_account_initiated_at_id;
+
+DROP INDEX IF EXISTS idx_movements_account_occurred_at_id;
+CREATE INDEX IF NOT EXISTS idx_movements_account_occurred_at ON movements(account_id, occurred_at);
@@ -0,0 +1,7 @@
+-- Keyset pagination walks the list of an account by (date, id): the indexes end with the id so that a page is read
+-- straight from the index, whichever side of the cursor it starts from.
+DROP INDEX IF EXISTS idx_movements_account_occurred_at;
+CREATE INDEX IF NOT EXISTS idx_movements_account_occurred_at_id ON movements(account_id, occurred_at, id);
+
+CREATE INDEX IF NOT EXISTS idx_transfers_from_account_initiated_at_id ON transfers(from_account, initiated_at, id);
+CREATE INDEX IF NOT EXISTS idx_transfers_to_account_initiated_at_id ON transfers(to_account, initiated_at, id);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_movements_legacy_transfer_id;
+DROP INDEX IF EXISTS idx_movements_journal_entry_id;
@@ -0,0 +1,7 @@
+-- Reconciliation matches movements to transfers through their journal entry, or by the "(Transfer #N)" suffix of
+-- the description for the movements recorded before the ledger existed. The expression must stay identical to
+-- legacyTransferID in the reconciliation repository for the planner to use the index.
+CREATE INDEX IF NOT EXISTS idx_movements_journal_entry_id ON movements(journal_entry_id) WHERE journal_entry_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_movements_legacy_transfer_id
+  ON movements ((substring(description FROM '\(Transfer #([0-9]+)\)$')::BIGINT))
+  WHERE journal_entry_id IS NULL;
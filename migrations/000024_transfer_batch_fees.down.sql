@@ -0,0 +1 @@
+ALTER TABLE transfer_batch_items DROP COLUMN IF EXISTS fee;
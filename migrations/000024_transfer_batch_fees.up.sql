@@ -0,0 +1,4 @@
+-- Fee of the instant transfer a batch item is executed as, reserved by the hold of the batch with its amount and
+-- given back with it when the item is executed
+ALTER TABLE transfer_batch_items
+  ADD COLUMN IF NOT EXISTS fee NUMERIC(19,3) NOT NULL DEFAULT 0 CHECK (fee >= 0);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_transfer_batches_pending;
+CREATE INDEX IF NOT EXISTS idx_transfer_batches_pending ON transfer_batches(created_at) WHERE status = 'pending';
+
+ALTER TABLE transfer_batches DROP COLUMN IF EXISTS locked_until;
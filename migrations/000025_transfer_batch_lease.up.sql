@@ -0,0 +1,7 @@
+-- A batch being processed is leased to one processor until locked_until. Batches left processing past their
+-- lease, e.g. after a crash, are claimed again and resumed.
+ALTER TABLE transfer_batches ADD COLUMN IF NOT EXISTS locked_until TIMESTAMPTZ;
+
+DROP INDEX IF EXISTS idx_transfer_batches_pending;
+CREATE INDEX IF NOT EXISTS idx_transfer_batches_pending ON transfer_batches(created_at)
+  WHERE status IN ('pending', 'processing');
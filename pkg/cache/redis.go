@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"VDM2-BankBE/internal/config"
+	"VDM2-BankBE/internal/model"
 
 	"github.com/go-redis/redis/v8"
 	"github.com/google/uuid"
@@ -148,3 +149,32 @@ func (r *RedisClient) GetOAuthState(ctx context.Context, state string) (string,
 
 	return redirectURL, nil
 }
+
+// SetIdempotencyRecord stores a completed idempotent response
+func (r *RedisClient) SetIdempotencyRecord(ctx context.Context, record *model.IdempotencyKey, ttl time.Duration) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal idempotency record")
+	}
+	key := fmt.Sprintf("idem:%s:%s", record.UserID.String(), record.Key)
+	return r.client.Set(ctx, key, data, ttl).Err()
+}
+
+// GetIdempotencyRecord retrieves a completed idempotent response
+func (r *RedisClient) GetIdempotencyRecord(ctx context.Context, userID uuid.UUID, idempotencyKey string) (*model.IdempotencyKey, error) {
+	key := fmt.Sprintf("idem:%s:%s", userID.String(), idempotencyKey)
+	data, err := r.client.Get(ctx, key).Bytes()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, errors.New("idempotency record not found in cache")
+		}
+		return nil, errors.Wrap(err, "failed to get idempotency record")
+	}
+
+	var record model.IdempotencyKey
+	if err := json.Unmarshal(data, &record); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal idempotency record")
+	}
+
+	return &record, nil
+}